@@ -2,19 +2,25 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
 type (
 	Config struct {
-		App     App
-		GRPC    GRPC
-		HTTP    HTTP
-		PG      PG
-		Log     Log
-		Metrics Metrics
-		Swagger Swagger
+		App          App
+		GRPC         GRPC
+		HTTP         HTTP
+		PG           PG
+		Log          Log
+		Metrics      Metrics
+		Swagger      Swagger
+		Admin        Admin
+		Coverage     Coverage
+		Slots        Slots
+		PickupPoints PickupPoints
+		Quotes       Quotes
 	}
 
 	App struct {
@@ -51,6 +57,37 @@ type (
 	Swagger struct {
 		Enabled bool `env:"SWAGGER_ENABLED" envDefault:"true"`
 	}
+
+	Admin struct {
+		// Token authorizes admin-only operations, they are disabled when it is empty.
+		Token string `env:"ADMIN_TOKEN"`
+	}
+
+	Coverage struct {
+		// RefreshInterval is how often the coverage revision is polled, the index is reloaded when it moves.
+		RefreshInterval time.Duration `env:"COVERAGE_REFRESH_INTERVAL" envDefault:"2s"`
+	}
+
+	PickupPoints struct {
+		// RefreshInterval is how often the pickup point revision is polled, the nearby index is reloaded when it moves.
+		RefreshInterval time.Duration `env:"PICKUP_POINT_REFRESH_INTERVAL" envDefault:"2s"`
+	}
+
+	Slots struct {
+		// HoldTTL is how long a hold keeps slot capacity before it expires unless confirmed.
+		HoldTTL time.Duration `env:"SLOT_HOLD_TTL" envDefault:"10m"`
+		// HoldSweepInterval is how often expired holds are released.
+		HoldSweepInterval time.Duration `env:"SLOT_HOLD_SWEEP_INTERVAL" envDefault:"15s"`
+		// GenerateDays is how many days ahead active templates generate slots.
+		GenerateDays int `env:"SLOT_GENERATE_DAYS" envDefault:"14"`
+		// GenerateInterval is how often slots are generated from active templates.
+		GenerateInterval time.Duration `env:"SLOT_GENERATE_INTERVAL" envDefault:"1h"`
+	}
+
+	Quotes struct {
+		// Timeout bounds a quote, providers not evaluated by then are reported as timed out.
+		Timeout time.Duration `env:"QUOTE_TIMEOUT" envDefault:"1s"`
+	}
 )
 
 func NewConfig() (*Config, error) {
@@ -59,5 +96,37 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("config error: %w", err)
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+
 	return cfg, nil
 }
+
+// validate rejects values env.Parse accepts but the service cannot run with, tickers panic on a non-positive interval.
+func (cfg *Config) validate() error {
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"COVERAGE_REFRESH_INTERVAL", cfg.Coverage.RefreshInterval},
+		{"PICKUP_POINT_REFRESH_INTERVAL", cfg.PickupPoints.RefreshInterval},
+		{"SLOT_HOLD_TTL", cfg.Slots.HoldTTL},
+		{"SLOT_HOLD_SWEEP_INTERVAL", cfg.Slots.HoldSweepInterval},
+		{"SLOT_GENERATE_INTERVAL", cfg.Slots.GenerateInterval},
+		{"QUOTE_TIMEOUT", cfg.Quotes.Timeout},
+	}
+
+	for _, d := range durations {
+		if d.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", d.name, d.value)
+		}
+	}
+
+	// Previews default to the generation horizon and cover at most 31 days.
+	if cfg.Slots.GenerateDays <= 0 || cfg.Slots.GenerateDays > 31 {
+		return fmt.Errorf("SLOT_GENERATE_DAYS must be within 1..31, got %d", cfg.Slots.GenerateDays)
+	}
+
+	return nil
+}
@@ -15,9 +15,145 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/coverage": {
+            "get": {
+                "description": "Finds active providers with a delivery zone containing the position, ordered by provider ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coverage"
+                ],
+                "summary": "Look up coverage",
+                "operationId": "coverageLookup",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude in degrees",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude in degrees",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.coverageLookupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup-points:nearby": {
+            "get": {
+                "description": "Finds pickup points of active providers nearest to the position, nearest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Find nearby pickup points",
+                "operationId": "pickupPointsNearby",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude in degrees",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude in degrees",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Search radius in meters, 5000 by default, 50000 at most",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of points, 10 by default, 100 at most",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Providers to keep",
+                        "name": "provider_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Point types to keep",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointsNearbyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
         "/providers": {
             "get": {
-                "description": "List all available providers registered in the system",
+                "description": "List providers registered in the system page by page",
                 "consumes": [
                     "application/json"
                 ],
@@ -29,14 +165,89 @@ const docTemplate = `{
                 ],
                 "summary": "List all providers",
                 "operationId": "providerListAll",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name prefix",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp, inclusive",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp, exclusive",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp, inclusive",
+                        "name": "updated_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 timestamp, exclusive",
+                        "name": "updated_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "provider_id (default) or created_at, optionally followed by asc or desc",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return archived providers",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Lifecycle statuses to keep: onboarding, active, suspended, terminated",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Labels to match, e.g. region=msk,tier in (gold,silver),!legacy",
+                        "name": "label_selector",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.providerEntityResponse"
-                            }
+                            "$ref": "#/definitions/v1.providerListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
                         }
                     },
                     "500": {
@@ -100,8 +311,57 @@ const docTemplate = `{
             }
         },
         "/providers/{providerID}": {
+            "get": {
+                "description": "Returns a delivery provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Get a provider",
+                "operationId": "providerGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
             "put": {
-                "description": "Updates a delivery provider",
+                "description": "Replaces all updatable fields of a delivery provider",
                 "consumes": [
                     "application/json"
                 ],
@@ -121,6 +381,12 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "ETag of the provider version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
                     {
                         "description": "Provider update parameters",
                         "name": "body",
@@ -150,6 +416,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.responseError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -159,7 +431,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes a delivery provider",
+                "description": "Archives a delivery provider, it is hidden from listing until restored",
                 "consumes": [
                     "application/json"
                 ],
@@ -169,7 +441,7 @@ const docTemplate = `{
                 "tags": [
                     "Provider"
                 ],
-                "summary": "Delete a provider",
+                "summary": "Archive a provider",
                 "operationId": "providerDelete",
                 "parameters": [
                     {
@@ -178,6 +450,12 @@ const docTemplate = `{
                         "name": "providerID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the provider version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -196,6 +474,82 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.responseError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates only the fields present in the request body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Partially update a provider",
+                "operationId": "providerPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the provider version being updated",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Provider fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -204,75 +558,6295 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "v1.providerCreateRequest": {
-            "type": "object",
-            "required": [
-                "name",
-                "provider_id"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "Купер"
-                },
-                "provider_id": {
-                    "type": "string",
+        },
+        "/providers/{providerID}/calendar": {
+            "get": {
+                "description": "Returns the working calendar of a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Get a provider calendar",
+                "operationId": "calendarGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.calendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the working calendar of a provider as a whole",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Update a provider calendar",
+                "operationId": "calendarUpdate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Calendar",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_providers_controller_http_routes_v1.calendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.calendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Sets up the working hours, order cut-off times and holidays of a provider, a provider has at most one calendar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Create a provider calendar",
+                "operationId": "calendarCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Calendar",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_providers_controller_http_routes_v1.calendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.calendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the working calendar of a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Delete a provider calendar",
+                "operationId": "calendarDelete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/calendar/holidays:import": {
+            "post": {
+                "description": "Reads holidays from the events of an iCalendar (RFC 5545) file, an event spanning several days gives\na holiday for each. Dates are taken in the calendar time zone. Imported holidays are merged into the\nstored ones by date, or replace them all. Cancelled events are skipped, recurring events are rejected.",
+                "consumes": [
+                    "text/calendar"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Import provider holidays",
+                "operationId": "calendarHolidaysImport",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Replace the stored holidays instead of merging",
+                        "name": "replace",
+                        "in": "query"
+                    },
+                    {
+                        "description": "iCalendar file",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.calendarResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/history": {
+            "get": {
+                "description": "Lists recorded changes of a delivery provider, newest first. History outlives purged providers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Provider history",
+                "operationId": "providerHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/holds/{holdID}:confirm": {
+            "post": {
+                "description": "Turns an unexpired hold into a booking. Confirming a confirmed hold changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Confirm a slot hold",
+                "operationId": "slotConfirm",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "holdID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotHoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/holds/{holdID}:release": {
+            "post": {
+                "description": "Gives the capacity of a hold or a booking back to its slot. Releasing a released or expired hold changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Release a slot hold",
+                "operationId": "slotRelease",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Hold ID",
+                        "name": "holdID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotHoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/pickup-points": {
+            "get": {
+                "description": "Lists pickup points of a provider ordered by ID page by page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "List pickup points",
+                "operationId": "pickupPointListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a pickup point, a parcel locker or a post office to a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Create a pickup point",
+                "operationId": "pickupPointCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pickup point create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/pickup-points/{pointID}": {
+            "get": {
+                "description": "Returns a pickup point of a provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Get a pickup point",
+                "operationId": "pickupPointGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pickup point ID",
+                        "name": "pointID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces all updatable fields of a pickup point",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Update a pickup point",
+                "operationId": "pickupPointUpdate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pickup point ID",
+                        "name": "pointID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pickup point version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Pickup point update parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a pickup point of a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Delete a pickup point",
+                "operationId": "pickupPointDelete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pickup point ID",
+                        "name": "pointID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pickup point version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates only the fields present in the request body, an empty opening_hours list makes every day a day off",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PickupPoint"
+                ],
+                "summary": "Partially update a pickup point",
+                "operationId": "pickupPointPatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pickup point ID",
+                        "name": "pointID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the pickup point version being updated",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Pickup point fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.pickupPointUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slot-templates": {
+            "get": {
+                "description": "Lists slot templates of a provider ordered by ID page by page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "List slot templates",
+                "operationId": "slotTemplateListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds an inactive weekly slot template to a zone of a provider. Windows are local to the time zone of the template",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Create a slot template",
+                "operationId": "slotTemplateCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slot template create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slot-templates/{templateID}": {
+            "get": {
+                "description": "Returns a slot template of a provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Get a slot template",
+                "operationId": "slotTemplateGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "templateID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a slot template, slots it has generated are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Delete a slot template",
+                "operationId": "slotTemplateDelete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "templateID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slot-templates/{templateID}:activate": {
+            "post": {
+                "description": "Starts generating slots from a template, slots within the generation horizon are generated right away.\nWindows that already have a slot are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Activate a slot template",
+                "operationId": "slotTemplateActivate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "templateID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateActivateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slot-templates/{templateID}:deactivate": {
+            "post": {
+                "description": "Stops generating slots from a template, slots already generated are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Deactivate a slot template",
+                "operationId": "slotTemplateDeactivate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "templateID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplateDeactivateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slot-templates/{templateID}:preview": {
+            "get": {
+                "description": "Lists the slots a template would generate within a period ordered by start, nothing is stored.\nThe period defaults to the generation horizon from now and is at most 31 days long",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SlotTemplate"
+                ],
+                "summary": "Preview a slot template",
+                "operationId": "slotTemplatePreview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template ID",
+                        "name": "templateID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting at or after this time, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting before this time, RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotTemplatePreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slots": {
+            "get": {
+                "description": "Lists delivery slots of a provider ordered by start page by page, closed slots are skipped by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "List delivery slots",
+                "operationId": "slotListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zone_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting at or after this time, RFC 3339",
+                        "name": "starts_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting before this time, RFC 3339",
+                        "name": "starts_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also list closed slots",
+                        "name": "include_closed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a delivery slot to a zone of a provider. The slot lasts at most 24 hours and must end in the future",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Create a delivery slot",
+                "operationId": "slotCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slot create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slots/{slotID}": {
+            "get": {
+                "description": "Returns a delivery slot of a provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Get a delivery slot",
+                "operationId": "slotGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Slot ID",
+                        "name": "slotID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slots/{slotID}:close": {
+            "post": {
+                "description": "Stops bookings of a delivery slot, existing bookings are kept. Closing a closed slot changes nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Close a delivery slot",
+                "operationId": "slotClose",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Slot ID",
+                        "name": "slotID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotCloseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/slots/{slotID}:hold": {
+            "post": {
+                "description": "Holds capacity of an open slot at checkout. The hold expires and gives the capacity back unless confirmed in time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Hold a delivery slot",
+                "operationId": "slotHold",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Slot ID",
+                        "name": "slotID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hold parameters",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotHoldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/tariffs": {
+            "get": {
+                "description": "Lists tariffs of a provider ordered by ID page by page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "List tariffs",
+                "operationId": "tariffListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a tariff to a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "Create a tariff",
+                "operationId": "tariffCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tariff create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/tariffs/{tariffID}": {
+            "get": {
+                "description": "Returns a tariff of a provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "Get a tariff",
+                "operationId": "tariffGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "tariffID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a tariff of a provider none of whose revisions has taken effect",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "Delete a tariff",
+                "operationId": "tariffDelete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "tariffID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/tariffs/{tariffID}/revisions": {
+            "get": {
+                "description": "Lists revisions of a tariff ordered by number",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "List tariff revisions",
+                "operationId": "tariffRevisionListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "tariffID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffRevisionListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Schedules a revision of a tariff, revisions never change once created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "Create a tariff revision",
+                "operationId": "tariffRevisionCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "tariffID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tariff revision create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffRevisionCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.tariffRevisionCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/tariffs/{tariffID}:evaluate": {
+            "post": {
+                "description": "Prices parcels carried between two positions by a tariff, nothing is stored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tariff"
+                ],
+                "summary": "Evaluate a tariff",
+                "operationId": "tariffEvaluate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tariff ID",
+                        "name": "tariffID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shipment to price",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_providers_controller_http_routes_v1.shipmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.priceBreakdownResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/zones": {
+            "get": {
+                "description": "Lists delivery zones of a provider ordered by ID page by page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "List delivery zones",
+                "operationId": "zoneListAll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default, 500 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token of the next page from a previous response",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneListAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a delivery zone to a provider. Rings of the geometry must be closed and must not intersect,\nholes must lie within the exterior ring and coordinates must be within bounds",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "Create a delivery zone",
+                "operationId": "zoneCreate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Zone create parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/zones/{zoneID}": {
+            "get": {
+                "description": "Returns a delivery zone of a provider by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "Get a delivery zone",
+                "operationId": "zoneGet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zoneID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces all updatable fields of a delivery zone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "Update a delivery zone",
+                "operationId": "zoneUpdate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zoneID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the zone version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Zone update parameters",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a delivery zone of a provider",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "Delete a delivery zone",
+                "operationId": "zoneDelete",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zoneID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the zone version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates only the fields present in the request body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Zone"
+                ],
+                "summary": "Partially update a delivery zone",
+                "operationId": "zonePatch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zoneID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the zone version being updated",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Zone fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.zonePatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.zoneUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}/zones/{zoneID}/availability": {
+            "get": {
+                "description": "Lists open slots of a zone with free capacity that have not started yet, ordered by start.\nThe period defaults to a week from now and is at most 31 days long",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Slot"
+                ],
+                "summary": "Delivery slot availability",
+                "operationId": "slotAvailability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Zone ID",
+                        "name": "zoneID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting at or after this time, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slots starting before this time, RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.slotAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}:activate": {
+            "post": {
+                "description": "Moves a delivery provider from onboarding or suspended to active",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Activate a provider",
+                "operationId": "providerActivate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}:purge": {
+            "post": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "Deletes a delivery provider permanently, archived or not. Requires the admin token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Purge a provider",
+                "operationId": "providerPurge",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}:restore": {
+            "post": {
+                "description": "Restores an archived delivery provider, one that is not archived is returned unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Restore a provider",
+                "operationId": "providerRestore",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerRestoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}:suspend": {
+            "post": {
+                "description": "Temporarily takes an active delivery provider out of service, a reason is required",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Suspend a provider",
+                "operationId": "providerSuspend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Suspension reason",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerSuspendRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers/{providerID}:terminate": {
+            "post": {
+                "description": "Permanently takes a delivery provider out of service, terminated providers can not be reactivated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Terminate a provider",
+                "operationId": "providerTerminate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Provider ID",
+                        "name": "providerID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Termination reason",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerTerminateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers:export": {
+            "get": {
+                "description": "Streams the provider catalog ordered by ID as CSV (the import format plus read-only columns) or NDJSON.\nThe output can be imported back. An export interrupted by an error ends early without a trailer",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Export providers",
+                "operationId": "providerExport",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "csv (default) or ndjson",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive name prefix",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also export archived providers",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Lifecycle statuses to keep",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers:import": {
+            "post": {
+                "description": "Creates providers or updates them from a CSV file with a header row or from NDJSON. Fields missing from a row\nor left empty keep their stored values. Every row is validated and the import is applied in a single\ntransaction only if all rows succeed, otherwise nothing is changed.\nCSV columns: provider_id, name, legal_name, tax_id, website, countries (separated by \";\"), contacts (JSON array),\ncourier, pickup_points, lockers, same_day, cash_on_delivery, display_names (JSON object keyed by language tag), labels (JSON object).",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Import providers",
+                "operationId": "providerImport",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only report what would happen",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV or NDJSON file",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerImportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/providers:search": {
+            "get": {
+                "description": "Finds delivery providers by words of the name, display names or legal name, best matches first. Cyrillic and Latin spellings match each other and typos are tolerated. Archived providers are never returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Provider"
+                ],
+                "summary": "Search providers",
+                "operationId": "providerSearch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query, e.g. купер or kuper",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results, 20 by default, 100 at most",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.providerSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/quotes": {
+            "post": {
+                "description": "Prices a shipment by every active provider delivering to its destination. Providers not evaluated before the deadline are reported as failures instead of delaying the response",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Quote"
+                ],
+                "summary": "Quote a delivery",
+                "operationId": "quoteDelivery",
+                "parameters": [
+                    {
+                        "description": "Shipment to price",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_providers_controller_http_routes_v1.shipmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.quoteDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.responseError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.AuditAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete",
+                "restore",
+                "purge",
+                "status_change"
+            ],
+            "x-enum-varnames": [
+                "AuditActionCreate",
+                "AuditActionUpdate",
+                "AuditActionDelete",
+                "AuditActionRestore",
+                "AuditActionPurge",
+                "AuditActionStatusChange"
+            ]
+        },
+        "entity.Capabilities": {
+            "type": "object",
+            "properties": {
+                "cash_on_delivery": {
+                    "type": "boolean"
+                },
+                "courier": {
+                    "type": "boolean"
+                },
+                "lockers": {
+                    "type": "boolean"
+                },
+                "pickup_points": {
+                    "type": "boolean"
+                },
+                "same_day": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "entity.Contact": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "description": "Phone is in E.164 format.",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.HoldStatus": {
+            "type": "string",
+            "enum": [
+                "held",
+                "confirmed",
+                "released",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "HoldStatusHeld",
+                "HoldStatusConfirmed",
+                "HoldStatusReleased",
+                "HoldStatusExpired"
+            ]
+        },
+        "entity.ImportAction": {
+            "type": "string",
+            "enum": [
+                "created",
+                "updated",
+                "unchanged",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ImportActionCreated",
+                "ImportActionUpdated",
+                "ImportActionUnchanged",
+                "ImportActionFailed"
+            ]
+        },
+        "entity.LegalEntity": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "tax_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PaymentOption": {
+            "type": "string",
+            "enum": [
+                "prepaid",
+                "cash",
+                "card"
+            ],
+            "x-enum-varnames": [
+                "PaymentOptionPrepaid",
+                "PaymentOptionCash",
+                "PaymentOptionCard"
+            ]
+        },
+        "entity.PickupPointType": {
+            "type": "string",
+            "enum": [
+                "pvz",
+                "locker",
+                "post_office"
+            ],
+            "x-enum-varnames": [
+                "PickupPointTypePVZ",
+                "PickupPointTypeLocker",
+                "PickupPointTypePostOffice"
+            ]
+        },
+        "entity.PriceLineKind": {
+            "type": "string",
+            "enum": [
+                "weight",
+                "distance",
+                "surcharge",
+                "min_price",
+                "max_price"
+            ],
+            "x-enum-varnames": [
+                "PriceLineWeight",
+                "PriceLineDistance",
+                "PriceLineSurcharge",
+                "PriceLineMinPrice",
+                "PriceLineMaxPrice"
+            ]
+        },
+        "entity.ProviderStatus": {
+            "type": "string",
+            "enum": [
+                "onboarding",
+                "active",
+                "suspended",
+                "terminated"
+            ],
+            "x-enum-varnames": [
+                "ProviderStatusOnboarding",
+                "ProviderStatusActive",
+                "ProviderStatusSuspended",
+                "ProviderStatusTerminated"
+            ]
+        },
+        "entity.QuoteFailureReason": {
+            "type": "string",
+            "enum": [
+                "timeout",
+                "not_applicable",
+                "internal"
+            ],
+            "x-enum-varnames": [
+                "QuoteFailureTimeout",
+                "QuoteFailureNotApplicable",
+                "QuoteFailureInternal"
+            ]
+        },
+        "entity.ServiceLevel": {
+            "type": "string",
+            "enum": [
+                "economy",
+                "standard",
+                "express",
+                "same_day"
+            ],
+            "x-enum-varnames": [
+                "ServiceLevelEconomy",
+                "ServiceLevelStandard",
+                "ServiceLevelExpress",
+                "ServiceLevelSameDay"
+            ]
+        },
+        "internal_providers_controller_http_routes_v1.calendarRequest": {
+            "type": "object",
+            "required": [
+                "hours",
+                "time_zone"
+            ],
+            "properties": {
+                "cut_offs": {
+                    "description": "CutOffs are within the hours of their weekday, weekdays without one accept orders until closing.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.cutOff"
+                    }
+                },
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.holiday"
+                    }
+                },
+                "hours": {
+                    "description": "Intervals of a weekday must not overlap, weekdays without any are days off.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "time_zone": {
+                    "description": "TimeZone is an IANA name, hours, cut-offs and holidays are local to it.",
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                }
+            }
+        },
+        "internal_providers_controller_http_routes_v1.shipmentRequest": {
+            "type": "object",
+            "required": [
+                "destination",
+                "origin",
+                "parcels"
+            ],
+            "properties": {
+                "at": {
+                    "description": "At prices by the tariff revisions in effect at the time, now by default.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14Z"
+                },
+                "destination": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "origin": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "parcels": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.parcelDimensions"
+                    }
+                }
+            }
+        },
+        "v1.auditEntryResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.AuditAction"
+                        }
+                    ],
+                    "example": "update"
+                },
+                "audit_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "claimed_actor": {
+                    "description": "ClaimedActor is who the caller named in X-Actor, it is not verified.",
+                    "type": "string",
+                    "example": "ops@kuper.ru"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "new_value": {
+                    "description": "NewValue is the provider after the change, absent on purge.",
+                    "type": "object"
+                },
+                "old_value": {
+                    "description": "OldValue is the provider before the change, absent on create.",
+                    "type": "object"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5f0e8a1c-3b2d-4c7a-9d1e-2f3a4b5c6d7e"
+                }
+            }
+        },
+        "v1.calendarEntityResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "cut_offs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.cutOff"
+                    }
+                },
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.holiday"
+                    }
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                }
+            }
+        },
+        "v1.calendarResponse": {
+            "type": "object",
+            "properties": {
+                "calendar": {
+                    "$ref": "#/definitions/v1.calendarEntityResponse"
+                }
+            }
+        },
+        "v1.coverageLookupResponse": {
+            "type": "object",
+            "properties": {
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.coverageMatchResponse"
+                    }
+                }
+            }
+        },
+        "v1.coverageMatchResponse": {
+            "type": "object",
+            "properties": {
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "zone_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "msk-center"
+                    ]
+                }
+            }
+        },
+        "v1.cutOff": {
+            "type": "object",
+            "required": [
+                "at",
+                "weekday"
+            ],
+            "properties": {
+                "at": {
+                    "type": "string",
+                    "example": "14:00"
+                },
+                "weekday": {
+                    "description": "Weekday is ISO, 1 is Monday and 7 is Sunday.",
+                    "type": "integer",
+                    "maximum": 7,
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "v1.distanceBand": {
+            "type": "object",
+            "properties": {
+                "per_km": {
+                    "type": "string",
+                    "example": "2.5"
+                },
+                "price": {
+                    "type": "string",
+                    "example": "150"
+                },
+                "up_to_km": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "v1.eta": {
+            "type": "object",
+            "properties": {
+                "max_days": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "min_days": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.generatedSlotResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00+03:00"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00+03:00"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.holiday": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2026-01-01"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Новый год"
+                }
+            }
+        },
+        "v1.importRowResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ImportAction"
+                        }
+                    ],
+                    "example": "created"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "countries: \"rus\" is not an ISO 3166-1 alpha-2 code: invalid argument"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                }
+            }
+        },
+        "v1.location": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "example": 55.7558
+                },
+                "lon": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "example": 37.6173
+                }
+            }
+        },
+        "v1.nearbyPickupPointResponse": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "description": "Distance is the great-circle distance from the searched position in meters.",
+                    "type": "number",
+                    "example": 111.2
+                },
+                "pickup_point": {
+                    "$ref": "#/definitions/v1.pickupPointEntityResponse"
+                }
+            }
+        },
+        "v1.openingHours": {
+            "type": "object",
+            "required": [
+                "closes",
+                "opens",
+                "weekday"
+            ],
+            "properties": {
+                "closes": {
+                    "description": "Closes \"24:00\" is the end of the day.",
+                    "type": "string",
+                    "example": "21:00"
+                },
+                "opens": {
+                    "type": "string",
+                    "example": "09:00"
+                },
+                "weekday": {
+                    "description": "Weekday is ISO, 1 is Monday and 7 is Sunday.",
+                    "type": "integer",
+                    "maximum": 7,
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "v1.parcelDimensions": {
+            "type": "object",
+            "properties": {
+                "height_cm": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 40
+                },
+                "length_cm": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 60
+                },
+                "weight_g": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 15000
+                },
+                "width_cm": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 40
+                }
+            }
+        },
+        "v1.pickupPointCreateRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "location",
+                "name",
+                "payment_options",
+                "point_id",
+                "type"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "location": {
+                    "description": "Location is required, it is a pointer to tell it from the zero position.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.location"
+                        }
+                    ]
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "description": "Intervals of a weekday must not overlap, weekdays without any are days off.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "point_id": {
+                    "type": "string",
+                    "example": "msk-0001"
+                },
+                "type": {
+                    "enum": [
+                        "pvz",
+                        "locker",
+                        "post_office"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                }
+            }
+        },
+        "v1.pickupPointCreateResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "point_id": {
+                    "type": "string",
+                    "example": "msk-0001"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.pickupPointEntityResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "point_id": {
+                    "type": "string",
+                    "example": "msk-0001"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.pickupPointGetResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "point_id": {
+                    "type": "string",
+                    "example": "msk-0001"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.pickupPointListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "bXNrLTAwMDE"
+                },
+                "pickup_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.pickupPointEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.pickupPointPatchRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "type": {
+                    "enum": [
+                        "pvz",
+                        "locker",
+                        "post_office"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                }
+            }
+        },
+        "v1.pickupPointUpdateRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "location",
+                "name",
+                "payment_options",
+                "type"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "type": {
+                    "enum": [
+                        "pvz",
+                        "locker",
+                        "post_office"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                }
+            }
+        },
+        "v1.pickupPointUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "Москва, Тверская ул., 1"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "location": {
+                    "$ref": "#/definitions/v1.location"
+                },
+                "max_parcel": {
+                    "$ref": "#/definitions/v1.parcelDimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Пункт выдачи на Тверской"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.openingHours"
+                    }
+                },
+                "payment_options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentOption"
+                    },
+                    "example": [
+                        "prepaid",
+                        "card"
+                    ]
+                },
+                "point_id": {
+                    "type": "string",
+                    "example": "msk-0001"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PickupPointType"
+                        }
+                    ],
+                    "example": "pvz"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.pickupPointsNearbyResponse": {
+            "type": "object",
+            "properties": {
+                "pickup_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.nearbyPickupPointResponse"
+                    }
+                }
+            }
+        },
+        "v1.priceBreakdownResponse": {
+            "type": "object",
+            "properties": {
+                "chargeable_weight_g": {
+                    "type": "integer",
+                    "example": 5400
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "distance_m": {
+                    "type": "integer",
+                    "example": 3806
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.priceLineResponse"
+                    }
+                },
+                "revision": {
+                    "description": "Revision of the tariff the price comes from.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "string",
+                    "example": "435.50"
+                }
+            }
+        },
+        "v1.priceLineResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "200.00"
+                },
+                "code": {
+                    "description": "Code is the surcharge of a surcharge line.",
+                    "type": "string",
+                    "example": "fuel"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PriceLineKind"
+                        }
+                    ],
+                    "example": "weight"
+                },
+                "parcel": {
+                    "description": "Parcel is the index of the parcel of a weight line.",
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "v1.providerCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "provider_id"
+            ],
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "display_names": {
+                    "description": "DisplayNames are names shown to customers keyed by BCP-47 language tag.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerCreateResponse": {
+            "type": "object",
+            "properties": {
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                }
+            }
+        },
+        "v1.providerEntityResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "display_name": {
+                    "description": "DisplayName is the display name in the language of Accept-Language, name when there is none.",
+                    "type": "string",
+                    "example": "Kuper"
+                },
+                "display_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ProviderStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "status_reason": {
+                    "description": "StatusReason explains the last suspension or termination.",
+                    "type": "string",
+                    "example": "contract review"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerGetResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "display_name": {
+                    "description": "DisplayName is the display name in the language of Accept-Language, name when there is none.",
+                    "type": "string",
+                    "example": "Kuper"
+                },
+                "display_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ProviderStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "status_reason": {
+                    "description": "StatusReason explains the last suspension or termination.",
+                    "type": "string",
+                    "example": "contract review"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.auditEntryResponse"
+                    }
+                },
+                "next_page_token": {
+                    "type": "string",
+                    "example": "NDI"
+                }
+            }
+        },
+        "v1.providerImportResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.importRowResponse"
+                    }
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "v1.providerListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "eyJvIjoicHJvdmlkZXJfaWQiLCJpZCI6Imt1cGVyIn0"
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.providerEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.providerPatchRequest": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "display_names": {
+                    "description": "DisplayNames are merged into the stored ones language by language, null removes a language.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper"
+                    }
+                },
+                "labels": {
+                    "description": "Labels are merged the same way.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "Купер"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerRestoreResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "display_name": {
+                    "description": "DisplayName is the display name in the language of Accept-Language, name when there is none.",
+                    "type": "string",
+                    "example": "Kuper"
+                },
+                "display_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ProviderStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "status_reason": {
+                    "description": "StatusReason explains the last suspension or termination.",
+                    "type": "string",
+                    "example": "contract review"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerSearchResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.providerSearchResult"
+                    }
+                }
+            }
+        },
+        "v1.providerSearchResult": {
+            "type": "object",
+            "properties": {
+                "provider": {
+                    "$ref": "#/definitions/v1.providerEntityResponse"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 0.83
+                }
+            }
+        },
+        "v1.providerStatusResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "display_name": {
+                    "description": "DisplayName is the display name in the language of Accept-Language, name when there is none.",
+                    "type": "string",
+                    "example": "Kuper"
+                },
+                "display_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ProviderStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "status_reason": {
+                    "description": "StatusReason explains the last suspension or termination.",
+                    "type": "string",
+                    "example": "contract review"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerSuspendRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256,
+                    "example": "contract review"
+                }
+            }
+        },
+        "v1.providerTerminateRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 256,
+                    "example": "contract ended"
+                }
+            }
+        },
+        "v1.providerUpdateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "display_names": {
+                    "description": "DisplayNames and Labels replace all stored ones.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.providerUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "$ref": "#/definitions/entity.Capabilities"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Contact"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "RU",
+                        "KZ"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "display_name": {
+                    "description": "DisplayName is the display name in the language of Accept-Language, name when there is none.",
+                    "type": "string",
+                    "example": "Kuper"
+                },
+                "display_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "en": "Kuper",
+                        "kk": "Купер"
+                    }
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "region": "msk",
+                        "tier": "gold"
+                    }
+                },
+                "legal_entity": {
+                    "$ref": "#/definitions/entity.LegalEntity"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Купер"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ProviderStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "status_reason": {
+                    "description": "StatusReason explains the last suspension or termination.",
+                    "type": "string",
+                    "example": "contract review"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://kuper.ru"
+                }
+            }
+        },
+        "v1.quoteDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.quoteFailureResponse"
+                    }
+                },
+                "options": {
+                    "description": "Options are grouped by currency, cheapest and then fastest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.quoteOptionResponse"
+                    }
+                }
+            }
+        },
+        "v1.quoteFailureResponse": {
+            "type": "object",
+            "properties": {
+                "provider_id": {
+                    "type": "string",
+                    "example": "lavka"
+                },
+                "reason": {
+                    "description": "Reason is timeout, not_applicable or internal.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.QuoteFailureReason"
+                        }
+                    ],
+                    "example": "timeout"
+                }
+            }
+        },
+        "v1.quoteOptionResponse": {
+            "type": "object",
+            "properties": {
+                "eta": {
+                    "$ref": "#/definitions/v1.eta"
+                },
+                "price": {
+                    "$ref": "#/definitions/v1.priceBreakdownResponse"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "service_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ServiceLevel"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "tariff_name": {
+                    "type": "string",
+                    "example": "Стандарт"
+                }
+            }
+        },
+        "v1.responseError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "message"
+                }
+            }
+        },
+        "v1.slotAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.slotCloseResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available is zero once the slot is closed.",
+                    "type": "integer",
+                    "example": 15
+                },
+                "booked": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "capacity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "closed_at": {
+                    "type": "string",
+                    "example": "2025-05-08T18:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00Z"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "slot_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotCreateRequest": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "ends_at",
+                "starts_at",
+                "zone_id"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1,
+                    "example": 20
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00Z"
+                },
+                "starts_at": {
+                    "description": "The slot lasts at most 24 hours and must end in the future.",
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00Z"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotCreateResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available is zero once the slot is closed.",
+                    "type": "integer",
+                    "example": 15
+                },
+                "booked": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "capacity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "closed_at": {
+                    "type": "string",
+                    "example": "2025-05-08T18:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00Z"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "slot_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotEntityResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available is zero once the slot is closed.",
+                    "type": "integer",
+                    "example": 15
+                },
+                "booked": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "capacity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "closed_at": {
+                    "type": "string",
+                    "example": "2025-05-08T18:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00Z"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "slot_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotGetResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "Available is zero once the slot is closed.",
+                    "type": "integer",
+                    "example": 15
+                },
+                "booked": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "capacity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "closed_at": {
+                    "type": "string",
+                    "example": "2025-05-08T18:00:00Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2025-05-09T12:00:00Z"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "slot_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2025-05-09T09:00:00Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotHoldRequest": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "description": "Quantity defaults to 1.",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "v1.slotHoldResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt only matters while the hold is held.",
+                    "type": "string",
+                    "example": "2025-05-08T06:17:14.810915Z"
+                },
+                "hold_id": {
+                    "type": "string",
+                    "example": "5b0c2c1e-8d5f-4f8e-9a57-2f0a4b8f3c11"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slot_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.HoldStatus"
+                        }
+                    ],
+                    "example": "held"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                }
+            }
+        },
+        "v1.slotListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "eyJ0cyI6IjIwMjUtMDUtMDlUMDk6MDA6MDBaIiwiaWQiOjQyfQ"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.slotTemplateActivateResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "description": "ActivatedAt is set while the template generates slots.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateCreateRequest": {
+            "type": "object",
+            "required": [
+                "days",
+                "template_id",
+                "time_zone",
+                "windows",
+                "zone_id"
+            ],
+            "properties": {
+                "days": {
+                    "description": "Every weekday at most once.",
+                    "type": "array",
+                    "maxItems": 7,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "description": "Exclusions are local dates without slots.",
+                    "type": "array",
+                    "maxItems": 366,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "description": "TimeZone is the IANA name the windows are local to.",
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "windows": {
+                    "description": "Windows must not overlap.",
+                    "type": "array",
+                    "maxItems": 48,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateCreateResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "description": "ActivatedAt is set while the template generates slots.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateDay": {
+            "type": "object",
+            "required": [
+                "capacity",
+                "weekday"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1,
+                    "example": 20
+                },
+                "weekday": {
+                    "description": "Weekday is ISO, 1 is Monday and 7 is Sunday.",
+                    "type": "integer",
+                    "maximum": 7,
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "v1.slotTemplateDeactivateResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "description": "ActivatedAt is set while the template generates slots.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateEntityResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "description": "ActivatedAt is set while the template generates slots.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateGetResponse": {
+            "type": "object",
+            "properties": {
+                "activated_at": {
+                    "description": "ActivatedAt is set while the template generates slots.",
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateDay"
+                    }
+                },
+                "exclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-05-09"
+                    ]
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "template_id": {
+                    "type": "string",
+                    "example": "weekdays"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateWindow"
+                    }
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.slotTemplateListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "d2Vla2RheXM"
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.slotTemplateEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.slotTemplatePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.generatedSlotResponse"
+                    }
+                }
+            }
+        },
+        "v1.slotTemplateWindow": {
+            "type": "object",
+            "required": [
+                "end",
+                "start"
+            ],
+            "properties": {
+                "end": {
+                    "description": "End \"24:00\" is the end of the day.",
+                    "type": "string",
+                    "example": "12:00"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "09:00"
+                }
+            }
+        },
+        "v1.surcharge": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "35.50"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "fuel"
+                }
+            }
+        },
+        "v1.tariffCreateRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "name",
+                "tariff_id",
+                "weight_breaks"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "distance_bands": {
+                    "description": "DistanceBands price the route, it is free of charge without any.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "description": "EffectiveFrom defaults to now and may not be in the past. A revision in effect without an end\nis ended at this time, any other overlap is rejected.",
+                    "type": "string",
+                    "example": "2025-06-01T00:00:00Z"
+                },
+                "effective_to": {
+                    "description": "EffectiveTo is absent for a revision in effect until a later one starts.",
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "description": "MinPrice and MaxPrice bound the total, zero means no bound.",
+                    "type": "string",
+                    "example": "300"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Стандарт"
+                },
+                "service_level": {
+                    "description": "ServiceLevel defaults to standard.",
+                    "enum": [
+                        "economy",
+                        "standard",
+                        "express",
+                        "same_day"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ServiceLevel"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "transit_days_max": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "transit_days_min": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "volumetric_divisor": {
+                    "description": "VolumetricDivisor turns cm³ into volumetric kg, zero prices parcels by actual weight only.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "description": "WeightBreaks price a parcel by the first break its chargeable weight fits in.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
+                }
+            }
+        },
+        "v1.tariffCreateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "distance_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "effective_to": {
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "type": "string",
+                    "example": "300"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Стандарт"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "revision": {
+                    "description": "Revision the rules come from, the one in effect or the next one. It is absent once all of them ended.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "service_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ServiceLevel"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "transit_days_max": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "transit_days_min": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "volumetric_divisor": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
+                }
+            }
+        },
+        "v1.tariffEntityResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "distance_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "effective_to": {
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "type": "string",
+                    "example": "300"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Стандарт"
+                },
+                "provider_id": {
+                    "type": "string",
                     "example": "kuper"
+                },
+                "revision": {
+                    "description": "Revision the rules come from, the one in effect or the next one. It is absent once all of them ended.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "service_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ServiceLevel"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "transit_days_max": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "transit_days_min": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "volumetric_divisor": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
                 }
             }
         },
-        "v1.providerCreateResponse": {
+        "v1.tariffGetResponse": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "distance_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "effective_to": {
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "type": "string",
+                    "example": "300"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Стандарт"
+                },
                 "provider_id": {
                     "type": "string",
                     "example": "kuper"
+                },
+                "revision": {
+                    "description": "Revision the rules come from, the one in effect or the next one. It is absent once all of them ended.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "service_level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ServiceLevel"
+                        }
+                    ],
+                    "example": "standard"
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "transit_days_max": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "transit_days_min": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "volumetric_divisor": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
                 }
             }
         },
-        "v1.providerEntityResponse": {
+        "v1.tariffListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "c3RhbmRhcmQ"
+                },
+                "tariffs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.tariffEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.tariffRevisionCreateRequest": {
+            "type": "object",
+            "required": [
+                "weight_breaks"
+            ],
+            "properties": {
+                "distance_bands": {
+                    "description": "DistanceBands price the route, it is free of charge without any.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "description": "EffectiveFrom defaults to now and may not be in the past. A revision in effect without an end\nis ended at this time, any other overlap is rejected.",
+                    "type": "string",
+                    "example": "2025-06-01T00:00:00Z"
+                },
+                "effective_to": {
+                    "description": "EffectiveTo is absent for a revision in effect until a later one starts.",
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "description": "MinPrice and MaxPrice bound the total, zero means no bound.",
+                    "type": "string",
+                    "example": "300"
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "volumetric_divisor": {
+                    "description": "VolumetricDivisor turns cm³ into volumetric kg, zero prices parcels by actual weight only.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "description": "WeightBreaks price a parcel by the first break its chargeable weight fits in.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
+                }
+            }
+        },
+        "v1.tariffRevisionCreateResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "2025-05-08T06:07:14.810915Z"
                 },
-                "name": {
+                "distance_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
                     "type": "string",
-                    "example": "Купер"
+                    "example": "2025-06-01T00:00:00Z"
+                },
+                "effective_to": {
+                    "description": "EffectiveTo is absent while no later revision is scheduled.",
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "type": "string",
+                    "example": "300"
                 },
                 "provider_id": {
                     "type": "string",
                     "example": "kuper"
                 },
-                "updated_at": {
+                "revision": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "volumetric_divisor": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
+                }
+            }
+        },
+        "v1.tariffRevisionListAllResponse": {
+            "type": "object",
+            "properties": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.tariffRevisionResponse"
+                    }
+                }
+            }
+        },
+        "v1.tariffRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string",
                     "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "distance_bands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.distanceBand"
+                    }
+                },
+                "effective_from": {
+                    "type": "string",
+                    "example": "2025-06-01T00:00:00Z"
+                },
+                "effective_to": {
+                    "description": "EffectiveTo is absent while no later revision is scheduled.",
+                    "type": "string",
+                    "example": "2025-09-01T00:00:00Z"
+                },
+                "max_price": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "min_price": {
+                    "type": "string",
+                    "example": "300"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "surcharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.surcharge"
+                    }
+                },
+                "tariff_id": {
+                    "type": "string",
+                    "example": "standard"
+                },
+                "volumetric_divisor": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "weight_breaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.weightBreak"
+                    }
                 }
             }
         },
-        "v1.providerUpdateRequest": {
+        "v1.weightBreak": {
+            "type": "object",
+            "properties": {
+                "per_kg": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "price": {
+                    "type": "string",
+                    "example": "199.90"
+                },
+                "up_to_g": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "v1.zoneCreateRequest": {
             "type": "object",
+            "required": [
+                "geometry",
+                "name",
+                "zone_id"
+            ],
             "properties": {
+                "geometry": {
+                    "description": "Geometry is a GeoJSON Polygon or MultiPolygon, positions are [longitude, latitude].",
+                    "type": "object"
+                },
                 "name": {
                     "type": "string",
-                    "example": "Купер"
+                    "example": "Москва, центр"
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
                 }
             }
         },
-        "v1.providerUpdateResponse": {
+        "v1.zoneCreateResponse": {
+            "type": "object",
+            "properties": {
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.zoneEntityResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "2025-05-08T06:07:14.810915Z"
                 },
+                "geometry": {
+                    "type": "object"
+                },
                 "name": {
                     "type": "string",
-                    "example": "Купер"
+                    "example": "Москва, центр"
                 },
                 "provider_id": {
                     "type": "string",
@@ -281,18 +6855,133 @@ const docTemplate = `{
                 "updated_at": {
                     "type": "string",
                     "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
                 }
             }
         },
-        "v1.responseError": {
+        "v1.zoneGetResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "created_at": {
                     "type": "string",
-                    "example": "message"
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "geometry": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Москва, центр"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
+                }
+            }
+        },
+        "v1.zoneListAllResponse": {
+            "type": "object",
+            "properties": {
+                "next_page_token": {
+                    "type": "string",
+                    "example": "bXNrLWNlbnRlcg"
+                },
+                "zones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.zoneEntityResponse"
+                    }
+                }
+            }
+        },
+        "v1.zonePatchRequest": {
+            "type": "object",
+            "properties": {
+                "geometry": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "Москва, центр"
+                }
+            }
+        },
+        "v1.zoneUpdateRequest": {
+            "type": "object",
+            "required": [
+                "geometry",
+                "name"
+            ],
+            "properties": {
+                "geometry": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Москва, центр"
+                }
+            }
+        },
+        "v1.zoneUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "geometry": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Москва, центр"
+                },
+                "provider_id": {
+                    "type": "string",
+                    "example": "kuper"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-05-08T06:07:14.810915Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "zone_id": {
+                    "type": "string",
+                    "example": "msk-center"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "AdminToken": {
+            "description": "Admin token as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
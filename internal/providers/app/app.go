@@ -36,11 +36,82 @@ func Run(cfg *config.Config) {
 	// ** UseCase **
 	providerUseCase := usecase.NewUseCaseProviders(
 		repo.NewPostgresRepo(pg),
+		repo.NewAuditRepo(pg),
+		pg,
 	)
+	zoneUseCase := usecase.NewUseCaseZones(
+		repo.NewZoneRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+	)
+	coverageUseCase := usecase.NewUseCaseCoverage(
+		repo.NewCoverageRepo(pg),
+	)
+	slotUseCase := usecase.NewUseCaseSlots(
+		repo.NewSlotRepo(pg),
+		repo.NewSlotHoldRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+		cfg.Slots.HoldTTL,
+	)
+	slotTemplateUseCase := usecase.NewUseCaseSlotTemplates(
+		repo.NewSlotTemplateRepo(pg),
+		repo.NewSlotRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+		time.Duration(cfg.Slots.GenerateDays)*24*time.Hour,
+	)
+	pickupPointUseCase := usecase.NewUseCasePickupPoints(
+		repo.NewPickupPointRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+	)
+	pickupPointSearchUseCase := usecase.NewUseCasePickupPointSearch(
+		repo.NewPickupPointIndexRepo(pg),
+	)
+	tariffUseCase := usecase.NewUseCaseTariffs(
+		repo.NewTariffRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+	)
+	quoteUseCase := usecase.NewUseCaseQuotes(
+		coverageUseCase,
+		repo.NewTariffRepo(pg),
+		cfg.Quotes.Timeout,
+	)
+	calendarUseCase := usecase.NewUseCaseCalendars(
+		repo.NewCalendarRepo(pg),
+		repo.NewPostgresRepo(pg),
+		pg,
+	)
+	useCases := usecase.UseCases{
+		Providers:         providerUseCase,
+		Zones:             zoneUseCase,
+		Coverage:          coverageUseCase,
+		Slots:             slotUseCase,
+		SlotTemplates:     slotTemplateUseCase,
+		PickupPoints:      pickupPointUseCase,
+		PickupPointSearch: pickupPointSearchUseCase,
+		Tariffs:           tariffUseCase,
+		Quotes:            quoteUseCase,
+		Calendars:         calendarUseCase,
+	}
 
 	// ** Delivery **
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	// Coverage lookups and nearby pickup point searches are served from memory, both are loaded before the servers start.
+	if err := coverageUseCase.Refresh(ctx); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - coverageUseCase.Refresh: %w", err))
+	}
+	if err := pickupPointSearchUseCase.Refresh(ctx); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - pickupPointSearchUseCase.Refresh: %w", err))
+	}
+	go runPeriodically(ctx, cfg.Coverage.RefreshInterval, l, "coverageUseCase.Refresh", coverageUseCase.Refresh)
+	go runPeriodically(ctx, cfg.PickupPoints.RefreshInterval, l, "pickupPointSearchUseCase.Refresh", pickupPointSearchUseCase.Refresh)
+	go runPeriodically(ctx, cfg.Slots.HoldSweepInterval, l, "slotUseCase.ExpireHolds", slotUseCase.ExpireHolds)
+	go runPeriodically(ctx, cfg.Slots.GenerateInterval, l, "slotTemplateUseCase.Generate", slotTemplateUseCase.Generate)
+
 	// HTTP Server
 	httpServer := httpserver.New(
 		httpserver.Address("", cfg.HTTP.Port),
@@ -48,14 +119,16 @@ func Run(cfg *config.Config) {
 		httpserver.WriteTimeout(time.Duration(cfg.HTTP.WriteTimeoutSeconds)*time.Second),
 		httpserver.ServerShutdownTimeout(time.Duration(cfg.HTTP.ServerShutdownTimeout)*time.Second),
 	)
-	http.NewRouterProvider(httpServer.App, providerUseCase, cfg, l)
+	http.NewRouterProvider(httpServer.App, useCases, cfg, l)
 
 	// GRPC Server
 	grpcServer := grpcserver.New(
 		grpcserver.AddressGRPC("", cfg.GRPC.Port),
 		grpcserver.AddressGateway("", cfg.GRPC.GatewayPort),
+		grpcserver.ErrorTranslation(grpc.TranslateError),
+		grpcserver.GatewayIncomingHeaders("X-Actor", "X-Request-Id"),
 	)
-	grpc.NewRouterProvider(ctx, grpcServer, providerUseCase, l)
+	grpc.NewRouterProvider(ctx, grpcServer, useCases, cfg, l)
 
 	// Start servers
 	httpServer.Run()
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/classydevv/fulfillment/pkg/logger"
+)
+
+// runPeriodically calls fn every interval until ctx is done. Failures are logged and retried on the next tick.
+func runPeriodically(ctx context.Context, interval time.Duration, l logger.Interface, name string, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				l.Error(fmt.Errorf("app - runPeriodically - %s: %w", name, err))
+			}
+		}
+	}
+}
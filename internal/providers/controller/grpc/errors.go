@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const _errorDomain = "providers.fulfillment.classydevv.github.com"
+
+// Stable reason codes attached to errors as errdetails.ErrorInfo, clients may branch on them.
+const (
+	ReasonNotFound            = "NOT_FOUND"
+	ReasonAlreadyExists       = "ALREADY_EXISTS"
+	ReasonInvalidArgument     = "INVALID_ARGUMENT"
+	ReasonStaleETag           = "STALE_ETAG"
+	ReasonPermissionDenied    = "PERMISSION_DENIED"
+	ReasonIllegalTransition   = "ILLEGAL_STATUS_TRANSITION"
+	ReasonSlotUnavailable     = "SLOT_UNAVAILABLE"
+	ReasonHoldNotActive       = "HOLD_NOT_ACTIVE"
+	ReasonTariffNotApplicable = "TARIFF_NOT_APPLICABLE"
+	ReasonRevisionOverlap     = "TARIFF_REVISION_OVERLAP"
+	ReasonTariffInEffect      = "TARIFF_IN_EFFECT"
+	ReasonDeadline            = "DEADLINE_EXCEEDED"
+	ReasonCanceled            = "CANCELED"
+	ReasonInternal            = "INTERNAL"
+)
+
+// TranslateError maps usecase and entity errors to gRPC status errors.
+// Statuses built by the controllers (e.g. validation errors) are passed through without the wrap chain,
+// everything else gets a code, a public message and an ErrorInfo with a stable reason.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	type grpcStatus interface{ GRPCStatus() *status.Status }
+
+	var gs grpcStatus
+	if errors.As(err, &gs) {
+		if st := gs.GRPCStatus(); st != nil && st.Code() != codes.Unknown {
+			return st.Err()
+		}
+	}
+
+	var transitionErr *entity.StatusTransitionError
+
+	switch {
+	case errors.As(err, &transitionErr):
+		return newStatusError(codes.FailedPrecondition, ReasonIllegalTransition, transitionErr)
+	case errors.Is(err, entity.ErrSlotUnavailable):
+		return newStatusError(codes.FailedPrecondition, ReasonSlotUnavailable, entity.ErrSlotUnavailable)
+	case errors.Is(err, entity.ErrHoldNotActive):
+		return newStatusError(codes.FailedPrecondition, ReasonHoldNotActive, entity.ErrHoldNotActive)
+	case errors.Is(err, entity.ErrTariffNotApplicable):
+		return newStatusError(codes.FailedPrecondition, ReasonTariffNotApplicable, entity.ErrTariffNotApplicable)
+	case errors.Is(err, entity.ErrRevisionOverlap):
+		return newStatusError(codes.FailedPrecondition, ReasonRevisionOverlap, entity.ErrRevisionOverlap)
+	case errors.Is(err, entity.ErrTariffInEffect):
+		return newStatusError(codes.FailedPrecondition, ReasonTariffInEffect, entity.ErrTariffInEffect)
+	case errors.Is(err, entity.ErrNotFound):
+		return newStatusError(codes.NotFound, ReasonNotFound, entity.ErrNotFound)
+	case errors.Is(err, entity.ErrAlreadyExists):
+		return newStatusError(codes.AlreadyExists, ReasonAlreadyExists, entity.ErrAlreadyExists)
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return newStatusError(codes.InvalidArgument, ReasonInvalidArgument, entity.ErrInvalidArgument)
+	case errors.Is(err, entity.ErrPreconditionFailed):
+		return newStatusError(codes.FailedPrecondition, ReasonStaleETag, entity.ErrPreconditionFailed)
+	case errors.Is(err, entity.ErrPermissionDenied):
+		return newStatusError(codes.PermissionDenied, ReasonPermissionDenied, entity.ErrPermissionDenied)
+	case errors.Is(err, context.DeadlineExceeded):
+		return newStatusError(codes.DeadlineExceeded, ReasonDeadline, context.DeadlineExceeded)
+	case errors.Is(err, context.Canceled):
+		return newStatusError(codes.Canceled, ReasonCanceled, context.Canceled)
+	default:
+		return newStatusError(codes.Internal, ReasonInternal, entity.ErrInternalServerError)
+	}
+}
+
+func newStatusError(code codes.Code, reason string, public error) error {
+	st, err := status.New(code, public.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: _errorDomain,
+	})
+	if err != nil {
+		return status.Error(code, public.Error())
+	}
+
+	return st.Err()
+}
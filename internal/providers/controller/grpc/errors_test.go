@@ -0,0 +1,143 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/controller/grpc"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslateError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantMsg    string
+		wantReason string
+	}{
+		{
+			name:       "not found",
+			err:        fmt.Errorf("grpc - v1 - ProviderUpdate - uc.Update: %w", entity.ErrNotFound),
+			wantCode:   codes.NotFound,
+			wantMsg:    entity.ErrNotFound.Error(),
+			wantReason: grpc.ReasonNotFound,
+		},
+		{
+			name:       "already exists",
+			err:        fmt.Errorf("grpc - v1 - ProviderCreate - uc.Create: %w", entity.ErrAlreadyExists),
+			wantCode:   codes.AlreadyExists,
+			wantMsg:    entity.ErrAlreadyExists.Error(),
+			wantReason: grpc.ReasonAlreadyExists,
+		},
+		{
+			name:       "invalid argument",
+			err:        fmt.Errorf("usecase: %w", entity.ErrInvalidArgument),
+			wantCode:   codes.InvalidArgument,
+			wantMsg:    entity.ErrInvalidArgument.Error(),
+			wantReason: grpc.ReasonInvalidArgument,
+		},
+		{
+			name:       "stale etag",
+			err:        fmt.Errorf("repo: %w", entity.ErrPreconditionFailed),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrPreconditionFailed.Error(),
+			wantReason: grpc.ReasonStaleETag,
+		},
+		{
+			name: "illegal status transition",
+			err: fmt.Errorf("usecase: %w", &entity.StatusTransitionError{
+				From: entity.ProviderStatusTerminated,
+				To:   entity.ProviderStatusActive,
+			}),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    "illegal status transition: terminated -> active",
+			wantReason: grpc.ReasonIllegalTransition,
+		},
+		{
+			name:       "slot unavailable",
+			err:        fmt.Errorf("usecase: %w", entity.ErrSlotUnavailable),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrSlotUnavailable.Error(),
+			wantReason: grpc.ReasonSlotUnavailable,
+		},
+		{
+			name:       "hold not active",
+			err:        fmt.Errorf("usecase: %w", entity.ErrHoldNotActive),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrHoldNotActive.Error(),
+			wantReason: grpc.ReasonHoldNotActive,
+		},
+		{
+			name:       "tariff not applicable",
+			err:        fmt.Errorf("usecase: %w", entity.ErrTariffNotApplicable),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrTariffNotApplicable.Error(),
+			wantReason: grpc.ReasonTariffNotApplicable,
+		},
+		{
+			name:       "tariff revision overlap",
+			err:        fmt.Errorf("usecase: %w", entity.ErrRevisionOverlap),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrRevisionOverlap.Error(),
+			wantReason: grpc.ReasonRevisionOverlap,
+		},
+		{
+			name:       "tariff in effect",
+			err:        fmt.Errorf("usecase: %w", entity.ErrTariffInEffect),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    entity.ErrTariffInEffect.Error(),
+			wantReason: grpc.ReasonTariffInEffect,
+		},
+		{
+			name:       "deadline exceeded",
+			err:        fmt.Errorf("repo: %w", context.DeadlineExceeded),
+			wantCode:   codes.DeadlineExceeded,
+			wantMsg:    context.DeadlineExceeded.Error(),
+			wantReason: grpc.ReasonDeadline,
+		},
+		{
+			name:       "unknown error hides internals",
+			err:        fmt.Errorf("PostgresRepo - Store - pg.Pool.Exec: %w", errors.New("connection refused")),
+			wantCode:   codes.Internal,
+			wantMsg:    entity.ErrInternalServerError.Error(),
+			wantReason: grpc.ReasonInternal,
+		},
+		{
+			name:     "wrapped status is passed through",
+			err:      fmt.Errorf("grpc - v1 - validate: %w", status.Error(codes.InvalidArgument, "InvalidArgument")),
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "InvalidArgument",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			st, ok := status.FromError(grpc.TranslateError(tt.err))
+
+			require.True(t, ok)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMsg, st.Message())
+
+			if tt.wantReason == "" {
+				return
+			}
+
+			require.Len(t, st.Details(), 1)
+
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, tt.wantReason, info.GetReason())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	config "github.com/classydevv/fulfillment/configs/providers"
 	v1 "github.com/classydevv/fulfillment/internal/providers/controller/grpc/v1"
 	"github.com/classydevv/fulfillment/internal/providers/usecase"
 	"github.com/classydevv/fulfillment/pkg/grpcserver"
@@ -10,9 +11,9 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
-func NewRouterProvider(ctx context.Context, s *grpcserver.Server, uc usecase.Provider, l logger.Interface) {
+func NewRouterProvider(ctx context.Context, s *grpcserver.Server, uc usecase.UseCases, cfg *config.Config, l logger.Interface) {
 	{
-		v1.NewControllerProvider(ctx, s, uc, l)
+		v1.NewControllerProvider(ctx, s, uc, cfg.Admin.Token, l)
 	}
 
 	reflection.Register(s.GRPC.Server)
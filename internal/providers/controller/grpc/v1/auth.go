@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"google.golang.org/grpc/metadata"
+)
+
+// authorizeAdmin expects "authorization: Bearer <token>" metadata, the gateway forwards the HTTP header as is.
+// An empty token disables admin-only methods altogether.
+func authorizeAdmin(ctx context.Context, token string) error {
+	if token == "" {
+		return entity.ErrPermissionDenied
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		provided, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return entity.ErrPermissionDenied
+}
+
+// withPrincipal puts the caller into the context for the audit log, taken from "x-actor" and "x-request-id" metadata.
+func withPrincipal(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return entity.ContextWithPrincipal(ctx, entity.Principal{
+		ClaimedActor: firstValue(md, "x-actor"),
+		RequestID:    firstValue(md, "x-request-id"),
+	})
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
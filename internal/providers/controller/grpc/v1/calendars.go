@@ -0,0 +1,280 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) CalendarCreate(ctx context.Context, req *pb.CalendarCreateRequest) (*pb.CalendarCreateResponse, error) {
+	if err := validateCalendarRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarCreate - validateCalendarRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarCreate - validateCalendarRequest: %w", err)
+	}
+
+	calendar, err := c.calendars.Create(ctx, calendarFromPB(req))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarCreate - calendars.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarCreate - calendars.Create: %w", err)
+	}
+
+	return &pb.CalendarCreateResponse{
+		Calendar: calendarToPB(calendar),
+	}, nil
+}
+
+func (c *controllerProvider) CalendarGet(ctx context.Context, req *pb.CalendarGetRequest) (*pb.CalendarGetResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarGet - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarGet - validateProviderIDRequest: %w", err)
+	}
+
+	calendar, err := c.calendars.GetByID(ctx, entity.ProviderID(req.GetProviderID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarGet - calendars.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarGet - calendars.GetByID: %w", err)
+	}
+
+	return &pb.CalendarGetResponse{
+		Calendar: calendarToPB(calendar),
+	}, nil
+}
+
+func (c *controllerProvider) CalendarUpdate(ctx context.Context, req *pb.CalendarUpdateRequest) (*pb.CalendarUpdateResponse, error) {
+	if err := validateCalendarRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarUpdate - validateCalendarRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarUpdate - validateCalendarRequest: %w", err)
+	}
+
+	calendar, err := c.calendars.Update(ctx, calendarFromPB(req))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarUpdate - calendars.Update: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarUpdate - calendars.Update: %w", err)
+	}
+
+	return &pb.CalendarUpdateResponse{
+		Calendar: calendarToPB(calendar),
+	}, nil
+}
+
+func (c *controllerProvider) CalendarDelete(ctx context.Context, req *pb.CalendarDeleteRequest) (*pb.CalendarDeleteResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarDelete - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarDelete - validateProviderIDRequest: %w", err)
+	}
+
+	if err := c.calendars.Delete(ctx, entity.ProviderID(req.GetProviderID())); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarDelete - calendars.Delete: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarDelete - calendars.Delete: %w", err)
+	}
+
+	return &pb.CalendarDeleteResponse{}, nil
+}
+
+func (c *controllerProvider) CalendarHolidaysImport(ctx context.Context, req *pb.CalendarHolidaysImportRequest) (*pb.CalendarHolidaysImportResponse, error) {
+	if err := validateCalendarHolidaysImportRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarHolidaysImport - validateCalendarHolidaysImportRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarHolidaysImport - validateCalendarHolidaysImportRequest: %w", err)
+	}
+
+	calendar, err := c.calendars.ImportHolidays(ctx, entity.ProviderID(req.GetProviderID()), []byte(req.GetIcs()), req.GetReplace())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CalendarHolidaysImport - calendars.ImportHolidays: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CalendarHolidaysImport - calendars.ImportHolidays: %w", err)
+	}
+
+	return &pb.CalendarHolidaysImportResponse{
+		Calendar: calendarToPB(calendar),
+	}, nil
+}
+
+// calendarRequest is implemented by create and update requests.
+type calendarRequest interface {
+	GetProviderID() string
+	GetTimeZone() string
+	GetHours() []*pb.OpeningHours
+	GetCutOffs() []*pb.CutOff
+	GetHolidays() []*pb.Holiday
+}
+
+// validateCalendarRequest reports missing and malformed fields, both requests carry the whole calendar.
+func validateCalendarRequest(req calendarRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetTimeZone() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "time_zone",
+			Description: "empty",
+		})
+	}
+	if len(req.GetHours()) == 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "hours",
+			Description: "empty",
+		})
+	}
+	for i, hours := range req.GetHours() {
+		if hours.GetWeekday() < 1 || hours.GetWeekday() > 7 {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("hours[%d].weekday", i),
+				Description: "not an ISO weekday within 1..7",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(hours.GetOpens()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("hours[%d].opens", i),
+				Description: "not HH:MM",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(hours.GetCloses()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("hours[%d].closes", i),
+				Description: "not HH:MM",
+			})
+		}
+	}
+	for i, cutOff := range req.GetCutOffs() {
+		if cutOff.GetWeekday() < 1 || cutOff.GetWeekday() > 7 {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("cut_offs[%d].weekday", i),
+				Description: "not an ISO weekday within 1..7",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(cutOff.GetAt()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("cut_offs[%d].at", i),
+				Description: "not HH:MM",
+			})
+		}
+	}
+	for i, holiday := range req.GetHolidays() {
+		if _, err := time.Parse(time.DateOnly, holiday.GetDate()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("holidays[%d].date", i),
+				Description: "not YYYY-MM-DD",
+			})
+		}
+	}
+
+	return fieldViolationsError(violations)
+}
+
+func validateCalendarHolidaysImportRequest(req *pb.CalendarHolidaysImportRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetIcs() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "ics",
+			Description: "empty",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+// calendarFromPB expects a request checked by validateCalendarRequest, malformed hours and cut-offs are dropped.
+func calendarFromPB(req calendarRequest) *entity.Calendar {
+	calendar := &entity.Calendar{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		TimeZone:   req.GetTimeZone(),
+		Hours:      make([]entity.OpeningHours, 0, len(req.GetHours())),
+		CutOffs:    make([]entity.CutOff, 0, len(req.GetCutOffs())),
+		Holidays:   make([]entity.Holiday, len(req.GetHolidays())),
+	}
+
+	for _, hours := range req.GetHours() {
+		opens, err := entity.ParseTimeOfDay(hours.GetOpens())
+		if err != nil {
+			continue
+		}
+
+		closes, err := entity.ParseTimeOfDay(hours.GetCloses())
+		if err != nil {
+			continue
+		}
+
+		calendar.Hours = append(calendar.Hours, entity.OpeningHours{
+			Weekday: time.Weekday(hours.GetWeekday() % 7),
+			Opens:   opens,
+			Closes:  closes,
+		})
+	}
+
+	for _, cutOff := range req.GetCutOffs() {
+		at, err := entity.ParseTimeOfDay(cutOff.GetAt())
+		if err != nil {
+			continue
+		}
+
+		calendar.CutOffs = append(calendar.CutOffs, entity.CutOff{
+			Weekday: time.Weekday(cutOff.GetWeekday() % 7),
+			At:      at,
+		})
+	}
+
+	for i, holiday := range req.GetHolidays() {
+		calendar.Holidays[i] = entity.Holiday{Date: holiday.GetDate(), Name: holiday.GetName()}
+	}
+
+	return calendar
+}
+
+func calendarToPB(calendar *entity.Calendar) *pb.Calendar {
+	c := &pb.Calendar{
+		ProviderID: string(calendar.ProviderID),
+		TimeZone:   calendar.TimeZone,
+		Hours:      make([]*pb.OpeningHours, len(calendar.Hours)),
+		CutOffs:    make([]*pb.CutOff, len(calendar.CutOffs)),
+		Holidays:   make([]*pb.Holiday, len(calendar.Holidays)),
+		CreatedAt:  timestamppb.New(calendar.CreatedAt),
+		UpdatedAt:  timestamppb.New(calendar.UpdatedAt),
+	}
+
+	for i, hours := range calendar.Hours {
+		c.Hours[i] = &pb.OpeningHours{
+			Weekday: isoWeekday(hours.Weekday),
+			Opens:   hours.Opens.String(),
+			Closes:  hours.Closes.String(),
+		}
+	}
+
+	for i, cutOff := range calendar.CutOffs {
+		c.CutOffs[i] = &pb.CutOff{
+			Weekday: isoWeekday(cutOff.Weekday),
+			At:      cutOff.At.String(),
+		}
+	}
+
+	for i, holiday := range calendar.Holidays {
+		c.Holidays[i] = &pb.Holiday{Date: holiday.Date, Name: holiday.Name}
+	}
+
+	return c
+}
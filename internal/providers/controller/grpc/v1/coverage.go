@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+)
+
+func (c *controllerProvider) CoverageLookup(ctx context.Context, req *pb.CoverageLookupRequest) (*pb.CoverageLookupResponse, error) {
+	matches, err := c.coverage.Lookup(ctx, entity.Position{req.GetLon(), req.GetLat()})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - CoverageLookup - coverage.Lookup: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - CoverageLookup - coverage.Lookup: %w", err)
+	}
+
+	response := &pb.CoverageLookupResponse{
+		Matches: make([]*pb.CoverageMatch, len(matches)),
+	}
+
+	for i, match := range matches {
+		zoneIDs := make([]string, len(match.ZoneIDs))
+		for j, zoneID := range match.ZoneIDs {
+			zoneIDs[j] = string(zoneID)
+		}
+
+		response.Matches[i] = &pb.CoverageMatch{
+			ProviderID: string(match.ProviderID),
+			ZoneIds:    zoneIDs,
+		}
+	}
+
+	return response, nil
+}
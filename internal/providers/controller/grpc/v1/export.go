@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/grpc"
+)
+
+func (c *controllerProvider) ProviderExport(req *pb.ProviderExportRequest, stream grpc.ServerStreamingServer[pb.ProviderExportResponse]) error {
+	filter := entity.ProviderFilter{
+		NamePrefix:      req.GetNamePrefix(),
+		IncludeArchived: req.GetIncludeArchived(),
+		Statuses:        statusesFromPB(req.GetStatuses()),
+	}
+
+	err := c.uc.Export(stream.Context(), filter, func(p *entity.Provider) error {
+		localize(stream.Context(), p)
+
+		return stream.Send(&pb.ProviderExportResponse{Provider: providerToPB(p)})
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderExport - uc.Export: %w", err))
+
+		return fmt.Errorf("grpc - v1 - ProviderExport - uc.Export: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) ProviderHistory(ctx context.Context, req *pb.ProviderHistoryRequest) (*pb.ProviderHistoryResponse, error) {
+	if err := validateProviderHistoryRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderHistory - validateProviderHistoryRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderHistory - validateProviderHistoryRequest: %w", err)
+	}
+
+	page, err := c.uc.History(ctx, entity.ProviderID(req.GetProviderID()), entity.HistoryListParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderHistory - uc.History: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderHistory - uc.History: %w", err)
+	}
+
+	entries := make([]*pb.AuditEntry, len(page.Entries))
+
+	for i, e := range page.Entries {
+		entries[i], err = auditEntryToPB(e)
+		if err != nil {
+			c.l.Error(fmt.Errorf("grpc - v1 - ProviderHistory - auditEntryToPB: %w", err))
+
+			return nil, fmt.Errorf("grpc - v1 - ProviderHistory - auditEntryToPB: %w", err)
+		}
+	}
+
+	return &pb.ProviderHistoryResponse{
+		Entries:       entries,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func validateProviderHistoryRequest(req *pb.ProviderHistoryRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetPageSize() < 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "page_size",
+			Description: "negative",
+		})
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+			&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+
+	return nil
+}
+
+func auditEntryToPB(e *entity.AuditEntry) (*pb.AuditEntry, error) {
+	oldValue, err := snapshotToPB(e.OldValue)
+	if err != nil {
+		return nil, err
+	}
+
+	newValue, err := snapshotToPB(e.NewValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuditEntry{
+		AuditID:      e.AuditID,
+		ProviderID:   string(e.ProviderID),
+		Action:       string(e.Action),
+		OldValue:     oldValue,
+		NewValue:     newValue,
+		ClaimedActor: e.ClaimedActor,
+		RequestID:    e.RequestID,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+	}, nil
+}
+
+func snapshotToPB(raw json.RawMessage) (*structpb.Struct, error) {
+	if len(raw) == 0 {
+		return nil, nil //nolint:nilnil // absent snapshot
+	}
+
+	s := new(structpb.Struct)
+	if err := s.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("structpb.Struct.UnmarshalJSON: %w", err)
+	}
+
+	return s, nil
+}
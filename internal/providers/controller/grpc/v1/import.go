@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/grpc"
+)
+
+func (c *controllerProvider) ProviderImport(stream grpc.ClientStreamingServer[pb.ProviderImportRequest, pb.ProviderImportResponse]) error {
+	ctx := withPrincipal(stream.Context())
+
+	var (
+		rows   []entity.ImportRow
+		dryRun bool
+	)
+
+	for line := 1; ; line++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			c.l.Error(fmt.Errorf("grpc - v1 - ProviderImport - stream.Recv: %w", err))
+
+			return fmt.Errorf("grpc - v1 - ProviderImport - stream.Recv: %w", err)
+		}
+
+		// The usecase rejects such imports anyway, the stream is not read to the end to keep it out of memory.
+		if line > usecase.MaxImportRows {
+			c.l.Error(fmt.Errorf("grpc - v1 - ProviderImport - more than %d rows", usecase.MaxImportRows))
+
+			return fmt.Errorf("grpc - v1 - ProviderImport - more than %d rows: %w", usecase.MaxImportRows, entity.ErrInvalidArgument)
+		}
+
+		if line == 1 {
+			dryRun = req.GetDryRun()
+		}
+
+		if req.GetProvider() == nil {
+			rows = append(rows, entity.ImportRow{Line: line, Err: errors.New("provider is missing")})
+
+			continue
+		}
+
+		rows = append(rows, entity.ImportRow{Line: line, Provider: providerFromCreateRequest(req.GetProvider())})
+	}
+
+	report, err := c.uc.Import(ctx, rows, dryRun)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderImport - uc.Import: %w", err))
+
+		return fmt.Errorf("grpc - v1 - ProviderImport - uc.Import: %w", err)
+	}
+
+	response := &pb.ProviderImportResponse{
+		DryRun:    report.DryRun,
+		Applied:   report.Applied,
+		Created:   int32(report.Created),   //nolint:gosec // bounded by the row limit of the usecase
+		Updated:   int32(report.Updated),   //nolint:gosec // bounded by the row limit of the usecase
+		Unchanged: int32(report.Unchanged), //nolint:gosec // bounded by the row limit of the usecase
+		Failed:    int32(report.Failed),    //nolint:gosec // bounded by the row limit of the usecase
+		Rows:      make([]*pb.ProviderImportRowResult, len(report.Rows)),
+	}
+
+	for i, row := range report.Rows {
+		response.Rows[i] = &pb.ProviderImportRowResult{
+			Line:       int32(row.Line), //nolint:gosec // bounded by the row limit of the usecase
+			ProviderID: string(row.ProviderID),
+			Action:     importActionToPB(row.Action),
+			Error:      row.Error,
+		}
+	}
+
+	return stream.SendAndClose(response)
+}
+
+func importActionToPB(action entity.ImportAction) pb.ProviderImportAction {
+	switch action {
+	case entity.ImportActionCreated:
+		return pb.ProviderImportAction_PROVIDER_IMPORT_ACTION_CREATED
+	case entity.ImportActionUpdated:
+		return pb.ProviderImportAction_PROVIDER_IMPORT_ACTION_UPDATED
+	case entity.ImportActionUnchanged:
+		return pb.ProviderImportAction_PROVIDER_IMPORT_ACTION_UNCHANGED
+	case entity.ImportActionFailed:
+		return pb.ProviderImportAction_PROVIDER_IMPORT_ACTION_FAILED
+	default:
+		return pb.ProviderImportAction_PROVIDER_IMPORT_ACTION_UNSPECIFIED
+	}
+}
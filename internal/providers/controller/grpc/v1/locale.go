@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+// localize picks display names of the providers in the languages of "accept-language" metadata,
+// which has the Accept-Language header format. The gateway forwards the HTTP header with its own prefix.
+func localize(ctx context.Context, providers ...*entity.Provider) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	header := firstValue(md, "accept-language")
+	if header == "" {
+		header = firstValue(md, runtime.MetadataHeaderPrefix+"accept-language")
+	}
+
+	languages := entity.ParseAcceptLanguage(header)
+
+	for _, p := range providers {
+		if p != nil {
+			p.Localize(languages)
+		}
+	}
+}
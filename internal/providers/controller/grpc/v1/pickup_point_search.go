@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+)
+
+func (c *controllerProvider) PickupPointsNearby(ctx context.Context, req *pb.PickupPointsNearbyRequest) (*pb.PickupPointsNearbyResponse, error) {
+	query := entity.PickupPointNearbyQuery{
+		Position:    entity.Position{req.GetLon(), req.GetLat()},
+		RadiusM:     req.GetRadius(),
+		Limit:       int(req.GetLimit()),
+		ProviderIDs: make([]entity.ProviderID, len(req.GetProviderIds())),
+		Types:       make([]entity.PickupPointType, len(req.GetTypes())),
+	}
+
+	for i, providerID := range req.GetProviderIds() {
+		query.ProviderIDs[i] = entity.ProviderID(providerID)
+	}
+
+	// Unspecified types convert to an empty one, the use case rejects it.
+	for i, t := range req.GetTypes() {
+		query.Types[i] = pickupPointTypeFromPB(t)
+	}
+
+	found, err := c.pickupPointSearch.Nearby(ctx, query)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointsNearby - pickupPointSearch.Nearby: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointsNearby - pickupPointSearch.Nearby: %w", err)
+	}
+
+	response := &pb.PickupPointsNearbyResponse{
+		PickupPoints: make([]*pb.NearbyPickupPoint, len(found)),
+	}
+
+	for i, f := range found {
+		response.PickupPoints[i] = &pb.NearbyPickupPoint{
+			PickupPoint: pickupPointToPB(f.Point),
+			Distance:    f.DistanceM,
+		}
+	}
+
+	return response, nil
+}
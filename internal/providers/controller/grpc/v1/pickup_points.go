@@ -0,0 +1,437 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) PickupPointCreate(ctx context.Context, req *pb.PickupPointCreateRequest) (*pb.PickupPointCreateResponse, error) {
+	if err := validatePickupPointRequest(req, true); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointCreate - validatePickupPointRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointCreate - validatePickupPointRequest: %w", err)
+	}
+
+	point := pickupPointFromPB(req)
+	point.ProviderID = entity.ProviderID(req.GetProviderID())
+	point.PointID = entity.PickupPointID(req.GetPointID())
+
+	point, err := c.pickupPoints.Create(ctx, point)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointCreate - pickupPoints.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointCreate - pickupPoints.Create: %w", err)
+	}
+
+	return &pb.PickupPointCreateResponse{
+		PickupPoint: pickupPointToPB(point),
+	}, nil
+}
+
+func (c *controllerProvider) PickupPointGet(ctx context.Context, req *pb.PickupPointGetRequest) (*pb.PickupPointGetResponse, error) {
+	if err := validatePickupPointIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointGet - validatePickupPointIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointGet - validatePickupPointIDRequest: %w", err)
+	}
+
+	point, err := c.pickupPoints.GetByID(ctx, entity.ProviderID(req.GetProviderID()), entity.PickupPointID(req.GetPointID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointGet - pickupPoints.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointGet - pickupPoints.GetByID: %w", err)
+	}
+
+	return &pb.PickupPointGetResponse{
+		PickupPoint: pickupPointToPB(point),
+	}, nil
+}
+
+func (c *controllerProvider) PickupPointListAll(ctx context.Context, req *pb.PickupPointListAllRequest) (*pb.PickupPointListAllResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointListAll - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointListAll - validateProviderIDRequest: %w", err)
+	}
+
+	page, err := c.pickupPoints.ListAll(ctx, entity.PickupPointListParams{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointListAll - pickupPoints.ListAll: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointListAll - pickupPoints.ListAll: %w", err)
+	}
+
+	return &pb.PickupPointListAllResponse{
+		PickupPoints:  pickupPointsToPB(page.PickupPoints),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *controllerProvider) PickupPointUpdate(ctx context.Context, req *pb.PickupPointUpdateRequest) (*pb.PickupPointUpdateResponse, error) {
+	if err := validatePickupPointRequest(req, false); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointUpdate - validatePickupPointRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointUpdate - validatePickupPointRequest: %w", err)
+	}
+
+	version, err := entity.ParseETag(req.GetEtag())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointUpdate - entity.ParseETag: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointUpdate - entity.ParseETag: %w", err)
+	}
+
+	point := pickupPointFromPB(req)
+	point.Version = version
+
+	pointUpdated, err := c.pickupPoints.Update(ctx, entity.ProviderID(req.GetProviderID()), entity.PickupPointID(req.GetPointID()), point, pickupPointUpdateMask(req))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointUpdate - pickupPoints.Update: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointUpdate - pickupPoints.Update: %w", err)
+	}
+
+	return &pb.PickupPointUpdateResponse{
+		PickupPoint: pickupPointToPB(pointUpdated),
+	}, nil
+}
+
+// pickupPointUpdateMask returns the requested mask or, when it is omitted, the populated fields of the request.
+func pickupPointUpdateMask(req *pb.PickupPointUpdateRequest) entity.PickupPointMask {
+	if req.GetUpdateMask() != nil {
+		paths := req.GetUpdateMask().GetPaths()
+		mask := make(entity.PickupPointMask, len(paths))
+
+		for i, path := range paths {
+			mask[i] = entity.PickupPointField(path)
+		}
+
+		return mask
+	}
+
+	var mask entity.PickupPointMask
+
+	if req.GetName() != "" {
+		mask = append(mask, entity.PickupPointFieldName)
+	}
+	if req.GetType() != pb.PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED {
+		mask = append(mask, entity.PickupPointFieldType)
+	}
+	if req.GetAddress() != "" {
+		mask = append(mask, entity.PickupPointFieldAddress)
+	}
+	if req.GetLocation() != nil {
+		mask = append(mask, entity.PickupPointFieldLocation)
+	}
+	if len(req.GetOpeningHours()) > 0 {
+		mask = append(mask, entity.PickupPointFieldOpeningHours)
+	}
+	if len(req.GetPaymentOptions()) > 0 {
+		mask = append(mask, entity.PickupPointFieldPaymentOptions)
+	}
+	if req.GetMaxParcel() != nil {
+		mask = append(mask, entity.PickupPointFieldMaxParcel)
+	}
+
+	return mask
+}
+
+func (c *controllerProvider) PickupPointDelete(ctx context.Context, req *pb.PickupPointDeleteRequest) (*pb.PickupPointDeleteResponse, error) {
+	if err := validatePickupPointIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointDelete - validatePickupPointIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointDelete - validatePickupPointIDRequest: %w", err)
+	}
+
+	version, err := entity.ParseETag(req.GetEtag())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointDelete - entity.ParseETag: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointDelete - entity.ParseETag: %w", err)
+	}
+
+	err = c.pickupPoints.Delete(ctx, entity.ProviderID(req.GetProviderID()), entity.PickupPointID(req.GetPointID()), version)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - PickupPointDelete - pickupPoints.Delete: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - PickupPointDelete - pickupPoints.Delete: %w", err)
+	}
+
+	return &pb.PickupPointDeleteResponse{}, nil
+}
+
+type pickupPointIDRequest interface {
+	GetProviderID() string
+	GetPointID() string
+}
+
+func validatePickupPointIDRequest(req pickupPointIDRequest) error {
+	return fieldViolationsError(pickupPointIDViolations(req))
+}
+
+// pickupPointRequest is implemented by create and update requests.
+type pickupPointRequest interface {
+	pickupPointIDRequest
+	GetName() string
+	GetType() pb.PickupPointType
+	GetAddress() string
+	GetLocation() *pb.Location
+	GetOpeningHours() []*pb.OpeningHours
+	GetPaymentOptions() []pb.PaymentOption
+	GetMaxParcel() *pb.ParcelDimensions
+}
+
+// validatePickupPointRequest reports malformed fields, create requests report missing required fields as well.
+func validatePickupPointRequest(req pickupPointRequest, create bool) error {
+	violations := pickupPointIDViolations(req)
+
+	if create && req.GetName() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "name",
+			Description: "empty",
+		})
+	}
+	if create && req.GetType() == pb.PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "type",
+			Description: "unspecified",
+		})
+	}
+	if create && req.GetAddress() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "address",
+			Description: "empty",
+		})
+	}
+	if create && req.GetLocation() == nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "location",
+			Description: "empty",
+		})
+	}
+	if location := req.GetLocation(); location != nil {
+		if !(location.GetLat() >= -90 && location.GetLat() <= 90) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "location.lat",
+				Description: "not within -90..90",
+			})
+		}
+		if !(location.GetLon() >= -180 && location.GetLon() <= 180) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "location.lon",
+				Description: "not within -180..180",
+			})
+		}
+	}
+	for i, hours := range req.GetOpeningHours() {
+		if hours.GetWeekday() < 1 || hours.GetWeekday() > 7 {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("opening_hours[%d].weekday", i),
+				Description: "not an ISO weekday within 1..7",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(hours.GetOpens()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("opening_hours[%d].opens", i),
+				Description: "not HH:MM",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(hours.GetCloses()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("opening_hours[%d].closes", i),
+				Description: "not HH:MM",
+			})
+		}
+	}
+	if create && len(req.GetPaymentOptions()) == 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "payment_options",
+			Description: "empty",
+		})
+	}
+	for i, option := range req.GetPaymentOptions() {
+		if paymentOptionFromPB(option) == "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("payment_options[%d]", i),
+				Description: "unspecified",
+			})
+		}
+	}
+	if d := req.GetMaxParcel(); d.GetLengthCm() < 0 || d.GetWidthCm() < 0 || d.GetHeightCm() < 0 || d.GetWeightG() < 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "max_parcel",
+			Description: "negative",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+func pickupPointIDViolations(req pickupPointIDRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetPointID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "point_id",
+			Description: "empty",
+		})
+	}
+
+	return violations
+}
+
+// pickupPointFromPB expects a request checked by validatePickupPointRequest, malformed hours are dropped.
+func pickupPointFromPB(req pickupPointRequest) *entity.PickupPoint {
+	point := &entity.PickupPoint{
+		Name:           req.GetName(),
+		Type:           pickupPointTypeFromPB(req.GetType()),
+		Address:        req.GetAddress(),
+		Lat:            req.GetLocation().GetLat(),
+		Lon:            req.GetLocation().GetLon(),
+		OpeningHours:   make([]entity.OpeningHours, 0, len(req.GetOpeningHours())),
+		PaymentOptions: make([]entity.PaymentOption, len(req.GetPaymentOptions())),
+		MaxParcel: entity.ParcelDimensions{
+			LengthCM: int(req.GetMaxParcel().GetLengthCm()),
+			WidthCM:  int(req.GetMaxParcel().GetWidthCm()),
+			HeightCM: int(req.GetMaxParcel().GetHeightCm()),
+			WeightG:  int(req.GetMaxParcel().GetWeightG()),
+		},
+	}
+
+	for _, hours := range req.GetOpeningHours() {
+		opens, err := entity.ParseTimeOfDay(hours.GetOpens())
+		if err != nil {
+			continue
+		}
+
+		closes, err := entity.ParseTimeOfDay(hours.GetCloses())
+		if err != nil {
+			continue
+		}
+
+		point.OpeningHours = append(point.OpeningHours, entity.OpeningHours{
+			Weekday: time.Weekday(hours.GetWeekday() % 7),
+			Opens:   opens,
+			Closes:  closes,
+		})
+	}
+
+	for i, option := range req.GetPaymentOptions() {
+		point.PaymentOptions[i] = paymentOptionFromPB(option)
+	}
+
+	return point
+}
+
+func pickupPointToPB(point *entity.PickupPoint) *pb.PickupPoint {
+	p := &pb.PickupPoint{
+		ProviderID:     string(point.ProviderID),
+		PointID:        string(point.PointID),
+		Name:           point.Name,
+		Type:           pickupPointTypeToPB(point.Type),
+		Address:        point.Address,
+		Location:       &pb.Location{Lat: point.Lat, Lon: point.Lon},
+		OpeningHours:   make([]*pb.OpeningHours, len(point.OpeningHours)),
+		PaymentOptions: make([]pb.PaymentOption, len(point.PaymentOptions)),
+		MaxParcel: &pb.ParcelDimensions{
+			LengthCm: int32(point.MaxParcel.LengthCM), //nolint:gosec // dimensions come from int32 fields
+			WidthCm:  int32(point.MaxParcel.WidthCM),  //nolint:gosec // dimensions come from int32 fields
+			HeightCm: int32(point.MaxParcel.HeightCM), //nolint:gosec // dimensions come from int32 fields
+			WeightG:  int32(point.MaxParcel.WeightG),  //nolint:gosec // dimensions come from int32 fields
+		},
+		CreatedAt: timestamppb.New(point.CreatedAt),
+		UpdatedAt: timestamppb.New(point.UpdatedAt),
+		Etag:      point.ETag(),
+	}
+
+	for i, hours := range point.OpeningHours {
+		p.OpeningHours[i] = &pb.OpeningHours{
+			Weekday: isoWeekday(hours.Weekday),
+			Opens:   hours.Opens.String(),
+			Closes:  hours.Closes.String(),
+		}
+	}
+
+	for i, option := range point.PaymentOptions {
+		p.PaymentOptions[i] = paymentOptionToPB(option)
+	}
+
+	return p
+}
+
+func pickupPointsToPB(points []*entity.PickupPoint) []*pb.PickupPoint {
+	res := make([]*pb.PickupPoint, len(points))
+	for i, point := range points {
+		res[i] = pickupPointToPB(point)
+	}
+
+	return res
+}
+
+func pickupPointTypeFromPB(t pb.PickupPointType) entity.PickupPointType {
+	switch t {
+	case pb.PickupPointType_PICKUP_POINT_TYPE_PVZ:
+		return entity.PickupPointTypePVZ
+	case pb.PickupPointType_PICKUP_POINT_TYPE_LOCKER:
+		return entity.PickupPointTypeLocker
+	case pb.PickupPointType_PICKUP_POINT_TYPE_POST_OFFICE:
+		return entity.PickupPointTypePostOffice
+	default:
+		return ""
+	}
+}
+
+func pickupPointTypeToPB(t entity.PickupPointType) pb.PickupPointType {
+	switch t {
+	case entity.PickupPointTypePVZ:
+		return pb.PickupPointType_PICKUP_POINT_TYPE_PVZ
+	case entity.PickupPointTypeLocker:
+		return pb.PickupPointType_PICKUP_POINT_TYPE_LOCKER
+	case entity.PickupPointTypePostOffice:
+		return pb.PickupPointType_PICKUP_POINT_TYPE_POST_OFFICE
+	default:
+		return pb.PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED
+	}
+}
+
+func paymentOptionFromPB(o pb.PaymentOption) entity.PaymentOption {
+	switch o {
+	case pb.PaymentOption_PAYMENT_OPTION_PREPAID:
+		return entity.PaymentOptionPrepaid
+	case pb.PaymentOption_PAYMENT_OPTION_CASH:
+		return entity.PaymentOptionCash
+	case pb.PaymentOption_PAYMENT_OPTION_CARD:
+		return entity.PaymentOptionCard
+	default:
+		return ""
+	}
+}
+
+func paymentOptionToPB(o entity.PaymentOption) pb.PaymentOption {
+	switch o {
+	case entity.PaymentOptionPrepaid:
+		return pb.PaymentOption_PAYMENT_OPTION_PREPAID
+	case entity.PaymentOptionCash:
+		return pb.PaymentOption_PAYMENT_OPTION_CASH
+	case entity.PaymentOptionCard:
+		return pb.PaymentOption_PAYMENT_OPTION_CARD
+	default:
+		return pb.PaymentOption_PAYMENT_OPTION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+)
+
+func legalEntityToPB(legal entity.LegalEntity) *pb.LegalEntity {
+	return &pb.LegalEntity{
+		Name:  legal.Name,
+		TaxID: legal.TaxID,
+	}
+}
+
+func legalEntityFromPB(legal *pb.LegalEntity) entity.LegalEntity {
+	return entity.LegalEntity{
+		Name:  legal.GetName(),
+		TaxID: legal.GetTaxID(),
+	}
+}
+
+func contactsToPB(contacts []entity.Contact) []*pb.Contact {
+	result := make([]*pb.Contact, len(contacts))
+
+	for i, contact := range contacts {
+		result[i] = &pb.Contact{
+			Name:  contact.Name,
+			Email: contact.Email,
+			Phone: contact.Phone,
+		}
+	}
+
+	return result
+}
+
+func contactsFromPB(contacts []*pb.Contact) []entity.Contact {
+	result := make([]entity.Contact, len(contacts))
+
+	for i, contact := range contacts {
+		result[i] = entity.Contact{
+			Name:  contact.GetName(),
+			Email: contact.GetEmail(),
+			Phone: contact.GetPhone(),
+		}
+	}
+
+	return result
+}
+
+func capabilitiesToPB(capabilities entity.Capabilities) *pb.Capabilities {
+	return &pb.Capabilities{
+		Courier:        capabilities.Courier,
+		PickupPoints:   capabilities.PickupPoints,
+		Lockers:        capabilities.Lockers,
+		SameDay:        capabilities.SameDay,
+		CashOnDelivery: capabilities.CashOnDelivery,
+	}
+}
+
+func capabilitiesFromPB(capabilities *pb.Capabilities) entity.Capabilities {
+	return entity.Capabilities{
+		Courier:        capabilities.GetCourier(),
+		PickupPoints:   capabilities.GetPickupPoints(),
+		Lockers:        capabilities.GetLockers(),
+		SameDay:        capabilities.GetSameDay(),
+		CashOnDelivery: capabilities.GetCashOnDelivery(),
+	}
+}
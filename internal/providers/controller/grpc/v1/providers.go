@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 	"github.com/classydevv/fulfillment/internal/providers/usecase"
@@ -20,13 +21,37 @@ import (
 type controllerProvider struct {
 	pb.UnimplementedProvidersServiceServer
 
-	uc usecase.Provider
-	l  logger.Interface
-	v  *validator.Validate
+	uc                usecase.Provider
+	zones             usecase.Zone
+	coverage          usecase.Coverage
+	slots             usecase.Slot
+	templates         usecase.SlotTemplate
+	pickupPoints      usecase.PickupPoint
+	pickupPointSearch usecase.PickupPointSearch
+	tariffs           usecase.Tariff
+	quotes            usecase.Quote
+	calendars         usecase.Calendar
+	l                 logger.Interface
+	v                 *validator.Validate
+	adminToken        string
 }
 
-func NewControllerProvider(ctx context.Context, s *grpcserver.Server, uc usecase.Provider, l logger.Interface) {
-	c := &controllerProvider{uc: uc, l: l, v: validator.New(validator.WithRequiredStructEnabled())}
+func NewControllerProvider(ctx context.Context, s *grpcserver.Server, uc usecase.UseCases, adminToken string, l logger.Interface) {
+	c := &controllerProvider{
+		uc:                uc.Providers,
+		zones:             uc.Zones,
+		coverage:          uc.Coverage,
+		slots:             uc.Slots,
+		templates:         uc.SlotTemplates,
+		pickupPoints:      uc.PickupPoints,
+		pickupPointSearch: uc.PickupPointSearch,
+		tariffs:           uc.Tariffs,
+		quotes:            uc.Quotes,
+		calendars:         uc.Calendars,
+		l:                 l,
+		v:                 validator.New(validator.WithRequiredStructEnabled()),
+		adminToken:        adminToken,
+	}
 
 	{
 		pb.RegisterProvidersServiceServer(s.GRPC.Server, c)
@@ -35,9 +60,9 @@ func NewControllerProvider(ctx context.Context, s *grpcserver.Server, uc usecase
 }
 
 func (c *controllerProvider) ProviderCreate(ctx context.Context, req *pb.ProviderCreateRequest) (*pb.ProviderCreateResponse, error) {
-	provider := new(entity.Provider)
-	provider.ProviderID = entity.ProviderID(req.GetProviderID())
-	provider.Name = req.GetName()
+	ctx = withPrincipal(ctx)
+
+	provider := providerFromCreateRequest(req)
 
 	if err := validateProviderCreateRequest(req); err != nil {
 		c.l.Error(fmt.Errorf("grpc - v1 - CreateProvider - validateProviderCreateRequest: %w", err))
@@ -61,7 +86,7 @@ func validateProviderCreateRequest(req *pb.ProviderCreateRequest) error {
 	providerID := req.GetProviderID()
 	name := req.GetName()
 	var violations []*errdetails.BadRequest_FieldViolation
-	
+
 	if providerID == "" {
 		violations = append(violations, &errdetails.BadRequest_FieldViolation{
 			Field:       "provider_id",
@@ -89,34 +114,149 @@ func validateProviderCreateRequest(req *pb.ProviderCreateRequest) error {
 	return nil
 }
 
-func (c *controllerProvider) ProviderListAll(ctx context.Context, _ *pb.ProviderListAllRequest) (*pb.ProviderListAllResponse, error) {
-	providersEntity, err := c.uc.ListAll(ctx)
+func providerFromCreateRequest(req *pb.ProviderCreateRequest) *entity.Provider {
+	return &entity.Provider{
+		ProviderID:   entity.ProviderID(req.GetProviderID()),
+		Name:         req.GetName(),
+		LegalEntity:  legalEntityFromPB(req.GetLegalEntity()),
+		Contacts:     contactsFromPB(req.GetContacts()),
+		Website:      req.GetWebsite(),
+		Countries:    req.GetCountries(),
+		Capabilities: capabilitiesFromPB(req.GetCapabilities()),
+		DisplayNames: req.GetDisplayNames(),
+		Labels:       req.GetLabels(),
+	}
+}
+
+func (c *controllerProvider) ProviderGet(ctx context.Context, req *pb.ProviderGetRequest) (*pb.ProviderGetResponse, error) {
+	providerID := entity.ProviderID(req.GetProviderID())
+
+	if err := validateProviderGetRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderGet - validateProviderGetRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderGet - validateProviderGetRequest: %w", err)
+	}
+
+	provider, err := c.uc.GetByID(ctx, providerID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderGet - uc.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderGet - uc.GetByID: %w", err)
+	}
+
+	localize(ctx, provider)
+
+	return &pb.ProviderGetResponse{
+		Provider: providerToPB(provider),
+	}, nil
+}
+
+func validateProviderGetRequest(req *pb.ProviderGetRequest) error {
+	providerID := req.GetProviderID()
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if providerID == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+			&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+
+	return nil
+}
+
+func (c *controllerProvider) ProviderListAll(ctx context.Context, req *pb.ProviderListAllRequest) (*pb.ProviderListAllResponse, error) {
+	if err := validateProviderListAllRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderListAll - validateProviderListAllRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderListAll - validateProviderListAllRequest: %w", err)
+	}
+
+	page, err := c.uc.ListAll(ctx, entity.ProviderListParams{
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		OrderBy:       req.GetOrderBy(),
+		LabelSelector: req.GetLabelSelector(),
+		Filter: entity.ProviderFilter{
+			NamePrefix:      req.GetNamePrefix(),
+			CreatedAfter:    timeFromPB(req.GetCreatedAfter()),
+			CreatedBefore:   timeFromPB(req.GetCreatedBefore()),
+			UpdatedAfter:    timeFromPB(req.GetUpdatedAfter()),
+			UpdatedBefore:   timeFromPB(req.GetUpdatedBefore()),
+			IncludeArchived: req.GetIncludeArchived(),
+			Statuses:        statusesFromPB(req.GetStatuses()),
+		},
+	})
 	if err != nil {
 		c.l.Error(fmt.Errorf("grpc - v1 - ProviderListAll - uc.ListAll: %w", err))
 
 		return nil, fmt.Errorf("grpc - v1 - ProviderListAll - uc.ListAll: %w", err)
 	}
 
-	providers := make([]*pb.Provider, len(providersEntity))
+	localize(ctx, page.Providers...)
 
-	for i, provider := range providersEntity {
-		providers[i] = &pb.Provider{
-			ProviderID: string(provider.ProviderID),
-			Name:       provider.Name,
-			CreatedAt:  timestamppb.New(provider.CreatedAt),
-			UpdatedAt:  timestamppb.New(provider.UpdatedAt),
-		}
+	providers := make([]*pb.Provider, len(page.Providers))
+
+	for i, provider := range page.Providers {
+		providers[i] = providerToPB(provider)
 	}
 
 	return &pb.ProviderListAllResponse{
-		Providers: providers,
+		Providers:     providers,
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
+func validateProviderListAllRequest(req *pb.ProviderListAllRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetPageSize() < 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "page_size",
+			Description: "negative",
+		})
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+			&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+
+	return nil
+}
+
 func (c *controllerProvider) ProviderUpdate(ctx context.Context, req *pb.ProviderUpdateRequest) (*pb.ProviderUpdateResponse, error) {
+	ctx = withPrincipal(ctx)
+
 	provider := new(entity.Provider)
 	provider.ProviderID = entity.ProviderID(req.GetProviderID())
 	provider.Name = req.GetName()
+	provider.LegalEntity = legalEntityFromPB(req.GetLegalEntity())
+	provider.Contacts = contactsFromPB(req.GetContacts())
+	provider.Website = req.GetWebsite()
+	provider.Countries = req.GetCountries()
+	provider.Capabilities = capabilitiesFromPB(req.GetCapabilities())
+	provider.DisplayNames = req.GetDisplayNames()
+	provider.Labels = req.GetLabels()
 
 	if err := validateProviderUpdateRequest(req); err != nil {
 		c.l.Error(fmt.Errorf("grpc - v1 - ProviderUpdate - validateProviderUpdateRequest: %w", err))
@@ -124,21 +264,66 @@ func (c *controllerProvider) ProviderUpdate(ctx context.Context, req *pb.Provide
 		return nil, fmt.Errorf("grpc - v1 - ProviderUpdate - validateProviderUpdateRequest: %w", err)
 	}
 
-	providerUpdated, err := c.uc.Update(ctx, provider.ProviderID, provider)
+	// The etag format is checked by validateProviderUpdateRequest.
+	provider.Version, _ = entity.ParseETag(req.GetEtag())
+
+	providerUpdated, err := c.uc.Update(ctx, provider.ProviderID, provider, providerUpdateMask(req))
 	if err != nil {
 		c.l.Error(fmt.Errorf("grpc - v1 - ProviderUpdate - uc.Update: %w", err))
 
 		return nil, fmt.Errorf("grpc - v1 - ProviderUpdate - uc.Update: %w", err)
 	}
 
+	localize(ctx, providerUpdated)
+
 	return &pb.ProviderUpdateResponse{
-		Provider: &pb.Provider{
-			ProviderID: string(providerUpdated.ProviderID),
-			Name:       providerUpdated.Name,
-		},
+		Provider: providerToPB(providerUpdated),
 	}, nil
 }
 
+// providerUpdateMask returns the requested mask or, when it is omitted, the populated fields of the request.
+func providerUpdateMask(req *pb.ProviderUpdateRequest) entity.ProviderMask {
+	if req.GetUpdateMask() != nil {
+		paths := req.GetUpdateMask().GetPaths()
+		mask := make(entity.ProviderMask, len(paths))
+
+		for i, path := range paths {
+			mask[i] = entity.ProviderField(path)
+		}
+
+		return mask
+	}
+
+	var mask entity.ProviderMask
+
+	if req.GetName() != "" {
+		mask = append(mask, entity.ProviderFieldName)
+	}
+	if req.GetLegalEntity() != nil {
+		mask = append(mask, entity.ProviderFieldLegalEntity)
+	}
+	if len(req.GetContacts()) > 0 {
+		mask = append(mask, entity.ProviderFieldContacts)
+	}
+	if req.GetWebsite() != "" {
+		mask = append(mask, entity.ProviderFieldWebsite)
+	}
+	if len(req.GetCountries()) > 0 {
+		mask = append(mask, entity.ProviderFieldCountries)
+	}
+	if req.GetCapabilities() != nil {
+		mask = append(mask, entity.ProviderFieldCapabilities)
+	}
+	for locale := range req.GetDisplayNames() {
+		mask = append(mask, entity.ProviderFieldDisplayNames.Entry(locale))
+	}
+	for key := range req.GetLabels() {
+		mask = append(mask, entity.ProviderFieldLabels.Entry(key))
+	}
+
+	return mask
+}
+
 func validateProviderUpdateRequest(req *pb.ProviderUpdateRequest) error {
 	providerID := req.GetProviderID()
 	var violations []*errdetails.BadRequest_FieldViolation
@@ -149,6 +334,12 @@ func validateProviderUpdateRequest(req *pb.ProviderUpdateRequest) error {
 			Description: "empty",
 		})
 	}
+	if _, err := entity.ParseETag(req.GetEtag()); err != nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "etag",
+			Description: "malformed",
+		})
+	}
 
 	if len(violations) > 0 {
 		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
@@ -166,6 +357,8 @@ func validateProviderUpdateRequest(req *pb.ProviderUpdateRequest) error {
 }
 
 func (c *controllerProvider) ProviderDelete(ctx context.Context, req *pb.ProviderDeleteRequest) (*pb.ProviderDeleteResponse, error) {
+	ctx = withPrincipal(ctx)
+
 	providerID := entity.ProviderID(req.GetProviderID())
 
 	if err := validateProviderDeleteRequest(req); err != nil {
@@ -174,7 +367,10 @@ func (c *controllerProvider) ProviderDelete(ctx context.Context, req *pb.Provide
 		return nil, fmt.Errorf("grpc - v1 - ProviderDelete - validateProviderDeleteRequest: %w", err)
 	}
 
-	err := c.uc.Delete(ctx, providerID)
+	// The etag format is checked by validateProviderDeleteRequest.
+	version, _ := entity.ParseETag(req.GetEtag())
+
+	err := c.uc.Delete(ctx, providerID, version)
 	if err != nil {
 		c.l.Error(fmt.Errorf("grpc - v1 - ProviderDelete - uc.Delete: %w", err))
 
@@ -193,6 +389,12 @@ func validateProviderDeleteRequest(req *pb.ProviderDeleteRequest) error {
 			Description: "empty",
 		})
 	}
+	if _, err := entity.ParseETag(req.GetEtag()); err != nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "etag",
+			Description: "malformed",
+		})
+	}
 	if len(violations) > 0 {
 		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
 			&errdetails.BadRequest{
@@ -207,3 +409,113 @@ func validateProviderDeleteRequest(req *pb.ProviderDeleteRequest) error {
 
 	return nil
 }
+
+func (c *controllerProvider) ProviderRestore(ctx context.Context, req *pb.ProviderRestoreRequest) (*pb.ProviderRestoreResponse, error) {
+	ctx = withPrincipal(ctx)
+
+	providerID := entity.ProviderID(req.GetProviderID())
+
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderRestore - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderRestore - validateProviderIDRequest: %w", err)
+	}
+
+	provider, err := c.uc.Restore(ctx, providerID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderRestore - uc.Restore: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderRestore - uc.Restore: %w", err)
+	}
+
+	localize(ctx, provider)
+
+	return &pb.ProviderRestoreResponse{
+		Provider: providerToPB(provider),
+	}, nil
+}
+
+func (c *controllerProvider) ProviderPurge(ctx context.Context, req *pb.ProviderPurgeRequest) (*pb.ProviderPurgeResponse, error) {
+	ctx = withPrincipal(ctx)
+
+	providerID := entity.ProviderID(req.GetProviderID())
+
+	if err := authorizeAdmin(ctx, c.adminToken); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderPurge - authorizeAdmin: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderPurge - authorizeAdmin: %w", err)
+	}
+
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderPurge - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderPurge - validateProviderIDRequest: %w", err)
+	}
+
+	err := c.uc.Purge(ctx, providerID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderPurge - uc.Purge: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderPurge - uc.Purge: %w", err)
+	}
+
+	return &pb.ProviderPurgeResponse{}, nil
+}
+
+type providerIDRequest interface {
+	GetProviderID() string
+}
+
+// validateProviderIDRequest validates requests addressing a provider by its ID only.
+func validateProviderIDRequest(req providerIDRequest) error {
+	if req.GetProviderID() != "" {
+		return nil
+	}
+
+	st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "provider_id",
+				Description: "empty",
+			}},
+		})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return st.Err()
+}
+
+func providerToPB(provider *entity.Provider) *pb.Provider {
+	p := &pb.Provider{
+		ProviderID:   string(provider.ProviderID),
+		Name:         provider.Name,
+		CreatedAt:    timestamppb.New(provider.CreatedAt),
+		UpdatedAt:    timestamppb.New(provider.UpdatedAt),
+		Etag:         provider.ETag(),
+		Status:       statusToPB(provider.Status),
+		StatusReason: provider.StatusReason,
+		LegalEntity:  legalEntityToPB(provider.LegalEntity),
+		Contacts:     contactsToPB(provider.Contacts),
+		Website:      provider.Website,
+		Countries:    provider.Countries,
+		Capabilities: capabilitiesToPB(provider.Capabilities),
+		DisplayNames: provider.DisplayNames,
+		DisplayName:  provider.DisplayName,
+		Labels:       provider.Labels,
+	}
+
+	if provider.Archived() {
+		p.DeletedAt = timestamppb.New(*provider.DeletedAt)
+	}
+
+	return p
+}
+
+func timeFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+)
+
+func (c *controllerProvider) QuoteDelivery(ctx context.Context, req *pb.QuoteDeliveryRequest) (*pb.QuoteDeliveryResponse, error) {
+	if err := fieldViolationsError(shipmentViolations(req)); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - QuoteDelivery - shipmentViolations: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - QuoteDelivery - shipmentViolations: %w", err)
+	}
+
+	quote, err := c.quotes.Quote(ctx, shipmentFromPB(req), timeFromPB(req.GetAt()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - QuoteDelivery - quotes.Quote: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - QuoteDelivery - quotes.Quote: %w", err)
+	}
+
+	res := &pb.QuoteDeliveryResponse{
+		Options:  make([]*pb.QuoteOption, len(quote.Options)),
+		Failures: make([]*pb.QuoteFailure, len(quote.Failures)),
+	}
+
+	for i, option := range quote.Options {
+		res.Options[i] = &pb.QuoteOption{
+			ProviderID:   string(option.ProviderID),
+			TariffID:     string(option.TariffID),
+			TariffName:   option.TariffName,
+			ServiceLevel: serviceLevelToPB(option.ServiceLevel),
+			Eta: &pb.Eta{
+				MinDays: int32(option.TransitDaysMin), //nolint:gosec // bounded by the tariff validation
+				MaxDays: int32(option.TransitDaysMax), //nolint:gosec // bounded by the tariff validation
+			},
+			Price: priceBreakdownToPB(option.Price),
+		}
+	}
+
+	for i, failure := range quote.Failures {
+		res.Failures[i] = &pb.QuoteFailure{
+			ProviderID: string(failure.ProviderID),
+			Reason:     quoteFailureReasonToPB(failure.Reason),
+		}
+	}
+
+	return res, nil
+}
+
+func quoteFailureReasonToPB(r entity.QuoteFailureReason) pb.QuoteFailureReason {
+	switch r {
+	case entity.QuoteFailureTimeout:
+		return pb.QuoteFailureReason_QUOTE_FAILURE_REASON_TIMEOUT
+	case entity.QuoteFailureNotApplicable:
+		return pb.QuoteFailureReason_QUOTE_FAILURE_REASON_NOT_APPLICABLE
+	case entity.QuoteFailureInternal:
+		return pb.QuoteFailureReason_QUOTE_FAILURE_REASON_INTERNAL
+	default:
+		return pb.QuoteFailureReason_QUOTE_FAILURE_REASON_UNSPECIFIED
+	}
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+)
+
+func (c *controllerProvider) ProviderSearch(ctx context.Context, req *pb.ProviderSearchRequest) (*pb.ProviderSearchResponse, error) {
+	hits, err := c.uc.Search(ctx, entity.ProviderSearchParams{
+		Query:    req.GetQuery(),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderSearch - uc.Search: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderSearch - uc.Search: %w", err)
+	}
+
+	results := make([]*pb.ProviderSearchResult, len(hits))
+	for i, hit := range hits {
+		localize(ctx, hit.Provider)
+
+		results[i] = &pb.ProviderSearchResult{
+			Provider: providerToPB(hit.Provider),
+			Score:    hit.Score,
+		}
+	}
+
+	return &pb.ProviderSearchResponse{Results: results}, nil
+}
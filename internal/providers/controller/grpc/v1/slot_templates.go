@@ -0,0 +1,332 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) SlotTemplateCreate(ctx context.Context, req *pb.SlotTemplateCreateRequest) (*pb.SlotTemplateCreateResponse, error) {
+	if err := validateSlotTemplateCreateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateCreate - validateSlotTemplateCreateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateCreate - validateSlotTemplateCreateRequest: %w", err)
+	}
+
+	rule, err := slotTemplateRuleFromPB(req.GetDays(), req.GetWindows(), req.GetExclusions())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateCreate - slotTemplateRuleFromPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateCreate - slotTemplateRuleFromPB: %w", err)
+	}
+
+	template, err := c.templates.Create(ctx, &entity.SlotTemplate{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		TemplateID: entity.SlotTemplateID(req.GetTemplateID()),
+		ZoneID:     entity.ZoneID(req.GetZoneID()),
+		TimeZone:   req.GetTimeZone(),
+		Rule:       rule,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateCreate - templates.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateCreate - templates.Create: %w", err)
+	}
+
+	return &pb.SlotTemplateCreateResponse{
+		Template: slotTemplateToPB(template),
+	}, nil
+}
+
+func (c *controllerProvider) SlotTemplateGet(ctx context.Context, req *pb.SlotTemplateGetRequest) (*pb.SlotTemplateGetResponse, error) {
+	if err := validateSlotTemplateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateGet - validateSlotTemplateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateGet - validateSlotTemplateRequest: %w", err)
+	}
+
+	template, err := c.templates.GetByID(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotTemplateID(req.GetTemplateID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateGet - templates.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateGet - templates.GetByID: %w", err)
+	}
+
+	return &pb.SlotTemplateGetResponse{
+		Template: slotTemplateToPB(template),
+	}, nil
+}
+
+func (c *controllerProvider) SlotTemplateListAll(ctx context.Context, req *pb.SlotTemplateListAllRequest) (*pb.SlotTemplateListAllResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateListAll - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateListAll - validateProviderIDRequest: %w", err)
+	}
+
+	page, err := c.templates.ListAll(ctx, entity.SlotTemplateListParams{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateListAll - templates.ListAll: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateListAll - templates.ListAll: %w", err)
+	}
+
+	templates := make([]*pb.SlotTemplate, len(page.Templates))
+	for i, template := range page.Templates {
+		templates[i] = slotTemplateToPB(template)
+	}
+
+	return &pb.SlotTemplateListAllResponse{
+		Templates:     templates,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *controllerProvider) SlotTemplateDelete(ctx context.Context, req *pb.SlotTemplateDeleteRequest) (*pb.SlotTemplateDeleteResponse, error) {
+	if err := validateSlotTemplateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateDelete - validateSlotTemplateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateDelete - validateSlotTemplateRequest: %w", err)
+	}
+
+	if err := c.templates.Delete(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotTemplateID(req.GetTemplateID())); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateDelete - templates.Delete: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateDelete - templates.Delete: %w", err)
+	}
+
+	return &pb.SlotTemplateDeleteResponse{}, nil
+}
+
+func (c *controllerProvider) SlotTemplateActivate(ctx context.Context, req *pb.SlotTemplateActivateRequest) (*pb.SlotTemplateActivateResponse, error) {
+	if err := validateSlotTemplateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateActivate - validateSlotTemplateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateActivate - validateSlotTemplateRequest: %w", err)
+	}
+
+	template, err := c.templates.Activate(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotTemplateID(req.GetTemplateID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateActivate - templates.Activate: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateActivate - templates.Activate: %w", err)
+	}
+
+	return &pb.SlotTemplateActivateResponse{
+		Template: slotTemplateToPB(template),
+	}, nil
+}
+
+func (c *controllerProvider) SlotTemplateDeactivate(ctx context.Context, req *pb.SlotTemplateDeactivateRequest) (*pb.SlotTemplateDeactivateResponse, error) {
+	if err := validateSlotTemplateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateDeactivate - validateSlotTemplateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateDeactivate - validateSlotTemplateRequest: %w", err)
+	}
+
+	template, err := c.templates.Deactivate(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotTemplateID(req.GetTemplateID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplateDeactivate - templates.Deactivate: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplateDeactivate - templates.Deactivate: %w", err)
+	}
+
+	return &pb.SlotTemplateDeactivateResponse{
+		Template: slotTemplateToPB(template),
+	}, nil
+}
+
+func (c *controllerProvider) SlotTemplatePreview(ctx context.Context, req *pb.SlotTemplatePreviewRequest) (*pb.SlotTemplatePreviewResponse, error) {
+	if err := validateSlotTemplateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplatePreview - validateSlotTemplateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplatePreview - validateSlotTemplateRequest: %w", err)
+	}
+
+	slots, err := c.templates.Preview(ctx,
+		entity.ProviderID(req.GetProviderID()),
+		entity.SlotTemplateID(req.GetTemplateID()),
+		timeFromPB(req.GetFrom()),
+		timeFromPB(req.GetTo()),
+	)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotTemplatePreview - templates.Preview: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotTemplatePreview - templates.Preview: %w", err)
+	}
+
+	generated := make([]*pb.GeneratedSlot, len(slots))
+	for i, slot := range slots {
+		generated[i] = &pb.GeneratedSlot{
+			ZoneID:   string(slot.ZoneID),
+			StartsAt: timestamppb.New(slot.StartsAt),
+			EndsAt:   timestamppb.New(slot.EndsAt),
+			Capacity: int32(slot.Capacity), //nolint:gosec // capacity is bounded on create
+		}
+	}
+
+	return &pb.SlotTemplatePreviewResponse{
+		Slots: generated,
+	}, nil
+}
+
+type slotTemplateIDRequest interface {
+	GetProviderID() string
+	GetTemplateID() string
+}
+
+func validateSlotTemplateRequest(req slotTemplateIDRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetTemplateID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "template_id",
+			Description: "empty",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+func validateSlotTemplateCreateRequest(req *pb.SlotTemplateCreateRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetTemplateID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "template_id",
+			Description: "empty",
+		})
+	}
+	if req.GetZoneID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "zone_id",
+			Description: "empty",
+		})
+	}
+	if req.GetTimeZone() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "time_zone",
+			Description: "empty",
+		})
+	}
+	for i, day := range req.GetDays() {
+		if day.GetWeekday() < 1 || day.GetWeekday() > 7 {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("days[%d].weekday", i),
+				Description: "not an ISO weekday within 1..7",
+			})
+		}
+	}
+	for i, window := range req.GetWindows() {
+		if _, err := entity.ParseTimeOfDay(window.GetStart()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("windows[%d].start", i),
+				Description: "not HH:MM",
+			})
+		}
+		if _, err := entity.ParseTimeOfDay(window.GetEnd()); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("windows[%d].end", i),
+				Description: "not HH:MM",
+			})
+		}
+	}
+
+	return fieldViolationsError(violations)
+}
+
+// slotTemplateRuleFromPB expects weekdays and windows checked by validateSlotTemplateCreateRequest.
+func slotTemplateRuleFromPB(days []*pb.SlotTemplateDay, windows []*pb.SlotTemplateWindow, exclusions []string) (entity.SlotTemplateRule, error) {
+	rule := entity.SlotTemplateRule{
+		Days:       make([]entity.SlotTemplateDay, len(days)),
+		Windows:    make([]entity.SlotTemplateWindow, len(windows)),
+		Exclusions: exclusions,
+	}
+
+	for i, day := range days {
+		rule.Days[i] = entity.SlotTemplateDay{
+			Weekday:  time.Weekday(day.GetWeekday() % 7),
+			Capacity: int(day.GetCapacity()),
+		}
+	}
+
+	for i, window := range windows {
+		start, err := entity.ParseTimeOfDay(window.GetStart())
+		if err != nil {
+			return entity.SlotTemplateRule{}, err
+		}
+
+		end, err := entity.ParseTimeOfDay(window.GetEnd())
+		if err != nil {
+			return entity.SlotTemplateRule{}, err
+		}
+
+		rule.Windows[i] = entity.SlotTemplateWindow{Start: start, End: end}
+	}
+
+	return rule, nil
+}
+
+func slotTemplateToPB(template *entity.SlotTemplate) *pb.SlotTemplate {
+	t := &pb.SlotTemplate{
+		ProviderID: string(template.ProviderID),
+		TemplateID: string(template.TemplateID),
+		ZoneID:     string(template.ZoneID),
+		TimeZone:   template.TimeZone,
+		Days:       make([]*pb.SlotTemplateDay, len(template.Rule.Days)),
+		Windows:    make([]*pb.SlotTemplateWindow, len(template.Rule.Windows)),
+		Exclusions: template.Rule.Exclusions,
+		CreatedAt:  timestamppb.New(template.CreatedAt),
+		UpdatedAt:  timestamppb.New(template.UpdatedAt),
+	}
+
+	for i, day := range template.Rule.Days {
+		t.Days[i] = &pb.SlotTemplateDay{
+			Weekday:  isoWeekday(day.Weekday),
+			Capacity: int32(day.Capacity), //nolint:gosec // capacity is bounded on create
+		}
+	}
+
+	for i, window := range template.Rule.Windows {
+		t.Windows[i] = &pb.SlotTemplateWindow{
+			Start: window.Start.String(),
+			End:   window.End.String(),
+		}
+	}
+
+	if template.ActivatedAt != nil {
+		t.ActivatedAt = timestamppb.New(*template.ActivatedAt)
+	}
+
+	return t
+}
+
+// isoWeekday numbers weekdays from Monday as 1 to Sunday as 7.
+func isoWeekday(d time.Weekday) int32 {
+	if d == time.Sunday {
+		return 7
+	}
+
+	return int32(d)
+}
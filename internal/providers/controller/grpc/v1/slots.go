@@ -0,0 +1,346 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) SlotCreate(ctx context.Context, req *pb.SlotCreateRequest) (*pb.SlotCreateResponse, error) {
+	if err := validateSlotCreateRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotCreate - validateSlotCreateRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotCreate - validateSlotCreateRequest: %w", err)
+	}
+
+	slot, err := c.slots.Create(ctx, &entity.Slot{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		ZoneID:     entity.ZoneID(req.GetZoneID()),
+		StartsAt:   timeFromPB(req.GetStartsAt()),
+		EndsAt:     timeFromPB(req.GetEndsAt()),
+		Capacity:   int(req.GetCapacity()),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotCreate - slots.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotCreate - slots.Create: %w", err)
+	}
+
+	return &pb.SlotCreateResponse{
+		Slot: slotToPB(slot),
+	}, nil
+}
+
+func (c *controllerProvider) SlotGet(ctx context.Context, req *pb.SlotGetRequest) (*pb.SlotGetResponse, error) {
+	if err := validateSlotRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotGet - validateSlotRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotGet - validateSlotRequest: %w", err)
+	}
+
+	slot, err := c.slots.GetByID(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotID(req.GetSlotID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotGet - slots.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotGet - slots.GetByID: %w", err)
+	}
+
+	return &pb.SlotGetResponse{
+		Slot: slotToPB(slot),
+	}, nil
+}
+
+func (c *controllerProvider) SlotListAll(ctx context.Context, req *pb.SlotListAllRequest) (*pb.SlotListAllResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotListAll - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotListAll - validateProviderIDRequest: %w", err)
+	}
+
+	page, err := c.slots.ListAll(ctx, entity.SlotListParams{
+		Filter: entity.SlotFilter{
+			ProviderID:    entity.ProviderID(req.GetProviderID()),
+			ZoneID:        entity.ZoneID(req.GetZoneID()),
+			StartsFrom:    timeFromPB(req.GetStartsFrom()),
+			StartsBefore:  timeFromPB(req.GetStartsBefore()),
+			IncludeClosed: req.GetIncludeClosed(),
+		},
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotListAll - slots.ListAll: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotListAll - slots.ListAll: %w", err)
+	}
+
+	return &pb.SlotListAllResponse{
+		Slots:         slotsToPB(page.Slots),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *controllerProvider) SlotClose(ctx context.Context, req *pb.SlotCloseRequest) (*pb.SlotCloseResponse, error) {
+	if err := validateSlotRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotClose - validateSlotRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotClose - validateSlotRequest: %w", err)
+	}
+
+	slot, err := c.slots.Close(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotID(req.GetSlotID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotClose - slots.Close: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotClose - slots.Close: %w", err)
+	}
+
+	return &pb.SlotCloseResponse{
+		Slot: slotToPB(slot),
+	}, nil
+}
+
+func (c *controllerProvider) SlotAvailability(ctx context.Context, req *pb.SlotAvailabilityRequest) (*pb.SlotAvailabilityResponse, error) {
+	if err := validateZoneRequest(req, false, false); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotAvailability - validateZoneRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotAvailability - validateZoneRequest: %w", err)
+	}
+
+	slots, err := c.slots.Availability(ctx, entity.AvailabilityParams{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		ZoneID:     entity.ZoneID(req.GetZoneID()),
+		From:       timeFromPB(req.GetFrom()),
+		To:         timeFromPB(req.GetTo()),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotAvailability - slots.Availability: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotAvailability - slots.Availability: %w", err)
+	}
+
+	return &pb.SlotAvailabilityResponse{
+		Slots: slotsToPB(slots),
+	}, nil
+}
+
+func (c *controllerProvider) SlotHold(ctx context.Context, req *pb.SlotHoldRequest) (*pb.SlotHoldResponse, error) {
+	if err := validateSlotRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotHold - validateSlotRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotHold - validateSlotRequest: %w", err)
+	}
+
+	quantity := int(req.GetQuantity())
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	hold, err := c.slots.Hold(ctx, entity.ProviderID(req.GetProviderID()), entity.SlotID(req.GetSlotID()), quantity)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotHold - slots.Hold: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotHold - slots.Hold: %w", err)
+	}
+
+	return &pb.SlotHoldResponse{
+		Hold: slotHoldToPB(hold),
+	}, nil
+}
+
+func (c *controllerProvider) SlotConfirm(ctx context.Context, req *pb.SlotConfirmRequest) (*pb.SlotConfirmResponse, error) {
+	if err := validateHoldRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotConfirm - validateHoldRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotConfirm - validateHoldRequest: %w", err)
+	}
+
+	hold, err := c.slots.Confirm(ctx, entity.ProviderID(req.GetProviderID()), entity.HoldID(req.GetHoldID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotConfirm - slots.Confirm: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotConfirm - slots.Confirm: %w", err)
+	}
+
+	return &pb.SlotConfirmResponse{
+		Hold: slotHoldToPB(hold),
+	}, nil
+}
+
+func (c *controllerProvider) SlotRelease(ctx context.Context, req *pb.SlotReleaseRequest) (*pb.SlotReleaseResponse, error) {
+	if err := validateHoldRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotRelease - validateHoldRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotRelease - validateHoldRequest: %w", err)
+	}
+
+	hold, err := c.slots.Release(ctx, entity.ProviderID(req.GetProviderID()), entity.HoldID(req.GetHoldID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - SlotRelease - slots.Release: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - SlotRelease - slots.Release: %w", err)
+	}
+
+	return &pb.SlotReleaseResponse{
+		Hold: slotHoldToPB(hold),
+	}, nil
+}
+
+type slotIDRequest interface {
+	GetProviderID() string
+	GetSlotID() int64
+}
+
+func validateSlotRequest(req slotIDRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetSlotID() <= 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "slot_id",
+			Description: "not positive",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+type holdIDRequest interface {
+	GetProviderID() string
+	GetHoldID() string
+}
+
+func validateHoldRequest(req holdIDRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetHoldID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "hold_id",
+			Description: "empty",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+func validateSlotCreateRequest(req *pb.SlotCreateRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetZoneID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "zone_id",
+			Description: "empty",
+		})
+	}
+	if req.GetStartsAt() == nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "starts_at",
+			Description: "empty",
+		})
+	}
+	if req.GetEndsAt() == nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "ends_at",
+			Description: "empty",
+		})
+	}
+
+	return fieldViolationsError(violations)
+}
+
+// fieldViolationsError turns violations into an InvalidArgument status, no violations mean no error.
+func fieldViolationsError(violations []*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: violations,
+		})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return st.Err()
+}
+
+func slotToPB(slot *entity.Slot) *pb.Slot {
+	s := &pb.Slot{
+		SlotID:     int64(slot.SlotID),
+		ProviderID: string(slot.ProviderID),
+		ZoneID:     string(slot.ZoneID),
+		StartsAt:   timestamppb.New(slot.StartsAt),
+		EndsAt:     timestamppb.New(slot.EndsAt),
+		Capacity:   int32(slot.Capacity),    //nolint:gosec // capacity is bounded on create
+		Booked:     int32(slot.Booked),      //nolint:gosec // booked never exceeds capacity
+		Available:  int32(slot.Available()), //nolint:gosec // available never exceeds capacity
+		CreatedAt:  timestamppb.New(slot.CreatedAt),
+		UpdatedAt:  timestamppb.New(slot.UpdatedAt),
+	}
+
+	if slot.ClosedAt != nil {
+		s.ClosedAt = timestamppb.New(*slot.ClosedAt)
+	}
+
+	return s
+}
+
+func slotsToPB(slots []*entity.Slot) []*pb.Slot {
+	res := make([]*pb.Slot, len(slots))
+	for i, slot := range slots {
+		res[i] = slotToPB(slot)
+	}
+
+	return res
+}
+
+func slotHoldToPB(hold *entity.SlotHold) *pb.SlotHold {
+	return &pb.SlotHold{
+		HoldID:     string(hold.HoldID),
+		SlotID:     int64(hold.SlotID),
+		ProviderID: string(hold.ProviderID),
+		Quantity:   int32(hold.Quantity), //nolint:gosec // quantity is bounded by the slot capacity
+		Status:     holdStatusToPB(hold.Status),
+		ExpiresAt:  timestamppb.New(hold.ExpiresAt),
+		CreatedAt:  timestamppb.New(hold.CreatedAt),
+		UpdatedAt:  timestamppb.New(hold.UpdatedAt),
+	}
+}
+
+func holdStatusToPB(s entity.HoldStatus) pb.SlotHoldStatus {
+	switch s {
+	case entity.HoldStatusHeld:
+		return pb.SlotHoldStatus_SLOT_HOLD_STATUS_HELD
+	case entity.HoldStatusConfirmed:
+		return pb.SlotHoldStatus_SLOT_HOLD_STATUS_CONFIRMED
+	case entity.HoldStatusReleased:
+		return pb.SlotHoldStatus_SLOT_HOLD_STATUS_RELEASED
+	case entity.HoldStatusExpired:
+		return pb.SlotHoldStatus_SLOT_HOLD_STATUS_EXPIRED
+	default:
+		return pb.SlotHoldStatus_SLOT_HOLD_STATUS_UNSPECIFIED
+	}
+}
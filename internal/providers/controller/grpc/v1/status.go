@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *controllerProvider) ProviderActivate(ctx context.Context, req *pb.ProviderActivateRequest) (*pb.ProviderActivateResponse, error) {
+	ctx = withPrincipal(ctx)
+
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderActivate - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderActivate - validateProviderIDRequest: %w", err)
+	}
+
+	provider, err := c.uc.Activate(ctx, entity.ProviderID(req.GetProviderID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderActivate - uc.Activate: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderActivate - uc.Activate: %w", err)
+	}
+
+	localize(ctx, provider)
+
+	return &pb.ProviderActivateResponse{
+		Provider: providerToPB(provider),
+	}, nil
+}
+
+func (c *controllerProvider) ProviderSuspend(ctx context.Context, req *pb.ProviderSuspendRequest) (*pb.ProviderSuspendResponse, error) {
+	ctx = withPrincipal(ctx)
+
+	if err := validateProviderSuspendRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderSuspend - validateProviderSuspendRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderSuspend - validateProviderSuspendRequest: %w", err)
+	}
+
+	provider, err := c.uc.Suspend(ctx, entity.ProviderID(req.GetProviderID()), req.GetReason())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderSuspend - uc.Suspend: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderSuspend - uc.Suspend: %w", err)
+	}
+
+	localize(ctx, provider)
+
+	return &pb.ProviderSuspendResponse{
+		Provider: providerToPB(provider),
+	}, nil
+}
+
+func validateProviderSuspendRequest(req *pb.ProviderSuspendRequest) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetReason() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "reason",
+			Description: "empty",
+		})
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+			&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+
+	return nil
+}
+
+func (c *controllerProvider) ProviderTerminate(ctx context.Context, req *pb.ProviderTerminateRequest) (*pb.ProviderTerminateResponse, error) {
+	ctx = withPrincipal(ctx)
+
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderTerminate - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderTerminate - validateProviderIDRequest: %w", err)
+	}
+
+	provider, err := c.uc.Terminate(ctx, entity.ProviderID(req.GetProviderID()), req.GetReason())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ProviderTerminate - uc.Terminate: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ProviderTerminate - uc.Terminate: %w", err)
+	}
+
+	localize(ctx, provider)
+
+	return &pb.ProviderTerminateResponse{
+		Provider: providerToPB(provider),
+	}, nil
+}
+
+func statusToPB(s entity.ProviderStatus) pb.ProviderStatus {
+	switch s {
+	case entity.ProviderStatusOnboarding:
+		return pb.ProviderStatus_PROVIDER_STATUS_ONBOARDING
+	case entity.ProviderStatusActive:
+		return pb.ProviderStatus_PROVIDER_STATUS_ACTIVE
+	case entity.ProviderStatusSuspended:
+		return pb.ProviderStatus_PROVIDER_STATUS_SUSPENDED
+	case entity.ProviderStatusTerminated:
+		return pb.ProviderStatus_PROVIDER_STATUS_TERMINATED
+	default:
+		return pb.ProviderStatus_PROVIDER_STATUS_UNSPECIFIED
+	}
+}
+
+// statusFromPB maps unspecified and unknown values to an invalid entity status, which the usecase rejects.
+func statusFromPB(s pb.ProviderStatus) entity.ProviderStatus {
+	switch s {
+	case pb.ProviderStatus_PROVIDER_STATUS_ONBOARDING:
+		return entity.ProviderStatusOnboarding
+	case pb.ProviderStatus_PROVIDER_STATUS_ACTIVE:
+		return entity.ProviderStatusActive
+	case pb.ProviderStatus_PROVIDER_STATUS_SUSPENDED:
+		return entity.ProviderStatusSuspended
+	case pb.ProviderStatus_PROVIDER_STATUS_TERMINATED:
+		return entity.ProviderStatusTerminated
+	default:
+		return entity.ProviderStatus(s.String())
+	}
+}
+
+func statusesFromPB(statuses []pb.ProviderStatus) []entity.ProviderStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	result := make([]entity.ProviderStatus, len(statuses))
+	for i, s := range statuses {
+		result[i] = statusFromPB(s)
+	}
+
+	return result
+}
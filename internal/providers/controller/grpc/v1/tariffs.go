@@ -0,0 +1,512 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"github.com/shopspring/decimal"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) TariffCreate(ctx context.Context, req *pb.TariffCreateRequest) (*pb.TariffCreateResponse, error) {
+	tariff, violations := tariffFromPB(req)
+	if err := fieldViolationsError(violations); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffCreate - tariffFromPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffCreate - tariffFromPB: %w", err)
+	}
+
+	tariff, err := c.tariffs.Create(ctx, tariff)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffCreate - tariffs.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffCreate - tariffs.Create: %w", err)
+	}
+
+	return &pb.TariffCreateResponse{
+		Tariff: tariffToPB(tariff),
+	}, nil
+}
+
+func (c *controllerProvider) TariffGet(ctx context.Context, req *pb.TariffGetRequest) (*pb.TariffGetResponse, error) {
+	if err := fieldViolationsError(tariffIDViolations(req)); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffGet - tariffIDViolations: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffGet - tariffIDViolations: %w", err)
+	}
+
+	tariff, err := c.tariffs.GetByID(ctx, entity.ProviderID(req.GetProviderID()), entity.TariffID(req.GetTariffID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffGet - tariffs.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffGet - tariffs.GetByID: %w", err)
+	}
+
+	return &pb.TariffGetResponse{
+		Tariff: tariffToPB(tariff),
+	}, nil
+}
+
+func (c *controllerProvider) TariffListAll(ctx context.Context, req *pb.TariffListAllRequest) (*pb.TariffListAllResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffListAll - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffListAll - validateProviderIDRequest: %w", err)
+	}
+
+	page, err := c.tariffs.ListAll(ctx, entity.TariffListParams{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffListAll - tariffs.ListAll: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffListAll - tariffs.ListAll: %w", err)
+	}
+
+	tariffs := make([]*pb.Tariff, len(page.Tariffs))
+	for i, tariff := range page.Tariffs {
+		tariffs[i] = tariffToPB(tariff)
+	}
+
+	return &pb.TariffListAllResponse{
+		Tariffs:       tariffs,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *controllerProvider) TariffDelete(ctx context.Context, req *pb.TariffDeleteRequest) (*pb.TariffDeleteResponse, error) {
+	if err := fieldViolationsError(tariffIDViolations(req)); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffDelete - tariffIDViolations: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffDelete - tariffIDViolations: %w", err)
+	}
+
+	err := c.tariffs.Delete(ctx, entity.ProviderID(req.GetProviderID()), entity.TariffID(req.GetTariffID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffDelete - tariffs.Delete: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffDelete - tariffs.Delete: %w", err)
+	}
+
+	return &pb.TariffDeleteResponse{}, nil
+}
+
+func (c *controllerProvider) TariffEvaluate(ctx context.Context, req *pb.TariffEvaluateRequest) (*pb.TariffEvaluateResponse, error) {
+	violations := tariffIDViolations(req)
+	violations = append(violations, shipmentViolations(req)...)
+
+	if err := fieldViolationsError(violations); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffEvaluate - shipmentViolations: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffEvaluate - shipmentViolations: %w", err)
+	}
+
+	breakdown, err := c.tariffs.Evaluate(ctx, entity.ProviderID(req.GetProviderID()), entity.TariffID(req.GetTariffID()), shipmentFromPB(req), timeFromPB(req.GetAt()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffEvaluate - tariffs.Evaluate: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffEvaluate - tariffs.Evaluate: %w", err)
+	}
+
+	return &pb.TariffEvaluateResponse{
+		Breakdown: priceBreakdownToPB(breakdown),
+	}, nil
+}
+
+func (c *controllerProvider) TariffRevisionCreate(ctx context.Context, req *pb.TariffRevisionCreateRequest) (*pb.TariffRevisionCreateResponse, error) {
+	rules, violations := tariffRulesFromPB(req)
+	violations = append(tariffIDViolations(req), violations...)
+
+	if err := fieldViolationsError(violations); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffRevisionCreate - tariffRulesFromPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffRevisionCreate - tariffRulesFromPB: %w", err)
+	}
+
+	revision := tariffRevisionFromPB(req, rules)
+	revision.ProviderID, revision.TariffID = entity.ProviderID(req.GetProviderID()), entity.TariffID(req.GetTariffID())
+
+	revision, err := c.tariffs.CreateRevision(ctx, revision)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffRevisionCreate - tariffs.CreateRevision: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffRevisionCreate - tariffs.CreateRevision: %w", err)
+	}
+
+	return &pb.TariffRevisionCreateResponse{
+		Revision: tariffRevisionToPB(revision),
+	}, nil
+}
+
+func (c *controllerProvider) TariffRevisionListAll(ctx context.Context, req *pb.TariffRevisionListAllRequest) (*pb.TariffRevisionListAllResponse, error) {
+	if err := fieldViolationsError(tariffIDViolations(req)); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffRevisionListAll - tariffIDViolations: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffRevisionListAll - tariffIDViolations: %w", err)
+	}
+
+	revisions, err := c.tariffs.ListRevisions(ctx, entity.ProviderID(req.GetProviderID()), entity.TariffID(req.GetTariffID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - TariffRevisionListAll - tariffs.ListRevisions: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - TariffRevisionListAll - tariffs.ListRevisions: %w", err)
+	}
+
+	res := make([]*pb.TariffRevision, len(revisions))
+	for i, revision := range revisions {
+		res[i] = tariffRevisionToPB(revision)
+	}
+
+	return &pb.TariffRevisionListAllResponse{
+		Revisions: res,
+	}, nil
+}
+
+type tariffIDRequest interface {
+	GetProviderID() string
+	GetTariffID() string
+}
+
+func tariffIDViolations(req tariffIDRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetTariffID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "tariff_id",
+			Description: "empty",
+		})
+	}
+
+	return violations
+}
+
+// tariffFromPB converts the request and reports missing fields and malformed amounts, the rules are checked by the usecase.
+func tariffFromPB(req *pb.TariffCreateRequest) (*entity.Tariff, []*errdetails.BadRequest_FieldViolation) {
+	violations := tariffIDViolations(req)
+
+	if req.GetName() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "name",
+			Description: "empty",
+		})
+	}
+	if req.GetCurrency() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "currency",
+			Description: "empty",
+		})
+	}
+
+	rules, rulesViolations := tariffRulesFromPB(req)
+	violations = append(violations, rulesViolations...)
+
+	tariff := &entity.Tariff{
+		ProviderID:     entity.ProviderID(req.GetProviderID()),
+		TariffID:       entity.TariffID(req.GetTariffID()),
+		Name:           req.GetName(),
+		Currency:       entity.Currency(req.GetCurrency()),
+		ServiceLevel:   serviceLevelFromPB(req.GetServiceLevel()),
+		TransitDaysMin: int(req.GetTransitDaysMin()),
+		TransitDaysMax: int(req.GetTransitDaysMax()),
+		Revision:       tariffRevisionFromPB(req, rules),
+	}
+
+	return tariff, violations
+}
+
+// tariffRulesRequest is implemented by requests carrying the rules of a tariff revision.
+type tariffRulesRequest interface {
+	GetWeightBreaks() []*pb.WeightBreak
+	GetVolumetricDivisor() int32
+	GetDistanceBands() []*pb.DistanceBand
+	GetSurcharges() []*pb.Surcharge
+	GetMinPrice() string
+	GetMaxPrice() string
+	GetEffectiveFrom() *timestamppb.Timestamp
+	GetEffectiveTo() *timestamppb.Timestamp
+}
+
+func tariffRulesFromPB(req tariffRulesRequest) (entity.TariffRules, []*errdetails.BadRequest_FieldViolation) {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if len(req.GetWeightBreaks()) == 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "weight_breaks",
+			Description: "empty",
+		})
+	}
+
+	amount := func(field, s string) decimal.Decimal {
+		if s == "" {
+			return decimal.Zero
+		}
+
+		d, err := decimal.NewFromString(s)
+		if err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: "not a decimal number",
+			})
+		}
+
+		return d
+	}
+
+	rules := entity.TariffRules{
+		WeightBreaks:      make([]entity.WeightBreak, len(req.GetWeightBreaks())),
+		VolumetricDivisor: int(req.GetVolumetricDivisor()),
+		DistanceBands:     make([]entity.DistanceBand, len(req.GetDistanceBands())),
+		Surcharges:        make([]entity.Surcharge, len(req.GetSurcharges())),
+		MinPrice:          amount("min_price", req.GetMinPrice()),
+		MaxPrice:          amount("max_price", req.GetMaxPrice()),
+	}
+
+	for i, b := range req.GetWeightBreaks() {
+		rules.WeightBreaks[i] = entity.WeightBreak{
+			UpToG: int(b.GetUpToG()),
+			Price: amount(fmt.Sprintf("weight_breaks[%d].price", i), b.GetPrice()),
+			PerKg: amount(fmt.Sprintf("weight_breaks[%d].per_kg", i), b.GetPerKg()),
+		}
+	}
+
+	for i, b := range req.GetDistanceBands() {
+		rules.DistanceBands[i] = entity.DistanceBand{
+			UpToKm: int(b.GetUpToKm()),
+			Price:  amount(fmt.Sprintf("distance_bands[%d].price", i), b.GetPrice()),
+			PerKm:  amount(fmt.Sprintf("distance_bands[%d].per_km", i), b.GetPerKm()),
+		}
+	}
+
+	for i, s := range req.GetSurcharges() {
+		rules.Surcharges[i] = entity.Surcharge{
+			Code:   s.GetCode(),
+			Amount: amount(fmt.Sprintf("surcharges[%d].amount", i), s.GetAmount()),
+		}
+	}
+
+	return rules, violations
+}
+
+func tariffRevisionFromPB(req tariffRulesRequest, rules entity.TariffRules) *entity.TariffRevision {
+	revision := &entity.TariffRevision{
+		Rules:         rules,
+		EffectiveFrom: timeFromPB(req.GetEffectiveFrom()),
+	}
+
+	if req.GetEffectiveTo() != nil {
+		effectiveTo := req.GetEffectiveTo().AsTime()
+		revision.EffectiveTo = &effectiveTo
+	}
+
+	return revision
+}
+
+// shipmentRequest is implemented by requests pricing a shipment.
+type shipmentRequest interface {
+	GetOrigin() *pb.Location
+	GetDestination() *pb.Location
+	GetParcels() []*pb.ParcelDimensions
+}
+
+func shipmentViolations(req shipmentRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetOrigin() == nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "origin",
+			Description: "empty",
+		})
+	}
+	if req.GetDestination() == nil {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "destination",
+			Description: "empty",
+		})
+	}
+	if len(req.GetParcels()) == 0 {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "parcels",
+			Description: "empty",
+		})
+	}
+
+	return violations
+}
+
+func shipmentFromPB(req shipmentRequest) entity.Shipment {
+	shipment := entity.Shipment{
+		Origin:      entity.Position{req.GetOrigin().GetLon(), req.GetOrigin().GetLat()},
+		Destination: entity.Position{req.GetDestination().GetLon(), req.GetDestination().GetLat()},
+		Parcels:     make([]entity.ParcelDimensions, len(req.GetParcels())),
+	}
+
+	for i, p := range req.GetParcels() {
+		shipment.Parcels[i] = entity.ParcelDimensions{
+			LengthCM: int(p.GetLengthCm()),
+			WidthCM:  int(p.GetWidthCm()),
+			HeightCM: int(p.GetHeightCm()),
+			WeightG:  int(p.GetWeightG()),
+		}
+	}
+
+	return shipment
+}
+
+func tariffToPB(tariff *entity.Tariff) *pb.Tariff {
+	t := &pb.Tariff{
+		ProviderID:     string(tariff.ProviderID),
+		TariffID:       string(tariff.TariffID),
+		Name:           tariff.Name,
+		Currency:       string(tariff.Currency),
+		CreatedAt:      timestamppb.New(tariff.CreatedAt),
+		UpdatedAt:      timestamppb.New(tariff.UpdatedAt),
+		ServiceLevel:   serviceLevelToPB(tariff.ServiceLevel),
+		TransitDaysMin: int32(tariff.TransitDaysMin), //nolint:gosec // bounded by the usecase
+		TransitDaysMax: int32(tariff.TransitDaysMax), //nolint:gosec // bounded by the usecase
+	}
+
+	// A tariff whose revisions have all ended has no rules.
+	if tariff.Revision != nil {
+		r := tariffRevisionToPB(tariff.Revision)
+
+		t.WeightBreaks = r.GetWeightBreaks()
+		t.VolumetricDivisor = r.GetVolumetricDivisor()
+		t.DistanceBands = r.GetDistanceBands()
+		t.Surcharges = r.GetSurcharges()
+		t.MinPrice = r.GetMinPrice()
+		t.MaxPrice = r.GetMaxPrice()
+		t.Revision = r.GetRevision()
+		t.EffectiveFrom = r.GetEffectiveFrom()
+		t.EffectiveTo = r.GetEffectiveTo()
+	}
+
+	return t
+}
+
+func tariffRevisionToPB(revision *entity.TariffRevision) *pb.TariffRevision {
+	rules := revision.Rules
+
+	r := &pb.TariffRevision{
+		ProviderID:        string(revision.ProviderID),
+		TariffID:          string(revision.TariffID),
+		Revision:          int32(revision.Revision), //nolint:gosec // revisions are numbered one by one
+		WeightBreaks:      make([]*pb.WeightBreak, len(rules.WeightBreaks)),
+		VolumetricDivisor: int32(rules.VolumetricDivisor), //nolint:gosec // bounded by the usecase
+		DistanceBands:     make([]*pb.DistanceBand, len(rules.DistanceBands)),
+		Surcharges:        make([]*pb.Surcharge, len(rules.Surcharges)),
+		MinPrice:          rules.MinPrice.String(),
+		MaxPrice:          rules.MaxPrice.String(),
+		EffectiveFrom:     timestamppb.New(revision.EffectiveFrom),
+		CreatedAt:         timestamppb.New(revision.CreatedAt),
+	}
+
+	if revision.EffectiveTo != nil {
+		r.EffectiveTo = timestamppb.New(*revision.EffectiveTo)
+	}
+
+	for i, b := range rules.WeightBreaks {
+		r.WeightBreaks[i] = &pb.WeightBreak{
+			UpToG: int32(b.UpToG), //nolint:gosec // breaks come from int32 fields
+			Price: b.Price.String(),
+			PerKg: b.PerKg.String(),
+		}
+	}
+
+	for i, b := range rules.DistanceBands {
+		r.DistanceBands[i] = &pb.DistanceBand{
+			UpToKm: int32(b.UpToKm), //nolint:gosec // bands come from int32 fields
+			Price:  b.Price.String(),
+			PerKm:  b.PerKm.String(),
+		}
+	}
+
+	for i, s := range rules.Surcharges {
+		r.Surcharges[i] = &pb.Surcharge{Code: s.Code, Amount: s.Amount.String()}
+	}
+
+	return r
+}
+
+// priceBreakdownToPB keeps trailing zeros of the amounts, so 300 RUB is "300.00".
+func priceBreakdownToPB(breakdown *entity.PriceBreakdown) *pb.PriceBreakdown {
+	// The lines are rounded to the currency, the total is their sum, so it has the most decimal places.
+	places := max(-breakdown.Total.Exponent(), 0)
+
+	b := &pb.PriceBreakdown{
+		Currency:          string(breakdown.Currency),
+		ChargeableWeightG: int32(breakdown.ChargeableWeightG), //nolint:gosec // bounded by the shipment validation
+		DistanceM:         int32(breakdown.DistanceM),         //nolint:gosec // bounded by the Earth
+		Lines:             make([]*pb.PriceLine, len(breakdown.Lines)),
+		Total:             breakdown.Total.StringFixed(places),
+		Revision:          int32(breakdown.Revision), //nolint:gosec // revisions are numbered one by one
+	}
+
+	for i, line := range breakdown.Lines {
+		b.Lines[i] = &pb.PriceLine{
+			Kind:   priceLineKindToPB(line.Kind),
+			Parcel: int32(line.Parcel), //nolint:gosec // bounded by the shipment validation
+			Code:   line.Code,
+			Amount: line.Amount.StringFixed(places),
+		}
+	}
+
+	return b
+}
+
+func priceLineKindToPB(k entity.PriceLineKind) pb.PriceLineKind {
+	switch k {
+	case entity.PriceLineWeight:
+		return pb.PriceLineKind_PRICE_LINE_KIND_WEIGHT
+	case entity.PriceLineDistance:
+		return pb.PriceLineKind_PRICE_LINE_KIND_DISTANCE
+	case entity.PriceLineSurcharge:
+		return pb.PriceLineKind_PRICE_LINE_KIND_SURCHARGE
+	case entity.PriceLineMinPrice:
+		return pb.PriceLineKind_PRICE_LINE_KIND_MIN_PRICE
+	case entity.PriceLineMaxPrice:
+		return pb.PriceLineKind_PRICE_LINE_KIND_MAX_PRICE
+	default:
+		return pb.PriceLineKind_PRICE_LINE_KIND_UNSPECIFIED
+	}
+}
+
+func serviceLevelFromPB(l pb.ServiceLevel) entity.ServiceLevel {
+	switch l {
+	case pb.ServiceLevel_SERVICE_LEVEL_ECONOMY:
+		return entity.ServiceLevelEconomy
+	case pb.ServiceLevel_SERVICE_LEVEL_STANDARD:
+		return entity.ServiceLevelStandard
+	case pb.ServiceLevel_SERVICE_LEVEL_EXPRESS:
+		return entity.ServiceLevelExpress
+	case pb.ServiceLevel_SERVICE_LEVEL_SAME_DAY:
+		return entity.ServiceLevelSameDay
+	default:
+		return ""
+	}
+}
+
+func serviceLevelToPB(l entity.ServiceLevel) pb.ServiceLevel {
+	switch l {
+	case entity.ServiceLevelEconomy:
+		return pb.ServiceLevel_SERVICE_LEVEL_ECONOMY
+	case entity.ServiceLevelStandard:
+		return pb.ServiceLevel_SERVICE_LEVEL_STANDARD
+	case entity.ServiceLevelExpress:
+		return pb.ServiceLevel_SERVICE_LEVEL_EXPRESS
+	case entity.ServiceLevelSameDay:
+		return pb.ServiceLevel_SERVICE_LEVEL_SAME_DAY
+	default:
+		return pb.ServiceLevel_SERVICE_LEVEL_UNSPECIFIED
+	}
+}
@@ -0,0 +1,284 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	pb "github.com/classydevv/fulfillment/pkg/api/providers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *controllerProvider) ZoneCreate(ctx context.Context, req *pb.ZoneCreateRequest) (*pb.ZoneCreateResponse, error) {
+	if err := validateZoneRequest(req, req.GetName() == "", req.GetGeometry() == nil); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneCreate - validateZoneRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneCreate - validateZoneRequest: %w", err)
+	}
+
+	geometry, err := geometryFromPB(req.GetGeometry())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneCreate - geometryFromPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneCreate - geometryFromPB: %w", err)
+	}
+
+	zoneID, err := c.zones.Create(ctx, &entity.Zone{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		ZoneID:     entity.ZoneID(req.GetZoneID()),
+		Name:       req.GetName(),
+		Geometry:   geometry,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneCreate - zones.Create: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneCreate - zones.Create: %w", err)
+	}
+
+	return &pb.ZoneCreateResponse{
+		ZoneID: string(zoneID),
+	}, nil
+}
+
+func (c *controllerProvider) ZoneGet(ctx context.Context, req *pb.ZoneGetRequest) (*pb.ZoneGetResponse, error) {
+	if err := validateZoneRequest(req, false, false); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneGet - validateZoneRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneGet - validateZoneRequest: %w", err)
+	}
+
+	zone, err := c.zones.GetByID(ctx, entity.ProviderID(req.GetProviderID()), entity.ZoneID(req.GetZoneID()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneGet - zones.GetByID: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneGet - zones.GetByID: %w", err)
+	}
+
+	z, err := zoneToPB(zone)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneGet - zoneToPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneGet - zoneToPB: %w", err)
+	}
+
+	return &pb.ZoneGetResponse{
+		Zone: z,
+	}, nil
+}
+
+func (c *controllerProvider) ZoneListAll(ctx context.Context, req *pb.ZoneListAllRequest) (*pb.ZoneListAllResponse, error) {
+	if err := validateProviderIDRequest(req); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneListAll - validateProviderIDRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneListAll - validateProviderIDRequest: %w", err)
+	}
+
+	page, err := c.zones.ListAll(ctx, entity.ZoneListParams{
+		ProviderID: entity.ProviderID(req.GetProviderID()),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneListAll - zones.ListAll: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneListAll - zones.ListAll: %w", err)
+	}
+
+	zones := make([]*pb.Zone, len(page.Zones))
+
+	for i, zone := range page.Zones {
+		if zones[i], err = zoneToPB(zone); err != nil {
+			c.l.Error(fmt.Errorf("grpc - v1 - ZoneListAll - zoneToPB: %w", err))
+
+			return nil, fmt.Errorf("grpc - v1 - ZoneListAll - zoneToPB: %w", err)
+		}
+	}
+
+	return &pb.ZoneListAllResponse{
+		Zones:         zones,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (c *controllerProvider) ZoneUpdate(ctx context.Context, req *pb.ZoneUpdateRequest) (*pb.ZoneUpdateResponse, error) {
+	if err := validateZoneRequest(req, false, false); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneUpdate - validateZoneRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneUpdate - validateZoneRequest: %w", err)
+	}
+
+	version, err := entity.ParseETag(req.GetEtag())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneUpdate - entity.ParseETag: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneUpdate - entity.ParseETag: %w", err)
+	}
+
+	zone := &entity.Zone{Name: req.GetName(), Version: version}
+
+	if req.GetGeometry() != nil {
+		if zone.Geometry, err = geometryFromPB(req.GetGeometry()); err != nil {
+			c.l.Error(fmt.Errorf("grpc - v1 - ZoneUpdate - geometryFromPB: %w", err))
+
+			return nil, fmt.Errorf("grpc - v1 - ZoneUpdate - geometryFromPB: %w", err)
+		}
+	}
+
+	zoneUpdated, err := c.zones.Update(ctx, entity.ProviderID(req.GetProviderID()), entity.ZoneID(req.GetZoneID()), zone, zoneUpdateMask(req))
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneUpdate - zones.Update: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneUpdate - zones.Update: %w", err)
+	}
+
+	z, err := zoneToPB(zoneUpdated)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneUpdate - zoneToPB: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneUpdate - zoneToPB: %w", err)
+	}
+
+	return &pb.ZoneUpdateResponse{
+		Zone: z,
+	}, nil
+}
+
+// zoneUpdateMask returns the requested mask or, when it is omitted, the populated fields of the request.
+func zoneUpdateMask(req *pb.ZoneUpdateRequest) entity.ZoneMask {
+	if req.GetUpdateMask() != nil {
+		paths := req.GetUpdateMask().GetPaths()
+		mask := make(entity.ZoneMask, len(paths))
+
+		for i, path := range paths {
+			mask[i] = entity.ZoneField(path)
+		}
+
+		return mask
+	}
+
+	var mask entity.ZoneMask
+
+	if req.GetName() != "" {
+		mask = append(mask, entity.ZoneFieldName)
+	}
+	if req.GetGeometry() != nil {
+		mask = append(mask, entity.ZoneFieldGeometry)
+	}
+
+	return mask
+}
+
+func (c *controllerProvider) ZoneDelete(ctx context.Context, req *pb.ZoneDeleteRequest) (*pb.ZoneDeleteResponse, error) {
+	if err := validateZoneRequest(req, false, false); err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneDelete - validateZoneRequest: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneDelete - validateZoneRequest: %w", err)
+	}
+
+	version, err := entity.ParseETag(req.GetEtag())
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneDelete - entity.ParseETag: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneDelete - entity.ParseETag: %w", err)
+	}
+
+	err = c.zones.Delete(ctx, entity.ProviderID(req.GetProviderID()), entity.ZoneID(req.GetZoneID()), version)
+	if err != nil {
+		c.l.Error(fmt.Errorf("grpc - v1 - ZoneDelete - zones.Delete: %w", err))
+
+		return nil, fmt.Errorf("grpc - v1 - ZoneDelete - zones.Delete: %w", err)
+	}
+
+	return &pb.ZoneDeleteResponse{}, nil
+}
+
+type zoneIDRequest interface {
+	GetProviderID() string
+	GetZoneID() string
+}
+
+// validateZoneRequest validates requests addressing a zone, create requests report missing fields as well.
+func validateZoneRequest(req zoneIDRequest, noName, noGeometry bool) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetProviderID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "provider_id",
+			Description: "empty",
+		})
+	}
+	if req.GetZoneID() == "" {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "zone_id",
+			Description: "empty",
+		})
+	}
+	if noName {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "name",
+			Description: "empty",
+		})
+	}
+	if noGeometry {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "geometry",
+			Description: "empty",
+		})
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, codes.InvalidArgument.String()).WithDetails(
+			&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return st.Err()
+	}
+
+	return nil
+}
+
+// geometryFromPB reads the GeoJSON object, malformed ones are rejected with entity.ErrInvalidArgument.
+func geometryFromPB(s *structpb.Struct) (entity.Geometry, error) {
+	raw, err := s.MarshalJSON()
+	if err != nil {
+		return entity.Geometry{}, fmt.Errorf("structpb.Struct.MarshalJSON: %w", err)
+	}
+
+	var geometry entity.Geometry
+	if err := json.Unmarshal(raw, &geometry); err != nil {
+		return entity.Geometry{}, fmt.Errorf("geometry: %w", err)
+	}
+
+	return geometry, nil
+}
+
+func zoneToPB(zone *entity.Zone) (*pb.Zone, error) {
+	raw, err := json.Marshal(zone.Geometry)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	geometry := new(structpb.Struct)
+	if err := geometry.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("structpb.Struct.UnmarshalJSON: %w", err)
+	}
+
+	return &pb.Zone{
+		ProviderID: string(zone.ProviderID),
+		ZoneID:     string(zone.ZoneID),
+		Name:       zone.Name,
+		Geometry:   geometry,
+		CreatedAt:  timestamppb.New(zone.CreatedAt),
+		UpdatedAt:  timestamppb.New(zone.UpdatedAt),
+		Etag:       zone.ETag(),
+	}, nil
+}
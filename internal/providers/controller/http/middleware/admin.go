@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminOnly lets through requests carrying "Authorization: Bearer <token>".
+// An empty token disables the guarded routes altogether.
+func AdminOnly(token string, l logger.Interface) func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		provided, ok := strings.CutPrefix(ctx.Get(fiber.HeaderAuthorization), "Bearer ")
+		if token == "" || !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			l.Warn("%s - %s %s - admin token rejected", ctx.IP(), ctx.Method(), ctx.OriginalURL())
+
+			return ctx.Status(http.StatusForbidden).JSON(fiber.Map{"error": "permission denied"})
+		}
+
+		return ctx.Next()
+	}
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Principal puts the caller named by "X-Actor" and "X-Request-Id" headers into the user context for the audit log.
+func Principal() func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		ctx.SetUserContext(entity.ContextWithPrincipal(ctx.UserContext(), entity.Principal{
+			ClaimedActor: ctx.Get("X-Actor"),
+			RequestID:    ctx.Get(fiber.HeaderXRequestID),
+		}))
+
+		return ctx.Next()
+	}
+}
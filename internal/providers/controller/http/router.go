@@ -21,10 +21,16 @@ import (
 //	@version		1.0
 //	@host			localhost:8080
 //	@BasePath		/v1
-func NewRouterProvider(app *fiber.App, uc usecase.Provider, cfg *config.Config, l logger.Interface) {
+//
+//	@securityDefinitions.apikey	AdminToken
+//	@in							header
+//	@name						Authorization
+//	@description				Admin token as "Bearer <token>"
+func NewRouterProvider(app *fiber.App, uc usecase.UseCases, cfg *config.Config, l logger.Interface) {
 	// Options
 	app.Use(middleware.Logger(l))
 	app.Use(middleware.Recovery(l))
+	app.Use(middleware.Principal())
 
 	// Prometheus metrics
 	if cfg.Metrics.Enabled {
@@ -46,6 +52,15 @@ func NewRouterProvider(app *fiber.App, uc usecase.Provider, cfg *config.Config,
 	// Routes
 	apiV1Group := app.Group("/v1")
 	{
-		v1.NewRoutesProvider(apiV1Group, uc, l)
+		v1.NewRoutesProvider(apiV1Group, uc.Providers, cfg.Admin.Token, l)
+		v1.NewRoutesZone(apiV1Group, uc.Zones, l)
+		v1.NewRoutesCoverage(apiV1Group, uc.Coverage, l)
+		v1.NewRoutesSlot(apiV1Group, uc.Slots, l)
+		v1.NewRoutesSlotTemplate(apiV1Group, uc.SlotTemplates, l)
+		v1.NewRoutesPickupPoint(apiV1Group, uc.PickupPoints, l)
+		v1.NewRoutesPickupPointSearch(apiV1Group, uc.PickupPointSearch, l)
+		v1.NewRoutesTariff(apiV1Group, uc.Tariffs, l)
+		v1.NewRoutesQuote(apiV1Group, uc.Quotes, l)
+		v1.NewRoutesCalendar(apiV1Group, uc.Calendars, l)
 	}
 }
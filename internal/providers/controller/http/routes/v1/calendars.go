@@ -0,0 +1,367 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+const _mimeICS = "text/calendar"
+
+type controllerCalendar struct {
+	uc usecase.Calendar
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesCalendar(apiGroup fiber.Router, uc usecase.Calendar, l logger.Interface) {
+	r := &controllerCalendar{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	calendarGroup := apiGroup.Group("/providers/:providerID/calendar")
+	{
+		calendarGroup.Post("", r.calendarCreate)
+		calendarGroup.Get("", r.calendarGet)
+		calendarGroup.Put("", r.calendarUpdate)
+		calendarGroup.Delete("", r.calendarDelete)
+		calendarGroup.Post("/holidays\\:import", r.calendarHolidaysImport)
+	}
+}
+
+type cutOff struct {
+	// Weekday is ISO, 1 is Monday and 7 is Sunday.
+	Weekday int    `json:"weekday" validate:"required,gte=1,lte=7" example:"5"`
+	At      string `json:"at" validate:"required,len=5" example:"14:00"`
+}
+
+type holiday struct {
+	Date string `json:"date" validate:"required,datetime=2006-01-02" example:"2026-01-01"`
+	Name string `json:"name,omitempty" example:"Новый год"`
+}
+
+// calendarRequest carries the whole calendar, an update replaces everything.
+type calendarRequest struct {
+	// TimeZone is an IANA name, hours, cut-offs and holidays are local to it.
+	TimeZone string `json:"time_zone" validate:"required" example:"Europe/Moscow"`
+	// Intervals of a weekday must not overlap, weekdays without any are days off.
+	Hours []openingHours `json:"hours" validate:"required,min=1,dive"`
+	// CutOffs are within the hours of their weekday, weekdays without one accept orders until closing.
+	CutOffs  []cutOff  `json:"cut_offs" validate:"dive"`
+	Holidays []holiday `json:"holidays" validate:"dive"`
+}
+
+type calendarEntityResponse struct {
+	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
+	TimeZone   string            `json:"time_zone" example:"Europe/Moscow"`
+	Hours      []openingHours    `json:"hours"`
+	CutOffs    []cutOff          `json:"cut_offs"`
+	Holidays   []holiday         `json:"holidays"`
+	CreatedAt  time.Time         `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt  time.Time         `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+type calendarResponse struct {
+	Calendar calendarEntityResponse `json:"calendar"`
+}
+
+// calendarFromRequest expects a request checked by the validator, the times may still be malformed.
+func calendarFromRequest(providerID paramProviderID, r calendarRequest) (*entity.Calendar, error) {
+	hours, err := openingHoursFromRequest(r.Hours)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &entity.Calendar{
+		ProviderID: entity.ProviderID(providerID),
+		TimeZone:   r.TimeZone,
+		Hours:      hours,
+		CutOffs:    make([]entity.CutOff, len(r.CutOffs)),
+		Holidays:   make([]entity.Holiday, len(r.Holidays)),
+	}
+
+	for i, c := range r.CutOffs {
+		at, err := entity.ParseTimeOfDay(c.At)
+		if err != nil {
+			return nil, err
+		}
+
+		calendar.CutOffs[i] = entity.CutOff{Weekday: time.Weekday(c.Weekday % 7), At: at}
+	}
+
+	for i, h := range r.Holidays {
+		calendar.Holidays[i] = entity.Holiday(h)
+	}
+
+	return calendar, nil
+}
+
+func calendarToResponse(c *entity.Calendar) calendarResponse {
+	res := calendarEntityResponse{
+		ProviderID: c.ProviderID,
+		TimeZone:   c.TimeZone,
+		Hours:      make([]openingHours, len(c.Hours)),
+		CutOffs:    make([]cutOff, len(c.CutOffs)),
+		Holidays:   make([]holiday, len(c.Holidays)),
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+
+	for i, h := range c.Hours {
+		res.Hours[i] = openingHours{Weekday: isoWeekday(h.Weekday), Opens: h.Opens.String(), Closes: h.Closes.String()}
+	}
+
+	for i, co := range c.CutOffs {
+		res.CutOffs[i] = cutOff{Weekday: isoWeekday(co.Weekday), At: co.At.String()}
+	}
+
+	for i, h := range c.Holidays {
+		res.Holidays[i] = holiday(h)
+	}
+
+	return calendarResponse{Calendar: res}
+}
+
+func isoWeekday(d time.Weekday) int {
+	if d == time.Sunday {
+		return 7
+	}
+
+	return int(d)
+}
+
+// @Summary		Create a provider calendar
+// @Description	Sets up the working hours, order cut-off times and holidays of a provider, a provider has at most one calendar
+// @ID				calendarCreate
+// @Tags			Calendar
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string			true	"Provider ID"
+// @Param			body		body		calendarRequest	true	"Calendar"
+// @Success		201			{object}	calendarResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/calendar [post]
+func (c *controllerCalendar) calendarCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - calendarCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody calendarRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	calendar, err := calendarFromRequest(providerID, requestBody)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarCreate - calendarFromRequest: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	calendar, err = c.uc.Create(ctx.UserContext(), calendar)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarCreate - uc.Create: %w", err))
+
+		return calendarErrorResponse(ctx, providerID, err)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(calendarToResponse(calendar))
+}
+
+// @Summary		Get a provider calendar
+// @Description	Returns the working calendar of a provider
+// @ID				calendarGet
+// @Tags			Calendar
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Success		200			{object}	calendarResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/calendar [get]
+func (c *controllerCalendar) calendarGet(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - calendarGet - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	calendar, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarGet - uc.GetByID: %w", err))
+
+		return calendarErrorResponse(ctx, providerID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(calendarToResponse(calendar))
+}
+
+// @Summary		Update a provider calendar
+// @Description	Replaces the working calendar of a provider as a whole
+// @ID				calendarUpdate
+// @Tags			Calendar
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string			true	"Provider ID"
+// @Param			body		body		calendarRequest	true	"Calendar"
+// @Success		200			{object}	calendarResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/calendar [put]
+func (c *controllerCalendar) calendarUpdate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - calendarUpdate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody calendarRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarUpdate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarUpdate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	calendar, err := calendarFromRequest(providerID, requestBody)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarUpdate - calendarFromRequest: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	calendar, err = c.uc.Update(ctx.UserContext(), calendar)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarUpdate - uc.Update: %w", err))
+
+		return calendarErrorResponse(ctx, providerID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(calendarToResponse(calendar))
+}
+
+// @Summary		Delete a provider calendar
+// @Description	Deletes the working calendar of a provider
+// @ID				calendarDelete
+// @Tags			Calendar
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path	string	true	"Provider ID"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}/calendar [delete]
+func (c *controllerCalendar) calendarDelete(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - calendarDelete - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID)); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarDelete - uc.Delete: %w", err))
+
+		return calendarErrorResponse(ctx, providerID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+type calendarHolidaysImportQuery struct {
+	Replace bool `query:"replace"`
+}
+
+// @Summary		Import provider holidays
+// @Description	Reads holidays from the events of an iCalendar (RFC 5545) file, an event spanning several days gives
+// @Description	a holiday for each. Dates are taken in the calendar time zone. Imported holidays are merged into the
+// @Description	stored ones by date, or replace them all. Cancelled events are skipped, recurring events are rejected.
+// @ID				calendarHolidaysImport
+// @Tags			Calendar
+// @Accept			text/calendar
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			replace		query		bool	false	"Replace the stored holidays instead of merging"
+// @Param			body		body		string	true	"iCalendar file"
+// @Success		200			{object}	calendarResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		415			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/calendar/holidays:import [post]
+func (c *controllerCalendar) calendarHolidaysImport(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - calendarHolidaysImport - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query calendarHolidaysImportQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarHolidaysImport - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(ctx.Get(fiber.HeaderContentType)); mediaType != _mimeICS {
+		c.l.Error(fmt.Errorf("http - v1 - calendarHolidaysImport - %w %q", errUnsupportedFormat, mediaType))
+
+		return errorResponse(ctx, http.StatusUnsupportedMediaType, fmt.Sprintf("%s %q, use %s", errUnsupportedFormat, mediaType, _mimeICS))
+	}
+
+	calendar, err := c.uc.ImportHolidays(ctx.UserContext(), entity.ProviderID(providerID), ctx.Body(), query.Replace)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - calendarHolidaysImport - uc.ImportHolidays: %w", err))
+
+		return calendarErrorResponse(ctx, providerID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(calendarToResponse(calendar))
+}
+
+func calendarErrorResponse(ctx *fiber.Ctx, providerID paramProviderID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrAlreadyExists):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", providerID, entity.ErrAlreadyExists.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "calendar database problems")
+	}
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerCoverage struct {
+	uc usecase.Coverage
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesCoverage(apiGroup fiber.Router, uc usecase.Coverage, l logger.Interface) {
+	r := &controllerCoverage{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	apiGroup.Get("/coverage", r.coverageLookup)
+}
+
+type coverageLookupQuery struct {
+	Lat string `query:"lat" validate:"required,latitude"`
+	Lon string `query:"lon" validate:"required,longitude"`
+}
+
+type coverageMatchResponse struct {
+	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
+	ZoneIDs    []entity.ZoneID   `json:"zone_ids" example:"msk-center"`
+}
+
+type coverageLookupResponse struct {
+	Matches []coverageMatchResponse `json:"matches"`
+}
+
+// @Summary		Look up coverage
+// @Description	Finds active providers with a delivery zone containing the position, ordered by provider ID
+// @ID				coverageLookup
+// @Tags			Coverage
+// @Accept			json
+// @Produce		json
+// @Param			lat	query		number	true	"Latitude in degrees"
+// @Param			lon	query		number	true	"Longitude in degrees"
+// @Success		200	{object}	coverageLookupResponse
+// @Failure		400	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/coverage [get]
+func (c *controllerCoverage) coverageLookup(ctx *fiber.Ctx) error {
+	var query coverageLookupQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - coverageLookup - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - coverageLookup - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	// Both are checked by the validator.
+	lat, _ := strconv.ParseFloat(query.Lat, 64)
+	lon, _ := strconv.ParseFloat(query.Lon, 64)
+
+	matches, err := c.uc.Lookup(ctx.UserContext(), entity.Position{lon, lat})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - coverageLookup - uc.Lookup: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "coverage problems")
+	}
+
+	response := coverageLookupResponse{Matches: make([]coverageMatchResponse, len(matches))}
+
+	for i, match := range matches {
+		response.Matches[i] = coverageMatchResponse(*match)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(response)
+}
@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/text/language"
+)
+
+type providerExportQuery struct {
+	Format          string                  `query:"format" validate:"omitempty,oneof=csv ndjson"`
+	NamePrefix      string                  `query:"name_prefix"`
+	IncludeArchived bool                    `query:"include_archived"`
+	Statuses        []entity.ProviderStatus `query:"status" validate:"dive,oneof=onboarding active suspended terminated"`
+}
+
+// _exportFlushRows is how many exported rows are buffered before they are sent, a client gone away is noticed
+// on the next send.
+const _exportFlushRows = 100
+
+// providerWriter encodes exported providers one by one.
+type providerWriter interface {
+	Write(*entity.Provider) error
+	Flush() error
+}
+
+// @Summary		Export providers
+// @Description	Streams the provider catalog ordered by ID as CSV (the import format plus read-only columns) or NDJSON.
+// @Description	The output can be imported back. An export interrupted by an error ends early without a trailer
+// @ID				providerExport
+// @Tags			Provider
+// @Produce		text/csv,application/x-ndjson
+// @Param			format				query		string		false	"csv (default) or ndjson"
+// @Param			name_prefix			query		string		false	"Case-insensitive name prefix"
+// @Param			include_archived	query		bool		false	"Also export archived providers"
+// @Param			status				query		[]string	false	"Lifecycle statuses to keep"	collectionFormat(multi)
+// @Success		200					{string}	string
+// @Failure		400					{object}	responseError
+// @Router			/providers:export [get]
+func (c *controllerProvider) providerExport(ctx *fiber.Ctx) error {
+	var query providerExportQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerExport - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerExport - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	filter := entity.ProviderFilter{
+		NamePrefix:      query.NamePrefix,
+		IncludeArchived: query.IncludeArchived,
+		Statuses:        query.Statuses,
+	}
+
+	extension, contentType, newWriter := "csv", _mimeCSV, newCSVProviderWriter
+	if query.Format == "ndjson" {
+		extension, contentType, newWriter = "ndjson", _mimeNDJSON, newNDJSONProviderWriter
+	}
+
+	ctx.Set(fiber.HeaderContentType, contentType+"; charset=utf-8")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="providers.%s"`, extension))
+
+	ctx.Vary(fiber.HeaderAcceptLanguage)
+
+	// The writer runs after the handler returns, when the fiber context is already released.
+	userCtx := ctx.UserContext()
+	languages := entity.ParseAcceptLanguage(ctx.Get(fiber.HeaderAcceptLanguage))
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		pw, err := newWriter(w)
+		if err != nil {
+			c.l.Error(fmt.Errorf("http - v1 - providerExport - newWriter: %w", err))
+
+			return
+		}
+
+		if err := c.exportProviders(userCtx, pw, filter, languages); err != nil {
+			c.l.Error(fmt.Errorf("http - v1 - providerExport - c.exportProviders: %w", err))
+		}
+	})
+
+	return nil
+}
+
+// exportProviders writes the providers to the client and flushes them every _exportFlushRows rows. A failing
+// write or flush means the client is gone, the export is canceled then so that its query stops instead of
+// reading the rest of the table before the connection goes back to the pool.
+func (c *controllerProvider) exportProviders(ctx context.Context, pw providerWriter, filter entity.ProviderFilter, languages []language.Tag) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var written int
+
+	err := c.uc.Export(ctx, filter, func(p *entity.Provider) error {
+		p.Localize(languages)
+
+		written++
+
+		err := pw.Write(p)
+		if err == nil && written%_exportFlushRows == 0 {
+			err = pw.Flush()
+		}
+
+		if err != nil {
+			cancel()
+
+			return fmt.Errorf("client: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("c.uc.Export: %w", err)
+	}
+
+	if err := pw.Flush(); err != nil {
+		return fmt.Errorf("pw.Flush: %w", err)
+	}
+
+	return nil
+}
+
+type csvProviderWriter struct {
+	w *csv.Writer
+}
+
+func newCSVProviderWriter(w *bufio.Writer) (providerWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append(append([]string(nil), _csvColumns...), _csvReadOnlyColumns...)); err != nil {
+		return nil, fmt.Errorf("csv header: %w", err)
+	}
+
+	return &csvProviderWriter{w: cw}, nil
+}
+
+func (pw *csvProviderWriter) Write(p *entity.Provider) error {
+	contacts, err := jsonCell(p.Contacts, len(p.Contacts))
+	if err != nil {
+		return fmt.Errorf("contacts: %w", err)
+	}
+
+	displayNames, err := jsonCell(p.DisplayNames, len(p.DisplayNames))
+	if err != nil {
+		return fmt.Errorf("display_names: %w", err)
+	}
+
+	labels, err := jsonCell(p.Labels, len(p.Labels))
+	if err != nil {
+		return fmt.Errorf("labels: %w", err)
+	}
+
+	deletedAt := ""
+	if p.Archived() {
+		deletedAt = p.DeletedAt.Format(time.RFC3339Nano)
+	}
+
+	// The order follows _csvColumns and _csvReadOnlyColumns.
+	return pw.w.Write([]string{
+		string(p.ProviderID),
+		p.Name,
+		p.LegalEntity.Name,
+		p.LegalEntity.TaxID,
+		p.Website,
+		strings.Join(p.Countries, _csvListSeparator),
+		contacts,
+		strconv.FormatBool(p.Capabilities.Courier),
+		strconv.FormatBool(p.Capabilities.PickupPoints),
+		strconv.FormatBool(p.Capabilities.Lockers),
+		strconv.FormatBool(p.Capabilities.SameDay),
+		strconv.FormatBool(p.Capabilities.CashOnDelivery),
+		displayNames,
+		labels,
+		string(p.Status),
+		p.StatusReason,
+		strconv.FormatInt(p.Version, 10),
+		p.CreatedAt.Format(time.RFC3339Nano),
+		p.UpdatedAt.Format(time.RFC3339Nano),
+		deletedAt,
+	})
+}
+
+// jsonCell renders a list or a map of the given size as JSON, an empty one is left blank.
+func jsonCell(v any, size int) (string, error) {
+	if size == 0 {
+		return "", nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func (pw *csvProviderWriter) Flush() error {
+	pw.w.Flush()
+
+	return pw.w.Error()
+}
+
+type ndjsonProviderWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func newNDJSONProviderWriter(w *bufio.Writer) (providerWriter, error) {
+	return &ndjsonProviderWriter{w: w, enc: json.NewEncoder(w)}, nil
+}
+
+// Write puts a provider on its own line, json.Encoder terminates every value with a newline.
+func (pw *ndjsonProviderWriter) Write(p *entity.Provider) error {
+	return pw.enc.Encode(providerEntityResponse(*p))
+}
+
+func (pw *ndjsonProviderWriter) Flush() error {
+	return pw.w.Flush()
+}
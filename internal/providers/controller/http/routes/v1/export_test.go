@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_usecase "github.com/classydevv/fulfillment/internal/providers/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProviderWriters_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	exported := &entity.Provider{
+		ProviderID:   entity.ProviderID("kuper"),
+		Name:         "Купер",
+		CreatedAt:    time.Date(2025, 5, 8, 6, 7, 14, 0, time.UTC),
+		UpdatedAt:    time.Date(2025, 5, 9, 6, 7, 14, 0, time.UTC),
+		Version:      3,
+		Status:       entity.ProviderStatusActive,
+		LegalEntity:  entity.LegalEntity{Name: `ООО "Купер"`, TaxID: "7705935687"},
+		Contacts:     []entity.Contact{{Name: "support", Email: "help@kuper.ru", Phone: "+78005553535"}},
+		Website:      "https://kuper.ru",
+		Countries:    []string{"RU", "KZ"},
+		Capabilities: entity.Capabilities{Courier: true, SameDay: true, CashOnDelivery: true},
+		DisplayNames: map[string]string{"kk": "Купер", "en": "Kuper"},
+		Labels:       map[string]string{"region": "msk", "tier": "gold"},
+		DisplayName:  "Kuper",
+	}
+
+	// Only the importable part survives the round trip.
+	imported := &entity.Provider{
+		ProviderID:   exported.ProviderID,
+		Name:         exported.Name,
+		LegalEntity:  exported.LegalEntity,
+		Contacts:     exported.Contacts,
+		Website:      exported.Website,
+		Countries:    exported.Countries,
+		Capabilities: exported.Capabilities,
+		DisplayNames: exported.DisplayNames,
+		Labels:       exported.Labels,
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		newWriter   func(*bufio.Writer) (providerWriter, error)
+		wantLine    int
+	}{
+		{name: "csv", contentType: _mimeCSV, newWriter: newCSVProviderWriter, wantLine: 2},
+		{name: "ndjson", contentType: _mimeNDJSON, newWriter: newNDJSONProviderWriter, wantLine: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			w := bufio.NewWriter(&buf)
+			pw, err := tt.newWriter(w)
+			require.NoError(t, err)
+			require.NoError(t, pw.Write(exported))
+			require.NoError(t, pw.Flush())
+
+			rows, err := decodeImport(tt.contentType, &buf)
+			require.NoError(t, err)
+			require.Equal(t, []entity.ImportRow{{Line: tt.wantLine, Provider: imported}}, rows)
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func TestProviderExport_ClientGone(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := mock_usecase.NewMockProvider(ctrl)
+	uc.EXPECT().Export(gomock.Any(), entity.ProviderFilter{}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ entity.ProviderFilter, fn func(*entity.Provider) error) error {
+			for rows := 1; ; rows++ {
+				if err := fn(&entity.Provider{ProviderID: "kuper"}); err != nil {
+					// The write filling the buffer or the first flush fails and cancels the query.
+					require.LessOrEqual(t, rows, _exportFlushRows)
+					require.ErrorIs(t, ctx.Err(), context.Canceled)
+
+					return err
+				}
+			}
+		})
+
+	pw, err := newNDJSONProviderWriter(bufio.NewWriter(failingWriter{}))
+	require.NoError(t, err)
+
+	c := &controllerProvider{uc: uc}
+	require.Error(t, c.exportProviders(context.Background(), pw, entity.ProviderFilter{}, nil))
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+type providerHistoryQuery struct {
+	PageSize  int    `query:"page_size" validate:"gte=0"`
+	PageToken string `query:"page_token"`
+}
+
+type auditEntryResponse struct {
+	AuditID    int64              `json:"audit_id" example:"42"`
+	ProviderID entity.ProviderID  `json:"provider_id" example:"kuper"`
+	Action     entity.AuditAction `json:"action" example:"update"`
+	// OldValue is the provider before the change, absent on create.
+	OldValue json.RawMessage `json:"old_value,omitempty" swaggertype:"object"`
+	// NewValue is the provider after the change, absent on purge.
+	NewValue json.RawMessage `json:"new_value,omitempty" swaggertype:"object"`
+	// ClaimedActor is who the caller named in X-Actor, it is not verified.
+	ClaimedActor string    `json:"claimed_actor" example:"ops@kuper.ru"`
+	RequestID    string    `json:"request_id" example:"5f0e8a1c-3b2d-4c7a-9d1e-2f3a4b5c6d7e"`
+	CreatedAt    time.Time `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+type providerHistoryResponse struct {
+	Entries       []auditEntryResponse `json:"entries"`
+	NextPageToken string               `json:"next_page_token" example:"NDI"`
+}
+
+// @Summary		Provider history
+// @Description	Lists recorded changes of a delivery provider, newest first. History outlives purged providers
+// @ID				providerHistory
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			page_size	query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token	query		string	false	"Token of the next page from a previous response"
+// @Success		200			{object}	providerHistoryResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/history [get]
+func (c *controllerProvider) providerHistory(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerHistory - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query providerHistoryQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerHistory - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerHistory - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.History(ctx.UserContext(), entity.ProviderID(providerID), entity.HistoryListParams{
+		PageSize:  query.PageSize,
+		PageToken: query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerHistory - uc.History: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	entries := make([]auditEntryResponse, len(page.Entries))
+
+	for i, e := range page.Entries {
+		if e != nil {
+			entries[i] = auditEntryResponse(*e)
+		}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(providerHistoryResponse{
+		Entries:       entries,
+		NextPageToken: page.NextPageToken,
+	})
+}
@@ -0,0 +1,343 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	_mimeCSV    = "text/csv"
+	_mimeNDJSON = "application/x-ndjson"
+
+	// _csvListSeparator separates countries within a CSV cell.
+	_csvListSeparator = ";"
+)
+
+// _csvColumns is the header of provider CSV files, provider_id and name are required, the rest may be omitted.
+var _csvColumns = []string{
+	"provider_id", "name", "legal_name", "tax_id", "website", "countries", "contacts",
+	"courier", "pickup_points", "lockers", "same_day", "cash_on_delivery", "display_names", "labels",
+}
+
+// _csvReadOnlyColumns are written by the export and ignored by the import, so an export can be imported back.
+var _csvReadOnlyColumns = []string{
+	"status", "status_reason", "version", "created_at", "updated_at", "deleted_at",
+}
+
+var errUnsupportedFormat = errors.New("unsupported format")
+
+type providerImportQuery struct {
+	DryRun bool `query:"dry_run"`
+}
+
+// providerImportRecord is a line of an NDJSON import file. Read-only fields of exported providers are accepted
+// and ignored, other unknown fields are rejected to catch typos.
+type providerImportRecord struct {
+	ProviderID   entity.ProviderID   `json:"provider_id"`
+	Name         string              `json:"name"`
+	LegalEntity  entity.LegalEntity  `json:"legal_entity"`
+	Contacts     []entity.Contact    `json:"contacts"`
+	Website      string              `json:"website"`
+	Countries    []string            `json:"countries"`
+	Capabilities entity.Capabilities `json:"capabilities"`
+	DisplayNames map[string]string   `json:"display_names"`
+	Labels       map[string]string   `json:"labels"`
+
+	DisplayName  json.RawMessage `json:"display_name"`
+	Status       json.RawMessage `json:"status"`
+	StatusReason json.RawMessage `json:"status_reason"`
+	Version      json.RawMessage `json:"version"`
+	CreatedAt    json.RawMessage `json:"created_at"`
+	UpdatedAt    json.RawMessage `json:"updated_at"`
+	DeletedAt    json.RawMessage `json:"deleted_at"`
+}
+
+type importRowResponse struct {
+	Line       int                 `json:"line" example:"2"`
+	ProviderID entity.ProviderID   `json:"provider_id,omitempty" example:"kuper"`
+	Action     entity.ImportAction `json:"action" example:"created"`
+	Error      string              `json:"error,omitempty" example:"countries: \"rus\" is not an ISO 3166-1 alpha-2 code: invalid argument"`
+}
+
+type providerImportResponse struct {
+	DryRun    bool                `json:"dry_run" example:"false"`
+	Applied   bool                `json:"applied" example:"true"`
+	Created   int                 `json:"created" example:"10"`
+	Updated   int                 `json:"updated" example:"2"`
+	Unchanged int                 `json:"unchanged" example:"0"`
+	Failed    int                 `json:"failed" example:"0"`
+	Rows      []importRowResponse `json:"rows"`
+}
+
+// @Summary		Import providers
+// @Description	Creates providers or updates them from a CSV file with a header row or from NDJSON. Fields missing from a row
+// @Description	or left empty keep their stored values. Every row is validated and the import is applied in a single
+// @Description	transaction only if all rows succeed, otherwise nothing is changed.
+// @Description	CSV columns: provider_id, name, legal_name, tax_id, website, countries (separated by ";"), contacts (JSON array),
+// @Description	courier, pickup_points, lockers, same_day, cash_on_delivery, display_names (JSON object keyed by language tag), labels (JSON object).
+// @ID				providerImport
+// @Tags			Provider
+// @Accept			text/csv,application/x-ndjson
+// @Produce		json
+// @Param			dry_run	query		bool	false	"Only report what would happen"
+// @Param			body	body		string	true	"CSV or NDJSON file"
+// @Success		200		{object}	providerImportResponse
+// @Failure		400		{object}	responseError
+// @Failure		415		{object}	responseError
+// @Failure		422		{object}	providerImportResponse
+// @Failure		500		{object}	responseError
+// @Router			/providers:import [post]
+func (c *controllerProvider) providerImport(ctx *fiber.Ctx) error {
+	var query providerImportQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerImport - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	rows, err := decodeImport(ctx.Get(fiber.HeaderContentType), bytes.NewReader(ctx.Body()))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerImport - decodeImport: %w", err))
+
+		if errors.Is(err, errUnsupportedFormat) {
+			return errorResponse(ctx, http.StatusUnsupportedMediaType, err.Error())
+		}
+
+		return errorResponse(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	report, err := c.uc.Import(ctx.UserContext(), rows, query.DryRun)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerImport - uc.Import: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	response := providerImportResponse{
+		DryRun:    report.DryRun,
+		Applied:   report.Applied,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Unchanged: report.Unchanged,
+		Failed:    report.Failed,
+		Rows:      make([]importRowResponse, len(report.Rows)),
+	}
+
+	for i, row := range report.Rows {
+		response.Rows[i] = importRowResponse(row)
+	}
+
+	if report.Failed > 0 {
+		return ctx.Status(http.StatusUnprocessableEntity).JSON(response)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(response)
+}
+
+func decodeImport(contentType string, r io.Reader) ([]entity.ImportRow, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch mediaType {
+	case _mimeCSV:
+		return decodeImportCSV(r)
+	case _mimeNDJSON, "application/jsonl":
+		return decodeImportNDJSON(r)
+	default:
+		return nil, fmt.Errorf("%w %q, use %s or %s", errUnsupportedFormat, mediaType, _mimeCSV, _mimeNDJSON)
+	}
+}
+
+// decodeImportCSV fails as a whole only on a malformed header, problems of single rows are reported in the rows.
+func decodeImportCSV(r io.Reader) ([]entity.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if slices.Contains(_csvReadOnlyColumns, name) {
+			continue
+		}
+
+		if !slices.Contains(_csvColumns, name) {
+			return nil, fmt.Errorf("csv header: unknown column %q", name)
+		}
+
+		columns[name] = i
+	}
+
+	for _, required := range []string{"provider_id", "name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("csv header: column %q is missing", required)
+		}
+	}
+
+	var rows []entity.ImportRow
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, entity.ImportRow{Line: parseErr.StartLine, Err: parseErr.Err})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		if len(record) != len(header) {
+			rows = append(rows, entity.ImportRow{Line: line, Err: fmt.Errorf("%d fields, header has %d", len(record), len(header))})
+
+			continue
+		}
+
+		provider, err := providerFromCSV(record, columns)
+		rows = append(rows, entity.ImportRow{Line: line, Provider: provider, Err: err})
+	}
+}
+
+func providerFromCSV(record []string, columns map[string]int) (*entity.Provider, error) {
+	cell := func(name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	provider := &entity.Provider{
+		ProviderID: entity.ProviderID(cell("provider_id")),
+		Name:       cell("name"),
+		LegalEntity: entity.LegalEntity{
+			Name:  cell("legal_name"),
+			TaxID: cell("tax_id"),
+		},
+		Website: cell("website"),
+	}
+
+	if countries := cell("countries"); countries != "" {
+		for _, country := range strings.Split(countries, _csvListSeparator) {
+			provider.Countries = append(provider.Countries, strings.TrimSpace(country))
+		}
+	}
+
+	if contacts := cell("contacts"); contacts != "" {
+		if err := json.Unmarshal([]byte(contacts), &provider.Contacts); err != nil {
+			return nil, fmt.Errorf("contacts: not a JSON array of contacts: %w", err)
+		}
+	}
+
+	if displayNames := cell("display_names"); displayNames != "" {
+		if err := json.Unmarshal([]byte(displayNames), &provider.DisplayNames); err != nil {
+			return nil, fmt.Errorf("display_names: not a JSON object of names: %w", err)
+		}
+	}
+
+	if labels := cell("labels"); labels != "" {
+		if err := json.Unmarshal([]byte(labels), &provider.Labels); err != nil {
+			return nil, fmt.Errorf("labels: not a JSON object of labels: %w", err)
+		}
+	}
+
+	flags := []struct {
+		column string
+		value  *bool
+	}{
+		{"courier", &provider.Capabilities.Courier},
+		{"pickup_points", &provider.Capabilities.PickupPoints},
+		{"lockers", &provider.Capabilities.Lockers},
+		{"same_day", &provider.Capabilities.SameDay},
+		{"cash_on_delivery", &provider.Capabilities.CashOnDelivery},
+	}
+
+	for _, flag := range flags {
+		value := cell(flag.column)
+		if value == "" {
+			continue
+		}
+
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %q is not a boolean", flag.column, value)
+		}
+
+		*flag.value = b
+	}
+
+	return provider, nil
+}
+
+func decodeImportNDJSON(r io.Reader) ([]entity.ImportRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []entity.ImportRow
+
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var record providerImportRecord
+
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&record); err != nil {
+			rows = append(rows, entity.ImportRow{Line: line, Err: err})
+
+			continue
+		}
+
+		rows = append(rows, entity.ImportRow{Line: line, Provider: &entity.Provider{
+			ProviderID:   record.ProviderID,
+			Name:         record.Name,
+			LegalEntity:  record.LegalEntity,
+			Contacts:     record.Contacts,
+			Website:      record.Website,
+			Countries:    record.Countries,
+			Capabilities: record.Capabilities,
+			DisplayNames: record.DisplayNames,
+			Labels:       record.Labels,
+		}})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ndjson: %w", err)
+	}
+
+	return rows, nil
+}
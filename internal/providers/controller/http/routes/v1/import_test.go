@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("csv rows keep their line numbers", func(t *testing.T) {
+		t.Parallel()
+
+		body := "provider_id,name,countries,contacts,courier,same_day\n" +
+			"kuper,Купер,RU;KZ,\"[{\"\"email\"\":\"\"help@kuper.ru\"\"}]\",true,true\n" +
+			"\n" +
+			"dostavista,Достависта,RU,,yes,\n"
+
+		rows, err := decodeImport("text/csv; charset=utf-8", strings.NewReader(body))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, 2, rows[0].Line)
+		require.NoError(t, rows[0].Err)
+		require.Equal(t, &entity.Provider{
+			ProviderID:   entity.ProviderID("kuper"),
+			Name:         "Купер",
+			Countries:    []string{"RU", "KZ"},
+			Contacts:     []entity.Contact{{Email: "help@kuper.ru"}},
+			Capabilities: entity.Capabilities{Courier: true, SameDay: true},
+		}, rows[0].Provider)
+
+		require.Equal(t, 4, rows[1].Line)
+		require.EqualError(t, rows[1].Err, `courier: "yes" is not a boolean`)
+	})
+
+	t.Run("csv header must name known columns", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := decodeImport("text/csv", strings.NewReader("provider_id,title\nkuper,Купер\n"))
+		require.Error(t, err)
+
+		_, err = decodeImport("text/csv", strings.NewReader("provider_id\nkuper\n"))
+		require.Error(t, err)
+	})
+
+	t.Run("ndjson rows skip blank lines", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"provider_id":"kuper","name":"Купер","capabilities":{"lockers":true}}` + "\n\n" +
+			`{"provider_id":"dostavista","nmae":"Достависта"}` + "\n"
+
+		rows, err := decodeImport("application/x-ndjson", strings.NewReader(body))
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, 1, rows[0].Line)
+		require.Equal(t, &entity.Provider{
+			ProviderID:   entity.ProviderID("kuper"),
+			Name:         "Купер",
+			Capabilities: entity.Capabilities{Lockers: true},
+		}, rows[0].Provider)
+
+		require.Equal(t, 3, rows[1].Line)
+		require.Error(t, rows[1].Err)
+	})
+
+	t.Run("unsupported content type", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := decodeImport("application/json", strings.NewReader("[]"))
+		require.ErrorIs(t, err, errUnsupportedFormat)
+	})
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// localize picks display names of the providers in the languages of the Accept-Language header.
+func localize(ctx *fiber.Ctx, providers ...*entity.Provider) {
+	ctx.Vary(fiber.HeaderAcceptLanguage)
+
+	languages := entity.ParseAcceptLanguage(ctx.Get(fiber.HeaderAcceptLanguage))
+
+	for _, p := range providers {
+		if p != nil {
+			p.Localize(languages)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerPickupPointSearch struct {
+	uc usecase.PickupPointSearch
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesPickupPointSearch(apiGroup fiber.Router, uc usecase.PickupPointSearch, l logger.Interface) {
+	r := &controllerPickupPointSearch{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	apiGroup.Get("/pickup-points\\:nearby", r.pickupPointsNearby)
+}
+
+type pickupPointsNearbyQuery struct {
+	Lat         string                   `query:"lat" validate:"required,latitude"`
+	Lon         string                   `query:"lon" validate:"required,longitude"`
+	Radius      float64                  `query:"radius" validate:"gte=0"`
+	Limit       int                      `query:"limit" validate:"gte=0"`
+	ProviderIDs []entity.ProviderID      `query:"provider_id"`
+	Types       []entity.PickupPointType `query:"type" validate:"dive,oneof=pvz locker post_office"`
+}
+
+type nearbyPickupPointResponse struct {
+	PickupPoint pickupPointEntityResponse `json:"pickup_point"`
+	// Distance is the great-circle distance from the searched position in meters.
+	Distance float64 `json:"distance" example:"111.2"`
+}
+
+type pickupPointsNearbyResponse struct {
+	PickupPoints []nearbyPickupPointResponse `json:"pickup_points"`
+}
+
+// @Summary		Find nearby pickup points
+// @Description	Finds pickup points of active providers nearest to the position, nearest first
+// @ID				pickupPointsNearby
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			lat			query		number		true	"Latitude in degrees"
+// @Param			lon			query		number		true	"Longitude in degrees"
+// @Param			radius		query		number		false	"Search radius in meters, 5000 by default, 50000 at most"
+// @Param			limit		query		int			false	"Number of points, 10 by default, 100 at most"
+// @Param			provider_id	query		[]string	false	"Providers to keep"	collectionFormat(multi)
+// @Param			type		query		[]string	false	"Point types to keep"	collectionFormat(multi)
+// @Success		200			{object}	pickupPointsNearbyResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/pickup-points:nearby [get]
+func (c *controllerPickupPointSearch) pickupPointsNearby(ctx *fiber.Ctx) error {
+	var query pickupPointsNearbyQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointsNearby - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointsNearby - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	// Both are checked by the validator.
+	lat, _ := strconv.ParseFloat(query.Lat, 64)
+	lon, _ := strconv.ParseFloat(query.Lon, 64)
+
+	found, err := c.uc.Nearby(ctx.UserContext(), entity.PickupPointNearbyQuery{
+		Position:    entity.Position{lon, lat},
+		RadiusM:     query.Radius,
+		Limit:       query.Limit,
+		ProviderIDs: query.ProviderIDs,
+		Types:       query.Types,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointsNearby - uc.Nearby: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "pickup point search problems")
+	}
+
+	response := pickupPointsNearbyResponse{PickupPoints: make([]nearbyPickupPointResponse, len(found))}
+
+	for i, f := range found {
+		response.PickupPoints[i] = nearbyPickupPointResponse{
+			PickupPoint: pickupPointToResponse(f.Point),
+			Distance:    f.DistanceM,
+		}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(response)
+}
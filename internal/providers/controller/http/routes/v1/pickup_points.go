@@ -0,0 +1,550 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerPickupPoint struct {
+	uc usecase.PickupPoint
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesPickupPoint(apiGroup fiber.Router, uc usecase.PickupPoint, l logger.Interface) {
+	r := &controllerPickupPoint{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	pointGroup := apiGroup.Group("/providers/:providerID/pickup-points")
+	{
+		pointGroup.Post("", r.pickupPointCreate)
+		pointGroup.Get("", r.pickupPointGetAll)
+		pointGroup.Get("/:pointID", r.pickupPointGet)
+		pointGroup.Put("/:pointID", r.pickupPointUpdate)
+		pointGroup.Patch("/:pointID", r.pickupPointPatch)
+		pointGroup.Delete("/:pointID", r.pickupPointDelete)
+	}
+}
+
+type location struct {
+	Lat float64 `json:"lat" validate:"gte=-90,lte=90" example:"55.7558"`
+	Lon float64 `json:"lon" validate:"gte=-180,lte=180" example:"37.6173"`
+}
+
+type openingHours struct {
+	// Weekday is ISO, 1 is Monday and 7 is Sunday.
+	Weekday int    `json:"weekday" validate:"required,gte=1,lte=7" example:"1"`
+	Opens   string `json:"opens" validate:"required,len=5" example:"09:00"`
+	// Closes "24:00" is the end of the day.
+	Closes string `json:"closes" validate:"required,len=5" example:"21:00"`
+}
+
+// parcelDimensions limit accepted parcels, zero means no limit.
+type parcelDimensions struct {
+	LengthCM int `json:"length_cm" validate:"gte=0" example:"60"`
+	WidthCM  int `json:"width_cm" validate:"gte=0" example:"40"`
+	HeightCM int `json:"height_cm" validate:"gte=0" example:"40"`
+	WeightG  int `json:"weight_g" validate:"gte=0" example:"15000"`
+}
+
+type pickupPointCreateRequest struct {
+	PointID entity.PickupPointID   `json:"point_id" validate:"required" example:"msk-0001"`
+	Name    string                 `json:"name" validate:"required" example:"Пункт выдачи на Тверской"`
+	Type    entity.PickupPointType `json:"type" validate:"required,oneof=pvz locker post_office" example:"pvz"`
+	Address string                 `json:"address" validate:"required" example:"Москва, Тверская ул., 1"`
+	// Location is required, it is a pointer to tell it from the zero position.
+	Location *location `json:"location" validate:"required"`
+	// Intervals of a weekday must not overlap, weekdays without any are days off.
+	OpeningHours   []openingHours         `json:"opening_hours" validate:"dive"`
+	PaymentOptions []entity.PaymentOption `json:"payment_options" validate:"required,min=1,dive,oneof=prepaid cash card" example:"prepaid,card"`
+	MaxParcel      parcelDimensions       `json:"max_parcel"`
+}
+
+type pickupPointEntityResponse struct {
+	ProviderID     entity.ProviderID      `json:"provider_id" example:"kuper"`
+	PointID        entity.PickupPointID   `json:"point_id" example:"msk-0001"`
+	Name           string                 `json:"name" example:"Пункт выдачи на Тверской"`
+	Type           entity.PickupPointType `json:"type" example:"pvz"`
+	Address        string                 `json:"address" example:"Москва, Тверская ул., 1"`
+	Location       location               `json:"location"`
+	OpeningHours   []openingHours         `json:"opening_hours"`
+	PaymentOptions []entity.PaymentOption `json:"payment_options" example:"prepaid,card"`
+	MaxParcel      parcelDimensions       `json:"max_parcel"`
+	CreatedAt      time.Time              `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt      time.Time              `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+	Version        int64                  `json:"version" example:"1"`
+}
+
+func pickupPointToResponse(p *entity.PickupPoint) pickupPointEntityResponse {
+	res := pickupPointEntityResponse{
+		ProviderID:     p.ProviderID,
+		PointID:        p.PointID,
+		Name:           p.Name,
+		Type:           p.Type,
+		Address:        p.Address,
+		Location:       location{Lat: p.Lat, Lon: p.Lon},
+		OpeningHours:   make([]openingHours, len(p.OpeningHours)),
+		PaymentOptions: p.PaymentOptions,
+		MaxParcel:      parcelDimensions(p.MaxParcel),
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+		Version:        p.Version,
+	}
+
+	for i, h := range p.OpeningHours {
+		weekday := int(h.Weekday)
+		if h.Weekday == time.Sunday {
+			weekday = 7
+		}
+
+		res.OpeningHours[i] = openingHours{Weekday: weekday, Opens: h.Opens.String(), Closes: h.Closes.String()}
+	}
+
+	return res
+}
+
+// openingHoursFromRequest expects hours checked by the validator, the times may still be malformed.
+func openingHoursFromRequest(hours []openingHours) ([]entity.OpeningHours, error) {
+	res := make([]entity.OpeningHours, len(hours))
+
+	for i, h := range hours {
+		opens, err := entity.ParseTimeOfDay(h.Opens)
+		if err != nil {
+			return nil, err
+		}
+
+		closes, err := entity.ParseTimeOfDay(h.Closes)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = entity.OpeningHours{Weekday: time.Weekday(h.Weekday % 7), Opens: opens, Closes: closes}
+	}
+
+	return res, nil
+}
+
+type pickupPointCreateResponse pickupPointEntityResponse
+
+// @Summary		Create a pickup point
+// @Description	Adds a pickup point, a parcel locker or a post office to a provider
+// @ID				pickupPointCreate
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string						true	"Provider ID"
+// @Param			body		body		pickupPointCreateRequest	true	"Pickup point create parameters"
+// @Success		201			{object}	pickupPointCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/pickup-points [post]
+func (c *controllerPickupPoint) pickupPointCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody pickupPointCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	hours, err := openingHoursFromRequest(requestBody.OpeningHours)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointCreate - openingHoursFromRequest: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	point, err := c.uc.Create(ctx.UserContext(), &entity.PickupPoint{
+		ProviderID:     entity.ProviderID(providerID),
+		PointID:        requestBody.PointID,
+		Name:           requestBody.Name,
+		Type:           requestBody.Type,
+		Address:        requestBody.Address,
+		Lat:            requestBody.Location.Lat,
+		Lon:            requestBody.Location.Lon,
+		OpeningHours:   hours,
+		PaymentOptions: requestBody.PaymentOptions,
+		MaxParcel:      entity.ParcelDimensions(requestBody.MaxParcel),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointCreate - uc.Create: %w", err))
+
+		switch {
+		case errors.Is(err, entity.ErrAlreadyExists):
+			return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", requestBody.PointID, entity.ErrAlreadyExists.Error()))
+		case errors.Is(err, entity.ErrNotFound):
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		case errors.Is(err, entity.ErrInvalidArgument):
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		default:
+			return errorResponse(ctx, http.StatusInternalServerError, "pickup point database problems")
+		}
+	}
+
+	ctx.Set(fiber.HeaderETag, point.ETag())
+
+	return ctx.Status(http.StatusCreated).JSON(pickupPointCreateResponse(pickupPointToResponse(point)))
+}
+
+type pickupPointListAllQuery struct {
+	PageSize  int    `query:"page_size" validate:"gte=0"`
+	PageToken string `query:"page_token"`
+}
+
+type pickupPointListAllResponse struct {
+	PickupPoints  []pickupPointEntityResponse `json:"pickup_points"`
+	NextPageToken string                      `json:"next_page_token" example:"bXNrLTAwMDE"`
+}
+
+// @Summary		List pickup points
+// @Description	Lists pickup points of a provider ordered by ID page by page
+// @ID				pickupPointListAll
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			page_size	query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token	query		string	false	"Token of the next page from a previous response"
+// @Success		200			{object}	pickupPointListAllResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/pickup-points [get]
+func (c *controllerPickupPoint) pickupPointGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGetAll - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query pickupPointListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.PickupPointListParams{
+		ProviderID: entity.ProviderID(providerID),
+		PageSize:   query.PageSize,
+		PageToken:  query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGetAll - uc.ListAll: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "pickup point database problems")
+	}
+
+	points := make([]pickupPointEntityResponse, len(page.PickupPoints))
+
+	for i, p := range page.PickupPoints {
+		points[i] = pickupPointToResponse(p)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(pickupPointListAllResponse{
+		PickupPoints:  points,
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+type paramPointID entity.PickupPointID
+
+type pickupPointGetResponse pickupPointEntityResponse
+
+// @Summary		Get a pickup point
+// @Description	Returns a pickup point of a provider by its ID
+// @ID				pickupPointGet
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			pointID		path		string	true	"Pickup point ID"
+// @Success		200			{object}	pickupPointGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/pickup-points/{pointID} [get]
+func (c *controllerPickupPoint) pickupPointGet(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	pointID := paramPointID(ctx.Params("pointID"))
+	if providerID == "" || pointID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGet - providerID or pointID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	point, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID), entity.PickupPointID(pointID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointGet - uc.GetByID: %w", err))
+
+		return pickupPointErrorResponse(ctx, pointID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, point.ETag())
+
+	return ctx.Status(http.StatusOK).JSON(pickupPointGetResponse(pickupPointToResponse(point)))
+}
+
+type pickupPointUpdateRequest struct {
+	Name           string                 `json:"name" validate:"required" example:"Пункт выдачи на Тверской"`
+	Type           entity.PickupPointType `json:"type" validate:"required,oneof=pvz locker post_office" example:"pvz"`
+	Address        string                 `json:"address" validate:"required" example:"Москва, Тверская ул., 1"`
+	Location       *location              `json:"location" validate:"required"`
+	OpeningHours   []openingHours         `json:"opening_hours" validate:"dive"`
+	PaymentOptions []entity.PaymentOption `json:"payment_options" validate:"required,min=1,dive,oneof=prepaid cash card" example:"prepaid,card"`
+	MaxParcel      parcelDimensions       `json:"max_parcel"`
+}
+
+type pickupPointUpdateResponse pickupPointEntityResponse
+
+// @Summary		Update a pickup point
+// @Description	Replaces all updatable fields of a pickup point
+// @ID				pickupPointUpdate
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string						true	"Provider ID"
+// @Param			pointID		path		string						true	"Pickup point ID"
+// @Param			If-Match	header		string						false	"ETag of the pickup point version being replaced"
+// @Param			body		body		pickupPointUpdateRequest	true	"Pickup point update parameters"
+// @Success		200			{object}	pickupPointUpdateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/pickup-points/{pointID} [put]
+func (c *controllerPickupPoint) pickupPointUpdate(ctx *fiber.Ctx) error {
+	var requestBody pickupPointUpdateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointUpdate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointUpdate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	hours, err := openingHoursFromRequest(requestBody.OpeningHours)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointUpdate - openingHoursFromRequest: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	return c.update(ctx, "pickupPointUpdate", &entity.PickupPoint{
+		Name:           requestBody.Name,
+		Type:           requestBody.Type,
+		Address:        requestBody.Address,
+		Lat:            requestBody.Location.Lat,
+		Lon:            requestBody.Location.Lon,
+		OpeningHours:   hours,
+		PaymentOptions: requestBody.PaymentOptions,
+		MaxParcel:      entity.ParcelDimensions(requestBody.MaxParcel),
+	}, entity.PickupPointMask{"*"})
+}
+
+// pickupPointPatchRequest uses pointers to tell omitted fields from empty ones.
+type pickupPointPatchRequest struct {
+	Name           *string                 `json:"name" validate:"omitnil,min=1" example:"Пункт выдачи на Тверской"`
+	Type           *entity.PickupPointType `json:"type" validate:"omitnil,oneof=pvz locker post_office" example:"pvz"`
+	Address        *string                 `json:"address" validate:"omitnil,min=1" example:"Москва, Тверская ул., 1"`
+	Location       *location               `json:"location"`
+	OpeningHours   *[]openingHours         `json:"opening_hours" validate:"omitnil,dive"`
+	PaymentOptions *[]entity.PaymentOption `json:"payment_options" validate:"omitnil,min=1,dive,oneof=prepaid cash card" example:"prepaid,card"`
+	MaxParcel      *parcelDimensions       `json:"max_parcel"`
+}
+
+// @Summary		Partially update a pickup point
+// @Description	Updates only the fields present in the request body, an empty opening_hours list makes every day a day off
+// @ID				pickupPointPatch
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string					true	"Provider ID"
+// @Param			pointID		path		string					true	"Pickup point ID"
+// @Param			If-Match	header		string					false	"ETag of the pickup point version being updated"
+// @Param			body		body		pickupPointPatchRequest	true	"Pickup point fields to update"
+// @Success		200			{object}	pickupPointUpdateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/pickup-points/{pointID} [patch]
+func (c *controllerPickupPoint) pickupPointPatch(ctx *fiber.Ctx) error {
+	var requestBody pickupPointPatchRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointPatch - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointPatch - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	point := new(entity.PickupPoint)
+	var mask entity.PickupPointMask
+
+	if requestBody.Name != nil {
+		point.Name = *requestBody.Name
+		mask = append(mask, entity.PickupPointFieldName)
+	}
+	if requestBody.Type != nil {
+		point.Type = *requestBody.Type
+		mask = append(mask, entity.PickupPointFieldType)
+	}
+	if requestBody.Address != nil {
+		point.Address = *requestBody.Address
+		mask = append(mask, entity.PickupPointFieldAddress)
+	}
+	if requestBody.Location != nil {
+		point.Lat, point.Lon = requestBody.Location.Lat, requestBody.Location.Lon
+		mask = append(mask, entity.PickupPointFieldLocation)
+	}
+	if requestBody.OpeningHours != nil {
+		hours, err := openingHoursFromRequest(*requestBody.OpeningHours)
+		if err != nil {
+			c.l.Error(fmt.Errorf("http - v1 - pickupPointPatch - openingHoursFromRequest: %w", err))
+
+			return errorResponse(ctx, http.StatusBadRequest, "bad request")
+		}
+
+		point.OpeningHours = hours
+		mask = append(mask, entity.PickupPointFieldOpeningHours)
+	}
+	if requestBody.PaymentOptions != nil {
+		point.PaymentOptions = *requestBody.PaymentOptions
+		mask = append(mask, entity.PickupPointFieldPaymentOptions)
+	}
+	if requestBody.MaxParcel != nil {
+		point.MaxParcel = entity.ParcelDimensions(*requestBody.MaxParcel)
+		mask = append(mask, entity.PickupPointFieldMaxParcel)
+	}
+
+	return c.update(ctx, "pickupPointPatch", point, mask)
+}
+
+// update is shared by pickupPointUpdate and pickupPointPatch, handler names the caller in logs.
+func (c *controllerPickupPoint) update(ctx *fiber.Ctx, handler string, point *entity.PickupPoint, mask entity.PickupPointMask) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	pointID := paramPointID(ctx.Params("pointID"))
+	if providerID == "" || pointID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - %s - providerID or pointID not provided", handler))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - %s - entity.ParseETag: %w", handler, err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	point.Version = version
+
+	pointUpdated, err := c.uc.Update(ctx.UserContext(), entity.ProviderID(providerID), entity.PickupPointID(pointID), point, mask)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - %s - uc.Update: %w", handler, err))
+
+		return pickupPointErrorResponse(ctx, pointID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, pointUpdated.ETag())
+
+	return ctx.Status(http.StatusOK).JSON(pickupPointUpdateResponse(pickupPointToResponse(pointUpdated)))
+}
+
+// @Summary		Delete a pickup point
+// @Description	Deletes a pickup point of a provider
+// @ID				pickupPointDelete
+// @Tags			PickupPoint
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path	string	true	"Provider ID"
+// @Param			pointID		path	string	true	"Pickup point ID"
+// @Param			If-Match	header	string	false	"ETag of the pickup point version being deleted"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		412	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}/pickup-points/{pointID} [delete]
+func (c *controllerPickupPoint) pickupPointDelete(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	pointID := paramPointID(ctx.Params("pointID"))
+	if providerID == "" || pointID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointDelete - providerID or pointID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointDelete - entity.ParseETag: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	err = c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID), entity.PickupPointID(pointID), version)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - pickupPointDelete - uc.Delete: %w", err))
+
+		return pickupPointErrorResponse(ctx, pointID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+func pickupPointErrorResponse(ctx *fiber.Ctx, pointID paramPointID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", pointID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	case errors.Is(err, entity.ErrPreconditionFailed):
+		return errorResponse(ctx, http.StatusPreconditionFailed, fmt.Sprintf("%s: %s", pointID, entity.ErrPreconditionFailed.Error()))
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "pickup point database problems")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/classydevv/fulfillment/internal/providers/controller/http/middleware"
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 	"github.com/classydevv/fulfillment/internal/providers/usecase"
 	"github.com/classydevv/fulfillment/pkg/logger"
@@ -19,20 +20,41 @@ type controllerProvider struct {
 	v  *validator.Validate
 }
 
-func NewRoutesProvider(apiGroup fiber.Router, uc usecase.Provider, l logger.Interface) {
+func NewRoutesProvider(apiGroup fiber.Router, uc usecase.Provider, adminToken string, l logger.Interface) {
 	r := &controllerProvider{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+	// Collection-wide custom methods have to be registered outside of the group.
+	apiGroup.Post("/providers\\:import", r.providerImport)
+	apiGroup.Get("/providers\\:export", r.providerExport)
+	apiGroup.Get("/providers\\:search", r.providerSearch)
+
 	providerGroup := apiGroup.Group("/providers")
 	{
 		providerGroup.Post("", r.providerCreate)
 		providerGroup.Get("", r.providerGetAll)
+		providerGroup.Get("/:providerID", r.providerGet)
+		providerGroup.Get("/:providerID/history", r.providerHistory)
 		providerGroup.Put("/:providerID", r.providerUpdate)
+		providerGroup.Patch("/:providerID", r.providerPatch)
 		providerGroup.Delete("/:providerID", r.providerDelete)
+		providerGroup.Post("/:providerID\\:restore", r.providerRestore)
+		providerGroup.Post("/:providerID\\:purge", middleware.AdminOnly(adminToken, l), r.providerPurge)
+		providerGroup.Post("/:providerID\\:activate", r.providerActivate)
+		providerGroup.Post("/:providerID\\:suspend", r.providerSuspend)
+		providerGroup.Post("/:providerID\\:terminate", r.providerTerminate)
 	}
 }
 
 type providerCreateRequest struct {
-	ProviderID entity.ProviderID `json:"provider_id" validate:"required" example:"kuper"`
-	Name       string            `json:"name" validate:"required" example:"Купер"`
+	ProviderID   entity.ProviderID   `json:"provider_id" validate:"required" example:"kuper"`
+	Name         string              `json:"name" validate:"required" example:"Купер"`
+	LegalEntity  entity.LegalEntity  `json:"legal_entity"`
+	Contacts     []entity.Contact    `json:"contacts"`
+	Website      string              `json:"website" example:"https://kuper.ru"`
+	Countries    []string            `json:"countries" example:"RU,KZ"`
+	Capabilities entity.Capabilities `json:"capabilities"`
+	// DisplayNames are names shown to customers keyed by BCP-47 language tag.
+	DisplayNames map[string]string `json:"display_names" example:"kk:Купер,en:Kuper"`
+	Labels       map[string]string `json:"labels" example:"region:msk,tier:gold"`
 }
 
 type providerCreateResponse struct {
@@ -67,8 +89,15 @@ func (c *controllerProvider) providerCreate(ctx *fiber.Ctx) error {
 	}
 
 	providerID, err := c.uc.Create(ctx.UserContext(), &entity.Provider{
-		ProviderID: requestBody.ProviderID,
-		Name:       requestBody.Name,
+		ProviderID:   requestBody.ProviderID,
+		Name:         requestBody.Name,
+		LegalEntity:  requestBody.LegalEntity,
+		Contacts:     requestBody.Contacts,
+		Website:      requestBody.Website,
+		Countries:    requestBody.Countries,
+		Capabilities: requestBody.Capabilities,
+		DisplayNames: requestBody.DisplayNames,
+		Labels:       requestBody.Labels,
 	})
 	if err != nil {
 		c.l.Error(fmt.Errorf("http - v1 - providerCreate - uc.Save: %w", err))
@@ -77,6 +106,10 @@ func (c *controllerProvider) providerCreate(ctx *fiber.Ctx) error {
 			return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", requestBody.ProviderID, entity.ErrAlreadyExists.Error()))
 		}
 
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
 		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
 	}
 
@@ -84,61 +117,204 @@ func (c *controllerProvider) providerCreate(ctx *fiber.Ctx) error {
 }
 
 type providerEntityResponse struct {
-	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
-	Name       string            `json:"name" example:"Купер"`
-	CreatedAt  time.Time         `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
-	UpdatedAt  time.Time         `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+	ProviderID entity.ProviderID     `json:"provider_id" example:"kuper"`
+	Name       string                `json:"name" example:"Купер"`
+	CreatedAt  time.Time             `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt  time.Time             `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+	Version    int64                 `json:"version" example:"1"`
+	DeletedAt  *time.Time            `json:"deleted_at,omitempty" example:"2025-05-08T06:07:14.810915Z"`
+	Status     entity.ProviderStatus `json:"status" example:"active"`
+	// StatusReason explains the last suspension or termination.
+	StatusReason string              `json:"status_reason,omitempty" example:"contract review"`
+	LegalEntity  entity.LegalEntity  `json:"legal_entity"`
+	Contacts     []entity.Contact    `json:"contacts"`
+	Website      string              `json:"website" example:"https://kuper.ru"`
+	Countries    []string            `json:"countries" example:"RU,KZ"`
+	Capabilities entity.Capabilities `json:"capabilities"`
+	DisplayNames map[string]string   `json:"display_names" example:"kk:Купер,en:Kuper"`
+	Labels       map[string]string   `json:"labels" example:"region:msk,tier:gold"`
+	// DisplayName is the display name in the language of Accept-Language, name when there is none.
+	DisplayName string `json:"display_name" example:"Kuper"`
+}
+
+type providerListAllQuery struct {
+	PageSize      int    `query:"page_size" validate:"gte=0"`
+	PageToken     string `query:"page_token"`
+	NamePrefix    string `query:"name_prefix"`
+	CreatedAfter  string `query:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedBefore string `query:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	UpdatedAfter  string `query:"updated_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	UpdatedBefore string `query:"updated_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	OrderBy       string `query:"order_by"`
+	// IncludeArchived also lists archived providers.
+	IncludeArchived bool `query:"include_archived"`
+	// Statuses keeps providers in any of the listed statuses, the parameter may be repeated.
+	Statuses []entity.ProviderStatus `query:"status"`
+	// LabelSelector keeps providers with matching labels, e.g. "region=msk,tier in (gold,silver),!legacy".
+	LabelSelector string `query:"label_selector"`
 }
 
-type providerListAllResponse []providerEntityResponse
+type providerListAllResponse struct {
+	Providers     []providerEntityResponse `json:"providers"`
+	NextPageToken string                   `json:"next_page_token" example:"eyJvIjoicHJvdmlkZXJfaWQiLCJpZCI6Imt1cGVyIn0"`
+}
 
 // @Summary		List all providers
-// @Description	List all available providers registered in the system
+// @Description	List providers registered in the system page by page
 // @ID				providerListAll
 // @Tags			Provider
 // @Accept			json
 // @Produce		json
-// @Success		200	{object}	providerListAllResponse
-// @Failure		500	{object}	responseError
+// @Param			page_size		query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token		query		string	false	"Token of the next page from a previous response"
+// @Param			name_prefix		query		string	false	"Case-insensitive name prefix"
+// @Param			created_after	query		string	false	"RFC 3339 timestamp, inclusive"
+// @Param			created_before	query		string	false	"RFC 3339 timestamp, exclusive"
+// @Param			updated_after	query		string	false	"RFC 3339 timestamp, inclusive"
+// @Param			updated_before	query		string	false	"RFC 3339 timestamp, exclusive"
+// @Param			order_by		query		string	false	"provider_id (default) or created_at, optionally followed by asc or desc"
+// @Param			include_archived	query	bool	false	"Also return archived providers"
+// @Param			status			query		[]string	false	"Lifecycle statuses to keep: onboarding, active, suspended, terminated"	collectionFormat(multi)
+// @Param			label_selector	query		string	false	"Labels to match, e.g. region=msk,tier in (gold,silver),!legacy"
+// @Success		200				{object}	providerListAllResponse
+// @Failure		400				{object}	responseError
+// @Failure		500				{object}	responseError
 // @Router			/providers [get]
 func (c *controllerProvider) providerGetAll(ctx *fiber.Ctx) error {
-	providers, err := c.uc.ListAll(ctx.UserContext())
+	var query providerListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.ProviderListParams{
+		PageSize:      query.PageSize,
+		PageToken:     query.PageToken,
+		OrderBy:       query.OrderBy,
+		LabelSelector: query.LabelSelector,
+		Filter: entity.ProviderFilter{
+			NamePrefix:      query.NamePrefix,
+			CreatedAfter:    parseTimeQuery(query.CreatedAfter),
+			CreatedBefore:   parseTimeQuery(query.CreatedBefore),
+			UpdatedAfter:    parseTimeQuery(query.UpdatedAfter),
+			UpdatedBefore:   parseTimeQuery(query.UpdatedBefore),
+			IncludeArchived: query.IncludeArchived,
+			Statuses:        query.Statuses,
+		},
+	})
 	if err != nil {
 		c.l.Error(fmt.Errorf("http - v1 - providerGetAll - uc.ListAll: %w", err))
 
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
 		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
 	}
 
-	providersEntityResponse := make([]providerEntityResponse, len(providers))
+	localize(ctx, page.Providers...)
+
+	providersEntityResponse := make([]providerEntityResponse, len(page.Providers))
 
-	for i, p := range providers {
+	for i, p := range page.Providers {
 		if p != nil {
 			providersEntityResponse[i] = providerEntityResponse(*p)
 		}
 	}
 
-	return ctx.Status(http.StatusOK).JSON(providerListAllResponse(providersEntityResponse))
+	return ctx.Status(http.StatusOK).JSON(providerListAllResponse{
+		Providers:     providersEntityResponse,
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+// parseTimeQuery expects a value already checked by the validator, empty input yields the zero time.
+func parseTimeQuery(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
 }
 
 type paramProviderID entity.ProviderID
 
+type providerGetResponse providerEntityResponse
+
+// @Summary		Get a provider
+// @Description	Returns a delivery provider by its ID
+// @ID				providerGet
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Success		200			{object}	providerGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID} [get]
+func (c *controllerProvider) providerGet(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerGet - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerGet - uc.GetByID: %w", err))
+
+		if errors.Is(err, entity.ErrNotFound) {
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	ctx.Set(fiber.HeaderETag, provider.ETag())
+
+	localize(ctx, provider)
+
+	return ctx.Status(http.StatusOK).JSON(providerGetResponse(*provider))
+}
+
 type providerUpdateRequest struct {
-	Name string `json:"name" example:"Купер"`
+	Name         string              `json:"name" validate:"required" example:"Купер"`
+	LegalEntity  entity.LegalEntity  `json:"legal_entity"`
+	Contacts     []entity.Contact    `json:"contacts"`
+	Website      string              `json:"website" example:"https://kuper.ru"`
+	Countries    []string            `json:"countries" example:"RU,KZ"`
+	Capabilities entity.Capabilities `json:"capabilities"`
+	// DisplayNames and Labels replace all stored ones.
+	DisplayNames map[string]string `json:"display_names" example:"kk:Купер,en:Kuper"`
+	Labels       map[string]string `json:"labels" example:"region:msk,tier:gold"`
 }
 
 type providerUpdateResponse providerEntityResponse
 
 // @Summary		Update a provider
-// @Description	Updates a delivery provider
+// @Description	Replaces all updatable fields of a delivery provider
 // @ID				providerUpdate
 // @Tags			Provider
 // @Accept			json
 // @Produce		json
 // @Param			providerID	path		string					true	"Provider ID"
+// @Param			If-Match	header		string					false	"ETag of the provider version being replaced"
 // @Param			body		body		providerUpdateRequest	true	"Provider update parameters"
 // @Success		200			{object}	providerUpdateResponse
 // @Failure		400			{object}	responseError
 // @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
 // @Failure		500			{object}	responseError
 // @Router			/providers/{providerID} [put]
 func (c *controllerProvider) providerUpdate(ctx *fiber.Ctx) error {
@@ -162,36 +338,189 @@ func (c *controllerProvider) providerUpdate(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, http.StatusBadRequest, "bad request")
 	}
 
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerUpdate - entity.ParseETag: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
 	providerUpdated, err := c.uc.Update(ctx.UserContext(),
 		entity.ProviderID(providerID),
 		&entity.Provider{
-			Name: requestBody.Name, // TODO: do not update if field "name" is not passed
-		})
+			Name:         requestBody.Name,
+			Version:      version,
+			LegalEntity:  requestBody.LegalEntity,
+			Contacts:     requestBody.Contacts,
+			Website:      requestBody.Website,
+			Countries:    requestBody.Countries,
+			Capabilities: requestBody.Capabilities,
+			DisplayNames: requestBody.DisplayNames,
+			Labels:       requestBody.Labels,
+		},
+		entity.ProviderMask{"*"},
+	)
 	if err != nil {
 		c.l.Error(fmt.Errorf("http - v1 - providerUpdate - uc.Update: %w", err))
 
-		if errors.Is(err, entity.ErrNotFound) {
-			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		return updateErrorResponse(ctx, providerID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, providerUpdated.ETag())
+
+	localize(ctx, providerUpdated)
+
+	return ctx.Status(http.StatusOK).JSON(providerUpdateResponse(*providerUpdated))
+}
+
+// providerPatchRequest uses pointers to tell omitted fields from empty ones.
+type providerPatchRequest struct {
+	Name         *string              `json:"name" validate:"omitnil,min=1" example:"Купер"`
+	LegalEntity  *entity.LegalEntity  `json:"legal_entity"`
+	Contacts     *[]entity.Contact    `json:"contacts"`
+	Website      *string              `json:"website" example:"https://kuper.ru"`
+	Countries    *[]string            `json:"countries" example:"RU,KZ"`
+	Capabilities *entity.Capabilities `json:"capabilities"`
+	// DisplayNames are merged into the stored ones language by language, null removes a language.
+	DisplayNames map[string]*string `json:"display_names" example:"en:Kuper"`
+	// Labels are merged the same way.
+	Labels map[string]*string `json:"labels" example:"tier:gold"`
+}
+
+// @Summary		Partially update a provider
+// @Description	Updates only the fields present in the request body
+// @ID				providerPatch
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string					true	"Provider ID"
+// @Param			If-Match	header		string					false	"ETag of the provider version being updated"
+// @Param			body		body		providerPatchRequest	true	"Provider fields to update"
+// @Success		200			{object}	providerUpdateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID} [patch]
+func (c *controllerProvider) providerPatch(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerPatch - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody providerPatchRequest
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerPatch - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerPatch - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerPatch - entity.ParseETag: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider := &entity.Provider{Version: version}
+	var mask entity.ProviderMask
+
+	if requestBody.Name != nil {
+		provider.Name = *requestBody.Name
+		mask = append(mask, entity.ProviderFieldName)
+	}
+	if requestBody.LegalEntity != nil {
+		provider.LegalEntity = *requestBody.LegalEntity
+		mask = append(mask, entity.ProviderFieldLegalEntity)
+	}
+	if requestBody.Contacts != nil {
+		provider.Contacts = *requestBody.Contacts
+		mask = append(mask, entity.ProviderFieldContacts)
+	}
+	if requestBody.Website != nil {
+		provider.Website = *requestBody.Website
+		mask = append(mask, entity.ProviderFieldWebsite)
+	}
+	if requestBody.Countries != nil {
+		provider.Countries = *requestBody.Countries
+		mask = append(mask, entity.ProviderFieldCountries)
+	}
+	if requestBody.Capabilities != nil {
+		provider.Capabilities = *requestBody.Capabilities
+		mask = append(mask, entity.ProviderFieldCapabilities)
+	}
+	for locale, name := range requestBody.DisplayNames {
+		if provider.DisplayNames == nil {
+			provider.DisplayNames = make(map[string]string, len(requestBody.DisplayNames))
 		}
 
-		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+		if name != nil {
+			provider.DisplayNames[locale] = *name
+		}
+
+		mask = append(mask, entity.ProviderFieldDisplayNames.Entry(locale))
+	}
+	for key, value := range requestBody.Labels {
+		if provider.Labels == nil {
+			provider.Labels = make(map[string]string, len(requestBody.Labels))
+		}
+
+		if value != nil {
+			provider.Labels[key] = *value
+		}
+
+		mask = append(mask, entity.ProviderFieldLabels.Entry(key))
+	}
+
+	providerUpdated, err := c.uc.Update(ctx.UserContext(), entity.ProviderID(providerID), provider, mask)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerPatch - uc.Update: %w", err))
+
+		return updateErrorResponse(ctx, providerID, err)
 	}
 
+	ctx.Set(fiber.HeaderETag, providerUpdated.ETag())
+
+	localize(ctx, providerUpdated)
+
 	return ctx.Status(http.StatusOK).JSON(providerUpdateResponse(*providerUpdated))
 }
 
+func updateErrorResponse(ctx *fiber.Ctx, providerID paramProviderID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	case errors.Is(err, entity.ErrPreconditionFailed):
+		return errorResponse(ctx, http.StatusPreconditionFailed, fmt.Sprintf("%s: %s", providerID, entity.ErrPreconditionFailed.Error()))
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+}
+
 // type providerDeleteResponse struct{}
 
-// @Summary		Delete a provider
-// @Description	Deletes a delivery provider
+// @Summary		Archive a provider
+// @Description	Archives a delivery provider, it is hidden from listing until restored
 // @ID				providerDelete
 // @Tags			Provider
 // @Accept			json
 // @Produce		json
 // @Param			providerID	path	string	true	"Provider ID"
+// @Param			If-Match	header	string	false	"ETag of the provider version being deleted"
 // @Success		204
 // @Failure		400	{object}	responseError
 // @Failure		404	{object}	responseError
+// @Failure		412	{object}	responseError
 // @Failure		500	{object}	responseError
 // @Router			/providers/{providerID} [delete]
 func (c *controllerProvider) providerDelete(ctx *fiber.Ctx) error {
@@ -202,7 +531,14 @@ func (c *controllerProvider) providerDelete(ctx *fiber.Ctx) error {
 		return errorResponse(ctx, http.StatusBadRequest, "bad request")
 	}
 
-	err := c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID))
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerDelete - entity.ParseETag: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	err = c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID), version)
 	if err != nil {
 		c.l.Error(fmt.Errorf("http - v1 - providerDelete - uc.Delete: %w", err))
 
@@ -210,6 +546,86 @@ func (c *controllerProvider) providerDelete(ctx *fiber.Ctx) error {
 			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
 		}
 
+		if errors.Is(err, entity.ErrPreconditionFailed) {
+			return errorResponse(ctx, http.StatusPreconditionFailed, fmt.Sprintf("%s: %s", providerID, entity.ErrPreconditionFailed.Error()))
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+type providerRestoreResponse providerEntityResponse
+
+// @Summary		Restore a provider
+// @Description	Restores an archived delivery provider, one that is not archived is returned unchanged
+// @ID				providerRestore
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Success		200			{object}	providerRestoreResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}:restore [post]
+func (c *controllerProvider) providerRestore(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerRestore - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider, err := c.uc.Restore(ctx.UserContext(), entity.ProviderID(providerID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerRestore - uc.Restore: %w", err))
+
+		if errors.Is(err, entity.ErrNotFound) {
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	ctx.Set(fiber.HeaderETag, provider.ETag())
+
+	localize(ctx, provider)
+
+	return ctx.Status(http.StatusOK).JSON(providerRestoreResponse(*provider))
+}
+
+// @Summary		Purge a provider
+// @Description	Deletes a delivery provider permanently, archived or not. Requires the admin token
+// @ID				providerPurge
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Security		AdminToken
+// @Param			providerID	path	string	true	"Provider ID"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		403	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}:purge [post]
+func (c *controllerProvider) providerPurge(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerPurge - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	err := c.uc.Purge(ctx.UserContext(), entity.ProviderID(providerID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerPurge - uc.Purge: %w", err))
+
+		if errors.Is(err, entity.ErrNotFound) {
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		}
+
 		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
 	}
 
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerQuote struct {
+	uc usecase.Quote
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesQuote(apiGroup fiber.Router, uc usecase.Quote, l logger.Interface) {
+	r := &controllerQuote{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	apiGroup.Post("/quotes", r.quoteDelivery)
+}
+
+// eta is the delivery time in days after the order.
+type eta struct {
+	MinDays int `json:"min_days" example:"1"`
+	MaxDays int `json:"max_days" example:"3"`
+}
+
+type quoteOptionResponse struct {
+	ProviderID   entity.ProviderID      `json:"provider_id" example:"kuper"`
+	TariffID     entity.TariffID        `json:"tariff_id" example:"standard"`
+	TariffName   string                 `json:"tariff_name" example:"Стандарт"`
+	ServiceLevel entity.ServiceLevel    `json:"service_level" example:"standard"`
+	ETA          eta                    `json:"eta"`
+	Price        priceBreakdownResponse `json:"price"`
+}
+
+type quoteFailureResponse struct {
+	ProviderID entity.ProviderID `json:"provider_id" example:"lavka"`
+	// Reason is timeout, not_applicable or internal.
+	Reason entity.QuoteFailureReason `json:"reason" example:"timeout"`
+}
+
+type quoteDeliveryResponse struct {
+	// Options are grouped by currency, cheapest and then fastest first.
+	Options  []quoteOptionResponse  `json:"options"`
+	Failures []quoteFailureResponse `json:"failures"`
+}
+
+// @Summary		Quote a delivery
+// @Description	Prices a shipment by every active provider delivering to its destination. Providers not evaluated before the deadline are reported as failures instead of delaying the response
+// @ID				quoteDelivery
+// @Tags			Quote
+// @Accept			json
+// @Produce		json
+// @Param			body	body		shipmentRequest	true	"Shipment to price"
+// @Success		200		{object}	quoteDeliveryResponse
+// @Failure		400		{object}	responseError
+// @Failure		500		{object}	responseError
+// @Router			/quotes [post]
+func (c *controllerQuote) quoteDelivery(ctx *fiber.Ctx) error {
+	var requestBody shipmentRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - quoteDelivery - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - quoteDelivery - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	quote, err := c.uc.Quote(ctx.UserContext(), shipmentFromRequest(requestBody), requestBody.At)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - quoteDelivery - uc.Quote: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "quote problems")
+	}
+
+	response := quoteDeliveryResponse{
+		Options:  make([]quoteOptionResponse, len(quote.Options)),
+		Failures: make([]quoteFailureResponse, len(quote.Failures)),
+	}
+
+	for i, option := range quote.Options {
+		response.Options[i] = quoteOptionResponse{
+			ProviderID:   option.ProviderID,
+			TariffID:     option.TariffID,
+			TariffName:   option.TariffName,
+			ServiceLevel: option.ServiceLevel,
+			ETA:          eta{MinDays: option.TransitDaysMin, MaxDays: option.TransitDaysMax},
+			Price:        priceBreakdownToResponse(option.Price),
+		}
+	}
+
+	for i, failure := range quote.Failures {
+		response.Failures[i] = quoteFailureResponse{ProviderID: failure.ProviderID, Reason: failure.Reason}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(response)
+}
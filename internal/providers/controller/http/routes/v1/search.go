@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+type providerSearchQuery struct {
+	Query    string `query:"q" validate:"required"`
+	PageSize int    `query:"page_size" validate:"gte=0"`
+}
+
+type providerSearchResult struct {
+	Provider providerEntityResponse `json:"provider"`
+	Score    float64                `json:"score" example:"0.83"`
+}
+
+type providerSearchResponse struct {
+	Results []providerSearchResult `json:"results"`
+}
+
+// @Summary		Search providers
+// @Description	Finds delivery providers by words of the name, display names or legal name, best matches first. Cyrillic and Latin spellings match each other and typos are tolerated. Archived providers are never returned
+// @ID				providerSearch
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			q			query		string	true	"Search query, e.g. купер or kuper"
+// @Param			page_size	query		int		false	"Number of results, 20 by default, 100 at most"
+// @Success		200			{object}	providerSearchResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers:search [get]
+func (c *controllerProvider) providerSearch(ctx *fiber.Ctx) error {
+	var query providerSearchQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSearch - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSearch - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	hits, err := c.uc.Search(ctx.UserContext(), entity.ProviderSearchParams{
+		Query:    query.Query,
+		PageSize: query.PageSize,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSearch - uc.Search: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+
+	results := make([]providerSearchResult, len(hits))
+
+	for i, hit := range hits {
+		localize(ctx, hit.Provider)
+
+		results[i] = providerSearchResult{
+			Provider: providerEntityResponse(*hit.Provider),
+			Score:    hit.Score,
+		}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(providerSearchResponse{Results: results})
+}
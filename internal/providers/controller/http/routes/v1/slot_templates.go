@@ -0,0 +1,488 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerSlotTemplate struct {
+	uc usecase.SlotTemplate
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesSlotTemplate(apiGroup fiber.Router, uc usecase.SlotTemplate, l logger.Interface) {
+	r := &controllerSlotTemplate{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	templateGroup := apiGroup.Group("/providers/:providerID/slot-templates")
+	{
+		templateGroup.Post("", r.slotTemplateCreate)
+		templateGroup.Get("", r.slotTemplateGetAll)
+		templateGroup.Get("/:templateID\\:preview", r.slotTemplatePreview)
+		templateGroup.Post("/:templateID\\:activate", r.slotTemplateActivate)
+		templateGroup.Post("/:templateID\\:deactivate", r.slotTemplateDeactivate)
+		templateGroup.Get("/:templateID", r.slotTemplateGet)
+		templateGroup.Delete("/:templateID", r.slotTemplateDelete)
+	}
+}
+
+type slotTemplateDay struct {
+	// Weekday is ISO, 1 is Monday and 7 is Sunday.
+	Weekday  int `json:"weekday" validate:"required,gte=1,lte=7" example:"1"`
+	Capacity int `json:"capacity" validate:"required,gte=1,lte=10000" example:"20"`
+}
+
+type slotTemplateWindow struct {
+	Start string `json:"start" validate:"required,len=5" example:"09:00"`
+	// End "24:00" is the end of the day.
+	End string `json:"end" validate:"required,len=5" example:"12:00"`
+}
+
+type slotTemplateCreateRequest struct {
+	TemplateID entity.SlotTemplateID `json:"template_id" validate:"required" example:"weekdays"`
+	ZoneID     entity.ZoneID         `json:"zone_id" validate:"required" example:"msk-center"`
+	// TimeZone is the IANA name the windows are local to.
+	TimeZone string `json:"time_zone" validate:"required" example:"Europe/Moscow"`
+	// Every weekday at most once.
+	Days []slotTemplateDay `json:"days" validate:"required,min=1,max=7,dive"`
+	// Windows must not overlap.
+	Windows []slotTemplateWindow `json:"windows" validate:"required,min=1,max=48,dive"`
+	// Exclusions are local dates without slots.
+	Exclusions []string `json:"exclusions" validate:"max=366,dive,datetime=2006-01-02" example:"2025-05-09"`
+}
+
+type slotTemplateEntityResponse struct {
+	ProviderID entity.ProviderID     `json:"provider_id" example:"kuper"`
+	TemplateID entity.SlotTemplateID `json:"template_id" example:"weekdays"`
+	ZoneID     entity.ZoneID         `json:"zone_id" example:"msk-center"`
+	TimeZone   string                `json:"time_zone" example:"Europe/Moscow"`
+	Days       []slotTemplateDay     `json:"days"`
+	Windows    []slotTemplateWindow  `json:"windows"`
+	Exclusions []string              `json:"exclusions,omitempty" example:"2025-05-09"`
+	// ActivatedAt is set while the template generates slots.
+	ActivatedAt *time.Time `json:"activated_at,omitempty" example:"2025-05-08T06:07:14.810915Z"`
+	CreatedAt   time.Time  `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt   time.Time  `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+func slotTemplateRuleFromRequest(r slotTemplateCreateRequest) (entity.SlotTemplateRule, error) {
+	rule := entity.SlotTemplateRule{
+		Days:       make([]entity.SlotTemplateDay, len(r.Days)),
+		Windows:    make([]entity.SlotTemplateWindow, len(r.Windows)),
+		Exclusions: r.Exclusions,
+	}
+
+	for i, day := range r.Days {
+		rule.Days[i] = entity.SlotTemplateDay{
+			Weekday:  time.Weekday(day.Weekday % 7),
+			Capacity: day.Capacity,
+		}
+	}
+
+	for i, window := range r.Windows {
+		start, err := entity.ParseTimeOfDay(window.Start)
+		if err != nil {
+			return entity.SlotTemplateRule{}, err
+		}
+
+		end, err := entity.ParseTimeOfDay(window.End)
+		if err != nil {
+			return entity.SlotTemplateRule{}, err
+		}
+
+		rule.Windows[i] = entity.SlotTemplateWindow{Start: start, End: end}
+	}
+
+	return rule, nil
+}
+
+func slotTemplateToResponse(t *entity.SlotTemplate) slotTemplateEntityResponse {
+	res := slotTemplateEntityResponse{
+		ProviderID:  t.ProviderID,
+		TemplateID:  t.TemplateID,
+		ZoneID:      t.ZoneID,
+		TimeZone:    t.TimeZone,
+		Days:        make([]slotTemplateDay, len(t.Rule.Days)),
+		Windows:     make([]slotTemplateWindow, len(t.Rule.Windows)),
+		Exclusions:  t.Rule.Exclusions,
+		ActivatedAt: t.ActivatedAt,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+
+	for i, day := range t.Rule.Days {
+		weekday := int(day.Weekday)
+		if day.Weekday == time.Sunday {
+			weekday = 7
+		}
+
+		res.Days[i] = slotTemplateDay{Weekday: weekday, Capacity: day.Capacity}
+	}
+
+	for i, window := range t.Rule.Windows {
+		res.Windows[i] = slotTemplateWindow{Start: window.Start.String(), End: window.End.String()}
+	}
+
+	return res
+}
+
+type slotTemplateCreateResponse slotTemplateEntityResponse
+
+// @Summary		Create a slot template
+// @Description	Adds an inactive weekly slot template to a zone of a provider. Windows are local to the time zone of the template
+// @ID				slotTemplateCreate
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string						true	"Provider ID"
+// @Param			body		body		slotTemplateCreateRequest	true	"Slot template create parameters"
+// @Success		201			{object}	slotTemplateCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates [post]
+func (c *controllerSlotTemplate) slotTemplateCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody slotTemplateCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	rule, err := slotTemplateRuleFromRequest(requestBody)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateCreate - slotTemplateRuleFromRequest: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	template, err := c.uc.Create(ctx.UserContext(), &entity.SlotTemplate{
+		ProviderID: entity.ProviderID(providerID),
+		TemplateID: requestBody.TemplateID,
+		ZoneID:     requestBody.ZoneID,
+		TimeZone:   requestBody.TimeZone,
+		Rule:       rule,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateCreate - uc.Create: %w", err))
+
+		return slotTemplateErrorResponse(ctx, fmt.Sprintf("%s/%s", providerID, requestBody.ZoneID), err)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(slotTemplateCreateResponse(slotTemplateToResponse(template)))
+}
+
+type slotTemplateListAllQuery struct {
+	PageSize  int    `query:"page_size" validate:"gte=0"`
+	PageToken string `query:"page_token"`
+}
+
+type slotTemplateListAllResponse struct {
+	Templates     []slotTemplateEntityResponse `json:"templates"`
+	NextPageToken string                       `json:"next_page_token" example:"d2Vla2RheXM"`
+}
+
+// @Summary		List slot templates
+// @Description	Lists slot templates of a provider ordered by ID page by page
+// @ID				slotTemplateListAll
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			page_size	query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token	query		string	false	"Token of the next page from a previous response"
+// @Success		200			{object}	slotTemplateListAllResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates [get]
+func (c *controllerSlotTemplate) slotTemplateGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGetAll - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query slotTemplateListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.SlotTemplateListParams{
+		ProviderID: entity.ProviderID(providerID),
+		PageSize:   query.PageSize,
+		PageToken:  query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGetAll - uc.ListAll: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(providerID), err)
+	}
+
+	templates := make([]slotTemplateEntityResponse, len(page.Templates))
+	for i, template := range page.Templates {
+		templates[i] = slotTemplateToResponse(template)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotTemplateListAllResponse{
+		Templates:     templates,
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+type slotTemplateGetResponse slotTemplateEntityResponse
+
+// @Summary		Get a slot template
+// @Description	Returns a slot template of a provider by its ID
+// @ID				slotTemplateGet
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			templateID	path		string	true	"Template ID"
+// @Success		200			{object}	slotTemplateGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates/{templateID} [get]
+func (c *controllerSlotTemplate) slotTemplateGet(ctx *fiber.Ctx) error {
+	providerID, templateID, ok := slotTemplateParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGet - providerID or templateID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	template, err := c.uc.GetByID(ctx.UserContext(), providerID, templateID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateGet - uc.GetByID: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(templateID), err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotTemplateGetResponse(slotTemplateToResponse(template)))
+}
+
+// @Summary		Delete a slot template
+// @Description	Deletes a slot template, slots it has generated are kept
+// @ID				slotTemplateDelete
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path	string	true	"Provider ID"
+// @Param			templateID	path	string	true	"Template ID"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}/slot-templates/{templateID} [delete]
+func (c *controllerSlotTemplate) slotTemplateDelete(ctx *fiber.Ctx) error {
+	providerID, templateID, ok := slotTemplateParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateDelete - providerID or templateID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.uc.Delete(ctx.UserContext(), providerID, templateID); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateDelete - uc.Delete: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(templateID), err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+type slotTemplateActivateResponse slotTemplateEntityResponse
+
+// @Summary		Activate a slot template
+// @Description	Starts generating slots from a template, slots within the generation horizon are generated right away.
+// @Description	Windows that already have a slot are skipped
+// @ID				slotTemplateActivate
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			templateID	path		string	true	"Template ID"
+// @Success		200			{object}	slotTemplateActivateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates/{templateID}:activate [post]
+func (c *controllerSlotTemplate) slotTemplateActivate(ctx *fiber.Ctx) error {
+	providerID, templateID, ok := slotTemplateParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateActivate - providerID or templateID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	template, err := c.uc.Activate(ctx.UserContext(), providerID, templateID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateActivate - uc.Activate: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(templateID), err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotTemplateActivateResponse(slotTemplateToResponse(template)))
+}
+
+type slotTemplateDeactivateResponse slotTemplateEntityResponse
+
+// @Summary		Deactivate a slot template
+// @Description	Stops generating slots from a template, slots already generated are kept
+// @ID				slotTemplateDeactivate
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			templateID	path		string	true	"Template ID"
+// @Success		200			{object}	slotTemplateDeactivateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates/{templateID}:deactivate [post]
+func (c *controllerSlotTemplate) slotTemplateDeactivate(ctx *fiber.Ctx) error {
+	providerID, templateID, ok := slotTemplateParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateDeactivate - providerID or templateID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	template, err := c.uc.Deactivate(ctx.UserContext(), providerID, templateID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplateDeactivate - uc.Deactivate: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(templateID), err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotTemplateDeactivateResponse(slotTemplateToResponse(template)))
+}
+
+type slotTemplatePreviewQuery struct {
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type generatedSlotResponse struct {
+	ZoneID   entity.ZoneID `json:"zone_id" example:"msk-center"`
+	StartsAt time.Time     `json:"starts_at" example:"2025-05-09T09:00:00+03:00"`
+	EndsAt   time.Time     `json:"ends_at" example:"2025-05-09T12:00:00+03:00"`
+	Capacity int           `json:"capacity" example:"20"`
+}
+
+type slotTemplatePreviewResponse struct {
+	Slots []generatedSlotResponse `json:"slots"`
+}
+
+// @Summary		Preview a slot template
+// @Description	Lists the slots a template would generate within a period ordered by start, nothing is stored.
+// @Description	The period defaults to the generation horizon from now and is at most 31 days long
+// @ID				slotTemplatePreview
+// @Tags			SlotTemplate
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			templateID	path		string	true	"Template ID"
+// @Param			from		query		string	false	"Slots starting at or after this time, RFC 3339"
+// @Param			to			query		string	false	"Slots starting before this time, RFC 3339"
+// @Success		200			{object}	slotTemplatePreviewResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slot-templates/{templateID}:preview [get]
+func (c *controllerSlotTemplate) slotTemplatePreview(ctx *fiber.Ctx) error {
+	providerID, templateID, ok := slotTemplateParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplatePreview - providerID or templateID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query slotTemplatePreviewQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplatePreview - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplatePreview - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	slots, err := c.uc.Preview(ctx.UserContext(), providerID, templateID, parseTimeQuery(query.From), parseTimeQuery(query.To))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotTemplatePreview - uc.Preview: %w", err))
+
+		return slotTemplateErrorResponse(ctx, string(templateID), err)
+	}
+
+	res := slotTemplatePreviewResponse{Slots: make([]generatedSlotResponse, len(slots))}
+	for i, slot := range slots {
+		res.Slots[i] = generatedSlotResponse{
+			ZoneID:   slot.ZoneID,
+			StartsAt: slot.StartsAt,
+			EndsAt:   slot.EndsAt,
+			Capacity: slot.Capacity,
+		}
+	}
+
+	return ctx.Status(http.StatusOK).JSON(res)
+}
+
+// slotTemplateParams reads the path of a slot template, ok is false when either ID is missing.
+func slotTemplateParams(ctx *fiber.Ctx) (entity.ProviderID, entity.SlotTemplateID, bool) {
+	providerID := entity.ProviderID(ctx.Params("providerID"))
+	templateID := entity.SlotTemplateID(ctx.Params("templateID"))
+
+	return providerID, templateID, providerID != "" && templateID != ""
+}
+
+func slotTemplateErrorResponse(ctx *fiber.Ctx, id string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", id, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrAlreadyExists):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", id, entity.ErrAlreadyExists.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "slot template database problems")
+	}
+}
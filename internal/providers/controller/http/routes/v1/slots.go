@@ -0,0 +1,543 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerSlot struct {
+	uc usecase.Slot
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesSlot(apiGroup fiber.Router, uc usecase.Slot, l logger.Interface) {
+	r := &controllerSlot{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	apiGroup.Get("/providers/:providerID/zones/:zoneID/availability", r.slotAvailability)
+
+	slotGroup := apiGroup.Group("/providers/:providerID/slots")
+	{
+		slotGroup.Post("", r.slotCreate)
+		slotGroup.Get("", r.slotGetAll)
+		slotGroup.Get("/:slotID", r.slotGet)
+		slotGroup.Post("/:slotID\\:close", r.slotClose)
+		slotGroup.Post("/:slotID\\:hold", r.slotHold)
+	}
+
+	holdGroup := apiGroup.Group("/providers/:providerID/holds")
+	{
+		holdGroup.Post("/:holdID\\:confirm", r.slotConfirm)
+		holdGroup.Post("/:holdID\\:release", r.slotRelease)
+	}
+}
+
+type slotCreateRequest struct {
+	ZoneID entity.ZoneID `json:"zone_id" validate:"required" example:"msk-center"`
+	// The slot lasts at most 24 hours and must end in the future.
+	StartsAt time.Time `json:"starts_at" validate:"required" example:"2025-05-09T09:00:00Z"`
+	EndsAt   time.Time `json:"ends_at" validate:"required" example:"2025-05-09T12:00:00Z"`
+	Capacity int       `json:"capacity" validate:"required,gte=1,lte=10000" example:"20"`
+}
+
+type slotEntityResponse struct {
+	SlotID     entity.SlotID     `json:"slot_id" example:"42"`
+	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
+	ZoneID     entity.ZoneID     `json:"zone_id" example:"msk-center"`
+	StartsAt   time.Time         `json:"starts_at" example:"2025-05-09T09:00:00Z"`
+	EndsAt     time.Time         `json:"ends_at" example:"2025-05-09T12:00:00Z"`
+	Capacity   int               `json:"capacity" example:"20"`
+	Booked     int               `json:"booked" example:"5"`
+	// Available is zero once the slot is closed.
+	Available int        `json:"available" example:"15"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty" example:"2025-05-08T18:00:00Z"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt time.Time  `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+func slotToResponse(s *entity.Slot) slotEntityResponse {
+	return slotEntityResponse{
+		SlotID:     s.SlotID,
+		ProviderID: s.ProviderID,
+		ZoneID:     s.ZoneID,
+		StartsAt:   s.StartsAt,
+		EndsAt:     s.EndsAt,
+		Capacity:   s.Capacity,
+		Booked:     s.Booked,
+		Available:  s.Available(),
+		ClosedAt:   s.ClosedAt,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func slotsToResponse(slots []*entity.Slot) []slotEntityResponse {
+	res := make([]slotEntityResponse, len(slots))
+	for i, s := range slots {
+		res[i] = slotToResponse(s)
+	}
+
+	return res
+}
+
+type slotCreateResponse slotEntityResponse
+
+// @Summary		Create a delivery slot
+// @Description	Adds a delivery slot to a zone of a provider. The slot lasts at most 24 hours and must end in the future
+// @ID				slotCreate
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string				true	"Provider ID"
+// @Param			body		body		slotCreateRequest	true	"Slot create parameters"
+// @Success		201			{object}	slotCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slots [post]
+func (c *controllerSlot) slotCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody slotCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	slot, err := c.uc.Create(ctx.UserContext(), &entity.Slot{
+		ProviderID: entity.ProviderID(providerID),
+		ZoneID:     requestBody.ZoneID,
+		StartsAt:   requestBody.StartsAt,
+		EndsAt:     requestBody.EndsAt,
+		Capacity:   requestBody.Capacity,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotCreate - uc.Create: %w", err))
+
+		switch {
+		case errors.Is(err, entity.ErrAlreadyExists):
+			return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("slot: %s", entity.ErrAlreadyExists.Error()))
+		case errors.Is(err, entity.ErrNotFound):
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s/%s: %s", providerID, requestBody.ZoneID, entity.ErrNotFound.Error()))
+		case errors.Is(err, entity.ErrInvalidArgument):
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		default:
+			return errorResponse(ctx, http.StatusInternalServerError, "slot database problems")
+		}
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(slotCreateResponse(slotToResponse(slot)))
+}
+
+type slotListAllQuery struct {
+	PageSize     int    `query:"page_size" validate:"gte=0"`
+	PageToken    string `query:"page_token"`
+	ZoneID       string `query:"zone_id"`
+	StartsFrom   string `query:"starts_from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	StartsBefore string `query:"starts_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	// IncludeClosed also lists closed slots.
+	IncludeClosed bool `query:"include_closed"`
+}
+
+type slotListAllResponse struct {
+	Slots         []slotEntityResponse `json:"slots"`
+	NextPageToken string               `json:"next_page_token" example:"eyJ0cyI6IjIwMjUtMDUtMDlUMDk6MDA6MDBaIiwiaWQiOjQyfQ"`
+}
+
+// @Summary		List delivery slots
+// @Description	Lists delivery slots of a provider ordered by start page by page, closed slots are skipped by default
+// @ID				slotListAll
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID		path		string	true	"Provider ID"
+// @Param			zone_id			query		string	false	"Zone ID"
+// @Param			starts_from		query		string	false	"Slots starting at or after this time, RFC 3339"
+// @Param			starts_before	query		string	false	"Slots starting before this time, RFC 3339"
+// @Param			include_closed	query		bool	false	"Also list closed slots"
+// @Param			page_size		query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token		query		string	false	"Token of the next page from a previous response"
+// @Success		200				{object}	slotListAllResponse
+// @Failure		400				{object}	responseError
+// @Failure		500				{object}	responseError
+// @Router			/providers/{providerID}/slots [get]
+func (c *controllerSlot) slotGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotGetAll - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query slotListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.SlotListParams{
+		Filter: entity.SlotFilter{
+			ProviderID:    entity.ProviderID(providerID),
+			ZoneID:        entity.ZoneID(query.ZoneID),
+			StartsFrom:    parseTimeQuery(query.StartsFrom),
+			StartsBefore:  parseTimeQuery(query.StartsBefore),
+			IncludeClosed: query.IncludeClosed,
+		},
+		PageSize:  query.PageSize,
+		PageToken: query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotGetAll - uc.ListAll: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "slot database problems")
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotListAllResponse{
+		Slots:         slotsToResponse(page.Slots),
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+type slotGetResponse slotEntityResponse
+
+// @Summary		Get a delivery slot
+// @Description	Returns a delivery slot of a provider by its ID
+// @ID				slotGet
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			slotID		path		int		true	"Slot ID"
+// @Success		200			{object}	slotGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slots/{slotID} [get]
+func (c *controllerSlot) slotGet(ctx *fiber.Ctx) error {
+	providerID, slotID, ok := slotParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotGet - providerID or slotID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	slot, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID), slotID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotGet - uc.GetByID: %w", err))
+
+		return slotErrorResponse(ctx, slotID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotGetResponse(slotToResponse(slot)))
+}
+
+type slotCloseResponse slotEntityResponse
+
+// @Summary		Close a delivery slot
+// @Description	Stops bookings of a delivery slot, existing bookings are kept. Closing a closed slot changes nothing
+// @ID				slotClose
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			slotID		path		int		true	"Slot ID"
+// @Success		200			{object}	slotCloseResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slots/{slotID}:close [post]
+func (c *controllerSlot) slotClose(ctx *fiber.Ctx) error {
+	providerID, slotID, ok := slotParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotClose - providerID or slotID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	slot, err := c.uc.Close(ctx.UserContext(), entity.ProviderID(providerID), slotID)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotClose - uc.Close: %w", err))
+
+		return slotErrorResponse(ctx, slotID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotCloseResponse(slotToResponse(slot)))
+}
+
+type slotAvailabilityQuery struct {
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type slotAvailabilityResponse struct {
+	Slots []slotEntityResponse `json:"slots"`
+}
+
+// @Summary		Delivery slot availability
+// @Description	Lists open slots of a zone with free capacity that have not started yet, ordered by start.
+// @Description	The period defaults to a week from now and is at most 31 days long
+// @ID				slotAvailability
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			zoneID		path		string	true	"Zone ID"
+// @Param			from		query		string	false	"Slots starting at or after this time, RFC 3339"
+// @Param			to			query		string	false	"Slots starting before this time, RFC 3339"
+// @Success		200			{object}	slotAvailabilityResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones/{zoneID}/availability [get]
+func (c *controllerSlot) slotAvailability(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	zoneID := paramZoneID(ctx.Params("zoneID"))
+	if providerID == "" || zoneID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotAvailability - providerID or zoneID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query slotAvailabilityQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotAvailability - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotAvailability - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	slots, err := c.uc.Availability(ctx.UserContext(), entity.AvailabilityParams{
+		ProviderID: entity.ProviderID(providerID),
+		ZoneID:     entity.ZoneID(zoneID),
+		From:       parseTimeQuery(query.From),
+		To:         parseTimeQuery(query.To),
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotAvailability - uc.Availability: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "slot database problems")
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotAvailabilityResponse{
+		Slots: slotsToResponse(slots),
+	})
+}
+
+type slotHoldRequest struct {
+	// Quantity defaults to 1.
+	Quantity int `json:"quantity" validate:"gte=0,lte=10000" example:"1"`
+}
+
+type slotHoldResponse struct {
+	HoldID     entity.HoldID     `json:"hold_id" example:"5b0c2c1e-8d5f-4f8e-9a57-2f0a4b8f3c11"`
+	SlotID     entity.SlotID     `json:"slot_id" example:"42"`
+	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
+	Quantity   int               `json:"quantity" example:"1"`
+	Status     entity.HoldStatus `json:"status" example:"held"`
+	// ExpiresAt only matters while the hold is held.
+	ExpiresAt time.Time `json:"expires_at" example:"2025-05-08T06:17:14.810915Z"`
+	CreatedAt time.Time `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+// @Summary		Hold a delivery slot
+// @Description	Holds capacity of an open slot at checkout. The hold expires and gives the capacity back unless confirmed in time
+// @ID				slotHold
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string			true	"Provider ID"
+// @Param			slotID		path		int				true	"Slot ID"
+// @Param			body		body		slotHoldRequest	false	"Hold parameters"
+// @Success		201			{object}	slotHoldResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/slots/{slotID}:hold [post]
+func (c *controllerSlot) slotHold(ctx *fiber.Ctx) error {
+	providerID, slotID, ok := slotParams(ctx)
+	if !ok {
+		c.l.Error(fmt.Errorf("http - v1 - slotHold - providerID or slotID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody slotHoldRequest
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&requestBody); err != nil {
+			c.l.Error(fmt.Errorf("http - v1 - slotHold - bodyParser: %w", err))
+
+			return errorResponse(ctx, http.StatusBadRequest, "bad request")
+		}
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotHold - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if requestBody.Quantity == 0 {
+		requestBody.Quantity = 1
+	}
+
+	hold, err := c.uc.Hold(ctx.UserContext(), entity.ProviderID(providerID), slotID, requestBody.Quantity)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotHold - uc.Hold: %w", err))
+
+		return holdErrorResponse(ctx, fmt.Sprint(slotID), err)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(slotHoldResponse(*hold))
+}
+
+type paramHoldID entity.HoldID
+
+// @Summary		Confirm a slot hold
+// @Description	Turns an unexpired hold into a booking. Confirming a confirmed hold changes nothing
+// @ID				slotConfirm
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			holdID		path		string	true	"Hold ID"
+// @Success		200			{object}	slotHoldResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/holds/{holdID}:confirm [post]
+func (c *controllerSlot) slotConfirm(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	holdID := paramHoldID(ctx.Params("holdID"))
+	if providerID == "" || holdID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotConfirm - providerID or holdID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	hold, err := c.uc.Confirm(ctx.UserContext(), entity.ProviderID(providerID), entity.HoldID(holdID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotConfirm - uc.Confirm: %w", err))
+
+		return holdErrorResponse(ctx, string(holdID), err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotHoldResponse(*hold))
+}
+
+// @Summary		Release a slot hold
+// @Description	Gives the capacity of a hold or a booking back to its slot. Releasing a released or expired hold changes nothing
+// @ID				slotRelease
+// @Tags			Slot
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			holdID		path		string	true	"Hold ID"
+// @Success		200			{object}	slotHoldResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/holds/{holdID}:release [post]
+func (c *controllerSlot) slotRelease(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	holdID := paramHoldID(ctx.Params("holdID"))
+	if providerID == "" || holdID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - slotRelease - providerID or holdID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	hold, err := c.uc.Release(ctx.UserContext(), entity.ProviderID(providerID), entity.HoldID(holdID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - slotRelease - uc.Release: %w", err))
+
+		return holdErrorResponse(ctx, string(holdID), err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(slotHoldResponse(*hold))
+}
+
+// slotParams reads the path of a slot, ok is false when the provider is missing or the slot ID is not a positive number.
+func slotParams(ctx *fiber.Ctx) (paramProviderID, entity.SlotID, bool) {
+	providerID := paramProviderID(ctx.Params("providerID"))
+
+	slotID, err := strconv.ParseInt(ctx.Params("slotID"), 10, 64)
+	if err != nil || slotID <= 0 || providerID == "" {
+		return "", 0, false
+	}
+
+	return providerID, entity.SlotID(slotID), true
+}
+
+func slotErrorResponse(ctx *fiber.Ctx, slotID entity.SlotID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%d: %s", slotID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "slot database problems")
+	}
+}
+
+func holdErrorResponse(ctx *fiber.Ctx, id string, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", id, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrSlotUnavailable):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", id, entity.ErrSlotUnavailable.Error()))
+	case errors.Is(err, entity.ErrHoldNotActive):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", id, entity.ErrHoldNotActive.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "slot database problems")
+	}
+}
@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+type providerStatusResponse providerEntityResponse
+
+// @Summary		Activate a provider
+// @Description	Moves a delivery provider from onboarding or suspended to active
+// @ID				providerActivate
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Success		200			{object}	providerStatusResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}:activate [post]
+func (c *controllerProvider) providerActivate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerActivate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider, err := c.uc.Activate(ctx.UserContext(), entity.ProviderID(providerID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerActivate - uc.Activate: %w", err))
+
+		return transitionErrorResponse(ctx, providerID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, provider.ETag())
+
+	localize(ctx, provider)
+
+	return ctx.Status(http.StatusOK).JSON(providerStatusResponse(*provider))
+}
+
+type providerSuspendRequest struct {
+	Reason string `json:"reason" validate:"required,max=256" example:"contract review"`
+}
+
+// @Summary		Suspend a provider
+// @Description	Temporarily takes an active delivery provider out of service, a reason is required
+// @ID				providerSuspend
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string					true	"Provider ID"
+// @Param			body		body		providerSuspendRequest	true	"Suspension reason"
+// @Success		200			{object}	providerStatusResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}:suspend [post]
+func (c *controllerProvider) providerSuspend(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerSuspend - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody providerSuspendRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSuspend - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSuspend - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider, err := c.uc.Suspend(ctx.UserContext(), entity.ProviderID(providerID), requestBody.Reason)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerSuspend - uc.Suspend: %w", err))
+
+		return transitionErrorResponse(ctx, providerID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, provider.ETag())
+
+	localize(ctx, provider)
+
+	return ctx.Status(http.StatusOK).JSON(providerStatusResponse(*provider))
+}
+
+type providerTerminateRequest struct {
+	Reason string `json:"reason" validate:"max=256" example:"contract ended"`
+}
+
+// @Summary		Terminate a provider
+// @Description	Permanently takes a delivery provider out of service, terminated providers can not be reactivated
+// @ID				providerTerminate
+// @Tags			Provider
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string						true	"Provider ID"
+// @Param			body		body		providerTerminateRequest	false	"Termination reason"
+// @Success		200			{object}	providerStatusResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}:terminate [post]
+func (c *controllerProvider) providerTerminate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - providerTerminate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody providerTerminateRequest
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&requestBody); err != nil {
+			c.l.Error(fmt.Errorf("http - v1 - providerTerminate - bodyParser: %w", err))
+
+			return errorResponse(ctx, http.StatusBadRequest, "bad request")
+		}
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerTerminate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	provider, err := c.uc.Terminate(ctx.UserContext(), entity.ProviderID(providerID), requestBody.Reason)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - providerTerminate - uc.Terminate: %w", err))
+
+		return transitionErrorResponse(ctx, providerID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, provider.ETag())
+
+	localize(ctx, provider)
+
+	return ctx.Status(http.StatusOK).JSON(providerStatusResponse(*provider))
+}
+
+func transitionErrorResponse(ctx *fiber.Ctx, providerID paramProviderID, err error) error {
+	var transitionErr *entity.StatusTransitionError
+
+	switch {
+	case errors.As(err, &transitionErr):
+		return errorResponse(ctx, http.StatusConflict, transitionErr.Error())
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "provider database problems")
+	}
+}
@@ -0,0 +1,649 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/shopspring/decimal"
+)
+
+type controllerTariff struct {
+	uc usecase.Tariff
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesTariff(apiGroup fiber.Router, uc usecase.Tariff, l logger.Interface) {
+	r := &controllerTariff{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	tariffGroup := apiGroup.Group("/providers/:providerID/tariffs")
+	{
+		tariffGroup.Post("", r.tariffCreate)
+		tariffGroup.Get("", r.tariffGetAll)
+		tariffGroup.Post("/:tariffID\\:evaluate", r.tariffEvaluate)
+		tariffGroup.Get("/:tariffID", r.tariffGet)
+		tariffGroup.Delete("/:tariffID", r.tariffDelete)
+		tariffGroup.Post("/:tariffID/revisions", r.tariffRevisionCreate)
+		tariffGroup.Get("/:tariffID/revisions", r.tariffRevisionGetAll)
+	}
+}
+
+type weightBreak struct {
+	UpToG int             `json:"up_to_g" validate:"gt=0" example:"1000"`
+	Price decimal.Decimal `json:"price" swaggertype:"string" example:"199.90"`
+	PerKg decimal.Decimal `json:"per_kg" swaggertype:"string" example:"0"`
+}
+
+type distanceBand struct {
+	UpToKm int             `json:"up_to_km" validate:"gt=0" example:"100"`
+	Price  decimal.Decimal `json:"price" swaggertype:"string" example:"150"`
+	PerKm  decimal.Decimal `json:"per_km" swaggertype:"string" example:"2.5"`
+}
+
+type surcharge struct {
+	Code   string          `json:"code" validate:"required" example:"fuel"`
+	Amount decimal.Decimal `json:"amount" swaggertype:"string" example:"35.50"`
+}
+
+// Amounts are decimal strings in the tariff currency, numbers are accepted as well.
+type tariffRevisionCreateRequest struct {
+	// WeightBreaks price a parcel by the first break its chargeable weight fits in.
+	WeightBreaks []weightBreak `json:"weight_breaks" validate:"required,min=1,dive"`
+	// VolumetricDivisor turns cm³ into volumetric kg, zero prices parcels by actual weight only.
+	VolumetricDivisor int `json:"volumetric_divisor" validate:"gte=0" example:"5000"`
+	// DistanceBands price the route, it is free of charge without any.
+	DistanceBands []distanceBand `json:"distance_bands" validate:"dive"`
+	Surcharges    []surcharge    `json:"surcharges" validate:"dive"`
+	// MinPrice and MaxPrice bound the total, zero means no bound.
+	MinPrice decimal.Decimal `json:"min_price" swaggertype:"string" example:"300"`
+	MaxPrice decimal.Decimal `json:"max_price" swaggertype:"string" example:"0"`
+	// EffectiveFrom defaults to now and may not be in the past. A revision in effect without an end
+	// is ended at this time, any other overlap is rejected.
+	EffectiveFrom time.Time `json:"effective_from" example:"2025-06-01T00:00:00Z"`
+	// EffectiveTo is absent for a revision in effect until a later one starts.
+	EffectiveTo *time.Time `json:"effective_to,omitempty" example:"2025-09-01T00:00:00Z"`
+}
+
+func revisionFromRequest(r tariffRevisionCreateRequest) *entity.TariffRevision {
+	revision := &entity.TariffRevision{
+		Rules: entity.TariffRules{
+			WeightBreaks:      make([]entity.WeightBreak, len(r.WeightBreaks)),
+			VolumetricDivisor: r.VolumetricDivisor,
+			DistanceBands:     make([]entity.DistanceBand, len(r.DistanceBands)),
+			Surcharges:        make([]entity.Surcharge, len(r.Surcharges)),
+			MinPrice:          r.MinPrice,
+			MaxPrice:          r.MaxPrice,
+		},
+		EffectiveFrom: r.EffectiveFrom,
+		EffectiveTo:   r.EffectiveTo,
+	}
+
+	for i, b := range r.WeightBreaks {
+		revision.Rules.WeightBreaks[i] = entity.WeightBreak(b)
+	}
+
+	for i, b := range r.DistanceBands {
+		revision.Rules.DistanceBands[i] = entity.DistanceBand(b)
+	}
+
+	for i, s := range r.Surcharges {
+		revision.Rules.Surcharges[i] = entity.Surcharge(s)
+	}
+
+	return revision
+}
+
+// The rules become the first revision of the tariff.
+type tariffCreateRequest struct {
+	TariffID entity.TariffID `json:"tariff_id" validate:"required" example:"standard"`
+	Name     string          `json:"name" validate:"required" example:"Стандарт"`
+	Currency entity.Currency `json:"currency" validate:"required,len=3" example:"RUB"`
+	tariffRevisionCreateRequest
+	// ServiceLevel defaults to standard.
+	ServiceLevel   entity.ServiceLevel `json:"service_level" validate:"omitempty,oneof=economy standard express same_day" example:"standard"`
+	TransitDaysMin int                 `json:"transit_days_min" validate:"gte=0" example:"1"`
+	TransitDaysMax int                 `json:"transit_days_max" validate:"gtefield=TransitDaysMin" example:"3"`
+}
+
+type tariffEntityResponse struct {
+	ProviderID        entity.ProviderID   `json:"provider_id" example:"kuper"`
+	TariffID          entity.TariffID     `json:"tariff_id" example:"standard"`
+	Name              string              `json:"name" example:"Стандарт"`
+	Currency          entity.Currency     `json:"currency" example:"RUB"`
+	WeightBreaks      []weightBreak       `json:"weight_breaks"`
+	VolumetricDivisor int                 `json:"volumetric_divisor" example:"5000"`
+	DistanceBands     []distanceBand      `json:"distance_bands"`
+	Surcharges        []surcharge         `json:"surcharges"`
+	MinPrice          decimal.Decimal     `json:"min_price" swaggertype:"string" example:"300"`
+	MaxPrice          decimal.Decimal     `json:"max_price" swaggertype:"string" example:"0"`
+	ServiceLevel      entity.ServiceLevel `json:"service_level" example:"standard"`
+	TransitDaysMin    int                 `json:"transit_days_min" example:"1"`
+	TransitDaysMax    int                 `json:"transit_days_max" example:"3"`
+	// Revision the rules come from, the one in effect or the next one. It is absent once all of them ended.
+	Revision      int        `json:"revision,omitempty" example:"1"`
+	EffectiveFrom *time.Time `json:"effective_from,omitempty" example:"2025-05-08T06:07:14.810915Z"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty" example:"2025-09-01T00:00:00Z"`
+	CreatedAt     time.Time  `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+func tariffToResponse(t *entity.Tariff) tariffEntityResponse {
+	res := tariffEntityResponse{
+		ProviderID:     t.ProviderID,
+		TariffID:       t.TariffID,
+		Name:           t.Name,
+		Currency:       t.Currency,
+		ServiceLevel:   t.ServiceLevel,
+		TransitDaysMin: t.TransitDaysMin,
+		TransitDaysMax: t.TransitDaysMax,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+
+	if t.Revision != nil {
+		r := revisionToResponse(t.Revision)
+
+		res.WeightBreaks = r.WeightBreaks
+		res.VolumetricDivisor = r.VolumetricDivisor
+		res.DistanceBands = r.DistanceBands
+		res.Surcharges = r.Surcharges
+		res.MinPrice = r.MinPrice
+		res.MaxPrice = r.MaxPrice
+		res.Revision = r.Revision
+		res.EffectiveFrom = &r.EffectiveFrom
+		res.EffectiveTo = r.EffectiveTo
+	}
+
+	return res
+}
+
+type tariffRevisionResponse struct {
+	ProviderID        entity.ProviderID `json:"provider_id" example:"kuper"`
+	TariffID          entity.TariffID   `json:"tariff_id" example:"standard"`
+	Revision          int               `json:"revision" example:"2"`
+	WeightBreaks      []weightBreak     `json:"weight_breaks"`
+	VolumetricDivisor int               `json:"volumetric_divisor" example:"5000"`
+	DistanceBands     []distanceBand    `json:"distance_bands"`
+	Surcharges        []surcharge       `json:"surcharges"`
+	MinPrice          decimal.Decimal   `json:"min_price" swaggertype:"string" example:"300"`
+	MaxPrice          decimal.Decimal   `json:"max_price" swaggertype:"string" example:"0"`
+	EffectiveFrom     time.Time         `json:"effective_from" example:"2025-06-01T00:00:00Z"`
+	// EffectiveTo is absent while no later revision is scheduled.
+	EffectiveTo *time.Time `json:"effective_to,omitempty" example:"2025-09-01T00:00:00Z"`
+	CreatedAt   time.Time  `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+}
+
+func revisionToResponse(r *entity.TariffRevision) tariffRevisionResponse {
+	res := tariffRevisionResponse{
+		ProviderID:        r.ProviderID,
+		TariffID:          r.TariffID,
+		Revision:          r.Revision,
+		WeightBreaks:      make([]weightBreak, len(r.Rules.WeightBreaks)),
+		VolumetricDivisor: r.Rules.VolumetricDivisor,
+		DistanceBands:     make([]distanceBand, len(r.Rules.DistanceBands)),
+		Surcharges:        make([]surcharge, len(r.Rules.Surcharges)),
+		MinPrice:          r.Rules.MinPrice,
+		MaxPrice:          r.Rules.MaxPrice,
+		EffectiveFrom:     r.EffectiveFrom,
+		EffectiveTo:       r.EffectiveTo,
+		CreatedAt:         r.CreatedAt,
+	}
+
+	for i, b := range r.Rules.WeightBreaks {
+		res.WeightBreaks[i] = weightBreak(b)
+	}
+
+	for i, b := range r.Rules.DistanceBands {
+		res.DistanceBands[i] = distanceBand(b)
+	}
+
+	for i, s := range r.Rules.Surcharges {
+		res.Surcharges[i] = surcharge(s)
+	}
+
+	return res
+}
+
+type tariffCreateResponse tariffEntityResponse
+
+// @Summary		Create a tariff
+// @Description	Adds a tariff to a provider
+// @ID				tariffCreate
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string				true	"Provider ID"
+// @Param			body		body		tariffCreateRequest	true	"Tariff create parameters"
+// @Success		201			{object}	tariffCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs [post]
+func (c *controllerTariff) tariffCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody tariffCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	tariff := &entity.Tariff{
+		ProviderID:     entity.ProviderID(providerID),
+		TariffID:       requestBody.TariffID,
+		Name:           requestBody.Name,
+		Currency:       requestBody.Currency,
+		ServiceLevel:   requestBody.ServiceLevel,
+		TransitDaysMin: requestBody.TransitDaysMin,
+		TransitDaysMax: requestBody.TransitDaysMax,
+		Revision:       revisionFromRequest(requestBody.tariffRevisionCreateRequest),
+	}
+
+	tariff, err := c.uc.Create(ctx.UserContext(), tariff)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffCreate - uc.Create: %w", err))
+
+		switch {
+		case errors.Is(err, entity.ErrAlreadyExists):
+			return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", requestBody.TariffID, entity.ErrAlreadyExists.Error()))
+		case errors.Is(err, entity.ErrNotFound):
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		case errors.Is(err, entity.ErrInvalidArgument):
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		default:
+			return errorResponse(ctx, http.StatusInternalServerError, "tariff database problems")
+		}
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(tariffCreateResponse(tariffToResponse(tariff)))
+}
+
+type tariffListAllQuery struct {
+	PageSize  int    `query:"page_size" validate:"gte=0"`
+	PageToken string `query:"page_token"`
+}
+
+type tariffListAllResponse struct {
+	Tariffs       []tariffEntityResponse `json:"tariffs"`
+	NextPageToken string                 `json:"next_page_token" example:"c3RhbmRhcmQ"`
+}
+
+// @Summary		List tariffs
+// @Description	Lists tariffs of a provider ordered by ID page by page
+// @ID				tariffListAll
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			page_size	query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token	query		string	false	"Token of the next page from a previous response"
+// @Success		200			{object}	tariffListAllResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs [get]
+func (c *controllerTariff) tariffGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGetAll - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query tariffListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.TariffListParams{
+		ProviderID: entity.ProviderID(providerID),
+		PageSize:   query.PageSize,
+		PageToken:  query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGetAll - uc.ListAll: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "tariff database problems")
+	}
+
+	tariffs := make([]tariffEntityResponse, len(page.Tariffs))
+
+	for i, t := range page.Tariffs {
+		tariffs[i] = tariffToResponse(t)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(tariffListAllResponse{
+		Tariffs:       tariffs,
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+type paramTariffID entity.TariffID
+
+type tariffGetResponse tariffEntityResponse
+
+// @Summary		Get a tariff
+// @Description	Returns a tariff of a provider by its ID
+// @ID				tariffGet
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			tariffID	path		string	true	"Tariff ID"
+// @Success		200			{object}	tariffGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs/{tariffID} [get]
+func (c *controllerTariff) tariffGet(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	tariffID := paramTariffID(ctx.Params("tariffID"))
+	if providerID == "" || tariffID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGet - providerID or tariffID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	tariff, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID), entity.TariffID(tariffID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffGet - uc.GetByID: %w", err))
+
+		return tariffErrorResponse(ctx, tariffID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(tariffGetResponse(tariffToResponse(tariff)))
+}
+
+// @Summary		Delete a tariff
+// @Description	Deletes a tariff of a provider none of whose revisions has taken effect
+// @ID				tariffDelete
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path	string	true	"Provider ID"
+// @Param			tariffID	path	string	true	"Tariff ID"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		409	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}/tariffs/{tariffID} [delete]
+func (c *controllerTariff) tariffDelete(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	tariffID := paramTariffID(ctx.Params("tariffID"))
+	if providerID == "" || tariffID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffDelete - providerID or tariffID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	err := c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID), entity.TariffID(tariffID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffDelete - uc.Delete: %w", err))
+
+		return tariffErrorResponse(ctx, tariffID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+type shipmentRequest struct {
+	Origin      *location          `json:"origin" validate:"required"`
+	Destination *location          `json:"destination" validate:"required"`
+	Parcels     []parcelDimensions `json:"parcels" validate:"required,min=1,dive"`
+	// At prices by the tariff revisions in effect at the time, now by default.
+	At time.Time `json:"at" example:"2025-05-08T06:07:14Z"`
+}
+
+func shipmentFromRequest(r shipmentRequest) entity.Shipment {
+	shipment := entity.Shipment{
+		Origin:      entity.Position{r.Origin.Lon, r.Origin.Lat},
+		Destination: entity.Position{r.Destination.Lon, r.Destination.Lat},
+		Parcels:     make([]entity.ParcelDimensions, len(r.Parcels)),
+	}
+
+	for i, p := range r.Parcels {
+		shipment.Parcels[i] = entity.ParcelDimensions(p)
+	}
+
+	return shipment
+}
+
+type priceLineResponse struct {
+	Kind entity.PriceLineKind `json:"kind" example:"weight"`
+	// Parcel is the index of the parcel of a weight line.
+	Parcel int `json:"parcel" example:"0"`
+	// Code is the surcharge of a surcharge line.
+	Code   string `json:"code,omitempty" example:"fuel"`
+	Amount string `json:"amount" example:"200.00"`
+}
+
+// priceBreakdownResponse amounts are rounded to minor units of the currency and add up to the total.
+type priceBreakdownResponse struct {
+	Currency          entity.Currency     `json:"currency" example:"RUB"`
+	ChargeableWeightG int                 `json:"chargeable_weight_g" example:"5400"`
+	DistanceM         int                 `json:"distance_m" example:"3806"`
+	Lines             []priceLineResponse `json:"lines"`
+	Total             string              `json:"total" example:"435.50"`
+	// Revision of the tariff the price comes from.
+	Revision int `json:"revision" example:"1"`
+}
+
+// priceBreakdownToResponse keeps trailing zeros of the amounts, so 300 RUB is "300.00".
+func priceBreakdownToResponse(b *entity.PriceBreakdown) priceBreakdownResponse {
+	// The lines are rounded to the currency, the total is their sum, so it has the most decimal places.
+	places := max(-b.Total.Exponent(), 0)
+
+	res := priceBreakdownResponse{
+		Currency:          b.Currency,
+		ChargeableWeightG: b.ChargeableWeightG,
+		DistanceM:         b.DistanceM,
+		Lines:             make([]priceLineResponse, len(b.Lines)),
+		Total:             b.Total.StringFixed(places),
+		Revision:          b.Revision,
+	}
+
+	for i, line := range b.Lines {
+		res.Lines[i] = priceLineResponse{
+			Kind:   line.Kind,
+			Parcel: line.Parcel,
+			Code:   line.Code,
+			Amount: line.Amount.StringFixed(places),
+		}
+	}
+
+	return res
+}
+
+// @Summary		Evaluate a tariff
+// @Description	Prices parcels carried between two positions by a tariff, nothing is stored
+// @ID				tariffEvaluate
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string			true	"Provider ID"
+// @Param			tariffID	path		string			true	"Tariff ID"
+// @Param			body		body		shipmentRequest	true	"Shipment to price"
+// @Success		200			{object}	priceBreakdownResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		422			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs/{tariffID}:evaluate [post]
+func (c *controllerTariff) tariffEvaluate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	tariffID := paramTariffID(ctx.Params("tariffID"))
+	if providerID == "" || tariffID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffEvaluate - providerID or tariffID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody shipmentRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffEvaluate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffEvaluate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	breakdown, err := c.uc.Evaluate(ctx.UserContext(), entity.ProviderID(providerID), entity.TariffID(tariffID), shipmentFromRequest(requestBody), requestBody.At)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffEvaluate - uc.Evaluate: %w", err))
+
+		return tariffErrorResponse(ctx, tariffID, err)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(priceBreakdownToResponse(breakdown))
+}
+
+type tariffRevisionCreateResponse tariffRevisionResponse
+
+// @Summary		Create a tariff revision
+// @Description	Schedules a revision of a tariff, revisions never change once created
+// @ID				tariffRevisionCreate
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string						true	"Provider ID"
+// @Param			tariffID	path		string						true	"Tariff ID"
+// @Param			body		body		tariffRevisionCreateRequest	true	"Tariff revision create parameters"
+// @Success		201			{object}	tariffRevisionCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs/{tariffID}/revisions [post]
+func (c *controllerTariff) tariffRevisionCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	tariffID := paramTariffID(ctx.Params("tariffID"))
+	if providerID == "" || tariffID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionCreate - providerID or tariffID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody tariffRevisionCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	revision := revisionFromRequest(requestBody)
+	revision.ProviderID, revision.TariffID = entity.ProviderID(providerID), entity.TariffID(tariffID)
+
+	revision, err := c.uc.CreateRevision(ctx.UserContext(), revision)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionCreate - uc.CreateRevision: %w", err))
+
+		return tariffErrorResponse(ctx, tariffID, err)
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(tariffRevisionCreateResponse(revisionToResponse(revision)))
+}
+
+type tariffRevisionListAllResponse struct {
+	Revisions []tariffRevisionResponse `json:"revisions"`
+}
+
+// @Summary		List tariff revisions
+// @Description	Lists revisions of a tariff ordered by number
+// @ID				tariffRevisionListAll
+// @Tags			Tariff
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			tariffID	path		string	true	"Tariff ID"
+// @Success		200			{object}	tariffRevisionListAllResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/tariffs/{tariffID}/revisions [get]
+func (c *controllerTariff) tariffRevisionGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	tariffID := paramTariffID(ctx.Params("tariffID"))
+	if providerID == "" || tariffID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionGetAll - providerID or tariffID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	revisions, err := c.uc.ListRevisions(ctx.UserContext(), entity.ProviderID(providerID), entity.TariffID(tariffID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - tariffRevisionGetAll - uc.ListRevisions: %w", err))
+
+		return tariffErrorResponse(ctx, tariffID, err)
+	}
+
+	response := tariffRevisionListAllResponse{Revisions: make([]tariffRevisionResponse, len(revisions))}
+
+	for i, revision := range revisions {
+		response.Revisions[i] = revisionToResponse(revision)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(response)
+}
+
+func tariffErrorResponse(ctx *fiber.Ctx, tariffID paramTariffID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", tariffID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	case errors.Is(err, entity.ErrTariffNotApplicable):
+		return errorResponse(ctx, http.StatusUnprocessableEntity, fmt.Sprintf("%s: %s", tariffID, entity.ErrTariffNotApplicable.Error()))
+	case errors.Is(err, entity.ErrRevisionOverlap):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", tariffID, entity.ErrRevisionOverlap.Error()))
+	case errors.Is(err, entity.ErrTariffInEffect):
+		return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", tariffID, entity.ErrTariffInEffect.Error()))
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "tariff database problems")
+	}
+}
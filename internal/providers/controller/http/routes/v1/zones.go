@@ -0,0 +1,411 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/classydevv/fulfillment/pkg/logger"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type controllerZone struct {
+	uc usecase.Zone
+	l  logger.Interface
+	v  *validator.Validate
+}
+
+func NewRoutesZone(apiGroup fiber.Router, uc usecase.Zone, l logger.Interface) {
+	r := &controllerZone{uc, l, validator.New(validator.WithRequiredStructEnabled())}
+
+	zoneGroup := apiGroup.Group("/providers/:providerID/zones")
+	{
+		zoneGroup.Post("", r.zoneCreate)
+		zoneGroup.Get("", r.zoneGetAll)
+		zoneGroup.Get("/:zoneID", r.zoneGet)
+		zoneGroup.Put("/:zoneID", r.zoneUpdate)
+		zoneGroup.Patch("/:zoneID", r.zonePatch)
+		zoneGroup.Delete("/:zoneID", r.zoneDelete)
+	}
+}
+
+type zoneCreateRequest struct {
+	ZoneID entity.ZoneID `json:"zone_id" validate:"required" example:"msk-center"`
+	Name   string        `json:"name" validate:"required" example:"Москва, центр"`
+	// Geometry is a GeoJSON Polygon or MultiPolygon, positions are [longitude, latitude].
+	Geometry *entity.Geometry `json:"geometry" validate:"required" swaggertype:"object"`
+}
+
+type zoneCreateResponse struct {
+	ZoneID entity.ZoneID `json:"zone_id" example:"msk-center"`
+}
+
+// @Summary		Create a delivery zone
+// @Description	Adds a delivery zone to a provider. Rings of the geometry must be closed and must not intersect,
+// @Description	holes must lie within the exterior ring and coordinates must be within bounds
+// @ID				zoneCreate
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string				true	"Provider ID"
+// @Param			body		body		zoneCreateRequest	true	"Zone create parameters"
+// @Success		201			{object}	zoneCreateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		409			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones [post]
+func (c *controllerZone) zoneCreate(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - zoneCreate - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var requestBody zoneCreateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneCreate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneCreate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	zoneID, err := c.uc.Create(ctx.UserContext(), &entity.Zone{
+		ProviderID: entity.ProviderID(providerID),
+		ZoneID:     requestBody.ZoneID,
+		Name:       requestBody.Name,
+		Geometry:   *requestBody.Geometry,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneCreate - uc.Create: %w", err))
+
+		switch {
+		case errors.Is(err, entity.ErrAlreadyExists):
+			return errorResponse(ctx, http.StatusConflict, fmt.Sprintf("%s: %s", requestBody.ZoneID, entity.ErrAlreadyExists.Error()))
+		case errors.Is(err, entity.ErrNotFound):
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", providerID, entity.ErrNotFound.Error()))
+		case errors.Is(err, entity.ErrInvalidArgument):
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		default:
+			return errorResponse(ctx, http.StatusInternalServerError, "zone database problems")
+		}
+	}
+
+	return ctx.Status(http.StatusCreated).JSON(zoneCreateResponse{zoneID})
+}
+
+type zoneEntityResponse struct {
+	ProviderID entity.ProviderID `json:"provider_id" example:"kuper"`
+	ZoneID     entity.ZoneID     `json:"zone_id" example:"msk-center"`
+	Name       string            `json:"name" example:"Москва, центр"`
+	Geometry   entity.Geometry   `json:"geometry" swaggertype:"object"`
+	CreatedAt  time.Time         `json:"created_at" example:"2025-05-08T06:07:14.810915Z"`
+	UpdatedAt  time.Time         `json:"updated_at" example:"2025-05-08T06:07:14.810915Z"`
+	Version    int64             `json:"version" example:"1"`
+}
+
+type zoneListAllQuery struct {
+	PageSize  int    `query:"page_size" validate:"gte=0"`
+	PageToken string `query:"page_token"`
+}
+
+type zoneListAllResponse struct {
+	Zones         []zoneEntityResponse `json:"zones"`
+	NextPageToken string               `json:"next_page_token" example:"bXNrLWNlbnRlcg"`
+}
+
+// @Summary		List delivery zones
+// @Description	Lists delivery zones of a provider ordered by ID page by page
+// @ID				zoneListAll
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			page_size	query		int		false	"Page size, 50 by default, 500 at most"
+// @Param			page_token	query		string	false	"Token of the next page from a previous response"
+// @Success		200			{object}	zoneListAllResponse
+// @Failure		400			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones [get]
+func (c *controllerZone) zoneGetAll(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	if providerID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGetAll - providerID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	var query zoneListAllQuery
+
+	if err := ctx.QueryParser(&query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGetAll - queryParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(query); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGetAll - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	page, err := c.uc.ListAll(ctx.UserContext(), entity.ZoneListParams{
+		ProviderID: entity.ProviderID(providerID),
+		PageSize:   query.PageSize,
+		PageToken:  query.PageToken,
+	})
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGetAll - uc.ListAll: %w", err))
+
+		if errors.Is(err, entity.ErrInvalidArgument) {
+			return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "zone database problems")
+	}
+
+	zones := make([]zoneEntityResponse, len(page.Zones))
+
+	for i, z := range page.Zones {
+		zones[i] = zoneEntityResponse(*z)
+	}
+
+	return ctx.Status(http.StatusOK).JSON(zoneListAllResponse{
+		Zones:         zones,
+		NextPageToken: page.NextPageToken,
+	})
+}
+
+type paramZoneID entity.ZoneID
+
+type zoneGetResponse zoneEntityResponse
+
+// @Summary		Get a delivery zone
+// @Description	Returns a delivery zone of a provider by its ID
+// @ID				zoneGet
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string	true	"Provider ID"
+// @Param			zoneID		path		string	true	"Zone ID"
+// @Success		200			{object}	zoneGetResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones/{zoneID} [get]
+func (c *controllerZone) zoneGet(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	zoneID := paramZoneID(ctx.Params("zoneID"))
+	if providerID == "" || zoneID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGet - providerID or zoneID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	zone, err := c.uc.GetByID(ctx.UserContext(), entity.ProviderID(providerID), entity.ZoneID(zoneID))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneGet - uc.GetByID: %w", err))
+
+		if errors.Is(err, entity.ErrNotFound) {
+			return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", zoneID, entity.ErrNotFound.Error()))
+		}
+
+		return errorResponse(ctx, http.StatusInternalServerError, "zone database problems")
+	}
+
+	ctx.Set(fiber.HeaderETag, zone.ETag())
+
+	return ctx.Status(http.StatusOK).JSON(zoneGetResponse(*zone))
+}
+
+type zoneUpdateRequest struct {
+	Name     string           `json:"name" validate:"required" example:"Москва, центр"`
+	Geometry *entity.Geometry `json:"geometry" validate:"required" swaggertype:"object"`
+}
+
+type zoneUpdateResponse zoneEntityResponse
+
+// @Summary		Update a delivery zone
+// @Description	Replaces all updatable fields of a delivery zone
+// @ID				zoneUpdate
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string				true	"Provider ID"
+// @Param			zoneID		path		string				true	"Zone ID"
+// @Param			If-Match	header		string				false	"ETag of the zone version being replaced"
+// @Param			body		body		zoneUpdateRequest	true	"Zone update parameters"
+// @Success		200			{object}	zoneUpdateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones/{zoneID} [put]
+func (c *controllerZone) zoneUpdate(ctx *fiber.Ctx) error {
+	var requestBody zoneUpdateRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneUpdate - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneUpdate - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	return c.update(ctx, "zoneUpdate", &entity.Zone{
+		Name:     requestBody.Name,
+		Geometry: *requestBody.Geometry,
+	}, entity.ZoneMask{"*"})
+}
+
+// zonePatchRequest uses pointers to tell omitted fields from empty ones.
+type zonePatchRequest struct {
+	Name     *string          `json:"name" validate:"omitnil,min=1" example:"Москва, центр"`
+	Geometry *entity.Geometry `json:"geometry" swaggertype:"object"`
+}
+
+// @Summary		Partially update a delivery zone
+// @Description	Updates only the fields present in the request body
+// @ID				zonePatch
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path		string				true	"Provider ID"
+// @Param			zoneID		path		string				true	"Zone ID"
+// @Param			If-Match	header		string				false	"ETag of the zone version being updated"
+// @Param			body		body		zonePatchRequest	true	"Zone fields to update"
+// @Success		200			{object}	zoneUpdateResponse
+// @Failure		400			{object}	responseError
+// @Failure		404			{object}	responseError
+// @Failure		412			{object}	responseError
+// @Failure		500			{object}	responseError
+// @Router			/providers/{providerID}/zones/{zoneID} [patch]
+func (c *controllerZone) zonePatch(ctx *fiber.Ctx) error {
+	var requestBody zonePatchRequest
+
+	if err := ctx.BodyParser(&requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zonePatch - bodyParser: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	if err := c.v.Struct(requestBody); err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zonePatch - validate: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	zone := new(entity.Zone)
+	var mask entity.ZoneMask
+
+	if requestBody.Name != nil {
+		zone.Name = *requestBody.Name
+		mask = append(mask, entity.ZoneFieldName)
+	}
+	if requestBody.Geometry != nil {
+		zone.Geometry = *requestBody.Geometry
+		mask = append(mask, entity.ZoneFieldGeometry)
+	}
+
+	return c.update(ctx, "zonePatch", zone, mask)
+}
+
+// update is shared by zoneUpdate and zonePatch, handler names the caller in logs.
+func (c *controllerZone) update(ctx *fiber.Ctx, handler string, zone *entity.Zone, mask entity.ZoneMask) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	zoneID := paramZoneID(ctx.Params("zoneID"))
+	if providerID == "" || zoneID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - %s - providerID or zoneID not provided", handler))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - %s - entity.ParseETag: %w", handler, err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	zone.Version = version
+
+	zoneUpdated, err := c.uc.Update(ctx.UserContext(), entity.ProviderID(providerID), entity.ZoneID(zoneID), zone, mask)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - %s - uc.Update: %w", handler, err))
+
+		return zoneErrorResponse(ctx, zoneID, err)
+	}
+
+	ctx.Set(fiber.HeaderETag, zoneUpdated.ETag())
+
+	return ctx.Status(http.StatusOK).JSON(zoneUpdateResponse(*zoneUpdated))
+}
+
+// @Summary		Delete a delivery zone
+// @Description	Deletes a delivery zone of a provider
+// @ID				zoneDelete
+// @Tags			Zone
+// @Accept			json
+// @Produce		json
+// @Param			providerID	path	string	true	"Provider ID"
+// @Param			zoneID		path	string	true	"Zone ID"
+// @Param			If-Match	header	string	false	"ETag of the zone version being deleted"
+// @Success		204
+// @Failure		400	{object}	responseError
+// @Failure		404	{object}	responseError
+// @Failure		412	{object}	responseError
+// @Failure		500	{object}	responseError
+// @Router			/providers/{providerID}/zones/{zoneID} [delete]
+func (c *controllerZone) zoneDelete(ctx *fiber.Ctx) error {
+	providerID := paramProviderID(ctx.Params("providerID"))
+	zoneID := paramZoneID(ctx.Params("zoneID"))
+	if providerID == "" || zoneID == "" {
+		c.l.Error(fmt.Errorf("http - v1 - zoneDelete - providerID or zoneID not provided"))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	version, err := entity.ParseETag(ctx.Get(fiber.HeaderIfMatch))
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneDelete - entity.ParseETag: %w", err))
+
+		return errorResponse(ctx, http.StatusBadRequest, "bad request")
+	}
+
+	err = c.uc.Delete(ctx.UserContext(), entity.ProviderID(providerID), entity.ZoneID(zoneID), version)
+	if err != nil {
+		c.l.Error(fmt.Errorf("http - v1 - zoneDelete - uc.Delete: %w", err))
+
+		return zoneErrorResponse(ctx, zoneID, err)
+	}
+
+	return ctx.SendStatus(http.StatusNoContent)
+}
+
+func zoneErrorResponse(ctx *fiber.Ctx, zoneID paramZoneID, err error) error {
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return errorResponse(ctx, http.StatusNotFound, fmt.Sprintf("%s: %s", zoneID, entity.ErrNotFound.Error()))
+	case errors.Is(err, entity.ErrInvalidArgument):
+		return errorResponse(ctx, http.StatusBadRequest, entity.ErrInvalidArgument.Error())
+	case errors.Is(err, entity.ErrPreconditionFailed):
+		return errorResponse(ctx, http.StatusPreconditionFailed, fmt.Sprintf("%s: %s", zoneID, entity.ErrPreconditionFailed.Error()))
+	default:
+		return errorResponse(ctx, http.StatusInternalServerError, "zone database problems")
+	}
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate       AuditAction = "create"
+	AuditActionUpdate       AuditAction = "update"
+	AuditActionDelete       AuditAction = "delete"
+	AuditActionRestore      AuditAction = "restore"
+	AuditActionPurge        AuditAction = "purge"
+	AuditActionStatusChange AuditAction = "status_change"
+)
+
+// AuditEntry is one recorded change of a provider, values are JSON snapshots and nil when absent.
+type AuditEntry struct {
+	AuditID    int64           `db:"audit_id"`
+	ProviderID ProviderID      `db:"provider_id"`
+	Action     AuditAction     `db:"action"`
+	OldValue   json.RawMessage `db:"old_value"`
+	NewValue   json.RawMessage `db:"new_value"`
+	// ClaimedActor is who the caller says made the change, it is not verified.
+	ClaimedActor string    `db:"claimed_actor"`
+	RequestID    string    `db:"request_id"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// AuditQuery selects the history of a provider, newest first.
+type AuditQuery struct {
+	ProviderID ProviderID
+	// BeforeID skips entries not older than the given one, zero starts from the newest.
+	BeforeID int64
+	Limit    uint64
+}
+
+type HistoryListParams struct {
+	PageSize  int
+	PageToken string
+}
+
+type HistoryPage struct {
+	Entries       []*AuditEntry
+	NextPageToken string
+}
+
+// Principal describes who performs a request, transports put it into the context for the audit log.
+type Principal struct {
+	// ClaimedActor is named by the caller and is not tied to any authenticated identity.
+	ClaimedActor string
+	RequestID    string
+}
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) Principal {
+	p, _ := ctx.Value(principalKey{}).(Principal)
+
+	return p
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// Calendar is the working schedule of a provider. Hours, cut-offs and holidays are local to TimeZone.
+type Calendar struct {
+	ProviderID ProviderID `db:"provider_id"`
+	// TimeZone is the IANA name of the location the calendar is local to.
+	TimeZone string `db:"time_zone"`
+	// Hours are the weekly working hours, the provider does not work on weekdays without any.
+	Hours []OpeningHours `db:"hours"`
+	// CutOffs are the latest times of working days orders are dispatched on the same day,
+	// later ones wait for the next working day. Without one orders are accepted until closing.
+	CutOffs []CutOff `db:"cut_offs"`
+	// Holidays are local dates the provider does not work on whatever the weekday.
+	Holidays  []Holiday `db:"holidays"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type CutOff struct {
+	Weekday time.Weekday `json:"weekday"`
+	At      TimeOfDay    `json:"at"`
+}
+
+type Holiday struct {
+	// Date is local as "2006-01-02".
+	Date string `json:"date"`
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+// CoverageArea is a zone of an active provider as the coverage lookup sees it.
+type CoverageArea struct {
+	ProviderID ProviderID `db:"provider_id"`
+	ZoneID     ZoneID     `db:"zone_id"`
+	Geometry   Geometry   `db:"geometry"`
+}
+
+// CoverageMatch is a provider delivering to the looked up position and its zones containing it.
+type CoverageMatch struct {
+	ProviderID ProviderID
+	ZoneIDs    []ZoneID
+}
@@ -5,5 +5,8 @@ import "errors"
 var (
 	ErrAlreadyExists       = errors.New("already exists")
 	ErrNotFound            = errors.New("not found")
+	ErrInvalidArgument     = errors.New("invalid argument")
+	ErrPreconditionFailed  = errors.New("precondition failed")
+	ErrPermissionDenied    = errors.New("permission denied")
 	ErrInternalServerError = errors.New("internal server error")
 )
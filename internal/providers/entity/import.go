@@ -0,0 +1,38 @@
+package entity
+
+// ImportRow is a provider read from an import file, Line is 1-based and counts the header of a CSV file.
+type ImportRow struct {
+	Line     int
+	Provider *Provider
+	// Err is set when the row could not be parsed, Provider is nil then.
+	Err error
+}
+
+type ImportAction string
+
+const (
+	ImportActionCreated   ImportAction = "created"
+	ImportActionUpdated   ImportAction = "updated"
+	ImportActionUnchanged ImportAction = "unchanged"
+	ImportActionFailed    ImportAction = "failed"
+)
+
+type ImportRowResult struct {
+	Line       int
+	ProviderID ProviderID
+	Action     ImportAction
+	// Error explains why the row failed.
+	Error string
+}
+
+// ImportReport describes what an import did or, on a dry run or when some rows failed, what it would have done.
+type ImportReport struct {
+	DryRun bool
+	// Applied is true when the changes were committed, an import is applied entirely or not at all.
+	Applied   bool
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+	Rows      []ImportRowResult
+}
@@ -0,0 +1,23 @@
+package entity
+
+type LabelOperator string
+
+const (
+	LabelOperatorEquals       LabelOperator = "="
+	LabelOperatorNotEquals    LabelOperator = "!="
+	LabelOperatorIn           LabelOperator = "in"
+	LabelOperatorNotIn        LabelOperator = "notin"
+	LabelOperatorExists       LabelOperator = "exists"
+	LabelOperatorDoesNotExist LabelOperator = "!"
+)
+
+// LabelRequirement is a single condition of a label selector. Equals and NotEquals have one value, In and NotIn
+// at least one, Exists and DoesNotExist none. As in Kubernetes, NotEquals and NotIn match providers without the label.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// LabelSelector matches providers satisfying all of its requirements, an empty selector matches everything.
+type LabelSelector []LabelRequirement
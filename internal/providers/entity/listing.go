@@ -0,0 +1,66 @@
+package entity
+
+import "time"
+
+type ProviderOrderField string
+
+const (
+	ProviderOrderByProviderID ProviderOrderField = "provider_id"
+	ProviderOrderByCreatedAt  ProviderOrderField = "created_at"
+)
+
+type ProviderOrder struct {
+	Field ProviderOrderField
+	Desc  bool
+}
+
+func (o ProviderOrder) String() string {
+	if o.Desc {
+		return string(o.Field) + " desc"
+	}
+
+	return string(o.Field)
+}
+
+// ProviderFilter narrows provider listing, zero values mean "no restriction".
+type ProviderFilter struct {
+	NamePrefix    string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// IncludeArchived also returns archived providers, which are skipped by default.
+	IncludeArchived bool
+	// Statuses keeps providers in any of the listed statuses.
+	Statuses []ProviderStatus
+	Labels   LabelSelector
+}
+
+// ProviderListParams are listing parameters as received from the transports.
+type ProviderListParams struct {
+	PageSize  int
+	PageToken string
+	OrderBy   string
+	// LabelSelector is parsed into Filter.Labels, e.g. "region=msk,tier in (gold,silver),!legacy".
+	LabelSelector string
+	Filter        ProviderFilter
+}
+
+// ProviderCursor is the keyset position of the last provider returned on a page.
+type ProviderCursor struct {
+	ProviderID ProviderID
+	CreatedAt  time.Time
+}
+
+// ProviderQuery is a resolved listing query executed by the repository.
+type ProviderQuery struct {
+	Filter ProviderFilter
+	Order  ProviderOrder
+	After  *ProviderCursor
+	Limit  uint64
+}
+
+type ProviderPage struct {
+	Providers     []*Provider
+	NextPageToken string
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header, most preferred first.
+// A malformed header is treated as if there was none.
+func ParseAcceptLanguage(header string) []language.Tag {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	return tags
+}
+
+// Localize sets DisplayName to the display name in the first of the preferred languages the provider has one for.
+// A regional variant and its base language stand in for each other, so "kk-KZ" finds "kk" and "en" finds "en-GB".
+// When none of the languages match, DisplayName falls back to Name.
+func (p *Provider) Localize(preferred []language.Tag) {
+	p.DisplayName = p.Name
+
+	if len(preferred) == 0 || len(p.DisplayNames) == 0 {
+		return
+	}
+
+	// The matcher prefers earlier supported tags on ties, sort them for stable results.
+	keys := make([]string, 0, len(p.DisplayNames))
+	for key := range p.DisplayNames {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	supported := make([]language.Tag, 0, len(keys))
+	names := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		tag, err := language.Parse(key)
+		if err != nil {
+			continue
+		}
+
+		supported = append(supported, tag)
+		names = append(names, p.DisplayNames[key])
+	}
+
+	if len(supported) == 0 {
+		return
+	}
+
+	_, i, confidence := language.NewMatcher(supported).Match(preferred...)
+	if confidence >= language.High {
+		p.DisplayName = names[i]
+	}
+}
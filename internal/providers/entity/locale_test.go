@@ -0,0 +1,41 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_Localize(t *testing.T) {
+	t.Parallel()
+
+	displayNames := map[string]string{"kk": "Купер KZ", "en-GB": "Kuper"}
+
+	tests := []struct {
+		name           string
+		displayNames   map[string]string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "exact language", displayNames: displayNames, acceptLanguage: "kk", want: "Купер KZ"},
+		{name: "regional variant finds the base language", displayNames: displayNames, acceptLanguage: "kk-KZ", want: "Купер KZ"},
+		{name: "base language finds a regional variant", displayNames: displayNames, acceptLanguage: "en", want: "Kuper"},
+		{name: "first matching preference wins", displayNames: displayNames, acceptLanguage: "de, en;q=0.8, kk;q=0.5", want: "Kuper"},
+		{name: "no matching language falls back to the name", displayNames: displayNames, acceptLanguage: "ru-RU", want: "Купер"},
+		{name: "no accept-language falls back to the name", displayNames: displayNames, want: "Купер"},
+		{name: "malformed accept-language falls back to the name", displayNames: displayNames, acceptLanguage: "en;q=x;;", want: "Купер"},
+		{name: "no display names", acceptLanguage: "kk", want: "Купер"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &entity.Provider{Name: "Купер", DisplayNames: tt.displayNames}
+			p.Localize(entity.ParseAcceptLanguage(tt.acceptLanguage))
+
+			require.Equal(t, tt.want, p.DisplayName)
+		})
+	}
+}
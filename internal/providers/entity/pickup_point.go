@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// PickupPoint is a place of a provider where customers collect parcels.
+type PickupPoint struct {
+	ProviderID ProviderID      `db:"provider_id"`
+	PointID    PickupPointID   `db:"point_id"`
+	Name       string          `db:"name"`
+	Type       PickupPointType `db:"type"`
+	Address    string          `db:"address"`
+	Lat        float64         `db:"lat"`
+	Lon        float64         `db:"lon"`
+	// OpeningHours are local wall clock intervals, weekdays without any are days off.
+	OpeningHours   []OpeningHours   `db:"opening_hours"`
+	PaymentOptions []PaymentOption  `db:"payment_options"`
+	MaxParcel      ParcelDimensions `db:"max_parcel"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedAt      time.Time        `db:"updated_at"`
+	// Version is incremented on every change and guards concurrent updates.
+	Version int64 `db:"version"`
+}
+
+// PickupPointID is unique among the pickup points of a provider.
+type PickupPointID string
+
+func (p *PickupPoint) Position() Position {
+	return Position{p.Lon, p.Lat}
+}
+
+// ETag renders the pickup point version as a strong HTTP entity tag.
+func (p *PickupPoint) ETag() string {
+	return strconv.Quote(strconv.FormatInt(p.Version, 10))
+}
+
+type PickupPointType string
+
+const (
+	// PickupPointTypePVZ is a staffed pickup desk.
+	PickupPointTypePVZ        PickupPointType = "pvz"
+	PickupPointTypeLocker     PickupPointType = "locker"
+	PickupPointTypePostOffice PickupPointType = "post_office"
+)
+
+func (t PickupPointType) Valid() bool {
+	switch t {
+	case PickupPointTypePVZ, PickupPointTypeLocker, PickupPointTypePostOffice:
+		return true
+	default:
+		return false
+	}
+}
+
+type PaymentOption string
+
+const (
+	// PaymentOptionPrepaid accepts parcels paid for at checkout only.
+	PaymentOptionPrepaid PaymentOption = "prepaid"
+	PaymentOptionCash    PaymentOption = "cash"
+	PaymentOptionCard    PaymentOption = "card"
+)
+
+func (o PaymentOption) Valid() bool {
+	switch o {
+	case PaymentOptionPrepaid, PaymentOptionCash, PaymentOptionCard:
+		return true
+	default:
+		return false
+	}
+}
+
+// OpeningHours is an interval a pickup point is open on a weekday, a weekday may have several.
+type OpeningHours struct {
+	Weekday time.Weekday `json:"weekday"`
+	Opens   TimeOfDay    `json:"opens"`
+	Closes  TimeOfDay    `json:"closes"`
+}
+
+// ParcelDimensions limit the parcels a pickup point accepts, zero means no limit.
+type ParcelDimensions struct {
+	LengthCM int `json:"length_cm"`
+	WidthCM  int `json:"width_cm"`
+	HeightCM int `json:"height_cm"`
+	WeightG  int `json:"weight_g"`
+}
+
+// PickupPointField is a path of an updatable pickup point field as used in update masks.
+type PickupPointField string
+
+const (
+	PickupPointFieldName           PickupPointField = "name"
+	PickupPointFieldType           PickupPointField = "type"
+	PickupPointFieldAddress        PickupPointField = "address"
+	PickupPointFieldLocation       PickupPointField = "location"
+	PickupPointFieldOpeningHours   PickupPointField = "opening_hours"
+	PickupPointFieldPaymentOptions PickupPointField = "payment_options"
+	PickupPointFieldMaxParcel      PickupPointField = "max_parcel"
+)
+
+// PickupPointMask lists the fields an update is allowed to touch.
+type PickupPointMask []PickupPointField
+
+type PickupPointListParams struct {
+	ProviderID ProviderID
+	PageSize   int
+	PageToken  string
+}
+
+// PickupPointQuery is a validated pickup point listing request, points are ordered by ID.
+type PickupPointQuery struct {
+	ProviderID ProviderID
+	// After is the ID of the last point of the previous page.
+	After PickupPointID
+	Limit uint64
+}
+
+type PickupPointPage struct {
+	PickupPoints []*PickupPoint
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// PickupPointNearbyQuery asks for the pickup points of active providers closest to a position.
+type PickupPointNearbyQuery struct {
+	Position Position
+	// RadiusM bounds the great-circle distance in meters, zero means the default radius.
+	RadiusM float64
+	// Limit is the number of points to return, zero means the default.
+	Limit int
+	// ProviderIDs and Types narrow the search down when not empty.
+	ProviderIDs []ProviderID
+	Types       []PickupPointType
+}
+
+// PickupPointDistance is a pickup point found near a position and the great-circle distance to it.
+type PickupPointDistance struct {
+	Point     *PickupPoint
+	DistanceM float64
+}
@@ -0,0 +1,24 @@
+package entity
+
+// LegalEntity is the company a provider contracts through.
+type LegalEntity struct {
+	Name  string `json:"name"`
+	TaxID string `json:"tax_id"`
+}
+
+// Contact is a support channel of a provider, at least one of Email and Phone is set.
+type Contact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	// Phone is in E.164 format.
+	Phone string `json:"phone,omitempty"`
+}
+
+// Capabilities are delivery services a provider offers.
+type Capabilities struct {
+	Courier        bool `json:"courier"`
+	PickupPoints   bool `json:"pickup_points"`
+	Lockers        bool `json:"lockers"`
+	SameDay        bool `json:"same_day"`
+	CashOnDelivery bool `json:"cash_on_delivery"`
+}
@@ -1,12 +1,92 @@
 package entity
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type Provider struct {
 	ProviderID ProviderID `db:"provider_id"`
 	Name       string     `db:"name"`
 	CreatedAt  time.Time  `db:"created_at"`
 	UpdatedAt  time.Time  `db:"updated_at"`
+	// Version is incremented on every change and guards concurrent updates.
+	Version int64 `db:"version"`
+	// DeletedAt is set while the provider is archived.
+	DeletedAt *time.Time `db:"deleted_at"`
+	// Status is changed only through lifecycle transitions, see ProviderStatus.ValidateTransition.
+	Status       ProviderStatus `db:"status"`
+	StatusReason string         `db:"status_reason"`
+	LegalEntity  LegalEntity    `db:"legal_entity"`
+	Contacts     []Contact      `db:"contacts"`
+	Website      string         `db:"website"`
+	// Countries are ISO 3166-1 alpha-2 codes.
+	Countries    []string     `db:"countries"`
+	Capabilities Capabilities `db:"capabilities"`
+	// DisplayNames are names shown to customers keyed by BCP-47 language tag, Name is the fallback.
+	DisplayNames map[string]string `db:"display_names"`
+	// Labels are arbitrary key/value pairs to select providers by, see LabelSelector.
+	Labels map[string]string `db:"labels"`
+	// DisplayName is not stored, it is set by Localize for the languages of the caller.
+	DisplayName string `db:"-"`
 }
 
 type ProviderID string
+
+func (p *Provider) Archived() bool {
+	return p.DeletedAt != nil
+}
+
+// ETag renders the provider version as a strong HTTP entity tag.
+func (p *Provider) ETag() string {
+	return strconv.Quote(strconv.FormatInt(p.Version, 10))
+}
+
+// ParseETag returns the version an entity tag refers to. Empty tag and "*" match any version and yield 0.
+func ParseETag(etag string) (int64, error) {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("etag %q: %w", etag, ErrInvalidArgument)
+	}
+
+	return version, nil
+}
+
+// ProviderField is a path of an updatable provider field as used in update masks.
+type ProviderField string
+
+const (
+	ProviderFieldName         ProviderField = "name"
+	ProviderFieldLegalEntity  ProviderField = "legal_entity"
+	ProviderFieldContacts     ProviderField = "contacts"
+	ProviderFieldWebsite      ProviderField = "website"
+	ProviderFieldCountries    ProviderField = "countries"
+	ProviderFieldCapabilities ProviderField = "capabilities"
+	// ProviderFieldDisplayNames and ProviderFieldLabels replace the whole map, see ProviderField.Entry
+	// to update a single key.
+	ProviderFieldDisplayNames ProviderField = "display_names"
+	ProviderFieldLabels       ProviderField = "labels"
+)
+
+// Entry is the update mask path of a single key of a map field, e.g. "labels.tier". The update sets
+// or removes the key and leaves the rest of the map as is.
+func (f ProviderField) Entry(key string) ProviderField {
+	return f + "." + ProviderField(key)
+}
+
+// CutEntry splits a path made by Entry into the map field and the key.
+func (f ProviderField) CutEntry() (ProviderField, string, bool) {
+	field, key, ok := strings.Cut(string(f), ".")
+
+	return ProviderField(field), key, ok
+}
+
+// ProviderMask lists the fields an update is allowed to touch.
+type ProviderMask []ProviderField
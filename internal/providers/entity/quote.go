@@ -0,0 +1,34 @@
+package entity
+
+// QuoteOption is a way to deliver a shipment: a tariff of a provider and its price.
+type QuoteOption struct {
+	ProviderID     ProviderID
+	TariffID       TariffID
+	TariffName     string
+	ServiceLevel   ServiceLevel
+	TransitDaysMin int
+	TransitDaysMax int
+	Price          *PriceBreakdown
+}
+
+type QuoteFailureReason string
+
+const (
+	// QuoteFailureTimeout means the provider was not evaluated before the deadline.
+	QuoteFailureTimeout QuoteFailureReason = "timeout"
+	// QuoteFailureNotApplicable means no tariff of the provider prices the shipment.
+	QuoteFailureNotApplicable QuoteFailureReason = "not_applicable"
+	QuoteFailureInternal      QuoteFailureReason = "internal"
+)
+
+// QuoteFailure is an eligible provider without options and the reason why.
+type QuoteFailure struct {
+	ProviderID ProviderID
+	Reason     QuoteFailureReason
+}
+
+// Quote holds the options of every eligible provider, providers that failed are listed in Failures.
+type Quote struct {
+	Options  []*QuoteOption
+	Failures []QuoteFailure
+}
@@ -0,0 +1,20 @@
+package entity
+
+// ProviderSearchParams are search parameters as received from the transports.
+type ProviderSearchParams struct {
+	Query    string
+	PageSize int
+}
+
+// ProviderSearchQuery is a resolved search query executed by the repository.
+type ProviderSearchQuery struct {
+	Text  string
+	Limit uint64
+}
+
+// ProviderSearchHit is a provider matching a search query. Score is in [0, 1],
+// higher is a better match.
+type ProviderSearchHit struct {
+	Provider *Provider
+	Score    float64
+}
@@ -0,0 +1,78 @@
+package entity
+
+import "time"
+
+// Slot is a delivery window of a provider in one of its zones, customers book it up to its capacity.
+type Slot struct {
+	SlotID     SlotID     `db:"slot_id"`
+	ProviderID ProviderID `db:"provider_id"`
+	ZoneID     ZoneID     `db:"zone_id"`
+	StartsAt   time.Time  `db:"starts_at"`
+	EndsAt     time.Time  `db:"ends_at"`
+	Capacity   int        `db:"capacity"`
+	Booked     int        `db:"booked"`
+	// ClosedAt is set once the slot stops taking bookings, existing ones are kept.
+	ClosedAt  *time.Time `db:"closed_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
+
+type SlotID int64
+
+func (s *Slot) Closed() bool {
+	return s.ClosedAt != nil
+}
+
+// Available is the number of bookings the slot can still take.
+func (s *Slot) Available() int {
+	if s.Closed() {
+		return 0
+	}
+
+	return max(s.Capacity-s.Booked, 0)
+}
+
+// SlotFilter narrows slot listing, zero values mean "no restriction".
+type SlotFilter struct {
+	ProviderID ProviderID
+	ZoneID     ZoneID
+	// StartsFrom and StartsBefore bound the start of the slots, inclusive and exclusive.
+	StartsFrom   time.Time
+	StartsBefore time.Time
+	// IncludeClosed also returns closed slots, which are skipped by default.
+	IncludeClosed bool
+}
+
+// SlotListParams are listing parameters as received from the transports.
+type SlotListParams struct {
+	Filter    SlotFilter
+	PageSize  int
+	PageToken string
+}
+
+// SlotCursor is the keyset position of the last slot returned on a page.
+type SlotCursor struct {
+	StartsAt time.Time
+	SlotID   SlotID
+}
+
+// SlotQuery is a resolved listing query, slots are ordered by start and ID.
+type SlotQuery struct {
+	Filter SlotFilter
+	After  *SlotCursor
+	Limit  uint64
+}
+
+type SlotPage struct {
+	Slots []*Slot
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// AvailabilityParams ask for bookable slots of a zone starting within [From, To).
+type AvailabilityParams struct {
+	ProviderID ProviderID
+	ZoneID     ZoneID
+	From       time.Time
+	To         time.Time
+}
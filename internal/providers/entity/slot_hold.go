@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrSlotUnavailable is returned when a slot can not take a hold: it is closed, has started or is full.
+	ErrSlotUnavailable = errors.New("slot unavailable")
+	// ErrHoldNotActive is returned when a hold has expired or was released before it could be confirmed.
+	ErrHoldNotActive = errors.New("hold is not active")
+)
+
+// SlotHold keeps capacity of a slot for a customer at checkout, it expires unless confirmed in time.
+type SlotHold struct {
+	HoldID     HoldID     `db:"hold_id"`
+	SlotID     SlotID     `db:"slot_id"`
+	ProviderID ProviderID `db:"provider_id"`
+	Quantity   int        `db:"quantity"`
+	Status     HoldStatus `db:"status"`
+	// ExpiresAt only matters while the hold is held.
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type HoldID string
+
+type HoldStatus string
+
+const (
+	HoldStatusHeld      HoldStatus = "held"
+	HoldStatusConfirmed HoldStatus = "confirmed"
+	HoldStatusReleased  HoldStatus = "released"
+	HoldStatusExpired   HoldStatus = "expired"
+)
+
+// Active reports whether the hold still counts against the capacity of its slot.
+func (h *SlotHold) Active() bool {
+	return h.Status == HoldStatusHeld || h.Status == HoldStatusConfirmed
+}
+
+// Expired reports whether a held hold has outlived its TTL, the sweeper may not have released it yet.
+func (h *SlotHold) Expired(now time.Time) bool {
+	return h.Status == HoldStatusExpired || h.Status == HoldStatusHeld && !h.ExpiresAt.After(now)
+}
@@ -0,0 +1,61 @@
+package entity
+
+import "time"
+
+// SlotTemplate describes slots recurring every week in a zone, the generator turns active templates into dated slots.
+type SlotTemplate struct {
+	ProviderID ProviderID     `db:"provider_id"`
+	TemplateID SlotTemplateID `db:"template_id"`
+	ZoneID     ZoneID         `db:"zone_id"`
+	// TimeZone is the IANA name the windows are local to.
+	TimeZone string           `db:"time_zone"`
+	Rule     SlotTemplateRule `db:"rule"`
+	// ActivatedAt is set while the template generates slots.
+	ActivatedAt *time.Time `db:"activated_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+type SlotTemplateID string
+
+func (t *SlotTemplate) Active() bool {
+	return t.ActivatedAt != nil
+}
+
+// SlotTemplateRule is the weekly recurrence: every window on every listed weekday, except the excluded dates.
+type SlotTemplateRule struct {
+	Days    []SlotTemplateDay    `json:"days"`
+	Windows []SlotTemplateWindow `json:"windows"`
+	// Exclusions are local dates as "2006-01-02" without slots, holidays for example.
+	Exclusions []string `json:"exclusions,omitempty"`
+}
+
+// SlotTemplateDay is the capacity of every window on the weekday.
+type SlotTemplateDay struct {
+	Weekday  time.Weekday `json:"weekday"`
+	Capacity int          `json:"capacity"`
+}
+
+type SlotTemplateWindow struct {
+	Start TimeOfDay `json:"start"`
+	End   TimeOfDay `json:"end"`
+}
+
+type SlotTemplateListParams struct {
+	ProviderID ProviderID
+	PageSize   int
+	PageToken  string
+}
+
+// SlotTemplateQuery is a resolved listing query, templates are ordered by ID.
+type SlotTemplateQuery struct {
+	ProviderID ProviderID
+	After      SlotTemplateID
+	Limit      uint64
+}
+
+type SlotTemplatePage struct {
+	Templates []*SlotTemplate
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+type ProviderStatus string
+
+const (
+	ProviderStatusOnboarding ProviderStatus = "onboarding"
+	ProviderStatusActive     ProviderStatus = "active"
+	ProviderStatusSuspended  ProviderStatus = "suspended"
+	ProviderStatusTerminated ProviderStatus = "terminated"
+)
+
+var ErrIllegalTransition = errors.New("illegal status transition")
+
+// StatusTransitionError reports a lifecycle transition the state machine does not allow.
+type StatusTransitionError struct {
+	From ProviderStatus
+	To   ProviderStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s: %s -> %s", ErrIllegalTransition, e.From, e.To)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrIllegalTransition
+}
+
+// transitions lists the statuses reachable from each status, terminated is final.
+func (s ProviderStatus) transitions() []ProviderStatus {
+	switch s {
+	case ProviderStatusOnboarding:
+		return []ProviderStatus{ProviderStatusActive, ProviderStatusTerminated}
+	case ProviderStatusActive:
+		return []ProviderStatus{ProviderStatusSuspended, ProviderStatusTerminated}
+	case ProviderStatusSuspended:
+		return []ProviderStatus{ProviderStatusActive, ProviderStatusTerminated}
+	case ProviderStatusTerminated:
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (s ProviderStatus) Valid() bool {
+	switch s {
+	case ProviderStatusOnboarding, ProviderStatusActive, ProviderStatusSuspended, ProviderStatusTerminated:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateTransition returns a *StatusTransitionError when the provider can not move from s to the next status.
+func (s ProviderStatus) ValidateTransition(next ProviderStatus) error {
+	if !slices.Contains(s.transitions(), next) {
+		return &StatusTransitionError{From: s, To: next}
+	}
+
+	return nil
+}
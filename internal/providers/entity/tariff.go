@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrTariffNotApplicable is returned when a tariff has no price for a shipment: a parcel is heavier than
+// the last weight break or the route is longer than the last distance band.
+var ErrTariffNotApplicable = errors.New("tariff not applicable")
+
+// ErrRevisionOverlap is returned when a tariff revision would be in effect together with another one.
+var ErrRevisionOverlap = errors.New("tariff revision overlaps another one")
+
+// ErrTariffInEffect is returned when deleting a tariff one of whose revisions has already taken effect.
+var ErrTariffInEffect = errors.New("tariff revision has taken effect")
+
+// Tariff prices shipments of a provider by the rules of its revisions, all of their amounts are in Currency.
+type Tariff struct {
+	ProviderID ProviderID `db:"provider_id"`
+	TariffID   TariffID   `db:"tariff_id"`
+	Name       string     `db:"name"`
+	// Currency is an ISO 4217 code, prices are rounded to its minor units.
+	Currency     Currency     `db:"currency"`
+	ServiceLevel ServiceLevel `db:"service_level"`
+	// TransitDaysMin and TransitDaysMax bound the delivery time, zero days is delivery on the day of the order.
+	TransitDaysMin int       `db:"transit_days_min"`
+	TransitDaysMax int       `db:"transit_days_max"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	// Revision is the one in effect or, before the first one starts, the next one. It is stored separately.
+	Revision *TariffRevision `db:"-"`
+}
+
+type TariffID string
+
+// TariffRevision is an immutable price list of a tariff in effect from EffectiveFrom until EffectiveTo,
+// forever without it. Revisions of a tariff never overlap, so past shipments keep their prices.
+type TariffRevision struct {
+	ProviderID    ProviderID  `db:"provider_id"`
+	TariffID      TariffID    `db:"tariff_id"`
+	Revision      int         `db:"revision"`
+	Rules         TariffRules `db:"rules"`
+	EffectiveFrom time.Time   `db:"effective_from"`
+	// EffectiveTo of an open-ended revision is set once when a later revision is scheduled.
+	EffectiveTo *time.Time `db:"effective_to"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+// InEffect tells whether the revision prices shipments at the time.
+func (r *TariffRevision) InEffect(at time.Time) bool {
+	return !at.Before(r.EffectiveFrom) && (r.EffectiveTo == nil || at.Before(*r.EffectiveTo))
+}
+
+// Overlaps tells whether both revisions are in effect at some time.
+func (r *TariffRevision) Overlaps(other *TariffRevision) bool {
+	return (other.EffectiveTo == nil || r.EffectiveFrom.Before(*other.EffectiveTo)) &&
+		(r.EffectiveTo == nil || other.EffectiveFrom.Before(*r.EffectiveTo))
+}
+
+// Currency is an upper case ISO 4217 code like RUB.
+type Currency string
+
+// ServiceLevel tells customers how fast a tariff delivers.
+type ServiceLevel string
+
+const (
+	ServiceLevelEconomy  ServiceLevel = "economy"
+	ServiceLevelStandard ServiceLevel = "standard"
+	ServiceLevelExpress  ServiceLevel = "express"
+	ServiceLevelSameDay  ServiceLevel = "same_day"
+)
+
+// TariffRules add up to the price of a shipment: every parcel is priced by its chargeable weight, the route
+// by its distance, the surcharges are added and the total is kept within [MinPrice, MaxPrice].
+type TariffRules struct {
+	// WeightBreaks are ordered by UpToG, the first one the chargeable weight of a parcel fits in prices it.
+	WeightBreaks []WeightBreak `json:"weight_breaks"`
+	// VolumetricDivisor turns the volume of a parcel in cm³ into its volumetric weight in kg,
+	// zero prices parcels by their actual weight only.
+	VolumetricDivisor int `json:"volumetric_divisor"`
+	// DistanceBands are ordered by UpToKm, routes are free of charge without any.
+	DistanceBands []DistanceBand `json:"distance_bands,omitempty"`
+	Surcharges    []Surcharge    `json:"surcharges,omitempty"`
+	// MinPrice and MaxPrice bound the total, zero means no bound.
+	MinPrice decimal.Decimal `json:"min_price"`
+	MaxPrice decimal.Decimal `json:"max_price"`
+}
+
+// WeightBreak prices a parcel heavier than the previous break and at most UpToG heavy:
+// Price plus PerKg for every kilogram above the previous break.
+type WeightBreak struct {
+	UpToG int             `json:"up_to_g"`
+	Price decimal.Decimal `json:"price"`
+	PerKg decimal.Decimal `json:"per_kg"`
+}
+
+// DistanceBand prices a route longer than the previous band and at most UpToKm long:
+// Price plus PerKm for every kilometer above the previous band.
+type DistanceBand struct {
+	UpToKm int             `json:"up_to_km"`
+	Price  decimal.Decimal `json:"price"`
+	PerKm  decimal.Decimal `json:"per_km"`
+}
+
+// Surcharge is a fixed amount added to every shipment, a fuel or an insurance fee for example.
+type Surcharge struct {
+	Code   string          `json:"code"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// Shipment is what a tariff prices: parcels carried from Origin to Destination.
+type Shipment struct {
+	Origin      Position
+	Destination Position
+	Parcels     []ParcelDimensions
+}
+
+type PriceLineKind string
+
+const (
+	// PriceLineWeight prices a parcel, Parcel is its index in the shipment.
+	PriceLineWeight    PriceLineKind = "weight"
+	PriceLineDistance  PriceLineKind = "distance"
+	PriceLineSurcharge PriceLineKind = "surcharge"
+	// PriceLineMinPrice and PriceLineMaxPrice bring the total up to the minimum or down to the maximum price.
+	PriceLineMinPrice PriceLineKind = "min_price"
+	PriceLineMaxPrice PriceLineKind = "max_price"
+)
+
+type PriceLine struct {
+	Kind PriceLineKind
+	// Parcel is set on weight lines only.
+	Parcel int
+	// Code is set on surcharge lines only.
+	Code   string
+	Amount decimal.Decimal
+}
+
+// PriceBreakdown is an evaluated price, the amounts of the lines are rounded to minor units and add up to Total.
+type PriceBreakdown struct {
+	Currency Currency
+	// Revision is the tariff revision the price comes from.
+	Revision int
+	// ChargeableWeightG sums the greater of the actual and the volumetric weight of every parcel.
+	ChargeableWeightG int
+	DistanceM         int
+	Lines             []PriceLine
+	Total             decimal.Decimal
+}
+
+type TariffListParams struct {
+	ProviderID ProviderID
+	PageSize   int
+	PageToken  string
+}
+
+// TariffQuery is a resolved listing query, tariffs are ordered by ID.
+type TariffQuery struct {
+	ProviderID ProviderID
+	After      TariffID
+	Limit      uint64
+}
+
+type TariffPage struct {
+	Tariffs []*Tariff
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
@@ -0,0 +1,62 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTariffRevision_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) time.Time {
+		return time.Date(2026, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	period := func(from, to int) *entity.TariffRevision {
+		r := &entity.TariffRevision{EffectiveFrom: day(from)}
+		if to != 0 {
+			end := day(to)
+			r.EffectiveTo = &end
+		}
+
+		return r
+	}
+
+	tests := []struct {
+		name string
+		a, b *entity.TariffRevision
+		want bool
+	}{
+		{name: "adjacent", a: period(1, 10), b: period(10, 20), want: false},
+		{name: "apart", a: period(1, 5), b: period(10, 0), want: false},
+		{name: "intersecting", a: period(1, 10), b: period(9, 20), want: true},
+		{name: "open-ended before", a: period(1, 0), b: period(10, 20), want: true},
+		{name: "both open-ended", a: period(1, 0), b: period(10, 0), want: true},
+		{name: "nested", a: period(1, 20), b: period(5, 6), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.a.Overlaps(tt.b))
+			require.Equal(t, tt.want, tt.b.Overlaps(tt.a))
+		})
+	}
+}
+
+func TestTariffRevision_InEffect(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	r := &entity.TariffRevision{EffectiveFrom: from, EffectiveTo: &to}
+
+	require.False(t, r.InEffect(from.Add(-time.Nanosecond)))
+	require.True(t, r.InEffect(from))
+	require.True(t, r.InEffect(to.Add(-time.Nanosecond)))
+	require.False(t, r.InEffect(to))
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeOfDay is a wall clock time as minutes since midnight, encoded as "HH:MM".
+// "24:00" stands for the end of the day.
+type TimeOfDay int
+
+const EndOfDay TimeOfDay = 24 * 60
+
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	var h, m int
+	if len(s) != len("15:04") || s[2] != ':' {
+		return 0, fmt.Errorf("time of day %q is not HH:MM: %w", s, ErrInvalidArgument)
+	}
+
+	if _, err := fmt.Sscanf(s, "%02d:%02d", &h, &m); err != nil {
+		return 0, fmt.Errorf("time of day %q is not HH:MM: %w", s, ErrInvalidArgument)
+	}
+
+	t := TimeOfDay(h*60 + m)
+	if h < 0 || m < 0 || m > 59 || t > EndOfDay {
+		return 0, fmt.Errorf("time of day %q is out of range: %w", s, ErrInvalidArgument)
+	}
+
+	return t, nil
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// On returns the instant of t on the given date in loc. Times skipped by a DST change are moved forward.
+func (t TimeOfDay) On(year int, month time.Month, day int, loc *time.Location) time.Time {
+	return time.Date(year, month, day, int(t)/60, int(t)%60, 0, 0, loc)
+}
+
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = parsed
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package entity_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    entity.TimeOfDay
+		wantErr error
+	}{
+		{in: "00:00", want: 0},
+		{in: "09:30", want: 570},
+		{in: "24:00", want: entity.EndOfDay},
+		{in: "24:01", wantErr: entity.ErrInvalidArgument},
+		{in: "12:60", wantErr: entity.ErrInvalidArgument},
+		{in: "9:30", wantErr: entity.ErrInvalidArgument},
+		{in: "ab:cd", wantErr: entity.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := entity.ParseTimeOfDay(tt.in)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTimeOfDay_JSON(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal([]entity.TimeOfDay{570, entity.EndOfDay})
+	require.NoError(t, err)
+	require.JSONEq(t, `["09:30", "24:00"]`, string(raw))
+
+	var got []entity.TimeOfDay
+	require.NoError(t, json.Unmarshal(raw, &got))
+	require.Equal(t, []entity.TimeOfDay{570, entity.EndOfDay}, got)
+}
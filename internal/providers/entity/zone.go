@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Zone is an area a provider delivers to.
+type Zone struct {
+	ProviderID ProviderID `db:"provider_id"`
+	ZoneID     ZoneID     `db:"zone_id"`
+	Name       string     `db:"name"`
+	Geometry   Geometry   `db:"geometry"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	// Version is incremented on every change and guards concurrent updates.
+	Version int64 `db:"version"`
+}
+
+// ZoneID is unique among the zones of a provider.
+type ZoneID string
+
+// ETag renders the zone version as a strong HTTP entity tag.
+func (z *Zone) ETag() string {
+	return strconv.Quote(strconv.FormatInt(z.Version, 10))
+}
+
+// ZoneField is a path of an updatable zone field as used in update masks.
+type ZoneField string
+
+const (
+	ZoneFieldName     ZoneField = "name"
+	ZoneFieldGeometry ZoneField = "geometry"
+)
+
+// ZoneMask lists the fields an update is allowed to touch.
+type ZoneMask []ZoneField
+
+type ZoneListParams struct {
+	ProviderID ProviderID
+	PageSize   int
+	PageToken  string
+}
+
+// ZoneQuery is a validated zone listing request, zones are ordered by ID.
+type ZoneQuery struct {
+	ProviderID ProviderID
+	// After is the ID of the last zone of the previous page.
+	After ZoneID
+	Limit uint64
+}
+
+type ZonePage struct {
+	Zones []*Zone
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+type GeometryType string
+
+const (
+	GeometryPolygon      GeometryType = "Polygon"
+	GeometryMultiPolygon GeometryType = "MultiPolygon"
+)
+
+// Position is a longitude and a latitude in degrees, in this order as in GeoJSON.
+type Position [2]float64
+
+func (p Position) Lon() float64 { return p[0] }
+
+func (p Position) Lat() float64 { return p[1] }
+
+// Ring is a closed line, the first and the last positions are equal.
+type Ring []Position
+
+// Polygon is an exterior ring followed by the rings of its holes.
+type Polygon []Ring
+
+// Geometry is a GeoJSON (RFC 7946) Polygon or MultiPolygon. A Polygon holds exactly one element of Polygons.
+type Geometry struct {
+	Type     GeometryType
+	Polygons []Polygon
+}
+
+type geoJSON struct {
+	Type        GeometryType    `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+func (g Geometry) MarshalJSON() ([]byte, error) {
+	var coordinates any = g.Polygons
+
+	if g.Type == GeometryPolygon && len(g.Polygons) == 1 {
+		coordinates = g.Polygons[0]
+	}
+
+	raw, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(geoJSON{Type: g.Type, Coordinates: raw})
+}
+
+// UnmarshalJSON accepts positions with an altitude and drops it, the shape of the rings is left to validation.
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	var raw geoJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("geometry is not a GeoJSON object: %w", ErrInvalidArgument)
+	}
+
+	var polygons [][][][]float64
+
+	switch raw.Type {
+	case GeometryPolygon:
+		var polygon [][][]float64
+		if err := json.Unmarshal(raw.Coordinates, &polygon); err != nil {
+			return fmt.Errorf("coordinates of a Polygon are not an array of rings: %w", ErrInvalidArgument)
+		}
+
+		polygons = [][][][]float64{polygon}
+	case GeometryMultiPolygon:
+		if err := json.Unmarshal(raw.Coordinates, &polygons); err != nil {
+			return fmt.Errorf("coordinates of a MultiPolygon are not an array of polygons: %w", ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("geometry type %q, want Polygon or MultiPolygon: %w", raw.Type, ErrInvalidArgument)
+	}
+
+	g.Type = raw.Type
+	g.Polygons = make([]Polygon, len(polygons))
+
+	for i, polygon := range polygons {
+		g.Polygons[i] = make(Polygon, len(polygon))
+
+		for j, ring := range polygon {
+			g.Polygons[i][j] = make(Ring, len(ring))
+
+			for k, position := range ring {
+				if len(position) != 2 && len(position) != 3 {
+					return fmt.Errorf("position has %d elements, want longitude, latitude and optional altitude: %w", len(position), ErrInvalidArgument)
+				}
+
+				g.Polygons[i][j][k] = Position{position[0], position[1]}
+			}
+		}
+	}
+
+	return nil
+}
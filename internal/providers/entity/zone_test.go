@@ -0,0 +1,70 @@
+package entity_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometry_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		geoJSON string
+		want    entity.Geometry
+		wantErr bool
+	}{
+		{
+			name:    "polygon",
+			geoJSON: `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`,
+			want: entity.Geometry{Type: entity.GeometryPolygon, Polygons: []entity.Polygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			}},
+		},
+		{
+			name:    "multipolygon",
+			geoJSON: `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[2,2],[3,2],[3,3],[2,2]]]]}`,
+			want: entity.Geometry{Type: entity.GeometryMultiPolygon, Polygons: []entity.Polygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{2, 2}, {3, 2}, {3, 3}, {2, 2}}},
+			}},
+		},
+		{name: "unsupported type", geoJSON: `{"type":"Point","coordinates":[0,0]}`, wantErr: true},
+		{name: "coordinates of another type", geoJSON: `{"type":"MultiPolygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, wantErr: true},
+		{name: "position without latitude", geoJSON: `{"type":"Polygon","coordinates":[[[0],[1,0],[1,1],[0]]]}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var g entity.Geometry
+
+			err := json.Unmarshal([]byte(tt.geoJSON), &g)
+			if tt.wantErr {
+				require.ErrorIs(t, err, entity.ErrInvalidArgument)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, g)
+
+			raw, err := json.Marshal(g)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.geoJSON, string(raw))
+		})
+	}
+}
+
+func TestGeometry_UnmarshalDropsAltitude(t *testing.T) {
+	t.Parallel()
+
+	var g entity.Geometry
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"Polygon","coordinates":[[[0,0,10],[1,0,10],[1,1,10],[0,0,10]]]}`), &g))
+	require.Equal(t, entity.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, g.Polygons[0][0])
+}
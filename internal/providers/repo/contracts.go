@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 )
@@ -11,8 +12,131 @@ import (
 type (
 	ProviderRepo interface {
 		Store(context.Context, *entity.Provider) error
-		GetAll(context.Context) ([]*entity.Provider, error)
-		Update(context.Context, entity.ProviderID, *entity.Provider) (*entity.Provider, error)
+		GetByID(context.Context, entity.ProviderID) (*entity.Provider, error)
+		// GetForUpdate locks the provider row when called inside Transactor.InTx.
+		GetForUpdate(context.Context, entity.ProviderID) (*entity.Provider, error)
+		GetAll(context.Context, entity.ProviderQuery) ([]*entity.Provider, error)
+		// Each calls fn for every provider matching the filter, ordered by ID, while reading them from the database.
+		Each(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error
+		// Search returns active and suspended alike, archived providers are never found.
+		Search(context.Context, entity.ProviderSearchQuery) ([]*entity.ProviderSearchHit, error)
+		// Update and Archive match the stored version too when it is non-zero.
+		Update(context.Context, entity.ProviderID, *entity.Provider, entity.ProviderMask) (*entity.Provider, error)
+		Archive(context.Context, entity.ProviderID, int64) error
+		Restore(context.Context, entity.ProviderID) (*entity.Provider, error)
+		Purge(context.Context, entity.ProviderID) error
+		UpdateStatus(ctx context.Context, id entity.ProviderID, status entity.ProviderStatus, reason string) (*entity.Provider, error)
+	}
+
+	ZoneRepo interface {
+		Store(context.Context, *entity.Zone) error
+		GetByID(context.Context, entity.ProviderID, entity.ZoneID) (*entity.Zone, error)
+		GetAll(context.Context, entity.ZoneQuery) ([]*entity.Zone, error)
+		// Update and Delete match the stored version too when it is non-zero.
+		Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, z *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error)
+		Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error
+	}
+
+	PickupPointRepo interface {
+		// Store fills in the timestamps and version of the point.
+		Store(context.Context, *entity.PickupPoint) error
+		GetByID(context.Context, entity.ProviderID, entity.PickupPointID) (*entity.PickupPoint, error)
+		GetAll(context.Context, entity.PickupPointQuery) ([]*entity.PickupPoint, error)
+		// Update and Delete match the stored version too when it is non-zero.
+		Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, p *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error)
+		Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error
+	}
+
+	TariffRepo interface {
+		// Store fills in the timestamps of the tariff.
+		Store(context.Context, *entity.Tariff) error
+		GetByID(context.Context, entity.ProviderID, entity.TariffID) (*entity.Tariff, error)
+		// GetForUpdate locks the tariff, revisions are scheduled under the lock.
+		GetForUpdate(context.Context, entity.ProviderID, entity.TariffID) (*entity.Tariff, error)
+		GetAll(context.Context, entity.TariffQuery) ([]*entity.Tariff, error)
+		Delete(context.Context, entity.ProviderID, entity.TariffID) error
+		// StoreRevision fills in the timestamp of the revision.
+		StoreRevision(context.Context, *entity.TariffRevision) error
+		// EndRevision sets the end of an open-ended revision.
+		EndRevision(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID, revision int, effectiveTo time.Time) error
+		// GetRevisions returns every revision of the tariff ordered by number.
+		GetRevisions(context.Context, entity.ProviderID, entity.TariffID) ([]*entity.TariffRevision, error)
+		// GetRevisionsAt returns for each of the tariffs the revision in effect at the time or, before it starts, the next one.
+		GetRevisionsAt(ctx context.Context, providerID entity.ProviderID, tariffIDs []entity.TariffID, at time.Time) ([]*entity.TariffRevision, error)
+	}
+
+	CalendarRepo interface {
+		// Store fills in the timestamps of the calendar.
+		Store(context.Context, *entity.Calendar) error
+		GetByID(context.Context, entity.ProviderID) (*entity.Calendar, error)
+		// GetForUpdate locks the calendar, holidays are imported under the lock.
+		GetForUpdate(context.Context, entity.ProviderID) (*entity.Calendar, error)
+		Update(context.Context, *entity.Calendar) (*entity.Calendar, error)
 		Delete(context.Context, entity.ProviderID) error
 	}
+
+	SlotRepo interface {
+		// Store fills in the generated ID and timestamps of the slot.
+		Store(context.Context, *entity.Slot) error
+		GetByID(context.Context, entity.ProviderID, entity.SlotID) (*entity.Slot, error)
+		GetAll(context.Context, entity.SlotQuery) ([]*entity.Slot, error)
+		// GetAvailable returns open slots of active providers with capacity left, ordered by start.
+		GetAvailable(context.Context, entity.AvailabilityParams) ([]*entity.Slot, error)
+		// Close stops bookings of an open slot, a closed one is returned as is.
+		Close(context.Context, entity.ProviderID, entity.SlotID) (*entity.Slot, error)
+		// GetForUpdate locks the slot row when called inside Transactor.InTx.
+		GetForUpdate(context.Context, entity.ProviderID, entity.SlotID) (*entity.Slot, error)
+		// AddBooked changes the booked count by delta, going over capacity is entity.ErrSlotUnavailable.
+		AddBooked(ctx context.Context, id entity.SlotID, delta int) error
+		// StoreGenerated stores the slots whose window does not exist yet and returns how many were added.
+		StoreGenerated(context.Context, []*entity.Slot) (int64, error)
+	}
+
+	SlotTemplateRepo interface {
+		// Store fills in the timestamps of the template.
+		Store(context.Context, *entity.SlotTemplate) error
+		GetByID(context.Context, entity.ProviderID, entity.SlotTemplateID) (*entity.SlotTemplate, error)
+		GetAll(context.Context, entity.SlotTemplateQuery) ([]*entity.SlotTemplate, error)
+		// GetActive returns the active templates of active providers.
+		GetActive(context.Context) ([]*entity.SlotTemplate, error)
+		// SetActive activates or deactivates the template, activating an active one keeps its activation time.
+		SetActive(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, active bool) (*entity.SlotTemplate, error)
+		Delete(context.Context, entity.ProviderID, entity.SlotTemplateID) error
+	}
+
+	SlotHoldRepo interface {
+		// Store fills in the generated ID and timestamps of the hold.
+		Store(context.Context, *entity.SlotHold) error
+		// GetForUpdate locks the hold row when called inside Transactor.InTx.
+		GetForUpdate(context.Context, entity.ProviderID, entity.HoldID) (*entity.SlotHold, error)
+		UpdateStatus(context.Context, entity.HoldID, entity.HoldStatus) (*entity.SlotHold, error)
+		// LockExpired locks up to limit held holds expiring before the given time, skipping holds locked by others.
+		LockExpired(ctx context.Context, before time.Time, limit uint64) ([]*entity.SlotHold, error)
+		// Expire marks the given holds expired.
+		Expire(context.Context, []entity.HoldID) error
+	}
+
+	CoverageRepo interface {
+		// Revision changes whenever the zones GetAreas returns change.
+		Revision(context.Context) (int64, error)
+		// GetAreas returns the zones of active providers.
+		GetAreas(context.Context) ([]*entity.CoverageArea, error)
+	}
+
+	PickupPointIndexRepo interface {
+		// Revision changes whenever the pickup points GetActive returns change.
+		Revision(context.Context) (int64, error)
+		// GetActive returns the pickup points of active providers.
+		GetActive(context.Context) ([]*entity.PickupPoint, error)
+	}
+
+	AuditRepo interface {
+		Append(context.Context, *entity.AuditEntry) error
+		GetHistory(context.Context, entity.AuditQuery) ([]*entity.AuditEntry, error)
+	}
+
+	// Transactor runs fn in a transaction, repositories called with the context given to fn take part in it.
+	Transactor interface {
+		InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}
 )
@@ -12,6 +12,7 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/classydevv/fulfillment/internal/providers/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -41,33 +42,121 @@ func (m *MockProviderRepo) EXPECT() *MockProviderRepoMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockProviderRepo) Delete(arg0 context.Context, arg1 entity.ProviderID) error {
+// Archive mocks base method.
+func (m *MockProviderRepo) Archive(arg0 context.Context, arg1 entity.ProviderID, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockProviderRepoMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+// Archive indicates an expected call of Archive.
+func (mr *MockProviderRepoMockRecorder) Archive(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockProviderRepo)(nil).Archive), arg0, arg1, arg2)
+}
+
+// Each mocks base method.
+func (m *MockProviderRepo) Each(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Each", ctx, f, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Each indicates an expected call of Each.
+func (mr *MockProviderRepoMockRecorder) Each(ctx, f, fn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProviderRepo)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Each", reflect.TypeOf((*MockProviderRepo)(nil).Each), ctx, f, fn)
 }
 
 // GetAll mocks base method.
-func (m *MockProviderRepo) GetAll(arg0 context.Context) ([]*entity.Provider, error) {
+func (m *MockProviderRepo) GetAll(arg0 context.Context, arg1 entity.ProviderQuery) ([]*entity.Provider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", arg0)
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
 	ret0, _ := ret[0].([]*entity.Provider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockProviderRepoMockRecorder) GetAll(arg0 any) *gomock.Call {
+func (mr *MockProviderRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProviderRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockProviderRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProviderRepoMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProviderRepo)(nil).GetByID), arg0, arg1)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockProviderRepo) GetForUpdate(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockProviderRepoMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockProviderRepo)(nil).GetForUpdate), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockProviderRepo) Purge(arg0 context.Context, arg1 entity.ProviderID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockProviderRepoMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockProviderRepo)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockProviderRepo) Restore(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProviderRepoMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProviderRepo)(nil).Restore), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockProviderRepo) Search(arg0 context.Context, arg1 entity.ProviderSearchQuery) ([]*entity.ProviderSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ProviderSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockProviderRepoMockRecorder) Search(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProviderRepo)(nil).GetAll), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockProviderRepo)(nil).Search), arg0, arg1)
 }
 
 // Store mocks base method.
@@ -85,16 +174,1027 @@ func (mr *MockProviderRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
 }
 
 // Update mocks base method.
-func (m *MockProviderRepo) Update(arg0 context.Context, arg1 entity.ProviderID, arg2 *entity.Provider) (*entity.Provider, error) {
+func (m *MockProviderRepo) Update(arg0 context.Context, arg1 entity.ProviderID, arg2 *entity.Provider, arg3 entity.ProviderMask) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProviderRepoMockRecorder) Update(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProviderRepo)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockProviderRepo) UpdateStatus(ctx context.Context, id entity.ProviderID, status entity.ProviderStatus, reason string) (*entity.Provider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, reason)
 	ret0, _ := ret[0].(*entity.Provider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockProviderRepoMockRecorder) UpdateStatus(ctx, id, status, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockProviderRepo)(nil).UpdateStatus), ctx, id, status, reason)
+}
+
+// MockZoneRepo is a mock of ZoneRepo interface.
+type MockZoneRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockZoneRepoMockRecorder is the mock recorder for MockZoneRepo.
+type MockZoneRepoMockRecorder struct {
+	mock *MockZoneRepo
+}
+
+// NewMockZoneRepo creates a new mock instance.
+func NewMockZoneRepo(ctrl *gomock.Controller) *MockZoneRepo {
+	mock := &MockZoneRepo{ctrl: ctrl}
+	mock.recorder = &MockZoneRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZoneRepo) EXPECT() *MockZoneRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockZoneRepo) Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, providerID, zoneID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockZoneRepoMockRecorder) Delete(ctx, providerID, zoneID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockZoneRepo)(nil).Delete), ctx, providerID, zoneID, version)
+}
+
+// GetAll mocks base method.
+func (m *MockZoneRepo) GetAll(arg0 context.Context, arg1 entity.ZoneQuery) ([]*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockZoneRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockZoneRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockZoneRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.ZoneID) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockZoneRepoMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockZoneRepo)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// Store mocks base method.
+func (m *MockZoneRepo) Store(arg0 context.Context, arg1 *entity.Zone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockZoneRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockZoneRepo)(nil).Store), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockZoneRepo) Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, z *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, providerID, zoneID, z, mask)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockZoneRepoMockRecorder) Update(ctx, providerID, zoneID, z, mask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockZoneRepo)(nil).Update), ctx, providerID, zoneID, z, mask)
+}
+
+// MockPickupPointRepo is a mock of PickupPointRepo interface.
+type MockPickupPointRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupPointRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupPointRepoMockRecorder is the mock recorder for MockPickupPointRepo.
+type MockPickupPointRepoMockRecorder struct {
+	mock *MockPickupPointRepo
+}
+
+// NewMockPickupPointRepo creates a new mock instance.
+func NewMockPickupPointRepo(ctrl *gomock.Controller) *MockPickupPointRepo {
+	mock := &MockPickupPointRepo{ctrl: ctrl}
+	mock.recorder = &MockPickupPointRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupPointRepo) EXPECT() *MockPickupPointRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockPickupPointRepo) Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, providerID, pointID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPickupPointRepoMockRecorder) Delete(ctx, providerID, pointID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPickupPointRepo)(nil).Delete), ctx, providerID, pointID, version)
+}
+
+// GetAll mocks base method.
+func (m *MockPickupPointRepo) GetAll(arg0 context.Context, arg1 entity.PickupPointQuery) ([]*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPickupPointRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPickupPointRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockPickupPointRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.PickupPointID) (*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPickupPointRepoMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPickupPointRepo)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// Store mocks base method.
+func (m *MockPickupPointRepo) Store(arg0 context.Context, arg1 *entity.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockPickupPointRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockPickupPointRepo)(nil).Store), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockPickupPointRepo) Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, p *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, providerID, pointID, p, mask)
+	ret0, _ := ret[0].(*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Update indicates an expected call of Update.
-func (mr *MockProviderRepoMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockPickupPointRepoMockRecorder) Update(ctx, providerID, pointID, p, mask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPickupPointRepo)(nil).Update), ctx, providerID, pointID, p, mask)
+}
+
+// MockTariffRepo is a mock of TariffRepo interface.
+type MockTariffRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTariffRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockTariffRepoMockRecorder is the mock recorder for MockTariffRepo.
+type MockTariffRepoMockRecorder struct {
+	mock *MockTariffRepo
+}
+
+// NewMockTariffRepo creates a new mock instance.
+func NewMockTariffRepo(ctrl *gomock.Controller) *MockTariffRepo {
+	mock := &MockTariffRepo{ctrl: ctrl}
+	mock.recorder = &MockTariffRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTariffRepo) EXPECT() *MockTariffRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTariffRepo) Delete(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTariffRepoMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTariffRepo)(nil).Delete), arg0, arg1, arg2)
+}
+
+// EndRevision mocks base method.
+func (m *MockTariffRepo) EndRevision(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID, revision int, effectiveTo time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndRevision", ctx, providerID, tariffID, revision, effectiveTo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndRevision indicates an expected call of EndRevision.
+func (mr *MockTariffRepoMockRecorder) EndRevision(ctx, providerID, tariffID, revision, effectiveTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndRevision", reflect.TypeOf((*MockTariffRepo)(nil).EndRevision), ctx, providerID, tariffID, revision, effectiveTo)
+}
+
+// GetAll mocks base method.
+func (m *MockTariffRepo) GetAll(arg0 context.Context, arg1 entity.TariffQuery) ([]*entity.Tariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Tariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTariffRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTariffRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockTariffRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) (*entity.Tariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Tariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTariffRepoMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTariffRepo)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockTariffRepo) GetForUpdate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) (*entity.Tariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Tariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockTariffRepoMockRecorder) GetForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockTariffRepo)(nil).GetForUpdate), arg0, arg1, arg2)
+}
+
+// GetRevisions mocks base method.
+func (m *MockTariffRepo) GetRevisions(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) ([]*entity.TariffRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.TariffRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockTariffRepoMockRecorder) GetRevisions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockTariffRepo)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
+// GetRevisionsAt mocks base method.
+func (m *MockTariffRepo) GetRevisionsAt(ctx context.Context, providerID entity.ProviderID, tariffIDs []entity.TariffID, at time.Time) ([]*entity.TariffRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionsAt", ctx, providerID, tariffIDs, at)
+	ret0, _ := ret[0].([]*entity.TariffRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionsAt indicates an expected call of GetRevisionsAt.
+func (mr *MockTariffRepoMockRecorder) GetRevisionsAt(ctx, providerID, tariffIDs, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionsAt", reflect.TypeOf((*MockTariffRepo)(nil).GetRevisionsAt), ctx, providerID, tariffIDs, at)
+}
+
+// Store mocks base method.
+func (m *MockTariffRepo) Store(arg0 context.Context, arg1 *entity.Tariff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockTariffRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockTariffRepo)(nil).Store), arg0, arg1)
+}
+
+// StoreRevision mocks base method.
+func (m *MockTariffRepo) StoreRevision(arg0 context.Context, arg1 *entity.TariffRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRevision indicates an expected call of StoreRevision.
+func (mr *MockTariffRepoMockRecorder) StoreRevision(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRevision", reflect.TypeOf((*MockTariffRepo)(nil).StoreRevision), arg0, arg1)
+}
+
+// MockCalendarRepo is a mock of CalendarRepo interface.
+type MockCalendarRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarRepoMockRecorder is the mock recorder for MockCalendarRepo.
+type MockCalendarRepoMockRecorder struct {
+	mock *MockCalendarRepo
+}
+
+// NewMockCalendarRepo creates a new mock instance.
+func NewMockCalendarRepo(ctrl *gomock.Controller) *MockCalendarRepo {
+	mock := &MockCalendarRepo{ctrl: ctrl}
+	mock.recorder = &MockCalendarRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarRepo) EXPECT() *MockCalendarRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCalendarRepo) Delete(arg0 context.Context, arg1 entity.ProviderID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCalendarRepoMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCalendarRepo)(nil).Delete), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockCalendarRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCalendarRepoMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCalendarRepo)(nil).GetByID), arg0, arg1)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockCalendarRepo) GetForUpdate(arg0 context.Context, arg1 entity.ProviderID) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockCalendarRepoMockRecorder) GetForUpdate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockCalendarRepo)(nil).GetForUpdate), arg0, arg1)
+}
+
+// Store mocks base method.
+func (m *MockCalendarRepo) Store(arg0 context.Context, arg1 *entity.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockCalendarRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockCalendarRepo)(nil).Store), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockCalendarRepo) Update(arg0 context.Context, arg1 *entity.Calendar) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCalendarRepoMockRecorder) Update(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCalendarRepo)(nil).Update), arg0, arg1)
+}
+
+// MockSlotRepo is a mock of SlotRepo interface.
+type MockSlotRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotRepoMockRecorder is the mock recorder for MockSlotRepo.
+type MockSlotRepoMockRecorder struct {
+	mock *MockSlotRepo
+}
+
+// NewMockSlotRepo creates a new mock instance.
+func NewMockSlotRepo(ctrl *gomock.Controller) *MockSlotRepo {
+	mock := &MockSlotRepo{ctrl: ctrl}
+	mock.recorder = &MockSlotRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotRepo) EXPECT() *MockSlotRepoMockRecorder {
+	return m.recorder
+}
+
+// AddBooked mocks base method.
+func (m *MockSlotRepo) AddBooked(ctx context.Context, id entity.SlotID, delta int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBooked", ctx, id, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBooked indicates an expected call of AddBooked.
+func (mr *MockSlotRepoMockRecorder) AddBooked(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBooked", reflect.TypeOf((*MockSlotRepo)(nil).AddBooked), ctx, id, delta)
+}
+
+// Close mocks base method.
+func (m *MockSlotRepo) Close(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotID) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSlotRepoMockRecorder) Close(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSlotRepo)(nil).Close), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockSlotRepo) GetAll(arg0 context.Context, arg1 entity.SlotQuery) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSlotRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSlotRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetAvailable mocks base method.
+func (m *MockSlotRepo) GetAvailable(arg0 context.Context, arg1 entity.AvailabilityParams) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailable indicates an expected call of GetAvailable.
+func (mr *MockSlotRepoMockRecorder) GetAvailable(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockSlotRepo)(nil).GetAvailable), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockSlotRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotID) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSlotRepoMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlotRepo)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockSlotRepo) GetForUpdate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotID) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockSlotRepoMockRecorder) GetForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockSlotRepo)(nil).GetForUpdate), arg0, arg1, arg2)
+}
+
+// Store mocks base method.
+func (m *MockSlotRepo) Store(arg0 context.Context, arg1 *entity.Slot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockSlotRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockSlotRepo)(nil).Store), arg0, arg1)
+}
+
+// StoreGenerated mocks base method.
+func (m *MockSlotRepo) StoreGenerated(arg0 context.Context, arg1 []*entity.Slot) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreGenerated", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreGenerated indicates an expected call of StoreGenerated.
+func (mr *MockSlotRepoMockRecorder) StoreGenerated(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreGenerated", reflect.TypeOf((*MockSlotRepo)(nil).StoreGenerated), arg0, arg1)
+}
+
+// MockSlotTemplateRepo is a mock of SlotTemplateRepo interface.
+type MockSlotTemplateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotTemplateRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotTemplateRepoMockRecorder is the mock recorder for MockSlotTemplateRepo.
+type MockSlotTemplateRepoMockRecorder struct {
+	mock *MockSlotTemplateRepo
+}
+
+// NewMockSlotTemplateRepo creates a new mock instance.
+func NewMockSlotTemplateRepo(ctrl *gomock.Controller) *MockSlotTemplateRepo {
+	mock := &MockSlotTemplateRepo{ctrl: ctrl}
+	mock.recorder = &MockSlotTemplateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotTemplateRepo) EXPECT() *MockSlotTemplateRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSlotTemplateRepo) Delete(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSlotTemplateRepoMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSlotTemplateRepo)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetActive mocks base method.
+func (m *MockSlotTemplateRepo) GetActive(arg0 context.Context) ([]*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", arg0)
+	ret0, _ := ret[0].([]*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockSlotTemplateRepoMockRecorder) GetActive(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockSlotTemplateRepo)(nil).GetActive), arg0)
+}
+
+// GetAll mocks base method.
+func (m *MockSlotTemplateRepo) GetAll(arg0 context.Context, arg1 entity.SlotTemplateQuery) ([]*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSlotTemplateRepoMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSlotTemplateRepo)(nil).GetAll), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockSlotTemplateRepo) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSlotTemplateRepoMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlotTemplateRepo)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// SetActive mocks base method.
+func (m *MockSlotTemplateRepo) SetActive(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, active bool) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, providerID, templateID, active)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockSlotTemplateRepoMockRecorder) SetActive(ctx, providerID, templateID, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockSlotTemplateRepo)(nil).SetActive), ctx, providerID, templateID, active)
+}
+
+// Store mocks base method.
+func (m *MockSlotTemplateRepo) Store(arg0 context.Context, arg1 *entity.SlotTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockSlotTemplateRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockSlotTemplateRepo)(nil).Store), arg0, arg1)
+}
+
+// MockSlotHoldRepo is a mock of SlotHoldRepo interface.
+type MockSlotHoldRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotHoldRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotHoldRepoMockRecorder is the mock recorder for MockSlotHoldRepo.
+type MockSlotHoldRepoMockRecorder struct {
+	mock *MockSlotHoldRepo
+}
+
+// NewMockSlotHoldRepo creates a new mock instance.
+func NewMockSlotHoldRepo(ctrl *gomock.Controller) *MockSlotHoldRepo {
+	mock := &MockSlotHoldRepo{ctrl: ctrl}
+	mock.recorder = &MockSlotHoldRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotHoldRepo) EXPECT() *MockSlotHoldRepoMockRecorder {
+	return m.recorder
+}
+
+// Expire mocks base method.
+func (m *MockSlotHoldRepo) Expire(arg0 context.Context, arg1 []entity.HoldID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockSlotHoldRepoMockRecorder) Expire(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockSlotHoldRepo)(nil).Expire), arg0, arg1)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockSlotHoldRepo) GetForUpdate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.HoldID) (*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockSlotHoldRepoMockRecorder) GetForUpdate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockSlotHoldRepo)(nil).GetForUpdate), arg0, arg1, arg2)
+}
+
+// LockExpired mocks base method.
+func (m *MockSlotHoldRepo) LockExpired(ctx context.Context, before time.Time, limit uint64) ([]*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockExpired", ctx, before, limit)
+	ret0, _ := ret[0].([]*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockExpired indicates an expected call of LockExpired.
+func (mr *MockSlotHoldRepoMockRecorder) LockExpired(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockExpired", reflect.TypeOf((*MockSlotHoldRepo)(nil).LockExpired), ctx, before, limit)
+}
+
+// Store mocks base method.
+func (m *MockSlotHoldRepo) Store(arg0 context.Context, arg1 *entity.SlotHold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockSlotHoldRepoMockRecorder) Store(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockSlotHoldRepo)(nil).Store), arg0, arg1)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockSlotHoldRepo) UpdateStatus(arg0 context.Context, arg1 entity.HoldID, arg2 entity.HoldStatus) (*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockSlotHoldRepoMockRecorder) UpdateStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockSlotHoldRepo)(nil).UpdateStatus), arg0, arg1, arg2)
+}
+
+// MockCoverageRepo is a mock of CoverageRepo interface.
+type MockCoverageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoverageRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockCoverageRepoMockRecorder is the mock recorder for MockCoverageRepo.
+type MockCoverageRepoMockRecorder struct {
+	mock *MockCoverageRepo
+}
+
+// NewMockCoverageRepo creates a new mock instance.
+func NewMockCoverageRepo(ctrl *gomock.Controller) *MockCoverageRepo {
+	mock := &MockCoverageRepo{ctrl: ctrl}
+	mock.recorder = &MockCoverageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoverageRepo) EXPECT() *MockCoverageRepoMockRecorder {
+	return m.recorder
+}
+
+// GetAreas mocks base method.
+func (m *MockCoverageRepo) GetAreas(arg0 context.Context) ([]*entity.CoverageArea, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAreas", arg0)
+	ret0, _ := ret[0].([]*entity.CoverageArea)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAreas indicates an expected call of GetAreas.
+func (mr *MockCoverageRepoMockRecorder) GetAreas(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAreas", reflect.TypeOf((*MockCoverageRepo)(nil).GetAreas), arg0)
+}
+
+// Revision mocks base method.
+func (m *MockCoverageRepo) Revision(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockCoverageRepoMockRecorder) Revision(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockCoverageRepo)(nil).Revision), arg0)
+}
+
+// MockPickupPointIndexRepo is a mock of PickupPointIndexRepo interface.
+type MockPickupPointIndexRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupPointIndexRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupPointIndexRepoMockRecorder is the mock recorder for MockPickupPointIndexRepo.
+type MockPickupPointIndexRepoMockRecorder struct {
+	mock *MockPickupPointIndexRepo
+}
+
+// NewMockPickupPointIndexRepo creates a new mock instance.
+func NewMockPickupPointIndexRepo(ctrl *gomock.Controller) *MockPickupPointIndexRepo {
+	mock := &MockPickupPointIndexRepo{ctrl: ctrl}
+	mock.recorder = &MockPickupPointIndexRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupPointIndexRepo) EXPECT() *MockPickupPointIndexRepoMockRecorder {
+	return m.recorder
+}
+
+// GetActive mocks base method.
+func (m *MockPickupPointIndexRepo) GetActive(arg0 context.Context) ([]*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", arg0)
+	ret0, _ := ret[0].([]*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockPickupPointIndexRepoMockRecorder) GetActive(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockPickupPointIndexRepo)(nil).GetActive), arg0)
+}
+
+// Revision mocks base method.
+func (m *MockPickupPointIndexRepo) Revision(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockPickupPointIndexRepoMockRecorder) Revision(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockPickupPointIndexRepo)(nil).Revision), arg0)
+}
+
+// MockAuditRepo is a mock of AuditRepo interface.
+type MockAuditRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditRepoMockRecorder is the mock recorder for MockAuditRepo.
+type MockAuditRepoMockRecorder struct {
+	mock *MockAuditRepo
+}
+
+// NewMockAuditRepo creates a new mock instance.
+func NewMockAuditRepo(ctrl *gomock.Controller) *MockAuditRepo {
+	mock := &MockAuditRepo{ctrl: ctrl}
+	mock.recorder = &MockAuditRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepo) EXPECT() *MockAuditRepoMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockAuditRepo) Append(arg0 context.Context, arg1 *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockAuditRepoMockRecorder) Append(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockAuditRepo)(nil).Append), arg0, arg1)
+}
+
+// GetHistory mocks base method.
+func (m *MockAuditRepo) GetHistory(arg0 context.Context, arg1 entity.AuditQuery) ([]*entity.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockAuditRepoMockRecorder) GetHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockAuditRepo)(nil).GetHistory), arg0, arg1)
+}
+
+// MockTransactor is a mock of Transactor interface.
+type MockTransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactorMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactorMockRecorder is the mock recorder for MockTransactor.
+type MockTransactorMockRecorder struct {
+	mock *MockTransactor
+}
+
+// NewMockTransactor creates a new mock instance.
+func NewMockTransactor(ctrl *gomock.Controller) *MockTransactor {
+	mock := &MockTransactor{ctrl: ctrl}
+	mock.recorder = &MockTransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactor) EXPECT() *MockTransactorMockRecorder {
+	return m.recorder
+}
+
+// InTx mocks base method.
+func (m *MockTransactor) InTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTx indicates an expected call of InTx.
+func (mr *MockTransactorMockRecorder) InTx(ctx, fn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProviderRepo)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTx", reflect.TypeOf((*MockTransactor)(nil).InTx), ctx, fn)
 }
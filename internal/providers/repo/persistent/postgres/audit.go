@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type AuditRepo struct {
+	*postgres.Postgres
+}
+
+func NewAuditRepo(pg *postgres.Postgres) *AuditRepo {
+	return &AuditRepo{pg}
+}
+
+func (pg *AuditRepo) Append(ctx context.Context, e *entity.AuditEntry) error {
+	query, args, err := pg.Builder.
+		Insert("provider_audit_log").
+		Columns("provider_id, action, old_value, new_value, claimed_actor, request_id").
+		Values(e.ProviderID, e.Action, e.OldValue, e.NewValue, e.ClaimedActor, e.RequestID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("AuditRepo - Append - pg.Builder: %w", err)
+	}
+
+	_, err = pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("AuditRepo - Append - pg.Conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (pg *AuditRepo) GetHistory(ctx context.Context, q entity.AuditQuery) ([]*entity.AuditEntry, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("provider_audit_log").
+		Where("provider_id = ?", q.ProviderID).
+		OrderBy("audit_id DESC").
+		Limit(q.Limit)
+
+	if q.BeforeID != 0 {
+		builder = builder.Where("audit_id < ?", q.BeforeID)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepo - GetHistory - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepo - GetHistory - pg.Conn.Query: %w", err)
+	}
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.AuditEntry])
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepo - GetHistory - pgx.CollectRows: %w", err)
+	}
+
+	return entries, nil
+}
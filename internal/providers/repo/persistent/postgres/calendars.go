@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CalendarRepo struct {
+	*postgres.Postgres
+}
+
+func NewCalendarRepo(pg *postgres.Postgres) *CalendarRepo {
+	return &CalendarRepo{pg}
+}
+
+func (pg *CalendarRepo) Store(ctx context.Context, c *entity.Calendar) error {
+	query, args, err := pg.Builder.
+		Insert("provider_calendars").
+		Columns("provider_id, time_zone, hours, cut_offs, holidays").
+		Values(c.ProviderID, c.TimeZone, c.Hours, c.CutOffs, c.Holidays).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CalendarRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("CalendarRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Calendar])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("CalendarRepo - Store - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("CalendarRepo - Store - pgx.CollectOneRow: provider %s: %w", c.ProviderID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("CalendarRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	*c = *stored
+
+	return nil
+}
+
+func (pg *CalendarRepo) GetByID(ctx context.Context, providerID entity.ProviderID) (*entity.Calendar, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("provider_calendars").
+		Where("provider_id = ?", providerID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	calendar, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Calendar])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("CalendarRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("CalendarRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// GetForUpdate reads the calendar and locks its row until the surrounding transaction ends.
+func (pg *CalendarRepo) GetForUpdate(ctx context.Context, providerID entity.ProviderID) (*entity.Calendar, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("provider_calendars").
+		Where("provider_id = ?", providerID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - GetForUpdate - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - GetForUpdate - pg.Conn.Query: %w", err)
+	}
+
+	calendar, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Calendar])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("CalendarRepo - GetForUpdate - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("CalendarRepo - GetForUpdate - pgx.CollectOneRow: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// Update replaces everything but the timestamps of the calendar.
+func (pg *CalendarRepo) Update(ctx context.Context, c *entity.Calendar) (*entity.Calendar, error) {
+	query, args, err := pg.Builder.
+		Update("provider_calendars").
+		Set("time_zone", c.TimeZone).
+		Set("hours", c.Hours).
+		Set("cut_offs", c.CutOffs).
+		Set("holidays", c.Holidays).
+		Where("provider_id = ?", c.ProviderID).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - Update - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CalendarRepo - Update - pg.Conn.Query: %w", err)
+	}
+
+	calendar, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Calendar])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("CalendarRepo - Update - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("CalendarRepo - Update - pgx.CollectOneRow: %w", err)
+	}
+
+	return calendar, nil
+}
+
+func (pg *CalendarRepo) Delete(ctx context.Context, providerID entity.ProviderID) error {
+	query, args, err := pg.Builder.
+		Delete("provider_calendars").
+		Where("provider_id = ?", providerID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("CalendarRepo - Delete - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("CalendarRepo - Delete - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("CalendarRepo - Delete - pg.Conn.Exec: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
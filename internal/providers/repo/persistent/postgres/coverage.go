@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type CoverageRepo struct {
+	*postgres.Postgres
+}
+
+func NewCoverageRepo(pg *postgres.Postgres) *CoverageRepo {
+	return &CoverageRepo{pg}
+}
+
+// Revision fingerprints the zones GetAreas returns by their keys and versions. It reads no shared row, so zone
+// and provider writes never queue on each other, and a change that is rolled back or undone leaves it unchanged.
+func (pg *CoverageRepo) Revision(ctx context.Context) (int64, error) {
+	query, args, err := pg.Builder.
+		Select("COALESCE(SUM(hashtext(concat_ws('/', z.provider_id, z.zone_id, z.version, z.updated_at))), 0)").
+		From("zones z").
+		Join("providers p USING (provider_id)").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("CoverageRepo - Revision - pg.Builder: %w", err)
+	}
+
+	var revision int64
+	if err := pg.Conn(ctx).QueryRow(ctx, query, args...).Scan(&revision); err != nil {
+		return 0, fmt.Errorf("CoverageRepo - Revision - pg.Conn.QueryRow: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (pg *CoverageRepo) GetAreas(ctx context.Context) ([]*entity.CoverageArea, error) {
+	query, args, err := pg.Builder.
+		Select("z.provider_id, z.zone_id, z.geometry").
+		From("zones z").
+		Join("providers p USING (provider_id)").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		OrderBy("z.provider_id, z.zone_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("CoverageRepo - GetAreas - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CoverageRepo - GetAreas - pg.Conn.Query: %w", err)
+	}
+
+	areas, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.CoverageArea])
+	if err != nil {
+		return nil, fmt.Errorf("CoverageRepo - GetAreas - pgx.CollectRows: %w", err)
+	}
+
+	return areas, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+// labelRequirement translates a requirement into operators the GIN index on labels supports: "?" for
+// existence and "@>" for values. "??" is how squirrel escapes a literal "?".
+func labelRequirement(r entity.LabelRequirement) squirrel.Sqlizer {
+	switch r.Operator {
+	case entity.LabelOperatorExists:
+		return squirrel.Expr("labels ?? ?", r.Key)
+	case entity.LabelOperatorDoesNotExist:
+		return not{squirrel.Expr("labels ?? ?", r.Key)}
+	case entity.LabelOperatorEquals:
+		return labelHasValue(r.Key, r.Values[0])
+	case entity.LabelOperatorNotEquals:
+		return not{labelHasValue(r.Key, r.Values[0])}
+	case entity.LabelOperatorIn:
+		return labelHasAnyValue(r.Key, r.Values)
+	case entity.LabelOperatorNotIn:
+		return not{labelHasAnyValue(r.Key, r.Values)}
+	default:
+		// The usecase only builds the operators above, match nothing rather than everything.
+		return squirrel.Expr("FALSE")
+	}
+}
+
+func labelHasValue(key, value string) squirrel.Sqlizer {
+	return squirrel.Expr("labels @> ?::jsonb", map[string]string{key: value})
+}
+
+func labelHasAnyValue(key string, values []string) squirrel.Sqlizer {
+	anyOf := make(squirrel.Or, len(values))
+	for i, value := range values {
+		anyOf[i] = labelHasValue(key, value)
+	}
+
+	return anyOf
+}
+
+// not negates a condition. Negated label conditions also match providers without the label.
+type not struct {
+	squirrel.Sqlizer
+}
+
+func (n not) ToSql() (string, []any, error) {
+	sql, args, err := n.Sqlizer.ToSql()
+
+	return "NOT (" + sql + ")", args, err
+}
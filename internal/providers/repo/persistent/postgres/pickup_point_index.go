@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+type PickupPointIndexRepo struct {
+	*postgres.Postgres
+}
+
+func NewPickupPointIndexRepo(pg *postgres.Postgres) *PickupPointIndexRepo {
+	return &PickupPointIndexRepo{pg}
+}
+
+// Revision fingerprints the points GetActive returns by their keys and versions. Like the coverage revision it
+// reads no shared row, so pickup point and provider writes never queue on each other.
+func (pg *PickupPointIndexRepo) Revision(ctx context.Context) (int64, error) {
+	query, args, err := pg.Builder.
+		Select("COALESCE(SUM(hashtext(concat_ws('/', pp.provider_id, pp.point_id, pp.version, pp.updated_at))), 0)").
+		From("pickup_points pp").
+		Join("providers p USING (provider_id)").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("PickupPointIndexRepo - Revision - pg.Builder: %w", err)
+	}
+
+	var revision int64
+	if err := pg.Conn(ctx).QueryRow(ctx, query, args...).Scan(&revision); err != nil {
+		return 0, fmt.Errorf("PickupPointIndexRepo - Revision - pg.Conn.QueryRow: %w", err)
+	}
+
+	return revision, nil
+}
+
+func (pg *PickupPointIndexRepo) GetActive(ctx context.Context) ([]*entity.PickupPoint, error) {
+	query, args, err := pg.Builder.
+		Select("pp.*").
+		From("pickup_points pp").
+		Join("providers p USING (provider_id)").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		OrderBy("pp.provider_id, pp.point_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointIndexRepo - GetActive - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointIndexRepo - GetActive - pg.Conn.Query: %w", err)
+	}
+
+	points, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.PickupPoint])
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointIndexRepo - GetActive - pgx.CollectRows: %w", err)
+	}
+
+	return points, nil
+}
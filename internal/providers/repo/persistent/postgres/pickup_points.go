@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type PickupPointRepo struct {
+	*postgres.Postgres
+}
+
+func NewPickupPointRepo(pg *postgres.Postgres) *PickupPointRepo {
+	return &PickupPointRepo{pg}
+}
+
+func (pg *PickupPointRepo) Store(ctx context.Context, p *entity.PickupPoint) error {
+	query, args, err := pg.Builder.
+		Insert("pickup_points").
+		Columns("provider_id, point_id, name, type, address, lat, lon, opening_hours, payment_options, max_parcel").
+		Values(p.ProviderID, p.PointID, p.Name, p.Type, p.Address, p.Lat, p.Lon, p.OpeningHours, p.PaymentOptions, p.MaxParcel).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PickupPointRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("PickupPointRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.PickupPoint])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("PickupPointRepo - Store - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("PickupPointRepo - Store - pgx.CollectOneRow: provider %s: %w", p.ProviderID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("PickupPointRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	*p = *stored
+
+	return nil
+}
+
+func (pg *PickupPointRepo) GetByID(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID) (*entity.PickupPoint, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("pickup_points").
+		Where("provider_id = ? AND point_id = ?", providerID, pointID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	point, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.PickupPoint])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PickupPointRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("PickupPointRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return point, nil
+}
+
+func (pg *PickupPointRepo) GetAll(ctx context.Context, q entity.PickupPointQuery) ([]*entity.PickupPoint, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("pickup_points").
+		Where("provider_id = ?", q.ProviderID).
+		OrderBy("point_id ASC")
+
+	if q.After != "" {
+		builder = builder.Where("point_id > ?", q.After)
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - GetAll - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - GetAll - pg.Conn.Query: %w", err)
+	}
+
+	points, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.PickupPoint])
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - GetAll - pgx.CollectRows: %w", err)
+	}
+
+	return points, nil
+}
+
+func (pg *PickupPointRepo) Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, p *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error) {
+	builder := pg.Builder.Update("pickup_points")
+
+	for _, field := range mask {
+		switch field {
+		case entity.PickupPointFieldName:
+			builder = builder.Set("name", p.Name)
+		case entity.PickupPointFieldType:
+			builder = builder.Set("type", p.Type)
+		case entity.PickupPointFieldAddress:
+			builder = builder.Set("address", p.Address)
+		case entity.PickupPointFieldLocation:
+			builder = builder.Set("lat", p.Lat).Set("lon", p.Lon)
+		case entity.PickupPointFieldOpeningHours:
+			builder = builder.Set("opening_hours", p.OpeningHours)
+		case entity.PickupPointFieldPaymentOptions:
+			builder = builder.Set("payment_options", p.PaymentOptions)
+		case entity.PickupPointFieldMaxParcel:
+			builder = builder.Set("max_parcel", p.MaxParcel)
+		default:
+			return nil, fmt.Errorf("PickupPointRepo - Update - unknown field %q: %w", field, entity.ErrInvalidArgument)
+		}
+	}
+
+	builder = builder.Where("provider_id = ? AND point_id = ?", providerID, pointID)
+
+	if p.Version != 0 {
+		builder = builder.Where("version = ?", p.Version)
+	}
+
+	query, args, err := builder.
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - Update - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PickupPointRepo - Update - pg.Conn.Query: %w", err)
+	}
+
+	point, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.PickupPoint])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PickupPointRepo - Update - pgx.CollectOneRow: %w", pg.notFoundOrStale(ctx, providerID, pointID, p.Version))
+		}
+		return nil, fmt.Errorf("PickupPointRepo - Update - pgx.CollectOneRow: %w", err)
+	}
+
+	return point, nil
+}
+
+func (pg *PickupPointRepo) Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error {
+	builder := pg.Builder.
+		Delete("pickup_points").
+		Where("provider_id = ? AND point_id = ?", providerID, pointID)
+
+	if version != 0 {
+		builder = builder.Where("version = ?", version)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("PickupPointRepo - Delete - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("PickupPointRepo - Delete - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("PickupPointRepo - Delete - pg.Conn.Exec: %w", pg.notFoundOrStale(ctx, providerID, pointID, version))
+	}
+
+	return nil
+}
+
+// notFoundOrStale explains why a conditional write matched no rows, see PostgresRepo.notFoundOrStale.
+func (pg *PickupPointRepo) notFoundOrStale(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error {
+	if version == 0 {
+		return entity.ErrNotFound
+	}
+
+	query, args, err := pg.Builder.
+		Select("1").
+		From("pickup_points").
+		Where("provider_id = ? AND point_id = ?", providerID, pointID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PickupPointRepo - notFoundOrStale - pg.Builder: %w", err)
+	}
+
+	var exists int
+	if err := pg.Conn(ctx).QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("PickupPointRepo - notFoundOrStale - pg.Conn.QueryRow: %w", err)
+	}
+
+	return entity.ErrPreconditionFailed
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 	"github.com/classydevv/fulfillment/pkg/postgres"
 	"github.com/jackc/pgerrcode"
@@ -23,37 +25,103 @@ func NewPostgresRepo(pg *postgres.Postgres) *PostgresRepo {
 func (pg *PostgresRepo) Store(ctx context.Context, p *entity.Provider) error {
 	query, args, err := pg.Builder.
 		Insert("providers").
-		Columns("provider_id, name").
-		Values(p.ProviderID, p.Name).
+		Columns("provider_id, name, legal_entity, contacts, website, countries, capabilities, display_names, labels").
+		Values(p.ProviderID, p.Name, p.LegalEntity, nonNilContacts(p.Contacts), p.Website, nonNilCountries(p.Countries), p.Capabilities, nonNilMap(p.DisplayNames), nonNilMap(p.Labels)).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("PostgresRepo - Store - pg.Builder: %w", err)
 	}
 
-	_, err = pg.Pool.Exec(ctx, query, args...)
+	_, err = pg.Conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		var pgError *pgconn.PgError
 		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
-			return fmt.Errorf("PostgresRepo - Store - pg.Pool.Exec: %w", entity.ErrAlreadyExists)
+			return fmt.Errorf("PostgresRepo - Store - pg.Conn.Exec: %w", entity.ErrAlreadyExists)
 		}
-		return fmt.Errorf("PostgresRepo - Store - pg.Pool.Exec: %w", err)
+		return fmt.Errorf("PostgresRepo - Store - pg.Conn.Exec: %w", err)
 	}
 
 	return nil
 }
 
-func (pg *PostgresRepo) GetAll(ctx context.Context) ([]*entity.Provider, error) {
-	query, _, err := pg.Builder.
+func (pg *PostgresRepo) GetByID(ctx context.Context, id entity.ProviderID) (*entity.Provider, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("providers").
+		Where("provider_id = ?", id).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	provider, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Provider])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PostgresRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("PostgresRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return provider, nil
+}
+
+// GetForUpdate reads the provider and locks its row until the surrounding transaction ends.
+func (pg *PostgresRepo) GetForUpdate(ctx context.Context, id entity.ProviderID) (*entity.Provider, error) {
+	query, args, err := pg.Builder.
 		Select("*").
 		From("providers").
+		Where("provider_id = ?", id).
+		Suffix("FOR UPDATE").
 		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - GetForUpdate - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - GetForUpdate - pg.Conn.Query: %w", err)
+	}
+
+	provider, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Provider])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PostgresRepo - GetForUpdate - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("PostgresRepo - GetForUpdate - pgx.CollectOneRow: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (pg *PostgresRepo) GetAll(ctx context.Context, q entity.ProviderQuery) ([]*entity.Provider, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("providers").
+		OrderBy(providerOrderBy(q.Order)...)
+
+	builder = applyProviderFilter(builder, q.Filter)
+
+	if q.After != nil {
+		builder = builder.Where(providerKeyset(q.Order, q.After))
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("PostgresRepo - GetAll - pg.Builder: %w", err)
 	}
 
-	rows, err := pg.Pool.Query(ctx, query)
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("PostgresRepo - GetAll - pg.Pool.Query: %w", err)
+		return nil, fmt.Errorf("PostgresRepo - GetAll - pg.Conn.Query: %w", err)
 	}
 
 	providers, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.Provider])
@@ -64,27 +132,90 @@ func (pg *PostgresRepo) GetAll(ctx context.Context) ([]*entity.Provider, error)
 	return providers, nil
 }
 
-func (pg *PostgresRepo) Update(ctx context.Context, id entity.ProviderID, p *entity.Provider) (*entity.Provider, error) {
-	query, args, err := pg.Builder.
-		Update("providers").
-		Set(
-			"name", p.Name,
-		).
-		Where("provider_id = ?", id).
+// Each streams the providers matching the filter to fn one by one as they are read from the connection,
+// without collecting them in memory. An error returned by fn stops the iteration and is returned as is.
+func (pg *PostgresRepo) Each(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error {
+	builder := pg.Builder.
+		Select("*").
+		From("providers").
+		OrderBy("provider_id ASC")
+
+	query, args, err := applyProviderFilter(builder, f).ToSql()
+	if err != nil {
+		return fmt.Errorf("PostgresRepo - Each - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("PostgresRepo - Each - pg.Conn.Query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		provider, err := pgx.RowToAddrOfStructByName[entity.Provider](rows)
+		if err != nil {
+			return fmt.Errorf("PostgresRepo - Each - pgx.RowToAddrOfStructByName: %w", err)
+		}
+
+		if err := fn(provider); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("PostgresRepo - Each - rows.Err: %w", err)
+	}
+
+	return nil
+}
+
+func (pg *PostgresRepo) Update(ctx context.Context, id entity.ProviderID, p *entity.Provider, mask entity.ProviderMask) (*entity.Provider, error) {
+	builder := pg.Builder.Update("providers")
+
+	for _, field := range mask {
+		switch field {
+		case entity.ProviderFieldName:
+			builder = builder.Set("name", p.Name)
+		case entity.ProviderFieldLegalEntity:
+			builder = builder.Set("legal_entity", p.LegalEntity)
+		case entity.ProviderFieldContacts:
+			builder = builder.Set("contacts", nonNilContacts(p.Contacts))
+		case entity.ProviderFieldWebsite:
+			builder = builder.Set("website", p.Website)
+		case entity.ProviderFieldCountries:
+			builder = builder.Set("countries", nonNilCountries(p.Countries))
+		case entity.ProviderFieldCapabilities:
+			builder = builder.Set("capabilities", p.Capabilities)
+		case entity.ProviderFieldDisplayNames:
+			builder = builder.Set("display_names", nonNilMap(p.DisplayNames))
+		case entity.ProviderFieldLabels:
+			builder = builder.Set("labels", nonNilMap(p.Labels))
+		default:
+			return nil, fmt.Errorf("PostgresRepo - Update - unknown field %q: %w", field, entity.ErrInvalidArgument)
+		}
+	}
+
+	builder = builder.Where("provider_id = ? AND deleted_at IS NULL", id)
+
+	if p.Version != 0 {
+		builder = builder.Where("version = ?", p.Version)
+	}
+
+	query, args, err := builder.
 		Suffix("RETURNING *").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("PostgresRepo - Update - pg.Builder: %w", err)
 	}
 
-	rows, err := pg.Pool.Query(ctx, query, args...)
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("PostgresRepo - Update - pg.Pool.Query: %w", err)
+		return nil, fmt.Errorf("PostgresRepo - Update - pg.Conn.Query: %w", err)
 	}
 	provider, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Provider])
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("PostgresRepo - Update - pgx.CollectOneRow: %w", entity.ErrNotFound)
+			return nil, fmt.Errorf("PostgresRepo - Update - pgx.CollectOneRow: %w", pg.notFoundOrStale(ctx, id, p.Version))
 		}
 		return nil, fmt.Errorf("PostgresRepo - Update - pgx.CollectOneRow: %w", err)
 	}
@@ -92,23 +223,225 @@ func (pg *PostgresRepo) Update(ctx context.Context, id entity.ProviderID, p *ent
 	return provider, nil
 }
 
-func (pg *PostgresRepo) Delete(ctx context.Context, id entity.ProviderID) error {
+func (pg *PostgresRepo) Archive(ctx context.Context, id entity.ProviderID, version int64) error {
+	builder := pg.Builder.
+		Update("providers").
+		Set("deleted_at", squirrel.Expr("now()")).
+		Where("provider_id = ? AND deleted_at IS NULL", id)
+
+	if version != 0 {
+		builder = builder.Where("version = ?", version)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("PostgresRepo - Archive - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("PostgresRepo - Archive - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("PostgresRepo - Archive - pg.Conn.Exec: %w", pg.notFoundOrStale(ctx, id, version))
+	}
+
+	return nil
+}
+
+func (pg *PostgresRepo) Restore(ctx context.Context, id entity.ProviderID) (*entity.Provider, error) {
+	query, args, err := pg.Builder.
+		Update("providers").
+		Set("deleted_at", nil).
+		Where("provider_id = ? AND deleted_at IS NOT NULL", id).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - Restore - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - Restore - pg.Conn.Query: %w", err)
+	}
+
+	provider, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Provider])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PostgresRepo - Restore - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("PostgresRepo - Restore - pgx.CollectOneRow: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (pg *PostgresRepo) Purge(ctx context.Context, id entity.ProviderID) error {
 	query, args, err := pg.Builder.
 		Delete("providers").
 		Where("provider_id = ?", id).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("PostgresRepo - Delete - pg.Builder: %w", err)
+		return fmt.Errorf("PostgresRepo - Purge - pg.Builder: %w", err)
 	}
 
-	comm, err := pg.Pool.Exec(ctx, query, args...)
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("PostgresRepo - Delete - pg.Pool.Exec: %w", err)
+		return fmt.Errorf("PostgresRepo - Purge - pg.Conn.Exec: %w", err)
 	}
 
 	if comm.RowsAffected() != 1 {
-		return fmt.Errorf("PostgresRepo - Delete - pg.Pool.Exec: %w", entity.ErrNotFound)
+		return fmt.Errorf("PostgresRepo - Purge - pg.Conn.Exec: %w", entity.ErrNotFound)
 	}
 
 	return nil
 }
+
+func (pg *PostgresRepo) UpdateStatus(ctx context.Context, id entity.ProviderID, status entity.ProviderStatus, reason string) (*entity.Provider, error) {
+	query, args, err := pg.Builder.
+		Update("providers").
+		Set("status", status).
+		Set("status_reason", reason).
+		Where("provider_id = ? AND deleted_at IS NULL", id).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - UpdateStatus - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - UpdateStatus - pg.Conn.Query: %w", err)
+	}
+
+	provider, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Provider])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("PostgresRepo - UpdateStatus - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("PostgresRepo - UpdateStatus - pgx.CollectOneRow: %w", err)
+	}
+
+	return provider, nil
+}
+
+// notFoundOrStale explains why a conditional write matched no rows: the provider is either gone
+// or its version has moved on since the client read it.
+func (pg *PostgresRepo) notFoundOrStale(ctx context.Context, id entity.ProviderID, version int64) error {
+	if version == 0 {
+		return entity.ErrNotFound
+	}
+
+	query, args, err := pg.Builder.
+		Select("1").
+		From("providers").
+		Where("provider_id = ? AND deleted_at IS NULL", id).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PostgresRepo - notFoundOrStale - pg.Builder: %w", err)
+	}
+
+	var exists int
+	if err := pg.Conn(ctx).QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("PostgresRepo - notFoundOrStale - pg.Conn.QueryRow: %w", err)
+	}
+
+	return entity.ErrPreconditionFailed
+}
+
+func applyProviderFilter(b squirrel.SelectBuilder, f entity.ProviderFilter) squirrel.SelectBuilder {
+	if !f.IncludeArchived {
+		b = b.Where("deleted_at IS NULL")
+	}
+
+	if f.NamePrefix != "" {
+		b = b.Where(squirrel.ILike{"name": escapeLike(f.NamePrefix) + "%"})
+	}
+
+	if len(f.Statuses) > 0 {
+		b = b.Where(squirrel.Eq{"status": f.Statuses})
+	}
+
+	if !f.CreatedAfter.IsZero() {
+		b = b.Where(squirrel.GtOrEq{"created_at": f.CreatedAfter})
+	}
+
+	if !f.CreatedBefore.IsZero() {
+		b = b.Where(squirrel.Lt{"created_at": f.CreatedBefore})
+	}
+
+	if !f.UpdatedAfter.IsZero() {
+		b = b.Where(squirrel.GtOrEq{"updated_at": f.UpdatedAfter})
+	}
+
+	if !f.UpdatedBefore.IsZero() {
+		b = b.Where(squirrel.Lt{"updated_at": f.UpdatedBefore})
+	}
+
+	for _, requirement := range f.Labels {
+		b = b.Where(labelRequirement(requirement))
+	}
+
+	return b
+}
+
+func providerOrderBy(o entity.ProviderOrder) []string {
+	direction := "ASC"
+	if o.Desc {
+		direction = "DESC"
+	}
+
+	if o.Field == entity.ProviderOrderByCreatedAt {
+		return []string{"created_at " + direction, "provider_id " + direction}
+	}
+
+	return []string{"provider_id " + direction}
+}
+
+// providerKeyset continues listing right after the cursor, provider_id breaks ties on equal timestamps.
+func providerKeyset(o entity.ProviderOrder, c *entity.ProviderCursor) squirrel.Sqlizer {
+	op := ">"
+	if o.Desc {
+		op = "<"
+	}
+
+	if o.Field == entity.ProviderOrderByCreatedAt {
+		return squirrel.Expr("(created_at, provider_id) "+op+" (?, ?)", c.CreatedAt, c.ProviderID)
+	}
+
+	return squirrel.Expr("provider_id "+op+" ?", c.ProviderID)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// nonNilContacts keeps an empty list a JSON array, pgx would write a nil slice as NULL.
+func nonNilContacts(contacts []entity.Contact) []entity.Contact {
+	if contacts == nil {
+		return []entity.Contact{}
+	}
+
+	return contacts
+}
+
+func nonNilCountries(countries []string) []string {
+	if countries == nil {
+		return []string{}
+	}
+
+	return countries
+}
+
+func nonNilMap(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+
+	return m
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// The expressions below must match the indexes of the providers_display_names migration, otherwise
+// Postgres falls back to a sequential scan.
+const (
+	_searchDocument = "to_tsvector('simple', providers_search_text(name, legal_entity, display_names))"
+	_searchText     = "providers_search_text(name, legal_entity, display_names)"
+	_searchTSQuery  = "plainto_tsquery('simple', search.term)"
+)
+
+type providerSearchRow struct {
+	entity.Provider
+	Score float64 `db:"score"`
+}
+
+// Search matches whole words with full-text search and typos and word prefixes with trigrams.
+// Both sides are transliterated by providers_translit, so Cyrillic and Latin spellings match each other.
+func (pg *PostgresRepo) Search(ctx context.Context, q entity.ProviderSearchQuery) ([]*entity.ProviderSearchHit, error) {
+	query, args, err := pg.Builder.
+		Select("providers.*").
+		Column(fmt.Sprintf("greatest(ts_rank(%s, %s), word_similarity(search.term, %s)) AS score", _searchDocument, _searchTSQuery, _searchText)).
+		From("providers").
+		CrossJoin("(SELECT providers_translit(?) AS term) AS search", q.Text).
+		Where("deleted_at IS NULL").
+		Where(fmt.Sprintf("(%s @@ %s OR search.term <%% %s)", _searchDocument, _searchTSQuery, _searchText)).
+		OrderBy("score DESC", "provider_id ASC").
+		Limit(q.Limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - Search - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - Search - pg.Conn.Query: %w", err)
+	}
+
+	found, err := pgx.CollectRows(rows, pgx.RowToStructByName[providerSearchRow])
+	if err != nil {
+		return nil, fmt.Errorf("PostgresRepo - Search - pgx.CollectRows: %w", err)
+	}
+
+	hits := make([]*entity.ProviderSearchHit, len(found))
+	for i := range found {
+		hits[i] = &entity.ProviderSearchHit{Provider: &found[i].Provider, Score: found[i].Score}
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type SlotHoldRepo struct {
+	*postgres.Postgres
+}
+
+func NewSlotHoldRepo(pg *postgres.Postgres) *SlotHoldRepo {
+	return &SlotHoldRepo{pg}
+}
+
+func (pg *SlotHoldRepo) Store(ctx context.Context, h *entity.SlotHold) error {
+	query, args, err := pg.Builder.
+		Insert("slot_holds").
+		Columns("slot_id, provider_id, quantity, status, expires_at").
+		Values(h.SlotID, h.ProviderID, h.Quantity, h.Status, h.ExpiresAt).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotHoldRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SlotHoldRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotHold])
+	if err != nil {
+		return fmt.Errorf("SlotHoldRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	*h = *stored
+
+	return nil
+}
+
+func (pg *SlotHoldRepo) GetForUpdate(ctx context.Context, providerID entity.ProviderID, holdID entity.HoldID) (*entity.SlotHold, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("slot_holds").
+		Where("provider_id = ? AND hold_id = ?", providerID, holdID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - GetForUpdate - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - GetForUpdate - pg.Conn.Query: %w", err)
+	}
+
+	hold, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotHold])
+	if err != nil {
+		// A malformed ID can not belong to any hold.
+		var pgError *pgconn.PgError
+		if errors.Is(err, pgx.ErrNoRows) || errors.As(err, &pgError) && pgError.Code == pgerrcode.InvalidTextRepresentation {
+			return nil, fmt.Errorf("SlotHoldRepo - GetForUpdate - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotHoldRepo - GetForUpdate - pgx.CollectOneRow: %w", err)
+	}
+
+	return hold, nil
+}
+
+func (pg *SlotHoldRepo) UpdateStatus(ctx context.Context, id entity.HoldID, status entity.HoldStatus) (*entity.SlotHold, error) {
+	query, args, err := pg.Builder.
+		Update("slot_holds").
+		Set("status", status).
+		Where("hold_id = ?", id).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - UpdateStatus - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - UpdateStatus - pg.Conn.Query: %w", err)
+	}
+
+	hold, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotHold])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotHoldRepo - UpdateStatus - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotHoldRepo - UpdateStatus - pgx.CollectOneRow: %w", err)
+	}
+
+	return hold, nil
+}
+
+// LockExpired orders holds by slot so that concurrent sweepers update slots in the same order.
+func (pg *SlotHoldRepo) LockExpired(ctx context.Context, before time.Time, limit uint64) ([]*entity.SlotHold, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("slot_holds").
+		Where("status = ?", entity.HoldStatusHeld).
+		Where(squirrel.LtOrEq{"expires_at": before}).
+		OrderBy("slot_id", "hold_id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - LockExpired - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - LockExpired - pg.Conn.Query: %w", err)
+	}
+
+	holds, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.SlotHold])
+	if err != nil {
+		return nil, fmt.Errorf("SlotHoldRepo - LockExpired - pgx.CollectRows: %w", err)
+	}
+
+	return holds, nil
+}
+
+func (pg *SlotHoldRepo) Expire(ctx context.Context, ids []entity.HoldID) error {
+	holdIDs := make([]string, len(ids))
+	for i, id := range ids {
+		holdIDs[i] = string(id)
+	}
+
+	query, args, err := pg.Builder.
+		Update("slot_holds").
+		Set("status", entity.HoldStatusExpired).
+		Where("hold_id = ANY(?::uuid[])", holdIDs).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotHoldRepo - Expire - pg.Builder: %w", err)
+	}
+
+	if _, err := pg.Conn(ctx).Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("SlotHoldRepo - Expire - pg.Conn.Exec: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type SlotTemplateRepo struct {
+	*postgres.Postgres
+}
+
+func NewSlotTemplateRepo(pg *postgres.Postgres) *SlotTemplateRepo {
+	return &SlotTemplateRepo{pg}
+}
+
+func (pg *SlotTemplateRepo) Store(ctx context.Context, t *entity.SlotTemplate) error {
+	query, args, err := pg.Builder.
+		Insert("slot_templates").
+		Columns("provider_id, template_id, zone_id, time_zone, rule").
+		Values(t.ProviderID, t.TemplateID, t.ZoneID, t.TimeZone, t.Rule).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotTemplateRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SlotTemplateRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotTemplate])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("SlotTemplateRepo - Store - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("SlotTemplateRepo - Store - pgx.CollectOneRow: zone %s: %w", t.ZoneID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("SlotTemplateRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	*t = *stored
+
+	return nil
+}
+
+func (pg *SlotTemplateRepo) GetByID(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("slot_templates").
+		Where("provider_id = ? AND template_id = ?", providerID, templateID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	template, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotTemplate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotTemplateRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotTemplateRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return template, nil
+}
+
+func (pg *SlotTemplateRepo) GetAll(ctx context.Context, q entity.SlotTemplateQuery) ([]*entity.SlotTemplate, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("slot_templates").
+		Where("provider_id = ?", q.ProviderID).
+		OrderBy("template_id ASC")
+
+	if q.After != "" {
+		builder = builder.Where(squirrel.Gt{"template_id": q.After})
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetAll - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetAll - pg.Conn.Query: %w", err)
+	}
+
+	templates, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.SlotTemplate])
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetAll - pgx.CollectRows: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (pg *SlotTemplateRepo) GetActive(ctx context.Context) ([]*entity.SlotTemplate, error) {
+	query, args, err := pg.Builder.
+		Select("t.*").
+		From("slot_templates t").
+		Join("providers p USING (provider_id)").
+		Where("t.activated_at IS NOT NULL").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		OrderBy("t.provider_id", "t.template_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetActive - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetActive - pg.Conn.Query: %w", err)
+	}
+
+	templates, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.SlotTemplate])
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - GetActive - pgx.CollectRows: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (pg *SlotTemplateRepo) SetActive(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, active bool) (*entity.SlotTemplate, error) {
+	activatedAt := squirrel.Expr("NULL")
+	if active {
+		activatedAt = squirrel.Expr("COALESCE(activated_at, now())")
+	}
+
+	query, args, err := pg.Builder.
+		Update("slot_templates").
+		Set("activated_at", activatedAt).
+		Where("provider_id = ? AND template_id = ?", providerID, templateID).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - SetActive - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotTemplateRepo - SetActive - pg.Conn.Query: %w", err)
+	}
+
+	template, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.SlotTemplate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotTemplateRepo - SetActive - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotTemplateRepo - SetActive - pgx.CollectOneRow: %w", err)
+	}
+
+	return template, nil
+}
+
+func (pg *SlotTemplateRepo) Delete(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) error {
+	query, args, err := pg.Builder.
+		Delete("slot_templates").
+		Where("provider_id = ? AND template_id = ?", providerID, templateID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotTemplateRepo - Delete - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SlotTemplateRepo - Delete - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("SlotTemplateRepo - Delete - pg.Conn.Exec: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// _storeGeneratedBatch slots of 5 columns each keep an insert far below the 65535 bind parameters Postgres allows.
+const _storeGeneratedBatch = 1000
+
+type SlotRepo struct {
+	*postgres.Postgres
+}
+
+func NewSlotRepo(pg *postgres.Postgres) *SlotRepo {
+	return &SlotRepo{pg}
+}
+
+func (pg *SlotRepo) Store(ctx context.Context, s *entity.Slot) error {
+	query, args, err := pg.Builder.
+		Insert("slots").
+		Columns("provider_id, zone_id, starts_at, ends_at, capacity").
+		Values(s.ProviderID, s.ZoneID, s.StartsAt, s.EndsAt, s.Capacity).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("SlotRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("SlotRepo - Store - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("SlotRepo - Store - pgx.CollectOneRow: zone %s: %w", s.ZoneID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("SlotRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	*s = *stored
+
+	return nil
+}
+
+func (pg *SlotRepo) GetByID(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID) (*entity.Slot, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("slots").
+		Where("provider_id = ? AND slot_id = ?", providerID, slotID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	slot, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return slot, nil
+}
+
+func (pg *SlotRepo) GetAll(ctx context.Context, q entity.SlotQuery) ([]*entity.Slot, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("slots").
+		Where("provider_id = ?", q.Filter.ProviderID).
+		OrderBy("starts_at ASC", "slot_id ASC")
+
+	if q.Filter.ZoneID != "" {
+		builder = builder.Where("zone_id = ?", q.Filter.ZoneID)
+	}
+
+	if !q.Filter.StartsFrom.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"starts_at": q.Filter.StartsFrom})
+	}
+
+	if !q.Filter.StartsBefore.IsZero() {
+		builder = builder.Where(squirrel.Lt{"starts_at": q.Filter.StartsBefore})
+	}
+
+	if !q.Filter.IncludeClosed {
+		builder = builder.Where("closed_at IS NULL")
+	}
+
+	if q.After != nil {
+		builder = builder.Where("(starts_at, slot_id) > (?, ?)", q.After.StartsAt, q.After.SlotID)
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAll - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAll - pg.Conn.Query: %w", err)
+	}
+
+	slots, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAll - pgx.CollectRows: %w", err)
+	}
+
+	return slots, nil
+}
+
+// GetAvailable leaves out slots that have already started, they can not be booked any more.
+func (pg *SlotRepo) GetAvailable(ctx context.Context, params entity.AvailabilityParams) ([]*entity.Slot, error) {
+	query, args, err := pg.Builder.
+		Select("s.*").
+		From("slots s").
+		Join("providers p USING (provider_id)").
+		Where("s.provider_id = ? AND s.zone_id = ?", params.ProviderID, params.ZoneID).
+		Where(squirrel.GtOrEq{"s.starts_at": params.From}).
+		Where(squirrel.Lt{"s.starts_at": params.To}).
+		Where("s.starts_at > now()").
+		Where("s.closed_at IS NULL AND s.booked < s.capacity").
+		Where("p.deleted_at IS NULL").
+		Where("p.status = ?", entity.ProviderStatusActive).
+		OrderBy("s.starts_at ASC", "s.slot_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAvailable - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAvailable - pg.Conn.Query: %w", err)
+	}
+
+	slots, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetAvailable - pgx.CollectRows: %w", err)
+	}
+
+	return slots, nil
+}
+
+func (pg *SlotRepo) Close(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID) (*entity.Slot, error) {
+	query, args, err := pg.Builder.
+		Update("slots").
+		Set("closed_at", squirrel.Expr("COALESCE(closed_at, now())")).
+		Where("provider_id = ? AND slot_id = ?", providerID, slotID).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - Close - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - Close - pg.Conn.Query: %w", err)
+	}
+
+	slot, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotRepo - Close - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotRepo - Close - pgx.CollectOneRow: %w", err)
+	}
+
+	return slot, nil
+}
+
+func (pg *SlotRepo) GetForUpdate(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID) (*entity.Slot, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("slots").
+		Where("provider_id = ? AND slot_id = ?", providerID, slotID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetForUpdate - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("SlotRepo - GetForUpdate - pg.Conn.Query: %w", err)
+	}
+
+	slot, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Slot])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SlotRepo - GetForUpdate - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("SlotRepo - GetForUpdate - pgx.CollectOneRow: %w", err)
+	}
+
+	return slot, nil
+}
+
+func (pg *SlotRepo) AddBooked(ctx context.Context, id entity.SlotID, delta int) error {
+	query, args, err := pg.Builder.
+		Update("slots").
+		Set("booked", squirrel.Expr("booked + ?", delta)).
+		Where("slot_id = ?", id).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("SlotRepo - AddBooked - pg.Builder: %w", err)
+	}
+
+	tag, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		// The check constraints keep booked within capacity even if a caller got the count wrong.
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.CheckViolation {
+			return fmt.Errorf("SlotRepo - AddBooked - pg.Conn.Exec: %w", entity.ErrSlotUnavailable)
+		}
+		return fmt.Errorf("SlotRepo - AddBooked - pg.Conn.Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("SlotRepo - AddBooked - pg.Conn.Exec: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+// StoreGenerated skips slots whose window already exists, so generating the same period again adds nothing.
+// Slots are inserted _storeGeneratedBatch at a time to stay within the bind parameter limit of Postgres,
+// callers that need all or nothing run it inside a transaction.
+func (pg *SlotRepo) StoreGenerated(ctx context.Context, slots []*entity.Slot) (int64, error) {
+	var stored int64
+
+	for batch := range slices.Chunk(slots, _storeGeneratedBatch) {
+		builder := pg.Builder.
+			Insert("slots").
+			Columns("provider_id, zone_id, starts_at, ends_at, capacity").
+			Suffix("ON CONFLICT (provider_id, zone_id, starts_at, ends_at) DO NOTHING")
+
+		for _, s := range batch {
+			builder = builder.Values(s.ProviderID, s.ZoneID, s.StartsAt, s.EndsAt, s.Capacity)
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return stored, fmt.Errorf("SlotRepo - StoreGenerated - pg.Builder: %w", err)
+		}
+
+		tag, err := pg.Conn(ctx).Exec(ctx, query, args...)
+		if err != nil {
+			var pgError *pgconn.PgError
+			if errors.As(err, &pgError) && pgError.Code == pgerrcode.ForeignKeyViolation {
+				return stored, fmt.Errorf("SlotRepo - StoreGenerated - pg.Conn.Exec: zone: %w", entity.ErrNotFound)
+			}
+			return stored, fmt.Errorf("SlotRepo - StoreGenerated - pg.Conn.Exec: %w", err)
+		}
+
+		stored += tag.RowsAffected()
+	}
+
+	return stored, nil
+}
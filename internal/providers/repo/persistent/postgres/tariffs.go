@@ -0,0 +1,278 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type TariffRepo struct {
+	*postgres.Postgres
+}
+
+func NewTariffRepo(pg *postgres.Postgres) *TariffRepo {
+	return &TariffRepo{pg}
+}
+
+func (pg *TariffRepo) Store(ctx context.Context, t *entity.Tariff) error {
+	query, args, err := pg.Builder.
+		Insert("tariffs").
+		Columns("provider_id, tariff_id, name, currency, service_level, transit_days_min, transit_days_max").
+		Values(t.ProviderID, t.TariffID, t.Name, t.Currency, t.ServiceLevel, t.TransitDaysMin, t.TransitDaysMax).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TariffRepo - Store - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("TariffRepo - Store - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Tariff])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("TariffRepo - Store - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("TariffRepo - Store - pgx.CollectOneRow: provider %s: %w", t.ProviderID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("TariffRepo - Store - pgx.CollectOneRow: %w", err)
+	}
+
+	stored.Revision = t.Revision
+	*t = *stored
+
+	return nil
+}
+
+func (pg *TariffRepo) GetByID(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) (*entity.Tariff, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("tariffs").
+		Where("provider_id = ? AND tariff_id = ?", providerID, tariffID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	tariff, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Tariff])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("TariffRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("TariffRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return tariff, nil
+}
+
+// GetForUpdate reads the tariff and locks its row until the surrounding transaction ends.
+func (pg *TariffRepo) GetForUpdate(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) (*entity.Tariff, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("tariffs").
+		Where("provider_id = ? AND tariff_id = ?", providerID, tariffID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetForUpdate - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetForUpdate - pg.Conn.Query: %w", err)
+	}
+
+	tariff, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Tariff])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("TariffRepo - GetForUpdate - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("TariffRepo - GetForUpdate - pgx.CollectOneRow: %w", err)
+	}
+
+	return tariff, nil
+}
+
+func (pg *TariffRepo) GetAll(ctx context.Context, q entity.TariffQuery) ([]*entity.Tariff, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("tariffs").
+		Where("provider_id = ?", q.ProviderID).
+		OrderBy("tariff_id ASC")
+
+	if q.After != "" {
+		builder = builder.Where(squirrel.Gt{"tariff_id": q.After})
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetAll - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetAll - pg.Conn.Query: %w", err)
+	}
+
+	tariffs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.Tariff])
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetAll - pgx.CollectRows: %w", err)
+	}
+
+	return tariffs, nil
+}
+
+func (pg *TariffRepo) Delete(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) error {
+	query, args, err := pg.Builder.
+		Delete("tariffs").
+		Where("provider_id = ? AND tariff_id = ?", providerID, tariffID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TariffRepo - Delete - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("TariffRepo - Delete - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("TariffRepo - Delete - pg.Conn.Exec: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+// StoreRevision fills in the timestamp of the revision.
+func (pg *TariffRepo) StoreRevision(ctx context.Context, r *entity.TariffRevision) error {
+	query, args, err := pg.Builder.
+		Insert("tariff_revisions").
+		Columns("provider_id, tariff_id, revision, rules, effective_from, effective_to").
+		Values(r.ProviderID, r.TariffID, r.Revision, r.Rules, r.EffectiveFrom, r.EffectiveTo).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TariffRepo - StoreRevision - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("TariffRepo - StoreRevision - pg.Conn.Query: %w", err)
+	}
+
+	stored, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.TariffRevision])
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("TariffRepo - StoreRevision - pgx.CollectOneRow: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("TariffRepo - StoreRevision - pgx.CollectOneRow: tariff %s: %w", r.TariffID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("TariffRepo - StoreRevision - pgx.CollectOneRow: %w", err)
+	}
+
+	*r = *stored
+
+	return nil
+}
+
+// EndRevision closes an open-ended revision, revisions with an end are left as they are.
+func (pg *TariffRepo) EndRevision(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID, revision int, effectiveTo time.Time) error {
+	query, args, err := pg.Builder.
+		Update("tariff_revisions").
+		Set("effective_to", effectiveTo).
+		Where("provider_id = ? AND tariff_id = ? AND revision = ? AND effective_to IS NULL", providerID, tariffID, revision).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("TariffRepo - EndRevision - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("TariffRepo - EndRevision - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("TariffRepo - EndRevision - pg.Conn.Exec: %w", entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+// GetRevisions returns every revision of the tariff ordered by number.
+func (pg *TariffRepo) GetRevisions(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) ([]*entity.TariffRevision, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("tariff_revisions").
+		Where("provider_id = ? AND tariff_id = ?", providerID, tariffID).
+		OrderBy("revision ASC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisions - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisions - pg.Conn.Query: %w", err)
+	}
+
+	revisions, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.TariffRevision])
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisions - pgx.CollectRows: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetRevisionsAt returns for every tariff the revision in effect at the time or, when there is none, the next one.
+// Revisions do not overlap, so it is the earliest one not ended by then.
+func (pg *TariffRepo) GetRevisionsAt(ctx context.Context, providerID entity.ProviderID, tariffIDs []entity.TariffID, at time.Time) ([]*entity.TariffRevision, error) {
+	query, args, err := pg.Builder.
+		Select("DISTINCT ON (tariff_id) *").
+		From("tariff_revisions").
+		Where(squirrel.Eq{"provider_id": providerID, "tariff_id": tariffIDs}).
+		Where("(effective_to IS NULL OR effective_to > ?)", at).
+		OrderBy("tariff_id", "effective_from").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisionsAt - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisionsAt - pg.Conn.Query: %w", err)
+	}
+
+	revisions, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.TariffRevision])
+	if err != nil {
+		return nil, fmt.Errorf("TariffRepo - GetRevisionsAt - pgx.CollectRows: %w", err)
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/pkg/postgres"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type ZoneRepo struct {
+	*postgres.Postgres
+}
+
+func NewZoneRepo(pg *postgres.Postgres) *ZoneRepo {
+	return &ZoneRepo{pg}
+}
+
+func (pg *ZoneRepo) Store(ctx context.Context, z *entity.Zone) error {
+	query, args, err := pg.Builder.
+		Insert("zones").
+		Columns("provider_id, zone_id, name, geometry").
+		Values(z.ProviderID, z.ZoneID, z.Name, z.Geometry).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ZoneRepo - Store - pg.Builder: %w", err)
+	}
+
+	_, err = pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) {
+			switch pgError.Code {
+			case pgerrcode.UniqueViolation:
+				return fmt.Errorf("ZoneRepo - Store - pg.Conn.Exec: %w", entity.ErrAlreadyExists)
+			case pgerrcode.ForeignKeyViolation:
+				return fmt.Errorf("ZoneRepo - Store - pg.Conn.Exec: provider %s: %w", z.ProviderID, entity.ErrNotFound)
+			}
+		}
+		return fmt.Errorf("ZoneRepo - Store - pg.Conn.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (pg *ZoneRepo) GetByID(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID) (*entity.Zone, error) {
+	query, args, err := pg.Builder.
+		Select("*").
+		From("zones").
+		Where("provider_id = ? AND zone_id = ?", providerID, zoneID).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - GetByID - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - GetByID - pg.Conn.Query: %w", err)
+	}
+
+	zone, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Zone])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("ZoneRepo - GetByID - pgx.CollectOneRow: %w", entity.ErrNotFound)
+		}
+		return nil, fmt.Errorf("ZoneRepo - GetByID - pgx.CollectOneRow: %w", err)
+	}
+
+	return zone, nil
+}
+
+func (pg *ZoneRepo) GetAll(ctx context.Context, q entity.ZoneQuery) ([]*entity.Zone, error) {
+	builder := pg.Builder.
+		Select("*").
+		From("zones").
+		Where("provider_id = ?", q.ProviderID).
+		OrderBy("zone_id ASC")
+
+	if q.After != "" {
+		builder = builder.Where("zone_id > ?", q.After)
+	}
+
+	if q.Limit > 0 {
+		builder = builder.Limit(q.Limit)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - GetAll - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - GetAll - pg.Conn.Query: %w", err)
+	}
+
+	zones, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[entity.Zone])
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - GetAll - pgx.CollectRows: %w", err)
+	}
+
+	return zones, nil
+}
+
+func (pg *ZoneRepo) Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, z *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error) {
+	builder := pg.Builder.Update("zones")
+
+	for _, field := range mask {
+		switch field {
+		case entity.ZoneFieldName:
+			builder = builder.Set("name", z.Name)
+		case entity.ZoneFieldGeometry:
+			builder = builder.Set("geometry", z.Geometry)
+		default:
+			return nil, fmt.Errorf("ZoneRepo - Update - unknown field %q: %w", field, entity.ErrInvalidArgument)
+		}
+	}
+
+	builder = builder.Where("provider_id = ? AND zone_id = ?", providerID, zoneID)
+
+	if z.Version != 0 {
+		builder = builder.Where("version = ?", z.Version)
+	}
+
+	query, args, err := builder.
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - Update - pg.Builder: %w", err)
+	}
+
+	rows, err := pg.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ZoneRepo - Update - pg.Conn.Query: %w", err)
+	}
+
+	zone, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[entity.Zone])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("ZoneRepo - Update - pgx.CollectOneRow: %w", pg.notFoundOrStale(ctx, providerID, zoneID, z.Version))
+		}
+		return nil, fmt.Errorf("ZoneRepo - Update - pgx.CollectOneRow: %w", err)
+	}
+
+	return zone, nil
+}
+
+func (pg *ZoneRepo) Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error {
+	builder := pg.Builder.
+		Delete("zones").
+		Where("provider_id = ? AND zone_id = ?", providerID, zoneID)
+
+	if version != 0 {
+		builder = builder.Where("version = ?", version)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("ZoneRepo - Delete - pg.Builder: %w", err)
+	}
+
+	comm, err := pg.Conn(ctx).Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("ZoneRepo - Delete - pg.Conn.Exec: %w", err)
+	}
+
+	if comm.RowsAffected() != 1 {
+		return fmt.Errorf("ZoneRepo - Delete - pg.Conn.Exec: %w", pg.notFoundOrStale(ctx, providerID, zoneID, version))
+	}
+
+	return nil
+}
+
+// notFoundOrStale explains why a conditional write matched no rows, see PostgresRepo.notFoundOrStale.
+func (pg *ZoneRepo) notFoundOrStale(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error {
+	if version == 0 {
+		return entity.ErrNotFound
+	}
+
+	query, args, err := pg.Builder.
+		Select("1").
+		From("zones").
+		Where("provider_id = ? AND zone_id = ?", providerID, zoneID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("ZoneRepo - notFoundOrStale - pg.Builder: %w", err)
+	}
+
+	var exists int
+	if err := pg.Conn(ctx).QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("ZoneRepo - notFoundOrStale - pg.Conn.QueryRow: %w", err)
+	}
+
+	return entity.ErrPreconditionFailed
+}
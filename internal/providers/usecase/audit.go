@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+// providerSnapshot is how a provider is stored in the audit log. Converting from entity.Provider stops
+// compiling when a field is added, so the snapshot can not silently fall behind.
+type providerSnapshot struct {
+	ProviderID   entity.ProviderID     `json:"provider_id"`
+	Name         string                `json:"name"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+	Version      int64                 `json:"version"`
+	DeletedAt    *time.Time            `json:"deleted_at,omitempty"`
+	Status       entity.ProviderStatus `json:"status"`
+	StatusReason string                `json:"status_reason,omitempty"`
+	LegalEntity  entity.LegalEntity    `json:"legal_entity"`
+	Contacts     []entity.Contact      `json:"contacts"`
+	Website      string                `json:"website"`
+	Countries    []string              `json:"countries"`
+	Capabilities entity.Capabilities   `json:"capabilities"`
+	DisplayNames map[string]string     `json:"display_names"`
+	Labels       map[string]string     `json:"labels"`
+	DisplayName  string                `json:"-"`
+}
+
+func marshalSnapshot(p *entity.Provider) (json.RawMessage, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(providerSnapshot(*p))
+}
+
+// record appends a change to the audit log, it must be called inside the transaction that made the change.
+// The claimed actor is left empty when the caller named nobody.
+func (uc *UseCaseProviders) record(ctx context.Context, action entity.AuditAction, old, updated *entity.Provider) error {
+	principal := entity.PrincipalFromContext(ctx)
+
+	entry := &entity.AuditEntry{
+		Action:       action,
+		ClaimedActor: principal.ClaimedActor,
+		RequestID:    principal.RequestID,
+	}
+
+	switch {
+	case updated != nil:
+		entry.ProviderID = updated.ProviderID
+	case old != nil:
+		entry.ProviderID = old.ProviderID
+	}
+
+	var err error
+
+	if entry.OldValue, err = marshalSnapshot(old); err != nil {
+		return fmt.Errorf("marshalSnapshot: %w", err)
+	}
+
+	if entry.NewValue, err = marshalSnapshot(updated); err != nil {
+		return fmt.Errorf("marshalSnapshot: %w", err)
+	}
+
+	if err := uc.audit.Append(ctx, entry); err != nil {
+		return fmt.Errorf("uc.audit.Append: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *UseCaseProviders) History(ctx context.Context, providerID entity.ProviderID, params entity.HistoryListParams) (*entity.HistoryPage, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - History - pageLimit: %w", err)
+	}
+
+	query := entity.AuditQuery{
+		ProviderID: providerID,
+		Limit:      limit,
+	}
+
+	if params.PageToken != "" {
+		beforeID, err := decodeHistoryPageToken(params.PageToken)
+		if err != nil {
+			return nil, fmt.Errorf("UseCaseProviders - History - decodeHistoryPageToken: %w", err)
+		}
+
+		query.BeforeID = beforeID
+	}
+
+	entries, err := uc.audit.GetHistory(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - History - uc.audit.GetHistory: %w", err)
+	}
+
+	page := &entity.HistoryPage{}
+
+	var last *entity.AuditEntry
+	if page.Entries, last = trimPage(entries, query.Limit); last != nil {
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(strconv.AppendInt(nil, last.AuditID, 10))
+	}
+
+	return page, nil
+}
+
+func decodeHistoryPageToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_AuditRecord(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repo.NewMockProviderRepo(ctrl)
+	audit := mock_repo.NewMockAuditRepo(ctrl)
+	tx := mock_repo.NewMockTransactor(ctrl)
+
+	ctx := entity.ContextWithPrincipal(context.Background(), entity.Principal{ClaimedActor: "ops@kuper", RequestID: "req-1"})
+
+	expectInTx(tx)
+	repo.EXPECT().GetForUpdate(ctx, entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old", Version: 1}, nil)
+	repo.EXPECT().Update(ctx, entity.ProviderID("id"), &entity.Provider{Name: "new"}, entity.ProviderMask{entity.ProviderFieldName}).
+		Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "new", Version: 2}, nil)
+	audit.EXPECT().Append(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, e *entity.AuditEntry) error {
+		require.Equal(t, entity.ProviderID("id"), e.ProviderID)
+		require.Equal(t, entity.AuditActionUpdate, e.Action)
+		require.Equal(t, "ops@kuper", e.ClaimedActor)
+		require.Equal(t, "req-1", e.RequestID)
+
+		var old, updated map[string]any
+		require.NoError(t, json.Unmarshal(e.OldValue, &old))
+		require.NoError(t, json.Unmarshal(e.NewValue, &updated))
+		require.Equal(t, "old", old["name"])
+		require.Equal(t, "new", updated["name"])
+
+		return nil
+	})
+
+	uc := usecase.NewUseCaseProviders(repo, audit, tx)
+
+	_, err := uc.Update(ctx, entity.ProviderID("id"), &entity.Provider{Name: "new"}, entity.ProviderMask{entity.ProviderFieldName})
+	require.NoError(t, err)
+}
+
+func TestUseCaseProviders_History(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		audit *mock_repo.MockAuditRepo
+	}
+
+	type args struct {
+		ctx    context.Context
+		id     entity.ProviderID
+		params entity.HistoryListParams
+	}
+
+	entries := []*entity.AuditEntry{
+		{AuditID: 3, ProviderID: entity.ProviderID("id"), Action: entity.AuditActionUpdate},
+		{AuditID: 2, ProviderID: entity.ProviderID("id"), Action: entity.AuditActionUpdate},
+		{AuditID: 1, ProviderID: entity.ProviderID("id"), Action: entity.AuditActionCreate},
+	}
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		args     args
+		want     []*entity.AuditEntry
+		wantNext bool
+		wantErr  error
+	}{
+		{
+			name: "last page has no next page token",
+			prepare: func(f *fields) {
+				f.audit.EXPECT().GetHistory(context.Background(), entity.AuditQuery{ProviderID: entity.ProviderID("id"), Limit: 51}).Return(entries, nil)
+			},
+			args: args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want: entries,
+		},
+		{
+			name: "extra entry yields next page token",
+			prepare: func(f *fields) {
+				f.audit.EXPECT().GetHistory(context.Background(), entity.AuditQuery{ProviderID: entity.ProviderID("id"), Limit: 3}).Return(entries, nil)
+			},
+			args:     args{ctx: context.Background(), id: entity.ProviderID("id"), params: entity.HistoryListParams{PageSize: 2}},
+			want:     entries[:2],
+			wantNext: true,
+		},
+		{
+			name:    "error - negative page size",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), params: entity.HistoryListParams{PageSize: -1}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - malformed page token",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), params: entity.HistoryListParams{PageToken: "###"}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(mock_repo.NewMockProviderRepo(ctrl), f.audit, mock_repo.NewMockTransactor(ctrl))
+
+			res, err := uc.History(tt.args.ctx, tt.args.id, tt.args.params)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+			require.Equal(t, tt.want, res.Entries)
+			require.Equal(t, tt.wantNext, res.NextPageToken != "")
+		})
+	}
+
+	t.Run("next page token resumes after the last entry", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		audit := mock_repo.NewMockAuditRepo(ctrl)
+		gomock.InOrder(
+			audit.EXPECT().GetHistory(context.Background(), entity.AuditQuery{ProviderID: entity.ProviderID("id"), Limit: 3}).Return(entries, nil),
+			audit.EXPECT().GetHistory(context.Background(), entity.AuditQuery{ProviderID: entity.ProviderID("id"), BeforeID: 2, Limit: 3}).Return(entries[2:], nil),
+		)
+
+		uc := usecase.NewUseCaseProviders(mock_repo.NewMockProviderRepo(ctrl), audit, mock_repo.NewMockTransactor(ctrl))
+		params := entity.HistoryListParams{PageSize: 2}
+
+		first, err := uc.History(context.Background(), entity.ProviderID("id"), params)
+		require.NoError(t, err)
+
+		params.PageToken = first.NextPageToken
+		second, err := uc.History(context.Background(), entity.ProviderID("id"), params)
+		require.NoError(t, err)
+		require.Len(t, second.Entries, 1)
+		require.Empty(t, second.NextPageToken)
+	})
+}
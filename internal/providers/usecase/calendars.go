@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	// _maxCalendarHolidays covers a few years of public and company holidays.
+	_maxCalendarHolidays  = 1000
+	_maxHolidayNameLength = 128
+)
+
+type UseCaseCalendars struct {
+	repo      repo.CalendarRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+}
+
+func NewUseCaseCalendars(r repo.CalendarRepo, p repo.ProviderRepo, tx repo.Transactor) *UseCaseCalendars {
+	return &UseCaseCalendars{
+		repo:      r,
+		providers: p,
+		tx:        tx,
+	}
+}
+
+// Create sets up the calendar of a provider, archived providers do not get one.
+func (uc *UseCaseCalendars) Create(ctx context.Context, calendar *entity.Calendar) (*entity.Calendar, error) {
+	normalizeCalendar(calendar)
+
+	if err := validateCalendar(calendar); err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - Create - validateCalendar: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.lockProvider(ctx, calendar.ProviderID); err != nil {
+			return fmt.Errorf("uc.lockProvider: %w", err)
+		}
+
+		if err := uc.repo.Store(ctx, calendar); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - Create - uc.tx.InTx: %w", err)
+	}
+
+	return calendar, nil
+}
+
+func (uc *UseCaseCalendars) GetByID(ctx context.Context, providerID entity.ProviderID) (*entity.Calendar, error) {
+	calendar, err := uc.repo.GetByID(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// Update replaces the calendar of a provider, calendars of archived providers do not change.
+func (uc *UseCaseCalendars) Update(ctx context.Context, calendar *entity.Calendar) (*entity.Calendar, error) {
+	normalizeCalendar(calendar)
+
+	if err := validateCalendar(calendar); err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - Update - validateCalendar: %w", err)
+	}
+
+	var updated *entity.Calendar
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.lockProvider(ctx, calendar.ProviderID); err != nil {
+			return fmt.Errorf("uc.lockProvider: %w", err)
+		}
+
+		var err error
+
+		updated, err = uc.repo.Update(ctx, calendar)
+		if err != nil {
+			return fmt.Errorf("uc.repo.Update: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - Update - uc.tx.InTx: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *UseCaseCalendars) Delete(ctx context.Context, providerID entity.ProviderID) error {
+	if err := uc.repo.Delete(ctx, providerID); err != nil {
+		return fmt.Errorf("UseCaseCalendars - Delete - uc.repo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// ImportHolidays reads the holidays from iCalendar events on dates local to the calendar time zone. They are
+// merged into the stored ones, imported names win on the same date, or replace them all. Calendars of archived
+// providers do not change.
+func (uc *UseCaseCalendars) ImportHolidays(ctx context.Context, providerID entity.ProviderID, ics []byte, replace bool) (*entity.Calendar, error) {
+	var calendar *entity.Calendar
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.lockProvider(ctx, providerID); err != nil {
+			return fmt.Errorf("uc.lockProvider: %w", err)
+		}
+
+		stored, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		loc, err := time.LoadLocation(stored.TimeZone)
+		if err != nil {
+			return fmt.Errorf("time.LoadLocation: %w", err)
+		}
+
+		holidays, err := parseHolidaysICS(ics, loc)
+		if err != nil {
+			return fmt.Errorf("parseHolidaysICS: %w", err)
+		}
+
+		if !replace {
+			holidays = append(stored.Holidays, holidays...)
+		}
+
+		stored.Holidays = holidays
+		normalizeCalendar(stored)
+
+		if err := validateCalendar(stored); err != nil {
+			return fmt.Errorf("validateCalendar: %w", err)
+		}
+
+		calendar, err = uc.repo.Update(ctx, stored)
+		if err != nil {
+			return fmt.Errorf("uc.repo.Update: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseCalendars - ImportHolidays - uc.tx.InTx: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// lockProvider keeps the provider from being archived or purged until the transaction ends and rejects
+// archived providers.
+func (uc *UseCaseCalendars) lockProvider(ctx context.Context, providerID entity.ProviderID) error {
+	provider, err := uc.providers.GetForUpdate(ctx, providerID)
+	if err != nil {
+		return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+	}
+
+	if provider.Archived() {
+		return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+	}
+
+	return nil
+}
+
+// normalizeCalendar orders hours and cut-offs by weekday and holidays by date, keeping the last one of a date.
+func normalizeCalendar(calendar *entity.Calendar) {
+	sortOpeningHours(calendar.Hours)
+	slices.SortStableFunc(calendar.CutOffs, func(a, b entity.CutOff) int {
+		return cmp.Compare(a.Weekday, b.Weekday)
+	})
+
+	for i := range calendar.Holidays {
+		calendar.Holidays[i].Name = strings.TrimSpace(calendar.Holidays[i].Name)
+	}
+
+	slices.SortStableFunc(calendar.Holidays, func(a, b entity.Holiday) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+
+	holidays := calendar.Holidays[:0]
+	for _, h := range calendar.Holidays {
+		if len(holidays) > 0 && holidays[len(holidays)-1].Date == h.Date {
+			holidays[len(holidays)-1] = h
+
+			continue
+		}
+
+		holidays = append(holidays, h)
+	}
+
+	calendar.Holidays = holidays
+}
+
+// validateCalendar expects the calendar normalized by normalizeCalendar.
+func validateCalendar(calendar *entity.Calendar) error {
+	if err := validateTimeZone(calendar.TimeZone); err != nil {
+		return err
+	}
+
+	if len(calendar.Hours) == 0 {
+		return fmt.Errorf("hours are empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if err := validateOpeningHours(calendar.Hours); err != nil {
+		return fmt.Errorf("hours: %w", err)
+	}
+
+	if err := validateCutOffs(calendar.CutOffs, calendar.Hours); err != nil {
+		return fmt.Errorf("cut_offs: %w", err)
+	}
+
+	if err := validateHolidays(calendar.Holidays); err != nil {
+		return fmt.Errorf("holidays: %w", err)
+	}
+
+	return nil
+}
+
+// validateCutOffs allows a cut-off per working weekday within its hours.
+func validateCutOffs(cutOffs []entity.CutOff, hours []entity.OpeningHours) error {
+	for i, c := range cutOffs {
+		if i > 0 && cutOffs[i-1].Weekday == c.Weekday {
+			return fmt.Errorf("%s has more than one: %w", c.Weekday, entity.ErrInvalidArgument)
+		}
+
+		opens, closes := entity.EndOfDay, entity.TimeOfDay(0)
+		for _, h := range hours {
+			if h.Weekday == c.Weekday {
+				opens, closes = min(opens, h.Opens), max(closes, h.Closes)
+			}
+		}
+
+		if opens > closes {
+			return fmt.Errorf("%s is not a working day: %w", c.Weekday, entity.ErrInvalidArgument)
+		}
+
+		if c.At < opens || c.At > closes {
+			return fmt.Errorf("%s %s is out of %s-%s: %w", c.Weekday, c.At, opens, closes, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func validateHolidays(holidays []entity.Holiday) error {
+	if len(holidays) > _maxCalendarHolidays {
+		return fmt.Errorf("more than %d: %w", _maxCalendarHolidays, entity.ErrInvalidArgument)
+	}
+
+	for _, h := range holidays {
+		if _, err := time.Parse(time.DateOnly, h.Date); err != nil {
+			return fmt.Errorf("date %q is not YYYY-MM-DD: %w", h.Date, entity.ErrInvalidArgument)
+		}
+
+		if utf8.RuneCountInString(h.Name) > _maxHolidayNameLength {
+			return fmt.Errorf("%s name is longer than %d: %w", h.Date, _maxHolidayNameLength, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
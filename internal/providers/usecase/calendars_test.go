@@ -0,0 +1,410 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func calendar() *entity.Calendar {
+	return &entity.Calendar{
+		ProviderID: "kuper",
+		TimeZone:   "Europe/Moscow",
+		Hours: []entity.OpeningHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60},
+			{Weekday: time.Tuesday, Opens: 9 * 60, Closes: 18 * 60},
+		},
+		CutOffs: []entity.CutOff{{Weekday: time.Monday, At: 14 * 60}},
+		Holidays: []entity.Holiday{
+			{Date: "2026-01-01", Name: "Новый год"},
+		},
+	}
+}
+
+// ics wraps the events into a calendar with CRLF line endings.
+func ics(events ...string) []byte {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//test//EN"}
+	for _, event := range events {
+		lines = append(lines, "BEGIN:VEVENT")
+		lines = append(lines, strings.Split(event, "\n")...)
+		lines = append(lines, "END:VEVENT")
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+func TestUseCaseCalendars_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockCalendarRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		modify  func(c *entity.Calendar)
+		check   func(t *testing.T, c *entity.Calendar)
+		wantErr error
+	}{
+		{
+			name:    "working week",
+			prepare: stored,
+		},
+		{
+			name:    "normalized",
+			prepare: stored,
+			modify: func(c *entity.Calendar) {
+				c.CutOffs = []entity.CutOff{{Weekday: time.Tuesday, At: 12 * 60}, {Weekday: time.Monday, At: 14 * 60}}
+				c.Holidays = []entity.Holiday{
+					{Date: "2026-05-09", Name: " День Победы "},
+					{Date: "2026-01-01", Name: "Новый год"},
+					{Date: "2026-05-09"},
+				}
+			},
+			check: func(t *testing.T, c *entity.Calendar) {
+				t.Helper()
+
+				require.Equal(t, []entity.CutOff{{Weekday: time.Monday, At: 14 * 60}, {Weekday: time.Tuesday, At: 12 * 60}}, c.CutOffs)
+				require.Equal(t, []entity.Holiday{{Date: "2026-01-01", Name: "Новый год"}, {Date: "2026-05-09"}}, c.Holidays)
+			},
+		},
+		{
+			name:    "cut-off at closing",
+			prepare: stored,
+			modify:  func(c *entity.Calendar) { c.CutOffs = []entity.CutOff{{Weekday: time.Monday, At: 18 * 60}} },
+		},
+		{
+			name:    "error - time zone is not IANA",
+			modify:  func(c *entity.Calendar) { c.TimeZone = "MSK" },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - no hours",
+			modify:  func(c *entity.Calendar) { c.Hours = nil },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - cut-off on a day off",
+			modify:  func(c *entity.Calendar) { c.CutOffs = []entity.CutOff{{Weekday: time.Sunday, At: 12 * 60}} },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - cut-off after closing",
+			modify:  func(c *entity.Calendar) { c.CutOffs = []entity.CutOff{{Weekday: time.Monday, At: 19 * 60}} },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - two cut-offs a day",
+			modify: func(c *entity.Calendar) {
+				c.CutOffs = []entity.CutOff{{Weekday: time.Monday, At: 12 * 60}, {Weekday: time.Monday, At: 14 * 60}}
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - holiday date",
+			modify:  func(c *entity.Calendar) { c.Holidays = []entity.Holiday{{Date: "01.01.2026"}} },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - calendar already exists",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExists)
+			},
+			wantErr: entity.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockCalendarRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			c := calendar()
+			if tt.modify != nil {
+				tt.modify(c)
+			}
+
+			uc := usecase.NewUseCaseCalendars(f.repo, f.providers, f.tx)
+
+			res, err := uc.Create(context.Background(), c)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+
+			require.Equal(t, c, res)
+			if tt.check != nil {
+				tt.check(t, res)
+			}
+		})
+	}
+}
+
+func TestUseCaseCalendars_Update(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockCalendarRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		wantErr error
+	}{
+		{
+			name: "updated",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *entity.Calendar) (*entity.Calendar, error) {
+					return c, nil
+				})
+			},
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - calendar not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, entity.ErrNotFound)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockCalendarRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			tt.prepare(&f)
+
+			uc := usecase.NewUseCaseCalendars(f.repo, f.providers, f.tx)
+
+			c := calendar()
+
+			res, err := uc.Update(context.Background(), c)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+
+			require.Equal(t, c, res)
+		})
+	}
+}
+
+func TestUseCaseCalendars_ImportHolidays(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockCalendarRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	archivedAt := time.Now()
+
+	locked := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+	}
+
+	// updated returns the calendar as it is given to the repo.
+	updated := func(f *fields) {
+		locked(f)
+		f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(calendar(), nil)
+		f.repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *entity.Calendar) (*entity.Calendar, error) {
+			return c, nil
+		})
+	}
+
+	tests := []struct {
+		name         string
+		ics          []byte
+		replace      bool
+		prepare      func(f *fields)
+		wantHolidays []entity.Holiday
+		wantErr      error
+	}{
+		{
+			name: "merged, imported names win",
+			ics: ics(
+				"DTSTART;VALUE=DATE:20260101\nDTEND;VALUE=DATE:20260103\nSUMMARY:Новогодние каникулы",
+				"DTSTART;VALUE=DATE:20260309\nSUMMARY:Выходной\\, перенос",
+			),
+			prepare: updated,
+			wantHolidays: []entity.Holiday{
+				{Date: "2026-01-01", Name: "Новогодние каникулы"},
+				{Date: "2026-01-02", Name: "Новогодние каникулы"},
+				{Date: "2026-03-09", Name: "Выходной, перенос"},
+			},
+		},
+		{
+			name:         "replaced",
+			ics:          ics("DTSTART;VALUE=DATE:20260612\nSUMMARY:День России"),
+			replace:      true,
+			prepare:      updated,
+			wantHolidays: []entity.Holiday{{Date: "2026-06-12", Name: "День России"}},
+		},
+		{
+			name: "date-times are local to the calendar",
+			ics: ics(
+				// 23:00 UTC is already the next day in Moscow.
+				"DTSTART:20260611T230000Z\nDTEND:20260612T200000Z\nSUMMARY:День\n  России",
+				"DTSTART;TZID=Asia/Vladivostok:20261104T000000\nSUMMARY:День народного единства",
+			),
+			replace: true,
+			prepare: updated,
+			wantHolidays: []entity.Holiday{
+				{Date: "2026-06-12", Name: "День России"},
+				{Date: "2026-11-03", Name: "День народного единства"},
+			},
+		},
+		{
+			name: "cancelled events and alarms are skipped",
+			ics: ics(
+				"DTSTART;VALUE=DATE:20260223\nSTATUS:CANCELLED",
+				"DTSTART;VALUE=DATE:20260308\nSUMMARY:8 марта\nBEGIN:VALARM\nSUMMARY:Напоминание\nEND:VALARM",
+			),
+			replace:      true,
+			prepare:      updated,
+			wantHolidays: []entity.Holiday{{Date: "2026-03-08", Name: "8 марта"}},
+		},
+		{
+			name: "error - recurring event",
+			ics:  ics("DTSTART;VALUE=DATE:20260101\nRRULE:FREQ=YEARLY"),
+			prepare: func(f *fields) {
+				locked(f)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(calendar(), nil)
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - not a calendar",
+			ics:  []byte("provider_id,date\nkuper,2026-01-01\n"),
+			prepare: func(f *fields) {
+				locked(f)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(calendar(), nil)
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider archived",
+			ics:  ics(),
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - calendar not found",
+			ics:  ics(),
+			prepare: func(f *fields) {
+				locked(f)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(nil, entity.ErrNotFound)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockCalendarRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseCalendars(f.repo, f.providers, f.tx)
+
+			res, err := uc.ImportHolidays(context.Background(), "kuper", tt.ics, tt.replace)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+
+			require.Equal(t, tt.wantHolidays, res.Holidays)
+		})
+	}
+}
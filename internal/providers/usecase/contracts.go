@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 )
@@ -11,8 +12,131 @@ import (
 type (
 	Provider interface {
 		Create(context.Context, *entity.Provider) (entity.ProviderID, error)
-		ListAll(context.Context) ([]*entity.Provider, error)
-		Update(context.Context, entity.ProviderID, *entity.Provider) (*entity.Provider, error)
+		GetByID(context.Context, entity.ProviderID) (*entity.Provider, error)
+		ListAll(context.Context, entity.ProviderListParams) (*entity.ProviderPage, error)
+		// Update applies masked fields, a non-zero provider.Version makes it conditional on the stored version.
+		Update(context.Context, entity.ProviderID, *entity.Provider, entity.ProviderMask) (*entity.Provider, error)
+		// Delete archives the provider, a non-zero version makes it conditional on the stored version.
+		Delete(context.Context, entity.ProviderID, int64) error
+		Restore(context.Context, entity.ProviderID) (*entity.Provider, error)
+		// Purge removes the provider for good, archived or not.
+		Purge(context.Context, entity.ProviderID) error
+		Activate(context.Context, entity.ProviderID) (*entity.Provider, error)
+		Suspend(ctx context.Context, id entity.ProviderID, reason string) (*entity.Provider, error)
+		Terminate(ctx context.Context, id entity.ProviderID, reason string) (*entity.Provider, error)
+		// History lists recorded changes of the provider, newest first. It outlives purged providers.
+		History(context.Context, entity.ProviderID, entity.HistoryListParams) (*entity.HistoryPage, error)
+		// Import upserts providers all at once, a dry run only reports what would happen.
+		Import(ctx context.Context, rows []entity.ImportRow, dryRun bool) (*entity.ImportReport, error)
+		// Export streams matching providers to fn, ordered by ID. An error returned by fn stops the export.
+		Export(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error
+		// Search finds non-archived providers by name, display names or legal name, best matches first.
+		Search(context.Context, entity.ProviderSearchParams) ([]*entity.ProviderSearchHit, error)
+	}
+
+	Zone interface {
+		// Create adds a zone to a non-archived provider.
+		Create(context.Context, *entity.Zone) (entity.ZoneID, error)
+		GetByID(context.Context, entity.ProviderID, entity.ZoneID) (*entity.Zone, error)
+		ListAll(context.Context, entity.ZoneListParams) (*entity.ZonePage, error)
+		// Update applies masked fields, a non-zero zone.Version makes it conditional on the stored version.
+		Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, zone *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error)
+		// Delete removes the zone, a non-zero version makes it conditional on the stored version.
+		Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error
+	}
+
+	PickupPoint interface {
+		// Create adds a pickup point to a non-archived provider.
+		Create(context.Context, *entity.PickupPoint) (*entity.PickupPoint, error)
+		GetByID(context.Context, entity.ProviderID, entity.PickupPointID) (*entity.PickupPoint, error)
+		ListAll(context.Context, entity.PickupPointListParams) (*entity.PickupPointPage, error)
+		// Update applies masked fields, a non-zero point.Version makes it conditional on the stored version.
+		Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, point *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error)
+		// Delete removes the point, a non-zero version makes it conditional on the stored version.
+		Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error
+	}
+
+	Tariff interface {
+		// Create adds a tariff with its first revision to a non-archived provider.
+		Create(context.Context, *entity.Tariff) (*entity.Tariff, error)
+		GetByID(context.Context, entity.ProviderID, entity.TariffID) (*entity.Tariff, error)
+		ListAll(context.Context, entity.TariffListParams) (*entity.TariffPage, error)
+		Delete(context.Context, entity.ProviderID, entity.TariffID) error
+		// CreateRevision schedules a revision not overlapping the others.
+		CreateRevision(context.Context, *entity.TariffRevision) (*entity.TariffRevision, error)
+		ListRevisions(context.Context, entity.ProviderID, entity.TariffID) ([]*entity.TariffRevision, error)
+		// Evaluate prices the shipment by the revision in effect at the time without storing anything.
+		Evaluate(context.Context, entity.ProviderID, entity.TariffID, entity.Shipment, time.Time) (*entity.PriceBreakdown, error)
+	}
+
+	Quote interface {
+		// Quote prices the shipment by the tariffs of every active provider delivering to its destination
+		// as of the time.
+		Quote(context.Context, entity.Shipment, time.Time) (*entity.Quote, error)
+	}
+
+	Coverage interface {
+		// Lookup finds active providers with a zone containing the position.
+		Lookup(context.Context, entity.Position) ([]*entity.CoverageMatch, error)
+	}
+
+	PickupPointSearch interface {
+		// Nearby finds the pickup points of active providers closest to a position, nearest first.
+		Nearby(context.Context, entity.PickupPointNearbyQuery) ([]*entity.PickupPointDistance, error)
+	}
+
+	Slot interface {
+		// Create adds a slot to a zone of a non-archived provider.
+		Create(context.Context, *entity.Slot) (*entity.Slot, error)
+		GetByID(context.Context, entity.ProviderID, entity.SlotID) (*entity.Slot, error)
+		ListAll(context.Context, entity.SlotListParams) (*entity.SlotPage, error)
+		// Close stops bookings of the slot, it is idempotent.
+		Close(context.Context, entity.ProviderID, entity.SlotID) (*entity.Slot, error)
+		// Availability lists bookable slots of a zone, ordered by start.
+		Availability(context.Context, entity.AvailabilityParams) ([]*entity.Slot, error)
+		// Hold takes capacity of an open slot of an active provider until the hold is confirmed or expires.
+		Hold(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID, quantity int) (*entity.SlotHold, error)
+		// Confirm turns an unexpired hold into a booking, it is idempotent.
+		Confirm(context.Context, entity.ProviderID, entity.HoldID) (*entity.SlotHold, error)
+		// Release gives the capacity of a hold or a booking back, it is idempotent.
+		Release(context.Context, entity.ProviderID, entity.HoldID) (*entity.SlotHold, error)
+	}
+
+	SlotTemplate interface {
+		// Create adds an inactive template to a zone of a non-archived provider.
+		Create(context.Context, *entity.SlotTemplate) (*entity.SlotTemplate, error)
+		GetByID(context.Context, entity.ProviderID, entity.SlotTemplateID) (*entity.SlotTemplate, error)
+		ListAll(context.Context, entity.SlotTemplateListParams) (*entity.SlotTemplatePage, error)
+		Delete(context.Context, entity.ProviderID, entity.SlotTemplateID) error
+		// Activate starts generating slots from the template, Deactivate stops it.
+		Activate(context.Context, entity.ProviderID, entity.SlotTemplateID) (*entity.SlotTemplate, error)
+		Deactivate(context.Context, entity.ProviderID, entity.SlotTemplateID) (*entity.SlotTemplate, error)
+		// Preview returns the slots the template would generate within the period without storing them.
+		Preview(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, from, to time.Time) ([]*entity.Slot, error)
+	}
+
+	Calendar interface {
+		// Create sets up the calendar of a non-archived provider, a provider has at most one.
+		Create(context.Context, *entity.Calendar) (*entity.Calendar, error)
+		GetByID(context.Context, entity.ProviderID) (*entity.Calendar, error)
+		// Update replaces the calendar as a whole.
+		Update(context.Context, *entity.Calendar) (*entity.Calendar, error)
 		Delete(context.Context, entity.ProviderID) error
+		// ImportHolidays adds the events of an iCalendar file to the holidays, or replaces them.
+		ImportHolidays(ctx context.Context, providerID entity.ProviderID, ics []byte, replace bool) (*entity.Calendar, error)
+	}
+
+	// UseCases groups the usecases served by the transports, so they are handed over as one value.
+	UseCases struct {
+		Providers         Provider
+		Zones             Zone
+		Coverage          Coverage
+		Slots             Slot
+		SlotTemplates     SlotTemplate
+		PickupPoints      PickupPoint
+		PickupPointSearch PickupPointSearch
+		Tariffs           Tariff
+		Quotes            Quote
+		Calendars         Calendar
 	}
 )
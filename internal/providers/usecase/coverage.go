@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+// UseCaseCoverage answers lookups from an in-memory index of the coverage areas, Refresh keeps it up to date.
+type UseCaseCoverage struct {
+	repo  repo.CoverageRepo
+	index revisionSnapshot[*spatialIndex]
+}
+
+func NewUseCaseCoverage(r repo.CoverageRepo) *UseCaseCoverage {
+	return &UseCaseCoverage{
+		repo: r,
+	}
+}
+
+// Refresh reloads the index when the coverage revision has moved since the last load.
+func (uc *UseCaseCoverage) Refresh(ctx context.Context) error {
+	err := uc.index.refresh(ctx, uc.repo.Revision, func(ctx context.Context) (*spatialIndex, error) {
+		areas, err := uc.repo.GetAreas(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("uc.repo.GetAreas: %w", err)
+		}
+
+		return newSpatialIndex(areas), nil
+	})
+	if err != nil {
+		return fmt.Errorf("UseCaseCoverage - Refresh - uc.index.refresh: %w", err)
+	}
+
+	return nil
+}
+
+// Lookup returns providers with a zone containing the position ordered by ID, their zones are ordered by ID too.
+func (uc *UseCaseCoverage) Lookup(_ context.Context, position entity.Position) ([]*entity.CoverageMatch, error) {
+	if err := validatePosition(position); err != nil {
+		return nil, fmt.Errorf("UseCaseCoverage - Lookup - validatePosition: %w", err)
+	}
+
+	index, ok := uc.index.load()
+	if !ok {
+		return nil, fmt.Errorf("UseCaseCoverage - Lookup - coverage is not loaded yet: %w", entity.ErrInternalServerError)
+	}
+
+	areas := index.containing(position)
+
+	slices.SortFunc(areas, func(a, b *entity.CoverageArea) int {
+		if c := strings.Compare(string(a.ProviderID), string(b.ProviderID)); c != 0 {
+			return c
+		}
+
+		return strings.Compare(string(a.ZoneID), string(b.ZoneID))
+	})
+
+	var matches []*entity.CoverageMatch
+
+	for _, area := range areas {
+		if len(matches) == 0 || matches[len(matches)-1].ProviderID != area.ProviderID {
+			matches = append(matches, &entity.CoverageMatch{ProviderID: area.ProviderID})
+		}
+
+		last := matches[len(matches)-1]
+		last.ZoneIDs = append(last.ZoneIDs, area.ZoneID)
+	}
+
+	return matches, nil
+}
+
+func validatePosition(p entity.Position) error {
+	// Negated comparisons reject NaN too.
+	if !(p.Lat() >= -90 && p.Lat() <= 90) {
+		return fmt.Errorf("latitude %v is out of bounds: %w", p.Lat(), entity.ErrInvalidArgument)
+	}
+
+	if !(p.Lon() >= -180 && p.Lon() <= 180) {
+		return fmt.Errorf("longitude %v is out of bounds: %w", p.Lon(), entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseCoverage_Lookup(t *testing.T) {
+	t.Parallel()
+
+	areas := []*entity.CoverageArea{
+		{ProviderID: "kuper", ZoneID: "msk", Geometry: polygon(square(37, 55, 1), square(37.4, 55.4, 0.2))},
+		{ProviderID: "kuper", ZoneID: "center", Geometry: polygon(square(37.5, 55.7, 0.1))},
+		{ProviderID: "dostavista", ZoneID: "msk", Geometry: polygon(square(37, 55, 1))},
+		{ProviderID: "dostavista", ZoneID: "spb", Geometry: polygon(square(30, 59.8, 0.5))},
+	}
+
+	// Enough areas for a tree of several levels, none of them contains the positions looked up below.
+	for i := range 500 {
+		areas = append(areas, &entity.CoverageArea{
+			ProviderID: "filler",
+			ZoneID:     entity.ZoneID(fmt.Sprintf("z%d", i)),
+			Geometry:   polygon(square(float64(i%50)-100, float64(i/50)-50, 0.5)),
+		})
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockCoverageRepo(ctrl)
+	uc := usecase.NewUseCaseCoverage(r)
+
+	_, err := uc.Lookup(context.Background(), entity.Position{37.55, 55.75})
+	require.ErrorIs(t, err, entity.ErrInternalServerError, "lookup before the first load")
+
+	r.EXPECT().Revision(gomock.Any()).Return(int64(1), nil)
+	r.EXPECT().GetAreas(gomock.Any()).Return(areas, nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+
+	tests := []struct {
+		name     string
+		position entity.Position
+		want     []*entity.CoverageMatch
+		wantErr  error
+	}{
+		{
+			name:     "nested zones of a provider",
+			position: entity.Position{37.55, 55.75},
+			want: []*entity.CoverageMatch{
+				{ProviderID: "dostavista", ZoneIDs: []entity.ZoneID{"msk"}},
+				{ProviderID: "kuper", ZoneIDs: []entity.ZoneID{"center", "msk"}},
+			},
+		},
+		{
+			name:     "hole of a zone",
+			position: entity.Position{37.5, 55.5},
+			want: []*entity.CoverageMatch{
+				{ProviderID: "dostavista", ZoneIDs: []entity.ZoneID{"msk"}},
+			},
+		},
+		{
+			name:     "single provider",
+			position: entity.Position{30.3, 59.9},
+			want: []*entity.CoverageMatch{
+				{ProviderID: "dostavista", ZoneIDs: []entity.ZoneID{"spb"}},
+			},
+		},
+		{
+			name:     "nobody delivers",
+			position: entity.Position{0, 0},
+		},
+		{
+			name:     "error - latitude out of bounds",
+			position: entity.Position{37.55, 95},
+			wantErr:  entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := uc.Lookup(context.Background(), tt.position)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseCoverage_Refresh(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockCoverageRepo(ctrl)
+	uc := usecase.NewUseCaseCoverage(r)
+	center := entity.Position{37.55, 55.75}
+
+	r.EXPECT().Revision(gomock.Any()).Return(int64(1), nil)
+	r.EXPECT().GetAreas(gomock.Any()).Return([]*entity.CoverageArea{
+		{ProviderID: "kuper", ZoneID: "center", Geometry: polygon(square(37.5, 55.7, 0.1))},
+	}, nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+
+	// The same revision does not reload the areas.
+	r.EXPECT().Revision(gomock.Any()).Return(int64(1), nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+
+	r.EXPECT().Revision(gomock.Any()).Return(int64(2), nil)
+	r.EXPECT().GetAreas(gomock.Any()).Return(nil, nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+
+	matches, err := uc.Lookup(context.Background(), center)
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	// A failed reload keeps serving the last loaded index.
+	r.EXPECT().Revision(gomock.Any()).Return(int64(3), nil)
+	r.EXPECT().GetAreas(gomock.Any()).Return(nil, entity.ErrInternalServerError)
+	require.ErrorIs(t, uc.Refresh(context.Background()), entity.ErrInternalServerError)
+
+	_, err = uc.Lookup(context.Background(), center)
+	require.NoError(t, err)
+}
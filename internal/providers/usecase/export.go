@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+func (uc *UseCaseProviders) Export(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error {
+	if err := validateStatuses(f.Statuses); err != nil {
+		return fmt.Errorf("UseCaseProviders - Export - validateStatuses: %w", err)
+	}
+
+	if err := uc.repo.Each(ctx, f, fn); err != nil {
+		return fmt.Errorf("UseCaseProviders - Export - uc.repo.Each: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_Export(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx    context.Context
+		filter entity.ProviderFilter
+	}
+
+	kuper := &entity.Provider{ProviderID: entity.ProviderID("kuper"), Name: "kuper"}
+	dostavista := &entity.Provider{ProviderID: entity.ProviderID("dostavista"), Name: "dostavista"}
+	activeOnly := entity.ProviderFilter{Statuses: []entity.ProviderStatus{entity.ProviderStatusActive}}
+
+	tests := []struct {
+		name    string
+		prepare func(repo *mock_repo.MockProviderRepo)
+		args    args
+		want    []*entity.Provider
+		wantErr error
+	}{
+		{
+			name: "every provider is passed on",
+			prepare: func(repo *mock_repo.MockProviderRepo) {
+				repo.EXPECT().Each(context.Background(), activeOnly, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ entity.ProviderFilter, fn func(*entity.Provider) error) error {
+						for _, p := range []*entity.Provider{dostavista, kuper} {
+							if err := fn(p); err != nil {
+								return err
+							}
+						}
+
+						return nil
+					})
+			},
+			args: args{ctx: context.Background(), filter: activeOnly},
+			want: []*entity.Provider{dostavista, kuper},
+		},
+		{
+			name:    "error - unknown status",
+			args:    args{ctx: context.Background(), filter: entity.ProviderFilter{Statuses: []entity.ProviderStatus{"deleted"}}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - database not available",
+			prepare: func(repo *mock_repo.MockProviderRepo) {
+				repo.EXPECT().Each(context.Background(), entity.ProviderFilter{}, gomock.Any()).Return(entity.ErrInternalServerError)
+			},
+			args:    args{ctx: context.Background()},
+			wantErr: entity.ErrInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repo.NewMockProviderRepo(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(repo)
+			}
+
+			uc := usecase.NewUseCaseProviders(repo, mock_repo.NewMockAuditRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			var got []*entity.Provider
+
+			err := uc.Export(tt.args.ctx, tt.args.filter, func(p *entity.Provider) error {
+				got = append(got, p)
+
+				return nil
+			})
+
+			require.Equal(t, tt.want, got)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
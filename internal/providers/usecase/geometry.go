@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	// _maxGeometryPositions bounds the work of the intersection checks, city-sized areas fit with room to spare.
+	_maxGeometryPositions = 10000
+	_minRingPositions     = 4
+)
+
+// normalizeGeometry drops repeated consecutive positions, some editors emit them and they add nothing to the shape.
+func normalizeGeometry(g entity.Geometry) entity.Geometry {
+	normalized := entity.Geometry{Type: g.Type, Polygons: make([]entity.Polygon, len(g.Polygons))}
+
+	for i, polygon := range g.Polygons {
+		normalized.Polygons[i] = make(entity.Polygon, len(polygon))
+
+		for j, ring := range polygon {
+			normalized.Polygons[i][j] = slices.Compact(slices.Clone(ring))
+		}
+	}
+
+	return normalized
+}
+
+// validateGeometry checks the rules of RFC 7946 and the OGC simple features a zone relies on: coordinates are
+// within bounds, rings are closed and have an area, no ring crosses or touches itself or another ring, holes lie
+// within their exterior ring and polygons of a MultiPolygon do not overlap. Winding order is not enforced.
+func validateGeometry(g entity.Geometry) error {
+	switch g.Type {
+	case entity.GeometryPolygon:
+		if len(g.Polygons) != 1 {
+			return fmt.Errorf("Polygon has %d polygons: %w", len(g.Polygons), entity.ErrInvalidArgument)
+		}
+	case entity.GeometryMultiPolygon:
+		if len(g.Polygons) == 0 {
+			return fmt.Errorf("MultiPolygon has no polygons: %w", entity.ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("type %q, want Polygon or MultiPolygon: %w", g.Type, entity.ErrInvalidArgument)
+	}
+
+	var (
+		positions int
+		segments  []segment
+	)
+
+	for i, polygon := range g.Polygons {
+		if len(polygon) == 0 {
+			return fmt.Errorf("polygon %d has no rings: %w", i, entity.ErrInvalidArgument)
+		}
+
+		for j, ring := range polygon {
+			positions += len(ring)
+			if positions > _maxGeometryPositions {
+				return fmt.Errorf("more than %d positions: %w", _maxGeometryPositions, entity.ErrInvalidArgument)
+			}
+
+			if err := validateRing(ring); err != nil {
+				return fmt.Errorf("polygon %d ring %d: %w", i, j, err)
+			}
+
+			segments = appendSegments(segments, ring, ringRef{polygon: i, ring: j})
+		}
+	}
+
+	if a, b, ok := findIntersection(segments); ok {
+		if a.ring == b.ring {
+			return fmt.Errorf("polygon %d ring %d intersects itself: %w", a.ring.polygon, a.ring.ring, entity.ErrInvalidArgument)
+		}
+
+		return fmt.Errorf("polygon %d ring %d intersects polygon %d ring %d: %w",
+			a.ring.polygon, a.ring.ring, b.ring.polygon, b.ring.ring, entity.ErrInvalidArgument)
+	}
+
+	// Rings do not cross, so a single position tells on which side of another ring a whole ring lies.
+	for i, polygon := range g.Polygons {
+		for j, hole := range polygon[1:] {
+			if !ringContains(polygon[0], hole[0]) {
+				return fmt.Errorf("polygon %d hole %d lies outside of the exterior ring: %w", i, j+1, entity.ErrInvalidArgument)
+			}
+
+			for k, other := range polygon[1:] {
+				if k != j && ringContains(other, hole[0]) {
+					return fmt.Errorf("polygon %d hole %d lies within hole %d: %w", i, j+1, k+1, entity.ErrInvalidArgument)
+				}
+			}
+		}
+
+		for k, other := range g.Polygons {
+			if k != i && polygonContains(other, polygon[0][0]) {
+				return fmt.Errorf("polygon %d overlaps polygon %d: %w", i, k, entity.ErrInvalidArgument)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRing(ring entity.Ring) error {
+	if len(ring) < _minRingPositions {
+		return fmt.Errorf("%d positions, at least %d are required: %w", len(ring), _minRingPositions, entity.ErrInvalidArgument)
+	}
+
+	for k, p := range ring {
+		// Negated comparisons reject NaN too.
+		if !(p.Lon() >= -180 && p.Lon() <= 180) || !(p.Lat() >= -90 && p.Lat() <= 90) {
+			return fmt.Errorf("position %d %v is out of bounds: %w", k, p, entity.ErrInvalidArgument)
+		}
+	}
+
+	if ring[0] != ring[len(ring)-1] {
+		return fmt.Errorf("not closed, the first and the last positions differ: %w", entity.ErrInvalidArgument)
+	}
+
+	if ringArea(ring) == 0 {
+		return fmt.Errorf("has no area: %w", entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+type ringRef struct {
+	polygon int
+	ring    int
+}
+
+type segment struct {
+	a, b entity.Position
+	ring ringRef
+	// index is the position of the segment in its ring of count segments.
+	index int
+	count int
+}
+
+func appendSegments(segments []segment, ring entity.Ring, ref ringRef) []segment {
+	count := len(ring) - 1
+
+	for i := range count {
+		s := segment{a: ring[i], b: ring[i+1], ring: ref, index: i, count: count}
+		if s.b.Lon() < s.a.Lon() {
+			s.a, s.b = s.b, s.a
+		}
+
+		segments = append(segments, s)
+	}
+
+	return segments
+}
+
+// findIntersection reports a pair of intersecting segments other than neighbours of a ring meeting at their
+// common end. Segments are swept by longitude, so only the ones overlapping in longitude are compared.
+func findIntersection(segments []segment) (segment, segment, bool) {
+	slices.SortFunc(segments, func(x, y segment) int {
+		switch {
+		case x.a.Lon() < y.a.Lon():
+			return -1
+		case x.a.Lon() > y.a.Lon():
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for i, s := range segments {
+		for _, t := range segments[i+1:] {
+			if t.a.Lon() > s.b.Lon() {
+				break
+			}
+
+			if adjacent(s, t) {
+				if overlapAtCommonEnd(s, t) {
+					return s, t, true
+				}
+
+				continue
+			}
+
+			if intersect(s, t) {
+				return s, t, true
+			}
+		}
+	}
+
+	return segment{}, segment{}, false
+}
+
+func adjacent(s, t segment) bool {
+	if s.ring != t.ring {
+		return false
+	}
+
+	d := s.index - t.index
+	if d < 0 {
+		d = -d
+	}
+
+	return d == 1 || d == s.count-1
+}
+
+// overlapAtCommonEnd tells whether neighbouring segments fold back onto each other instead of only sharing an end.
+func overlapAtCommonEnd(s, t segment) bool {
+	return onSegment(s, t.a) && t.a != s.a && t.a != s.b ||
+		onSegment(s, t.b) && t.b != s.a && t.b != s.b ||
+		onSegment(t, s.a) && s.a != t.a && s.a != t.b ||
+		onSegment(t, s.b) && s.b != t.a && s.b != t.b
+}
+
+func intersect(s, t segment) bool {
+	d1 := orientation(t.a, t.b, s.a)
+	d2 := orientation(t.a, t.b, s.b)
+	d3 := orientation(s.a, s.b, t.a)
+	d4 := orientation(s.a, s.b, t.b)
+
+	if (d1 > 0 && d2 < 0 || d1 < 0 && d2 > 0) && (d3 > 0 && d4 < 0 || d3 < 0 && d4 > 0) {
+		return true
+	}
+
+	return onSegment(t, s.a) || onSegment(t, s.b) || onSegment(s, t.a) || onSegment(s, t.b)
+}
+
+// orientation is positive when r lies to the left of the line from p to q, negative to the right, zero on it.
+func orientation(p, q, r entity.Position) float64 {
+	return (q.Lon()-p.Lon())*(r.Lat()-p.Lat()) - (q.Lat()-p.Lat())*(r.Lon()-p.Lon())
+}
+
+func onSegment(s segment, p entity.Position) bool {
+	return orientation(s.a, s.b, p) == 0 &&
+		min(s.a.Lon(), s.b.Lon()) <= p.Lon() && p.Lon() <= max(s.a.Lon(), s.b.Lon()) &&
+		min(s.a.Lat(), s.b.Lat()) <= p.Lat() && p.Lat() <= max(s.a.Lat(), s.b.Lat())
+}
+
+// ringArea is twice the signed area of the ring by the shoelace formula.
+func ringArea(ring entity.Ring) float64 {
+	var area float64
+
+	for i := range len(ring) - 1 {
+		area += ring[i].Lon()*ring[i+1].Lat() - ring[i+1].Lon()*ring[i].Lat()
+	}
+
+	return area
+}
+
+// ringContains is the even-odd ray casting test, positions on the boundary may go either way.
+func ringContains(ring entity.Ring, p entity.Position) bool {
+	inside := false
+
+	for i := range len(ring) - 1 {
+		a, b := ring[i], ring[i+1]
+		if (a.Lat() > p.Lat()) != (b.Lat() > p.Lat()) &&
+			p.Lon() < a.Lon()+(p.Lat()-a.Lat())*(b.Lon()-a.Lon())/(b.Lat()-a.Lat()) {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// polygonContains tells whether the position lies within the exterior ring and outside of all holes.
+func polygonContains(polygon entity.Polygon, p entity.Position) bool {
+	if len(polygon) == 0 || !ringContains(polygon[0], p) {
+		return false
+	}
+
+	for _, hole := range polygon[1:] {
+		if ringContains(hole, p) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,293 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	_maxICSSize = 1 << 20
+	// _maxHolidayEventDays bounds a single event, holiday lists have no month-long ones.
+	_maxHolidayEventDays = 31
+)
+
+// icsLine is an unfolded content line, line is the number of its first physical line.
+type icsLine struct {
+	line   int
+	name   string
+	params map[string]string
+	value  string
+}
+
+// icsEvent collects the properties of a VEVENT holidays are made of.
+type icsEvent struct {
+	line      int
+	start     *icsLine
+	end       *icsLine
+	summary   string
+	cancelled bool
+}
+
+// parseHolidaysICS turns the events of an iCalendar file into holidays on dates local to loc, an event spanning
+// several days gives a holiday for each. Cancelled events are skipped. Recurring events are rejected, holiday
+// lists enumerate their dates. Date-times with a TZID unknown to the server are taken as local to loc.
+func parseHolidaysICS(data []byte, loc *time.Location) ([]entity.Holiday, error) {
+	if len(data) > _maxICSSize {
+		return nil, fmt.Errorf("ics is larger than %d bytes: %w", _maxICSSize, entity.ErrInvalidArgument)
+	}
+
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("ics is not UTF-8: %w", entity.ErrInvalidArgument)
+	}
+
+	lines, err := unfoldICS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 || lines[0].name != "BEGIN" || !strings.EqualFold(lines[0].value, "VCALENDAR") {
+		return nil, fmt.Errorf("ics does not start with BEGIN:VCALENDAR: %w", entity.ErrInvalidArgument)
+	}
+
+	var (
+		holidays   []entity.Holiday
+		components []string
+		event      *icsEvent
+	)
+
+	for _, l := range lines {
+		switch l.name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(l.value))
+			if len(components) == 2 && components[1] == "VEVENT" {
+				event = &icsEvent{line: l.line}
+			}
+
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(l.value) {
+				return nil, fmt.Errorf("ics line %d: END:%s does not close a component: %w", l.line, l.value, entity.ErrInvalidArgument)
+			}
+
+			components = components[:len(components)-1]
+			if event != nil && len(components) == 1 {
+				days, err := event.holidays(loc)
+				if err != nil {
+					return nil, err
+				}
+
+				holidays = append(holidays, days...)
+				event = nil
+			}
+
+			continue
+		}
+
+		// Properties of nested components, alarms for example, are not about the event.
+		if event == nil || len(components) != 2 {
+			continue
+		}
+
+		switch l.name {
+		case "DTSTART":
+			event.start = &l
+		case "DTEND":
+			event.end = &l
+		case "SUMMARY":
+			event.summary = unescapeICSText(l.value)
+		case "STATUS":
+			event.cancelled = strings.EqualFold(l.value, "CANCELLED")
+		case "RRULE", "RDATE":
+			return nil, fmt.Errorf("ics line %d: recurring events are not supported: %w", l.line, entity.ErrInvalidArgument)
+		}
+	}
+
+	if len(components) != 0 {
+		return nil, fmt.Errorf("ics ends within %s: %w", components[len(components)-1], entity.ErrInvalidArgument)
+	}
+
+	return holidays, nil
+}
+
+// unfoldICS joins folded physical lines and splits content lines into their parts.
+func unfoldICS(data []byte) ([]icsLine, error) {
+	var (
+		lines   []icsLine
+		current strings.Builder
+		start   int
+	)
+
+	flush := func() error {
+		if current.Len() == 0 {
+			return nil
+		}
+
+		l, err := parseICSLine(current.String())
+		if err != nil {
+			return fmt.Errorf("ics line %d: %w", start, err)
+		}
+
+		l.line = start
+		lines = append(lines, l)
+		current.Reset()
+
+		return nil
+	}
+
+	for i, raw := range bytes.Split(data, []byte("\n")) {
+		raw = bytes.TrimSuffix(raw, []byte("\r"))
+		if len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t') && current.Len() > 0 {
+			current.Write(raw[1:])
+
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+
+		start = i + 1
+		current.Write(raw)
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// parseICSLine splits "NAME;PARAM=value:VALUE", colons within quoted parameter values do not end the name.
+func parseICSLine(s string) (icsLine, error) {
+	quoted := false
+	colon := -1
+
+	for i, r := range s {
+		if r == '"' {
+			quoted = !quoted
+		}
+
+		if r == ':' && !quoted {
+			colon = i
+
+			break
+		}
+	}
+
+	if colon < 0 {
+		return icsLine{}, fmt.Errorf("no value: %w", entity.ErrInvalidArgument)
+	}
+
+	parts := strings.Split(s[:colon], ";")
+	l := icsLine{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  s[colon+1:],
+	}
+
+	for _, p := range parts[1:] {
+		key, value, _ := strings.Cut(p, "=")
+		l.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+
+	return l, nil
+}
+
+// holidays lists the local dates of the event, DTEND is exclusive.
+func (e *icsEvent) holidays(loc *time.Location) ([]entity.Holiday, error) {
+	if e.cancelled {
+		return nil, nil
+	}
+
+	if e.start == nil {
+		return nil, fmt.Errorf("ics line %d: event without DTSTART: %w", e.line, entity.ErrInvalidArgument)
+	}
+
+	start, allDay, err := parseICSTime(e.start, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	last := start
+	if e.end != nil {
+		end, _, err := parseICSTime(e.end, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		// All-day events end on the midnight after their last day, timed ones on their last instant.
+		if allDay {
+			last = end.AddDate(0, 0, -1)
+		} else {
+			last = end.Add(-time.Nanosecond)
+		}
+	}
+
+	first := dateOf(start)
+	last = dateOf(last)
+
+	name := truncateRunes(e.summary, _maxHolidayNameLength)
+
+	var holidays []entity.Holiday
+	for day := first; !day.After(last) || len(holidays) == 0; day = day.AddDate(0, 0, 1) {
+		if len(holidays) == _maxHolidayEventDays {
+			return nil, fmt.Errorf("ics line %d: event is longer than %d days: %w", e.line, _maxHolidayEventDays, entity.ErrInvalidArgument)
+		}
+
+		holidays = append(holidays, entity.Holiday{Date: day.Format(time.DateOnly), Name: name})
+	}
+
+	return holidays, nil
+}
+
+// parseICSTime reads a DATE, a UTC or a TZID date-time and a floating date-time taken as local to loc.
+func parseICSTime(l *icsLine, loc *time.Location) (time.Time, bool, error) {
+	value := strings.TrimSpace(l.value)
+
+	if strings.EqualFold(l.params["VALUE"], "DATE") || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("ics line %d: %s %q is not a date: %w", l.line, l.name, value, entity.ErrInvalidArgument)
+		}
+
+		return t, true, nil
+	}
+
+	tz := loc
+	if strings.HasSuffix(value, "Z") {
+		tz = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	} else if name := l.params["TZID"]; name != "" {
+		if named, err := time.LoadLocation(name); err == nil {
+			tz = named
+		}
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, tz)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("ics line %d: %s %q is not a date-time: %w", l.line, l.name, l.value, entity.ErrInvalidArgument)
+	}
+
+	return t.In(loc), false, nil
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func unescapeICSText(s string) string {
+	return strings.TrimSpace(strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s))
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	return strings.TrimSpace(string([]rune(s)[:n]))
+}
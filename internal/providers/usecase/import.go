@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+// MaxImportRows bounds an import, transports stop reading rows beyond it.
+const MaxImportRows = 10_000
+
+const (
+	_maxProviderIDLength   = 32
+	_maxProviderNameLength = 32
+)
+
+// errRollback discards the import transaction on a dry run or when some rows failed.
+var errRollback = errors.New("import rolled back")
+
+// Import validates every row and upserts the valid ones in a single transaction. The transaction is committed
+// only when all rows succeed and it is not a dry run, the report describes each row either way.
+func (uc *UseCaseProviders) Import(ctx context.Context, rows []entity.ImportRow, dryRun bool) (*entity.ImportReport, error) {
+	if len(rows) > MaxImportRows {
+		return nil, fmt.Errorf("UseCaseProviders - Import - more than %d rows: %w", MaxImportRows, entity.ErrInvalidArgument)
+	}
+
+	report := &entity.ImportReport{
+		DryRun: dryRun,
+		Rows:   make([]entity.ImportRowResult, len(rows)),
+	}
+
+	seen := make(map[entity.ProviderID]int, len(rows))
+
+	for i, row := range rows {
+		result := &report.Rows[i]
+		result.Line = row.Line
+
+		if row.Err != nil {
+			result.Action, result.Error = entity.ImportActionFailed, row.Err.Error()
+
+			continue
+		}
+
+		result.ProviderID = row.Provider.ProviderID
+
+		if err := validateImportedProvider(row.Provider); err != nil {
+			result.Action, result.Error = entity.ImportActionFailed, err.Error()
+
+			continue
+		}
+
+		if line, ok := seen[row.Provider.ProviderID]; ok {
+			result.Action, result.Error = entity.ImportActionFailed, fmt.Sprintf("duplicates line %d", line)
+
+			continue
+		}
+
+		seen[row.Provider.ProviderID] = row.Line
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		for i, row := range rows {
+			result := &report.Rows[i]
+			if result.Action == entity.ImportActionFailed {
+				continue
+			}
+
+			if err := uc.upsert(ctx, row.Provider, result); err != nil {
+				return err
+			}
+		}
+
+		for _, result := range report.Rows {
+			if result.Action == entity.ImportActionFailed {
+				return errRollback
+			}
+		}
+
+		if dryRun {
+			return errRollback
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errRollback) {
+		return nil, fmt.Errorf("UseCaseProviders - Import - uc.tx.InTx: %w", err)
+	}
+
+	for _, result := range report.Rows {
+		switch result.Action {
+		case entity.ImportActionCreated:
+			report.Created++
+		case entity.ImportActionUpdated:
+			report.Updated++
+		case entity.ImportActionUnchanged:
+			report.Unchanged++
+		case entity.ImportActionFailed:
+			report.Failed++
+		}
+	}
+
+	report.Applied = err == nil
+
+	return report, nil
+}
+
+// upsert creates the provider or updates the fields the row sets, and fills the result in. Only unexpected
+// failures are returned, as they leave the transaction unusable.
+func (uc *UseCaseProviders) upsert(ctx context.Context, provider *entity.Provider, result *entity.ImportRowResult) error {
+	old, err := uc.repo.GetForUpdate(ctx, provider.ProviderID)
+
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		if err := uc.repo.Store(ctx, provider); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		created, err := uc.repo.GetByID(ctx, provider.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetByID: %w", err)
+		}
+
+		result.Action = entity.ImportActionCreated
+
+		return uc.record(ctx, entity.AuditActionCreate, nil, created)
+	case err != nil:
+		return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+	case old.Archived():
+		result.Action, result.Error = entity.ImportActionFailed, "provider is archived, restore it first"
+
+		return nil
+	}
+
+	fields := importedFields(provider)
+	if sameProfile(old, provider, fields) {
+		result.Action = entity.ImportActionUnchanged
+
+		return nil
+	}
+
+	updated, err := uc.repo.Update(ctx, provider.ProviderID, provider, fields)
+	if err != nil {
+		return fmt.Errorf("uc.repo.Update: %w", err)
+	}
+
+	result.Action = entity.ImportActionUpdated
+
+	return uc.record(ctx, entity.AuditActionUpdate, old, updated)
+}
+
+// validateImportedProvider also rekeys display names by canonical language tags.
+func validateImportedProvider(provider *entity.Provider) error {
+	displayNames, err := normalizeDisplayNames(provider.DisplayNames)
+	if err != nil {
+		return err
+	}
+
+	provider.DisplayNames = displayNames
+
+	switch {
+	case provider.ProviderID == "":
+		return fmt.Errorf("provider_id is empty: %w", entity.ErrInvalidArgument)
+	case len(provider.ProviderID) > _maxProviderIDLength:
+		return fmt.Errorf("provider_id is longer than %d: %w", _maxProviderIDLength, entity.ErrInvalidArgument)
+	case len(provider.Name) > _maxProviderNameLength:
+		return fmt.Errorf("name is longer than %d: %w", _maxProviderNameLength, entity.ErrInvalidArgument)
+	}
+
+	return validateProviderUpdate(provider, updatableProviderFields())
+}
+
+// importedFields lists the fields the imported provider sets. Fields left empty keep their stored values, so a
+// column missing from or blank in the file never wipes anything. The name may not be empty, it is always set.
+func importedFields(p *entity.Provider) entity.ProviderMask {
+	fields := entity.ProviderMask{entity.ProviderFieldName}
+
+	set := []struct {
+		field entity.ProviderField
+		ok    bool
+	}{
+		{entity.ProviderFieldLegalEntity, p.LegalEntity != entity.LegalEntity{}},
+		{entity.ProviderFieldContacts, len(p.Contacts) > 0},
+		{entity.ProviderFieldWebsite, p.Website != ""},
+		{entity.ProviderFieldCountries, len(p.Countries) > 0},
+		{entity.ProviderFieldCapabilities, p.Capabilities != entity.Capabilities{}},
+		{entity.ProviderFieldDisplayNames, len(p.DisplayNames) > 0},
+		{entity.ProviderFieldLabels, len(p.Labels) > 0},
+	}
+
+	for _, s := range set {
+		if s.ok {
+			fields = append(fields, s.field)
+		}
+	}
+
+	return fields
+}
+
+// sameProfile reports whether importing the fields of b over a would change nothing.
+func sameProfile(a, b *entity.Provider, fields entity.ProviderMask) bool {
+	for _, field := range fields {
+		var same bool
+
+		switch field {
+		case entity.ProviderFieldName:
+			same = a.Name == b.Name
+		case entity.ProviderFieldLegalEntity:
+			same = a.LegalEntity == b.LegalEntity
+		case entity.ProviderFieldContacts:
+			same = slices.Equal(a.Contacts, b.Contacts)
+		case entity.ProviderFieldWebsite:
+			same = a.Website == b.Website
+		case entity.ProviderFieldCountries:
+			same = slices.Equal(a.Countries, b.Countries)
+		case entity.ProviderFieldCapabilities:
+			same = a.Capabilities == b.Capabilities
+		case entity.ProviderFieldDisplayNames:
+			same = maps.Equal(a.DisplayNames, b.DisplayNames)
+		case entity.ProviderFieldLabels:
+			same = maps.Equal(a.Labels, b.Labels)
+		}
+
+		if !same {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_Import(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
+	}
+
+	type args struct {
+		ctx    context.Context
+		rows   []entity.ImportRow
+		dryRun bool
+	}
+
+	archivedAt := time.Date(2025, 5, 8, 6, 7, 14, 0, time.UTC)
+	newProvider := &entity.Provider{ProviderID: entity.ProviderID("new"), Name: "new"}
+	renamed := &entity.Provider{ProviderID: entity.ProviderID("old"), Name: "renamed"}
+	same := &entity.Provider{ProviderID: entity.ProviderID("same"), Name: "same"}
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		args    args
+		want    *entity.ImportReport
+		wantErr error
+	}{
+		{
+			name: "rows created, updated and left unchanged",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("new")).Return(nil, entity.ErrNotFound)
+				f.repo.EXPECT().Store(context.Background(), newProvider).Return(nil)
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("new")).Return(newProvider, nil)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("old")).Return(&entity.Provider{ProviderID: entity.ProviderID("old"), Name: "old", Website: "https://old.example"}, nil)
+				// Fields left empty in the row keep their stored values.
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("old"), renamed, entity.ProviderMask{entity.ProviderFieldName}).Return(renamed, nil)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("same")).Return(&entity.Provider{ProviderID: entity.ProviderID("same"), Name: "same", Website: "https://same.example"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil).Times(2)
+			},
+			args: args{ctx: context.Background(), rows: []entity.ImportRow{
+				{Line: 2, Provider: newProvider},
+				{Line: 3, Provider: renamed},
+				{Line: 4, Provider: same},
+			}},
+			want: &entity.ImportReport{Applied: true, Created: 1, Updated: 1, Unchanged: 1, Rows: []entity.ImportRowResult{
+				{Line: 2, ProviderID: entity.ProviderID("new"), Action: entity.ImportActionCreated},
+				{Line: 3, ProviderID: entity.ProviderID("old"), Action: entity.ImportActionUpdated},
+				{Line: 4, ProviderID: entity.ProviderID("same"), Action: entity.ImportActionUnchanged},
+			}},
+		},
+		{
+			name: "dry run is not applied",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("new")).Return(nil, entity.ErrNotFound)
+				f.repo.EXPECT().Store(context.Background(), newProvider).Return(nil)
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("new")).Return(newProvider, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args: args{ctx: context.Background(), rows: []entity.ImportRow{{Line: 1, Provider: newProvider}}, dryRun: true},
+			want: &entity.ImportReport{DryRun: true, Created: 1, Rows: []entity.ImportRowResult{
+				{Line: 1, ProviderID: entity.ProviderID("new"), Action: entity.ImportActionCreated},
+			}},
+		},
+		{
+			name: "failed rows prevent the import from being applied",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("new")).Return(nil, entity.ErrNotFound)
+				f.repo.EXPECT().Store(context.Background(), newProvider).Return(nil)
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("new")).Return(newProvider, nil)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("gone")).Return(&entity.Provider{ProviderID: entity.ProviderID("gone"), DeletedAt: &archivedAt}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args: args{ctx: context.Background(), rows: []entity.ImportRow{
+				{Line: 2, Provider: newProvider},
+				{Line: 3, Err: errors.New("bare \" in non-quoted field")},
+				{Line: 4, Provider: &entity.Provider{ProviderID: entity.ProviderID("bad"), Name: "bad", Countries: []string{"rus"}}},
+				{Line: 5, Provider: &entity.Provider{ProviderID: entity.ProviderID("new"), Name: "again"}},
+				{Line: 6, Provider: &entity.Provider{ProviderID: entity.ProviderID("gone"), Name: "gone"}},
+			}},
+			want: &entity.ImportReport{Created: 1, Failed: 4, Rows: []entity.ImportRowResult{
+				{Line: 2, ProviderID: entity.ProviderID("new"), Action: entity.ImportActionCreated},
+				{Line: 3, Action: entity.ImportActionFailed, Error: "bare \" in non-quoted field"},
+				{Line: 4, ProviderID: entity.ProviderID("bad"), Action: entity.ImportActionFailed, Error: "countries: \"rus\" is not an ISO 3166-1 alpha-2 code: invalid argument"},
+				{Line: 5, ProviderID: entity.ProviderID("new"), Action: entity.ImportActionFailed, Error: "duplicates line 2"},
+				{Line: 6, ProviderID: entity.ProviderID("gone"), Action: entity.ImportActionFailed, Error: "provider is archived, restore it first"},
+			}},
+		},
+		{
+			name: "error - database not available",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("new")).Return(nil, entity.ErrInternalServerError)
+			},
+			args:    args{ctx: context.Background(), rows: []entity.ImportRow{{Line: 1, Provider: newProvider}}},
+			want:    nil,
+			wantErr: entity.ErrInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
+
+			res, err := uc.Import(tt.args.ctx, tt.args.rows, tt.args.dryRun)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	_maxLabels              = 64
+	_maxLabelNameLength     = 63
+	_maxLabelPrefixLength   = 253
+	_maxLabelSelectorLength = 1024
+)
+
+var (
+	_labelNamePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	_labelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// validateLabelKey follows Kubernetes: an optional DNS subdomain prefix followed by a slash, then a name of
+// at most 63 alphanumerics, "-", "_" and "." that starts and ends with an alphanumeric.
+func validateLabelKey(key string) error {
+	prefix, name, hasPrefix := strings.Cut(key, "/")
+	if !hasPrefix {
+		prefix, name = "", key
+	}
+
+	switch {
+	case hasPrefix && (len(prefix) > _maxLabelPrefixLength || !_labelPrefixPattern.MatchString(prefix)):
+		return fmt.Errorf("label key %q has a malformed prefix: %w", key, entity.ErrInvalidArgument)
+	case len(name) > _maxLabelNameLength || !_labelNamePattern.MatchString(name):
+		return fmt.Errorf("label key %q is malformed: %w", key, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// validateLabelValue accepts the same characters as the name part of a key, an empty value is fine.
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if len(value) > _maxLabelNameLength || !_labelNamePattern.MatchString(value) {
+		return fmt.Errorf("label value %q is malformed: %w", value, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateLabels(labels map[string]string) error {
+	if len(labels) > _maxLabels {
+		return fmt.Errorf("more than %d labels: %w", _maxLabels, entity.ErrInvalidArgument)
+	}
+
+	// Sorted for a stable error message.
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+
+		if err := validateLabelValue(labels[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeLabels applies single label updates of the mask to the stored labels. A label present in the update
+// is set, even to an empty value, a missing one is removed.
+func mergeLabels(stored, update map[string]string, mask entity.ProviderMask) map[string]string {
+	merged := maps.Clone(stored)
+	if merged == nil {
+		merged = make(map[string]string)
+	}
+
+	for _, field := range mask {
+		parent, key, ok := field.CutEntry()
+		if !ok || parent != entity.ProviderFieldLabels {
+			continue
+		}
+
+		if value, ok := update[key]; ok {
+			merged[key] = value
+		} else {
+			delete(merged, key)
+		}
+	}
+
+	return merged
+}
+
+type selectorTokenKind int
+
+const (
+	selectorEnd selectorTokenKind = iota
+	selectorIdentifier
+	selectorEquals
+	selectorNotEquals
+	selectorNot
+	selectorOpen
+	selectorClose
+	selectorComma
+)
+
+type selectorToken struct {
+	kind selectorTokenKind
+	text string
+}
+
+// parseLabelSelector parses the Kubernetes equality and set based selector syntax, e.g.
+// "region=msk,tier in (gold,silver),!legacy". Requirements are separated by commas and all have to match:
+//
+//	key, !key                         the label is set or not
+//	key=value, key==value, key!=value the label has or has not the value
+//	key in (v1,v2), key notin (v1,v2) the label has or has not one of the values
+func parseLabelSelector(s string) (entity.LabelSelector, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	if len(s) > _maxLabelSelectorLength {
+		return nil, fmt.Errorf("label_selector is longer than %d: %w", _maxLabelSelectorLength, entity.ErrInvalidArgument)
+	}
+
+	tokens, err := lexLabelSelector(s)
+	if err != nil {
+		return nil, fmt.Errorf("label_selector %q: %w", s, err)
+	}
+
+	p := &selectorParser{tokens: tokens}
+
+	var selector entity.LabelSelector
+
+	for {
+		requirement, err := p.requirement()
+		if err != nil {
+			return nil, fmt.Errorf("label_selector %q: %w", s, err)
+		}
+
+		selector = append(selector, requirement)
+
+		switch p.next().kind {
+		case selectorEnd:
+			return selector, nil
+		case selectorComma:
+		default:
+			return nil, fmt.Errorf("label_selector %q: expected \",\" after the requirement on %q: %w", s, requirement.Key, entity.ErrInvalidArgument)
+		}
+	}
+}
+
+func lexLabelSelector(s string) ([]selectorToken, error) {
+	var tokens []selectorToken
+
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == ',':
+			tokens = append(tokens, selectorToken{kind: selectorComma})
+			i++
+		case c == '(':
+			tokens = append(tokens, selectorToken{kind: selectorOpen})
+			i++
+		case c == ')':
+			tokens = append(tokens, selectorToken{kind: selectorClose})
+			i++
+		case c == '!' && strings.HasPrefix(s[i:], "!="):
+			tokens = append(tokens, selectorToken{kind: selectorNotEquals})
+			i += 2
+		case c == '!':
+			tokens = append(tokens, selectorToken{kind: selectorNot})
+			i++
+		case c == '=':
+			tokens = append(tokens, selectorToken{kind: selectorEquals})
+			i++
+			if strings.HasPrefix(s[i:], "=") {
+				i++
+			}
+		case isLabelChar(c):
+			start := i
+			for i < len(s) && isLabelChar(s[i]) {
+				i++
+			}
+
+			tokens = append(tokens, selectorToken{kind: selectorIdentifier, text: s[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d: %w", c, i, entity.ErrInvalidArgument)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isLabelChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '_' || c == '.' || c == '/'
+}
+
+type selectorParser struct {
+	tokens []selectorToken
+	pos    int
+}
+
+func (p *selectorParser) peek() selectorToken {
+	if p.pos >= len(p.tokens) {
+		return selectorToken{kind: selectorEnd}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *selectorParser) next() selectorToken {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *selectorParser) requirement() (entity.LabelRequirement, error) {
+	t := p.next()
+
+	if t.kind == selectorNot {
+		key := p.next()
+		if key.kind != selectorIdentifier {
+			return entity.LabelRequirement{}, fmt.Errorf("expected a label key after \"!\": %w", entity.ErrInvalidArgument)
+		}
+
+		if err := validateLabelKey(key.text); err != nil {
+			return entity.LabelRequirement{}, err
+		}
+
+		return entity.LabelRequirement{Key: key.text, Operator: entity.LabelOperatorDoesNotExist}, nil
+	}
+
+	if t.kind != selectorIdentifier {
+		return entity.LabelRequirement{}, fmt.Errorf("expected a label key: %w", entity.ErrInvalidArgument)
+	}
+
+	if err := validateLabelKey(t.text); err != nil {
+		return entity.LabelRequirement{}, err
+	}
+
+	requirement := entity.LabelRequirement{Key: t.text}
+
+	switch op := p.peek(); {
+	case op.kind == selectorEnd || op.kind == selectorComma:
+		requirement.Operator = entity.LabelOperatorExists
+	case op.kind == selectorEquals || op.kind == selectorNotEquals:
+		p.next()
+
+		requirement.Operator = entity.LabelOperatorEquals
+		if op.kind == selectorNotEquals {
+			requirement.Operator = entity.LabelOperatorNotEquals
+		}
+
+		// "key=" selects the empty value.
+		value := ""
+		if p.peek().kind == selectorIdentifier {
+			value = p.next().text
+		}
+
+		if err := validateLabelValue(value); err != nil {
+			return entity.LabelRequirement{}, err
+		}
+
+		requirement.Values = []string{value}
+	case op.kind == selectorIdentifier && (op.text == "in" || op.text == "notin"):
+		p.next()
+
+		requirement.Operator = entity.LabelOperator(op.text)
+
+		values, err := p.values()
+		if err != nil {
+			return entity.LabelRequirement{}, err
+		}
+
+		requirement.Values = values
+	default:
+		return entity.LabelRequirement{}, fmt.Errorf("expected an operator after %q: %w", t.text, entity.ErrInvalidArgument)
+	}
+
+	return requirement, nil
+}
+
+// values parses a parenthesized comma separated list of label values.
+func (p *selectorParser) values() ([]string, error) {
+	if p.next().kind != selectorOpen {
+		return nil, fmt.Errorf("expected \"(\": %w", entity.ErrInvalidArgument)
+	}
+
+	var values []string
+
+	for {
+		t := p.next()
+		if t.kind != selectorIdentifier {
+			return nil, fmt.Errorf("expected a label value: %w", entity.ErrInvalidArgument)
+		}
+
+		if err := validateLabelValue(t.text); err != nil {
+			return nil, err
+		}
+
+		values = append(values, t.text)
+
+		switch p.next().kind {
+		case selectorClose:
+			return values, nil
+		case selectorComma:
+		default:
+			return nil, fmt.Errorf("expected \",\" or \")\" after %q: %w", t.text, entity.ErrInvalidArgument)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_ListAllLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		selector string
+		want     entity.LabelSelector
+		wantErr  error
+	}{
+		{
+			name:     "empty selector matches everything",
+			selector: " ",
+		},
+		{
+			name:     "equality, set and existence requirements",
+			selector: "region=msk, tier in (gold, silver),!legacy,kuper.ru/integration,zone==north,env!=test,x notin (a),blank=",
+			want: entity.LabelSelector{
+				{Key: "region", Operator: entity.LabelOperatorEquals, Values: []string{"msk"}},
+				{Key: "tier", Operator: entity.LabelOperatorIn, Values: []string{"gold", "silver"}},
+				{Key: "legacy", Operator: entity.LabelOperatorDoesNotExist},
+				{Key: "kuper.ru/integration", Operator: entity.LabelOperatorExists},
+				{Key: "zone", Operator: entity.LabelOperatorEquals, Values: []string{"north"}},
+				{Key: "env", Operator: entity.LabelOperatorNotEquals, Values: []string{"test"}},
+				{Key: "x", Operator: entity.LabelOperatorNotIn, Values: []string{"a"}},
+				{Key: "blank", Operator: entity.LabelOperatorEquals, Values: []string{""}},
+			},
+		},
+		{name: "error - missing comma", selector: "region=msk tier=gold", wantErr: entity.ErrInvalidArgument},
+		{name: "error - unclosed set", selector: "tier in (gold,silver", wantErr: entity.ErrInvalidArgument},
+		{name: "error - empty set", selector: "tier in ()", wantErr: entity.ErrInvalidArgument},
+		{name: "error - dangling comma", selector: "region=msk,", wantErr: entity.ErrInvalidArgument},
+		{name: "error - unknown operator", selector: "tier > gold", wantErr: entity.ErrInvalidArgument},
+		{name: "error - malformed key", selector: "-tier=gold", wantErr: entity.ErrInvalidArgument},
+		{name: "error - malformed value", selector: "tier=.gold", wantErr: entity.ErrInvalidArgument},
+		{name: "error - too long", selector: strings.Repeat("a,", 513), wantErr: entity.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repo.NewMockProviderRepo(ctrl)
+			if tt.wantErr == nil {
+				repo.EXPECT().GetAll(context.Background(), entity.ProviderQuery{
+					Filter: entity.ProviderFilter{Labels: tt.want},
+					Order:  entity.ProviderOrder{Field: entity.ProviderOrderByProviderID},
+					Limit:  51,
+				}).Return([]*entity.Provider{}, nil)
+			}
+
+			uc := usecase.NewUseCaseProviders(repo, mock_repo.NewMockAuditRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			_, err := uc.ListAll(context.Background(), entity.ProviderListParams{LabelSelector: tt.selector})
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
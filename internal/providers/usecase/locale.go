@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"golang.org/x/text/language"
+)
+
+const (
+	_maxDisplayNames      = 16
+	_maxDisplayNameLength = 64
+)
+
+// canonicalLocale returns the canonical form of a BCP-47 language tag, e.g. "kk-kz" becomes "kk-KZ".
+func canonicalLocale(tag string) (string, error) {
+	t, err := language.Parse(tag)
+	if err != nil || t == language.Und {
+		return "", fmt.Errorf("%q is not a BCP-47 language tag: %w", tag, entity.ErrInvalidArgument)
+	}
+
+	return t.String(), nil
+}
+
+// normalizeDisplayNames rekeys display names by canonical language tags, so that every language is stored once.
+func normalizeDisplayNames(names map[string]string) (map[string]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	normalized := make(map[string]string, len(names))
+
+	for tag, name := range names {
+		locale, err := canonicalLocale(tag)
+		if err != nil {
+			return nil, fmt.Errorf("display_names: %w", err)
+		}
+
+		if _, ok := normalized[locale]; ok {
+			return nil, fmt.Errorf("display_names: %q is given more than once: %w", locale, entity.ErrInvalidArgument)
+		}
+
+		normalized[locale] = name
+	}
+
+	return normalized, nil
+}
+
+func validateDisplayNames(names map[string]string) error {
+	if len(names) > _maxDisplayNames {
+		return fmt.Errorf("more than %d languages: %w", _maxDisplayNames, entity.ErrInvalidArgument)
+	}
+
+	// Sorted for a stable error message.
+	for _, locale := range slices.Sorted(maps.Keys(names)) {
+		name := names[locale]
+
+		switch {
+		case strings.TrimSpace(name) == "":
+			return fmt.Errorf("%s is empty: %w", locale, entity.ErrInvalidArgument)
+		case utf8.RuneCountInString(name) > _maxDisplayNameLength:
+			return fmt.Errorf("%s is longer than %d: %w", locale, _maxDisplayNameLength, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+// mergeDisplayNames applies single language updates of the mask to the stored display names.
+// The update replaces the name of a language, an empty name removes the language.
+func mergeDisplayNames(stored, update map[string]string, mask entity.ProviderMask) map[string]string {
+	merged := maps.Clone(stored)
+	if merged == nil {
+		merged = make(map[string]string)
+	}
+
+	for _, field := range mask {
+		parent, locale, ok := field.CutEntry()
+		if !ok || parent != entity.ProviderFieldDisplayNames {
+			continue
+		}
+
+		if name := update[locale]; name != "" {
+			merged[locale] = name
+		} else {
+			delete(merged, locale)
+		}
+	}
+
+	return merged
+}
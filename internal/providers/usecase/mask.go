@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const _maskWildcard = "*"
+
+// updatableProviderFields is the set of fields "*" expands to.
+func updatableProviderFields() entity.ProviderMask {
+	return entity.ProviderMask{
+		entity.ProviderFieldName,
+		entity.ProviderFieldLegalEntity,
+		entity.ProviderFieldContacts,
+		entity.ProviderFieldWebsite,
+		entity.ProviderFieldCountries,
+		entity.ProviderFieldCapabilities,
+		entity.ProviderFieldDisplayNames,
+		entity.ProviderFieldLabels,
+	}
+}
+
+// normalizeProviderMask expands the wildcard, drops duplicates and rejects unknown paths. Keys of map entry
+// paths are checked, language tags get their canonical form. Entries of a map replaced as a whole are dropped.
+func normalizeProviderMask(mask entity.ProviderMask) (entity.ProviderMask, error) {
+	if len(mask) == 0 {
+		return nil, fmt.Errorf("update_mask is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	known := updatableProviderFields()
+	seen := make(map[entity.ProviderField]struct{}, len(mask))
+	normalized := make(entity.ProviderMask, 0, len(mask))
+
+	for _, field := range mask {
+		if field == _maskWildcard {
+			return known, nil
+		}
+
+		if parent, key, ok := field.CutEntry(); ok {
+			entry, err := normalizeMaskEntry(parent, key)
+			if err != nil {
+				return nil, fmt.Errorf("update_mask path %q: %w", field, err)
+			}
+
+			field = entry
+		} else if !slices.Contains(known, field) {
+			return nil, fmt.Errorf("update_mask path %q: %w", field, entity.ErrInvalidArgument)
+		}
+
+		if _, ok := seen[field]; ok {
+			continue
+		}
+
+		seen[field] = struct{}{}
+		normalized = append(normalized, field)
+	}
+
+	normalized = slices.DeleteFunc(normalized, func(field entity.ProviderField) bool {
+		parent, _, ok := field.CutEntry()
+		_, whole := seen[parent]
+
+		return ok && whole
+	})
+
+	return normalized, nil
+}
+
+func normalizeMaskEntry(parent entity.ProviderField, key string) (entity.ProviderField, error) {
+	switch parent {
+	case entity.ProviderFieldDisplayNames:
+		locale, err := canonicalLocale(key)
+		if err != nil {
+			return "", err
+		}
+
+		return parent.Entry(locale), nil
+	case entity.ProviderFieldLabels:
+		if err := validateLabelKey(key); err != nil {
+			return "", err
+		}
+
+		return parent.Entry(key), nil
+	default:
+		return "", entity.ErrInvalidArgument
+	}
+}
+
+func validateProviderUpdate(provider *entity.Provider, mask entity.ProviderMask) error {
+	if slices.Contains(mask, entity.ProviderFieldName) && provider.Name == "" {
+		return fmt.Errorf("name is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	return validateProviderProfile(provider, mask)
+}
+
+// hasEntries reports whether the mask updates single keys of the map field.
+func hasEntries(mask entity.ProviderMask, field entity.ProviderField) bool {
+	return slices.ContainsFunc(mask, func(f entity.ProviderField) bool {
+		parent, _, ok := f.CutEntry()
+
+		return ok && parent == field
+	})
+}
+
+// withWholeMap replaces the entry paths of the map field with the path of the field itself.
+func withWholeMap(mask entity.ProviderMask, field entity.ProviderField) entity.ProviderMask {
+	mask = slices.DeleteFunc(slices.Clone(mask), func(f entity.ProviderField) bool {
+		parent, _, ok := f.CutEntry()
+
+		return ok && parent == field
+	})
+
+	return append(mask, field)
+}
@@ -12,6 +12,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/classydevv/fulfillment/internal/providers/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// Activate mocks base method.
+func (m *MockProvider) Activate(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockProviderMockRecorder) Activate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockProvider)(nil).Activate), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockProvider) Create(arg0 context.Context, arg1 *entity.Provider) (entity.ProviderID, error) {
 	m.ctrl.T.Helper()
@@ -57,45 +73,989 @@ func (mr *MockProviderMockRecorder) Create(arg0, arg1 any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockProvider) Delete(arg0 context.Context, arg1 entity.ProviderID) error {
+func (m *MockProvider) Delete(arg0 context.Context, arg1 entity.ProviderID, arg2 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockProviderMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+func (mr *MockProviderMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProvider)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Export mocks base method.
+func (m *MockProvider) Export(ctx context.Context, f entity.ProviderFilter, fn func(*entity.Provider) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, f, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockProviderMockRecorder) Export(ctx, f, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockProvider)(nil).Export), ctx, f, fn)
+}
+
+// GetByID mocks base method.
+func (m *MockProvider) GetByID(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProviderMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProvider)(nil).GetByID), arg0, arg1)
+}
+
+// History mocks base method.
+func (m *MockProvider) History(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.HistoryListParams) (*entity.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockProviderMockRecorder) History(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockProvider)(nil).History), arg0, arg1, arg2)
+}
+
+// Import mocks base method.
+func (m *MockProvider) Import(ctx context.Context, rows []entity.ImportRow, dryRun bool) (*entity.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, dryRun)
+	ret0, _ := ret[0].(*entity.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockProviderMockRecorder) Import(ctx, rows, dryRun any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProvider)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockProvider)(nil).Import), ctx, rows, dryRun)
 }
 
 // ListAll mocks base method.
-func (m *MockProvider) ListAll(arg0 context.Context) ([]*entity.Provider, error) {
+func (m *MockProvider) ListAll(arg0 context.Context, arg1 entity.ProviderListParams) (*entity.ProviderPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAll", arg0)
-	ret0, _ := ret[0].([]*entity.Provider)
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ProviderPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAll indicates an expected call of ListAll.
-func (mr *MockProviderMockRecorder) ListAll(arg0 any) *gomock.Call {
+func (mr *MockProviderMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockProvider)(nil).ListAll), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockProvider) Purge(arg0 context.Context, arg1 entity.ProviderID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockProviderMockRecorder) Purge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockProvider)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockProvider) Restore(arg0 context.Context, arg1 entity.ProviderID) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockProviderMockRecorder) Restore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockProvider)(nil).Restore), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockProvider) Search(arg0 context.Context, arg1 entity.ProviderSearchParams) ([]*entity.ProviderSearchHit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ProviderSearchHit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockProviderMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockProvider)(nil).Search), arg0, arg1)
+}
+
+// Suspend mocks base method.
+func (m *MockProvider) Suspend(ctx context.Context, id entity.ProviderID, reason string) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, id, reason)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockProviderMockRecorder) Suspend(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockProvider)(nil).Suspend), ctx, id, reason)
+}
+
+// Terminate mocks base method.
+func (m *MockProvider) Terminate(ctx context.Context, id entity.ProviderID, reason string) (*entity.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Terminate", ctx, id, reason)
+	ret0, _ := ret[0].(*entity.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Terminate indicates an expected call of Terminate.
+func (mr *MockProviderMockRecorder) Terminate(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockProvider)(nil).ListAll), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockProvider)(nil).Terminate), ctx, id, reason)
 }
 
 // Update mocks base method.
-func (m *MockProvider) Update(arg0 context.Context, arg1 entity.ProviderID, arg2 *entity.Provider) (*entity.Provider, error) {
+func (m *MockProvider) Update(arg0 context.Context, arg1 entity.ProviderID, arg2 *entity.Provider, arg3 entity.ProviderMask) (*entity.Provider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*entity.Provider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockProviderMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockProviderMockRecorder) Update(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProvider)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// MockZone is a mock of Zone interface.
+type MockZone struct {
+	ctrl     *gomock.Controller
+	recorder *MockZoneMockRecorder
+	isgomock struct{}
+}
+
+// MockZoneMockRecorder is the mock recorder for MockZone.
+type MockZoneMockRecorder struct {
+	mock *MockZone
+}
+
+// NewMockZone creates a new mock instance.
+func NewMockZone(ctrl *gomock.Controller) *MockZone {
+	mock := &MockZone{ctrl: ctrl}
+	mock.recorder = &MockZoneMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockZone) EXPECT() *MockZoneMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockZone) Create(arg0 context.Context, arg1 *entity.Zone) (entity.ZoneID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(entity.ZoneID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockZoneMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockZone)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockZone) Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, providerID, zoneID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockZoneMockRecorder) Delete(ctx, providerID, zoneID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockZone)(nil).Delete), ctx, providerID, zoneID, version)
+}
+
+// GetByID mocks base method.
+func (m *MockZone) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.ZoneID) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockZoneMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockZone)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// ListAll mocks base method.
+func (m *MockZone) ListAll(arg0 context.Context, arg1 entity.ZoneListParams) (*entity.ZonePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ZonePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockZoneMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockZone)(nil).ListAll), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockZone) Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, zone *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, providerID, zoneID, zone, mask)
+	ret0, _ := ret[0].(*entity.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockZoneMockRecorder) Update(ctx, providerID, zoneID, zone, mask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockZone)(nil).Update), ctx, providerID, zoneID, zone, mask)
+}
+
+// MockPickupPoint is a mock of PickupPoint interface.
+type MockPickupPoint struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupPointMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupPointMockRecorder is the mock recorder for MockPickupPoint.
+type MockPickupPointMockRecorder struct {
+	mock *MockPickupPoint
+}
+
+// NewMockPickupPoint creates a new mock instance.
+func NewMockPickupPoint(ctrl *gomock.Controller) *MockPickupPoint {
+	mock := &MockPickupPoint{ctrl: ctrl}
+	mock.recorder = &MockPickupPointMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupPoint) EXPECT() *MockPickupPointMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPickupPoint) Create(arg0 context.Context, arg1 *entity.PickupPoint) (*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPickupPointMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPickupPoint)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockPickupPoint) Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, providerID, pointID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPickupPointMockRecorder) Delete(ctx, providerID, pointID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPickupPoint)(nil).Delete), ctx, providerID, pointID, version)
+}
+
+// GetByID mocks base method.
+func (m *MockPickupPoint) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.PickupPointID) (*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPickupPointMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPickupPoint)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// ListAll mocks base method.
+func (m *MockPickupPoint) ListAll(arg0 context.Context, arg1 entity.PickupPointListParams) (*entity.PickupPointPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.PickupPointPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockPickupPointMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockPickupPoint)(nil).ListAll), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockPickupPoint) Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, point *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, providerID, pointID, point, mask)
+	ret0, _ := ret[0].(*entity.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPickupPointMockRecorder) Update(ctx, providerID, pointID, point, mask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPickupPoint)(nil).Update), ctx, providerID, pointID, point, mask)
+}
+
+// MockTariff is a mock of Tariff interface.
+type MockTariff struct {
+	ctrl     *gomock.Controller
+	recorder *MockTariffMockRecorder
+	isgomock struct{}
+}
+
+// MockTariffMockRecorder is the mock recorder for MockTariff.
+type MockTariffMockRecorder struct {
+	mock *MockTariff
+}
+
+// NewMockTariff creates a new mock instance.
+func NewMockTariff(ctrl *gomock.Controller) *MockTariff {
+	mock := &MockTariff{ctrl: ctrl}
+	mock.recorder = &MockTariffMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTariff) EXPECT() *MockTariffMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTariff) Create(arg0 context.Context, arg1 *entity.Tariff) (*entity.Tariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Tariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTariffMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTariff)(nil).Create), arg0, arg1)
+}
+
+// CreateRevision mocks base method.
+func (m *MockTariff) CreateRevision(arg0 context.Context, arg1 *entity.TariffRevision) (*entity.TariffRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", arg0, arg1)
+	ret0, _ := ret[0].(*entity.TariffRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRevision indicates an expected call of CreateRevision.
+func (mr *MockTariffMockRecorder) CreateRevision(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockTariff)(nil).CreateRevision), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockTariff) Delete(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTariffMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTariff)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Evaluate mocks base method.
+func (m *MockTariff) Evaluate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID, arg3 entity.Shipment, arg4 time.Time) (*entity.PriceBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.PriceBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockTariffMockRecorder) Evaluate(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockTariff)(nil).Evaluate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetByID mocks base method.
+func (m *MockTariff) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) (*entity.Tariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Tariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTariffMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTariff)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// ListAll mocks base method.
+func (m *MockTariff) ListAll(arg0 context.Context, arg1 entity.TariffListParams) (*entity.TariffPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.TariffPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockTariffMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockTariff)(nil).ListAll), arg0, arg1)
+}
+
+// ListRevisions mocks base method.
+func (m *MockTariff) ListRevisions(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.TariffID) ([]*entity.TariffRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.TariffRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockTariffMockRecorder) ListRevisions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockTariff)(nil).ListRevisions), arg0, arg1, arg2)
+}
+
+// MockQuote is a mock of Quote interface.
+type MockQuote struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoteMockRecorder
+	isgomock struct{}
+}
+
+// MockQuoteMockRecorder is the mock recorder for MockQuote.
+type MockQuoteMockRecorder struct {
+	mock *MockQuote
+}
+
+// NewMockQuote creates a new mock instance.
+func NewMockQuote(ctrl *gomock.Controller) *MockQuote {
+	mock := &MockQuote{ctrl: ctrl}
+	mock.recorder = &MockQuoteMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuote) EXPECT() *MockQuoteMockRecorder {
+	return m.recorder
+}
+
+// Quote mocks base method.
+func (m *MockQuote) Quote(arg0 context.Context, arg1 entity.Shipment, arg2 time.Time) (*entity.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockQuoteMockRecorder) Quote(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockQuote)(nil).Quote), arg0, arg1, arg2)
+}
+
+// MockCoverage is a mock of Coverage interface.
+type MockCoverage struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoverageMockRecorder
+	isgomock struct{}
+}
+
+// MockCoverageMockRecorder is the mock recorder for MockCoverage.
+type MockCoverageMockRecorder struct {
+	mock *MockCoverage
+}
+
+// NewMockCoverage creates a new mock instance.
+func NewMockCoverage(ctrl *gomock.Controller) *MockCoverage {
+	mock := &MockCoverage{ctrl: ctrl}
+	mock.recorder = &MockCoverageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoverage) EXPECT() *MockCoverageMockRecorder {
+	return m.recorder
+}
+
+// Lookup mocks base method.
+func (m *MockCoverage) Lookup(arg0 context.Context, arg1 entity.Position) ([]*entity.CoverageMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lookup", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.CoverageMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lookup indicates an expected call of Lookup.
+func (mr *MockCoverageMockRecorder) Lookup(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockCoverage)(nil).Lookup), arg0, arg1)
+}
+
+// MockPickupPointSearch is a mock of PickupPointSearch interface.
+type MockPickupPointSearch struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupPointSearchMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupPointSearchMockRecorder is the mock recorder for MockPickupPointSearch.
+type MockPickupPointSearchMockRecorder struct {
+	mock *MockPickupPointSearch
+}
+
+// NewMockPickupPointSearch creates a new mock instance.
+func NewMockPickupPointSearch(ctrl *gomock.Controller) *MockPickupPointSearch {
+	mock := &MockPickupPointSearch{ctrl: ctrl}
+	mock.recorder = &MockPickupPointSearchMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupPointSearch) EXPECT() *MockPickupPointSearchMockRecorder {
+	return m.recorder
+}
+
+// Nearby mocks base method.
+func (m *MockPickupPointSearch) Nearby(arg0 context.Context, arg1 entity.PickupPointNearbyQuery) ([]*entity.PickupPointDistance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nearby", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.PickupPointDistance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Nearby indicates an expected call of Nearby.
+func (mr *MockPickupPointSearchMockRecorder) Nearby(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nearby", reflect.TypeOf((*MockPickupPointSearch)(nil).Nearby), arg0, arg1)
+}
+
+// MockSlot is a mock of Slot interface.
+type MockSlot struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotMockRecorder is the mock recorder for MockSlot.
+type MockSlotMockRecorder struct {
+	mock *MockSlot
+}
+
+// NewMockSlot creates a new mock instance.
+func NewMockSlot(ctrl *gomock.Controller) *MockSlot {
+	mock := &MockSlot{ctrl: ctrl}
+	mock.recorder = &MockSlotMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlot) EXPECT() *MockSlotMockRecorder {
+	return m.recorder
+}
+
+// Availability mocks base method.
+func (m *MockSlot) Availability(arg0 context.Context, arg1 entity.AvailabilityParams) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Availability", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Availability indicates an expected call of Availability.
+func (mr *MockSlotMockRecorder) Availability(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Availability", reflect.TypeOf((*MockSlot)(nil).Availability), arg0, arg1)
+}
+
+// Close mocks base method.
+func (m *MockSlot) Close(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotID) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSlotMockRecorder) Close(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSlot)(nil).Close), arg0, arg1, arg2)
+}
+
+// Confirm mocks base method.
+func (m *MockSlot) Confirm(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.HoldID) (*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockSlotMockRecorder) Confirm(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockSlot)(nil).Confirm), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockSlot) Create(arg0 context.Context, arg1 *entity.Slot) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSlotMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSlot)(nil).Create), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockSlot) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotID) (*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSlotMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlot)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// Hold mocks base method.
+func (m *MockSlot) Hold(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID, quantity int) (*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, providerID, slotID, quantity)
+	ret0, _ := ret[0].(*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockSlotMockRecorder) Hold(ctx, providerID, slotID, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockSlot)(nil).Hold), ctx, providerID, slotID, quantity)
+}
+
+// ListAll mocks base method.
+func (m *MockSlot) ListAll(arg0 context.Context, arg1 entity.SlotListParams) (*entity.SlotPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.SlotPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockSlotMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockSlot)(nil).ListAll), arg0, arg1)
+}
+
+// Release mocks base method.
+func (m *MockSlot) Release(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.HoldID) (*entity.SlotHold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotHold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockSlotMockRecorder) Release(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockSlot)(nil).Release), arg0, arg1, arg2)
+}
+
+// MockSlotTemplate is a mock of SlotTemplate interface.
+type MockSlotTemplate struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotTemplateMockRecorder
+	isgomock struct{}
+}
+
+// MockSlotTemplateMockRecorder is the mock recorder for MockSlotTemplate.
+type MockSlotTemplateMockRecorder struct {
+	mock *MockSlotTemplate
+}
+
+// NewMockSlotTemplate creates a new mock instance.
+func NewMockSlotTemplate(ctrl *gomock.Controller) *MockSlotTemplate {
+	mock := &MockSlotTemplate{ctrl: ctrl}
+	mock.recorder = &MockSlotTemplateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotTemplate) EXPECT() *MockSlotTemplateMockRecorder {
+	return m.recorder
+}
+
+// Activate mocks base method.
+func (m *MockSlotTemplate) Activate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockSlotTemplateMockRecorder) Activate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockSlotTemplate)(nil).Activate), arg0, arg1, arg2)
+}
+
+// Create mocks base method.
+func (m *MockSlotTemplate) Create(arg0 context.Context, arg1 *entity.SlotTemplate) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSlotTemplateMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSlotTemplate)(nil).Create), arg0, arg1)
+}
+
+// Deactivate mocks base method.
+func (m *MockSlotTemplate) Deactivate(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockSlotTemplateMockRecorder) Deactivate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockSlotTemplate)(nil).Deactivate), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockSlotTemplate) Delete(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSlotTemplateMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSlotTemplate)(nil).Delete), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockSlotTemplate) GetByID(arg0 context.Context, arg1 entity.ProviderID, arg2 entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.SlotTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSlotTemplateMockRecorder) GetByID(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSlotTemplate)(nil).GetByID), arg0, arg1, arg2)
+}
+
+// ListAll mocks base method.
+func (m *MockSlotTemplate) ListAll(arg0 context.Context, arg1 entity.SlotTemplateListParams) (*entity.SlotTemplatePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", arg0, arg1)
+	ret0, _ := ret[0].(*entity.SlotTemplatePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockSlotTemplateMockRecorder) ListAll(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockSlotTemplate)(nil).ListAll), arg0, arg1)
+}
+
+// Preview mocks base method.
+func (m *MockSlotTemplate) Preview(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, from, to time.Time) ([]*entity.Slot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preview", ctx, providerID, templateID, from, to)
+	ret0, _ := ret[0].([]*entity.Slot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preview indicates an expected call of Preview.
+func (mr *MockSlotTemplateMockRecorder) Preview(ctx, providerID, templateID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preview", reflect.TypeOf((*MockSlotTemplate)(nil).Preview), ctx, providerID, templateID, from, to)
+}
+
+// MockCalendar is a mock of Calendar interface.
+type MockCalendar struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarMockRecorder is the mock recorder for MockCalendar.
+type MockCalendarMockRecorder struct {
+	mock *MockCalendar
+}
+
+// NewMockCalendar creates a new mock instance.
+func NewMockCalendar(ctrl *gomock.Controller) *MockCalendar {
+	mock := &MockCalendar{ctrl: ctrl}
+	mock.recorder = &MockCalendarMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendar) EXPECT() *MockCalendarMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCalendar) Create(arg0 context.Context, arg1 *entity.Calendar) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCalendarMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCalendar)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockCalendar) Delete(arg0 context.Context, arg1 entity.ProviderID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCalendarMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCalendar)(nil).Delete), arg0, arg1)
+}
+
+// GetByID mocks base method.
+func (m *MockCalendar) GetByID(arg0 context.Context, arg1 entity.ProviderID) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCalendarMockRecorder) GetByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCalendar)(nil).GetByID), arg0, arg1)
+}
+
+// ImportHolidays mocks base method.
+func (m *MockCalendar) ImportHolidays(ctx context.Context, providerID entity.ProviderID, ics []byte, replace bool) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportHolidays", ctx, providerID, ics, replace)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportHolidays indicates an expected call of ImportHolidays.
+func (mr *MockCalendarMockRecorder) ImportHolidays(ctx, providerID, ics, replace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportHolidays", reflect.TypeOf((*MockCalendar)(nil).ImportHolidays), ctx, providerID, ics, replace)
+}
+
+// Update mocks base method.
+func (m *MockCalendar) Update(arg0 context.Context, arg1 *entity.Calendar) (*entity.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCalendarMockRecorder) Update(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProvider)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCalendar)(nil).Update), arg0, arg1)
 }
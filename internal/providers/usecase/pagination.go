@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	_defaultPageSize = 50
+	_maxPageSize     = 500
+)
+
+// pageToken is the opaque cursor handed out to clients. It remembers the ordering
+// it was issued for, so a token can not be replayed against a different sort.
+type pageToken struct {
+	OrderBy    string            `json:"o"`
+	ProviderID entity.ProviderID `json:"id"`
+	CreatedAt  time.Time         `json:"ts"`
+}
+
+// pageLimit resolves the requested page size into the repository limit, one row more than the page is asked for
+// to find out whether another page exists.
+func pageLimit(pageSize int) (uint64, error) {
+	if pageSize < 0 {
+		return 0, fmt.Errorf("page_size is negative: %w", entity.ErrInvalidArgument)
+	}
+
+	if pageSize == 0 {
+		pageSize = _defaultPageSize
+	}
+
+	return uint64(min(pageSize, _maxPageSize)) + 1, nil //nolint:gosec // bounded by _maxPageSize
+}
+
+// trimPage drops the extra row asked for by pageLimit, last is the last row of the page when another page follows.
+func trimPage[T any](rows []*T, limit uint64) (page []*T, last *T) {
+	pageSize := int(limit) - 1 //nolint:gosec // limit comes from pageLimit
+	if len(rows) <= pageSize {
+		return rows, nil
+	}
+
+	return rows[:pageSize], rows[pageSize-1]
+}
+
+// encodeIDPageToken is the cursor of listings ordered by a string ID, it holds the last ID of the page.
+func encodeIDPageToken[ID ~string](last ID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(last))
+}
+
+// decodeIDPageToken reads a token of encodeIDPageToken, an empty token gives an empty ID.
+func decodeIDPageToken[ID ~string](token string) (ID, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(after) == 0 {
+		return "", fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	return ID(after), nil
+}
+
+func newProviderQuery(params entity.ProviderListParams) (entity.ProviderQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.ProviderQuery{}, err
+	}
+
+	order, err := parseProviderOrder(params.OrderBy)
+	if err != nil {
+		return entity.ProviderQuery{}, err
+	}
+
+	if err := validateStatuses(params.Filter.Statuses); err != nil {
+		return entity.ProviderQuery{}, err
+	}
+
+	labels, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		return entity.ProviderQuery{}, err
+	}
+
+	query := entity.ProviderQuery{
+		Filter: params.Filter,
+		Order:  order,
+		Limit:  limit,
+	}
+
+	query.Filter.Labels = labels
+
+	if params.PageToken != "" {
+		cursor, err := decodePageToken(params.PageToken, order)
+		if err != nil {
+			return entity.ProviderQuery{}, err
+		}
+
+		query.After = cursor
+	}
+
+	return query, nil
+}
+
+func validateStatuses(statuses []entity.ProviderStatus) error {
+	for _, s := range statuses {
+		if !s.Valid() {
+			return fmt.Errorf("status %q: %w", s, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func parseProviderOrder(orderBy string) (entity.ProviderOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+	if len(fields) == 0 {
+		return entity.ProviderOrder{Field: entity.ProviderOrderByProviderID}, nil
+	}
+
+	var order entity.ProviderOrder
+
+	switch entity.ProviderOrderField(fields[0]) {
+	case entity.ProviderOrderByProviderID, entity.ProviderOrderByCreatedAt:
+		order.Field = entity.ProviderOrderField(fields[0])
+	default:
+		return entity.ProviderOrder{}, fmt.Errorf("order_by %q: unknown field: %w", orderBy, entity.ErrInvalidArgument)
+	}
+
+	switch {
+	case len(fields) == 1:
+	case len(fields) == 2 && fields[1] == "asc":
+	case len(fields) == 2 && fields[1] == "desc":
+		order.Desc = true
+	default:
+		return entity.ProviderOrder{}, fmt.Errorf("order_by %q: malformed: %w", orderBy, entity.ErrInvalidArgument)
+	}
+
+	return order, nil
+}
+
+func encodePageToken(order entity.ProviderOrder, last *entity.Provider) string {
+	raw, err := json.Marshal(pageToken{
+		OrderBy:    order.String(),
+		ProviderID: last.ProviderID,
+		CreatedAt:  last.CreatedAt,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string, order entity.ProviderOrder) (*entity.ProviderCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return nil, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	if t.OrderBy != order.String() {
+		return nil, fmt.Errorf("page_token was issued for another order_by: %w", entity.ErrInvalidArgument)
+	}
+
+	return &entity.ProviderCursor{
+		ProviderID: t.ProviderID,
+		CreatedAt:  t.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	// _earthRadiusM is the mean radius of the Earth, haversine distances are off by up to 0.5% from geodesic ones.
+	_earthRadiusM = 6371008.8
+
+	_defaultNearbyRadiusM = 5000
+	// _maxNearbyRadiusM keeps the candidates of a search few enough to rank them all.
+	_maxNearbyRadiusM     = 50000
+	_defaultNearbyLimit   = 10
+	_maxNearbyLimit       = 100
+	_maxNearbyFilterItems = 100
+)
+
+// UseCasePickupPointSearch answers nearby searches from an in-memory index of the pickup points, Refresh keeps it up to date.
+type UseCasePickupPointSearch struct {
+	repo repo.PickupPointIndexRepo
+	// index is the root of an R-tree over the points, nil when there are none.
+	index revisionSnapshot[*rtreeNode[*entity.PickupPoint]]
+}
+
+func NewUseCasePickupPointSearch(r repo.PickupPointIndexRepo) *UseCasePickupPointSearch {
+	return &UseCasePickupPointSearch{
+		repo: r,
+	}
+}
+
+// Refresh reloads the index when the pickup point revision has moved since the last load.
+func (uc *UseCasePickupPointSearch) Refresh(ctx context.Context) error {
+	err := uc.index.refresh(ctx, uc.repo.Revision, func(ctx context.Context) (*rtreeNode[*entity.PickupPoint], error) {
+		points, err := uc.repo.GetActive(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("uc.repo.GetActive: %w", err)
+		}
+
+		leaves := make([]*rtreeNode[*entity.PickupPoint], len(points))
+		for i, point := range points {
+			leaves[i] = &rtreeNode[*entity.PickupPoint]{box: bbox{minLon: point.Lon, minLat: point.Lat, maxLon: point.Lon, maxLat: point.Lat}, item: point}
+		}
+
+		return packRTree(leaves), nil
+	})
+	if err != nil {
+		return fmt.Errorf("UseCasePickupPointSearch - Refresh - uc.index.refresh: %w", err)
+	}
+
+	return nil
+}
+
+// Nearby returns up to query.Limit points within query.RadiusM ordered by distance, ties are ordered by provider and point ID.
+func (uc *UseCasePickupPointSearch) Nearby(_ context.Context, query entity.PickupPointNearbyQuery) ([]*entity.PickupPointDistance, error) {
+	query, err := normalizeNearbyQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPointSearch - Nearby - normalizeNearbyQuery: %w", err)
+	}
+
+	root, ok := uc.index.load()
+	if !ok {
+		return nil, fmt.Errorf("UseCasePickupPointSearch - Nearby - pickup points are not loaded yet: %w", entity.ErrInternalServerError)
+	}
+
+	var found []*entity.PickupPointDistance
+
+	for _, box := range radiusBBoxes(query.Position, query.RadiusM) {
+		for _, point := range pointsWithin(root, box) {
+			if len(query.ProviderIDs) > 0 && !slices.Contains(query.ProviderIDs, point.ProviderID) {
+				continue
+			}
+
+			if len(query.Types) > 0 && !slices.Contains(query.Types, point.Type) {
+				continue
+			}
+
+			if distance := haversine(query.Position, point.Position()); distance <= query.RadiusM {
+				found = append(found, &entity.PickupPointDistance{Point: point, DistanceM: distance})
+			}
+		}
+	}
+
+	slices.SortFunc(found, func(a, b *entity.PickupPointDistance) int {
+		return cmp.Or(
+			cmp.Compare(a.DistanceM, b.DistanceM),
+			strings.Compare(string(a.Point.ProviderID), string(b.Point.ProviderID)),
+			strings.Compare(string(a.Point.PointID), string(b.Point.PointID)),
+		)
+	})
+
+	return found[:min(len(found), query.Limit)], nil
+}
+
+func normalizeNearbyQuery(query entity.PickupPointNearbyQuery) (entity.PickupPointNearbyQuery, error) {
+	if err := validatePosition(query.Position); err != nil {
+		return query, err
+	}
+
+	switch {
+	case query.RadiusM == 0:
+		query.RadiusM = _defaultNearbyRadiusM
+	case !(query.RadiusM > 0 && query.RadiusM <= _maxNearbyRadiusM):
+		return query, fmt.Errorf("radius %v is not within (0, %d]: %w", query.RadiusM, _maxNearbyRadiusM, entity.ErrInvalidArgument)
+	}
+
+	if query.Limit < 0 {
+		return query, fmt.Errorf("limit is negative: %w", entity.ErrInvalidArgument)
+	}
+
+	if query.Limit == 0 {
+		query.Limit = _defaultNearbyLimit
+	}
+
+	query.Limit = min(query.Limit, _maxNearbyLimit)
+
+	if len(query.ProviderIDs) > _maxNearbyFilterItems || len(query.Types) > _maxNearbyFilterItems {
+		return query, fmt.Errorf("more than %d filter values: %w", _maxNearbyFilterItems, entity.ErrInvalidArgument)
+	}
+
+	for _, t := range query.Types {
+		if !t.Valid() {
+			return query, fmt.Errorf("type %q is unknown: %w", t, entity.ErrInvalidArgument)
+		}
+	}
+
+	return query, nil
+}
+
+// pointsWithin returns the pickup points of the tree lying in the box.
+func pointsWithin(root *rtreeNode[*entity.PickupPoint], box bbox) []*entity.PickupPoint {
+	if root == nil {
+		return nil
+	}
+
+	var (
+		found []*entity.PickupPoint
+		stack = []*rtreeNode[*entity.PickupPoint]{root}
+	)
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !node.box.intersects(box) {
+			continue
+		}
+
+		if node.children == nil {
+			found = append(found, node.item)
+
+			continue
+		}
+
+		stack = append(stack, node.children...)
+	}
+
+	return found
+}
+
+// radiusBBoxes bounds the positions within radiusM of p, the box is split in two when it crosses the antimeridian.
+// See "Finding Points Within a Distance of a Latitude/Longitude Using Bounding Coordinates" by Jan Matuschek.
+func radiusBBoxes(p entity.Position, radiusM float64) []bbox {
+	angular := radiusM / _earthRadiusM
+	lat := radians(p.Lat())
+	minLat, maxLat := degrees(lat-angular), degrees(lat+angular)
+
+	// A pole within the radius makes every longitude reachable.
+	if minLat <= -90 || maxLat >= 90 {
+		return []bbox{{minLon: -180, minLat: max(minLat, -90), maxLon: 180, maxLat: min(maxLat, 90)}}
+	}
+
+	deltaLon := degrees(math.Asin(math.Sin(angular) / math.Cos(lat)))
+	minLon, maxLon := p.Lon()-deltaLon, p.Lon()+deltaLon
+
+	switch {
+	case minLon < -180:
+		return []bbox{
+			{minLon: minLon + 360, minLat: minLat, maxLon: 180, maxLat: maxLat},
+			{minLon: -180, minLat: minLat, maxLon: maxLon, maxLat: maxLat},
+		}
+	case maxLon > 180:
+		return []bbox{
+			{minLon: minLon, minLat: minLat, maxLon: 180, maxLat: maxLat},
+			{minLon: -180, minLat: minLat, maxLon: maxLon - 360, maxLat: maxLat},
+		}
+	default:
+		return []bbox{{minLon: minLon, minLat: minLat, maxLon: maxLon, maxLat: maxLat}}
+	}
+}
+
+// haversine returns the great-circle distance between the positions in meters.
+func haversine(a, b entity.Position) float64 {
+	dLat := radians(b.Lat() - a.Lat())
+	dLon := radians(b.Lon() - a.Lon())
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(radians(a.Lat()))*math.Cos(radians(b.Lat()))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * _earthRadiusM * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCasePickupPointSearch_Nearby(t *testing.T) {
+	t.Parallel()
+
+	points := []*entity.PickupPoint{
+		// About 110 m north of the Kremlin.
+		{ProviderID: "kuper", PointID: "tverskaya", Type: entity.PickupPointTypePVZ, Lat: 55.7530, Lon: 37.6173},
+		// About 1.1 km north.
+		{ProviderID: "dostavista", PointID: "lubyanka", Type: entity.PickupPointTypeLocker, Lat: 55.7620, Lon: 37.6173},
+		// About 2.2 km north.
+		{ProviderID: "kuper", PointID: "sukharevskaya", Type: entity.PickupPointTypeLocker, Lat: 55.7720, Lon: 37.6173},
+		// About 10 km north.
+		{ProviderID: "kuper", PointID: "vdnh", Type: entity.PickupPointTypePostOffice, Lat: 55.8420, Lon: 37.6173},
+		// Both sides of the antimeridian, about 11 km apart.
+		{ProviderID: "kuper", PointID: "fiji-east", Type: entity.PickupPointTypePVZ, Lat: -16.5, Lon: 179.95},
+		{ProviderID: "kuper", PointID: "fiji-west", Type: entity.PickupPointTypePVZ, Lat: -16.5, Lon: -179.95},
+	}
+
+	// Enough points for a tree of several levels, none of them is near the positions searched below.
+	for i := range 500 {
+		points = append(points, &entity.PickupPoint{
+			ProviderID: "filler",
+			PointID:    entity.PickupPointID(fmt.Sprintf("p%d", i)),
+			Type:       entity.PickupPointTypePVZ,
+			Lat:        float64(i/50) - 50,
+			Lon:        float64(i%50) - 100,
+		})
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockPickupPointIndexRepo(ctrl)
+	uc := usecase.NewUseCasePickupPointSearch(r)
+
+	kremlin := entity.Position{37.6173, 55.7520}
+
+	_, err := uc.Nearby(context.Background(), entity.PickupPointNearbyQuery{Position: kremlin})
+	require.ErrorIs(t, err, entity.ErrInternalServerError, "search before the first load")
+
+	r.EXPECT().Revision(gomock.Any()).Return(int64(1), nil)
+	r.EXPECT().GetActive(gomock.Any()).Return(points, nil)
+	require.NoError(t, uc.Refresh(context.Background()))
+
+	tests := []struct {
+		name    string
+		query   entity.PickupPointNearbyQuery
+		want    []entity.PickupPointID
+		wantErr error
+	}{
+		{
+			name:  "default radius, nearest first",
+			query: entity.PickupPointNearbyQuery{Position: kremlin},
+			want:  []entity.PickupPointID{"tverskaya", "lubyanka", "sukharevskaya"},
+		},
+		{
+			name:  "radius",
+			query: entity.PickupPointNearbyQuery{Position: kremlin, RadiusM: 1500},
+			want:  []entity.PickupPointID{"tverskaya", "lubyanka"},
+		},
+		{
+			name:  "limit",
+			query: entity.PickupPointNearbyQuery{Position: kremlin, RadiusM: 20000, Limit: 2},
+			want:  []entity.PickupPointID{"tverskaya", "lubyanka"},
+		},
+		{
+			name:  "provider filter",
+			query: entity.PickupPointNearbyQuery{Position: kremlin, RadiusM: 20000, ProviderIDs: []entity.ProviderID{"kuper"}},
+			want:  []entity.PickupPointID{"tverskaya", "sukharevskaya", "vdnh"},
+		},
+		{
+			name:  "type filter",
+			query: entity.PickupPointNearbyQuery{Position: kremlin, RadiusM: 20000, Types: []entity.PickupPointType{entity.PickupPointTypeLocker, entity.PickupPointTypePostOffice}},
+			want:  []entity.PickupPointID{"lubyanka", "sukharevskaya", "vdnh"},
+		},
+		{
+			name:  "across the antimeridian",
+			query: entity.PickupPointNearbyQuery{Position: entity.Position{179.99, -16.5}, RadiusM: 20000},
+			want:  []entity.PickupPointID{"fiji-east", "fiji-west"},
+		},
+		{
+			name:  "nothing nearby",
+			query: entity.PickupPointNearbyQuery{Position: entity.Position{0, 0}},
+		},
+		{
+			name:    "error - latitude out of bounds",
+			query:   entity.PickupPointNearbyQuery{Position: entity.Position{37.6173, 91}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - radius too large",
+			query:   entity.PickupPointNearbyQuery{Position: kremlin, RadiusM: 100000},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - negative limit",
+			query:   entity.PickupPointNearbyQuery{Position: kremlin, Limit: -1},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown type",
+			query:   entity.PickupPointNearbyQuery{Position: kremlin, Types: []entity.PickupPointType{"drone"}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := uc.Nearby(context.Background(), tt.query)
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var got []entity.PickupPointID
+			for i, found := range res {
+				got = append(got, found.Point.PointID)
+
+				if i > 0 {
+					require.GreaterOrEqual(t, found.DistanceM, res[i-1].DistanceM)
+				}
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	res, err := uc.Nearby(context.Background(), entity.PickupPointNearbyQuery{Position: kremlin, Limit: 1})
+	require.NoError(t, err)
+	require.InDelta(t, 111, res[0].DistanceM, 1, "haversine distance of 0.001 degrees of latitude")
+}
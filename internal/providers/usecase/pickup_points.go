@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	_maxPickupPointIDLength      = 32
+	_maxPickupPointNameLength    = 128
+	_maxPickupPointAddressLength = 512
+	// _maxOpeningHours allows a few intervals on every weekday, lunch breaks for example.
+	_maxOpeningHours = 28
+)
+
+type UseCasePickupPoints struct {
+	repo      repo.PickupPointRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+}
+
+func NewUseCasePickupPoints(r repo.PickupPointRepo, p repo.ProviderRepo, tx repo.Transactor) *UseCasePickupPoints {
+	return &UseCasePickupPoints{
+		repo:      r,
+		providers: p,
+		tx:        tx,
+	}
+}
+
+// Create adds a pickup point to a provider, archived providers do not get new points.
+func (uc *UseCasePickupPoints) Create(ctx context.Context, point *entity.PickupPoint) (*entity.PickupPoint, error) {
+	if err := validatePickupPointID(point.PointID); err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Create - validatePickupPointID: %w", err)
+	}
+
+	normalizePickupPoint(point)
+
+	if err := validatePickupPoint(point, updatablePickupPointFields()); err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Create - validatePickupPoint: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// The lock keeps the provider from being archived or purged until the point is stored.
+		provider, err := uc.providers.GetForUpdate(ctx, point.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		if err := uc.repo.Store(ctx, point); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Create - uc.tx.InTx: %w", err)
+	}
+
+	return point, nil
+}
+
+func (uc *UseCasePickupPoints) GetByID(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID) (*entity.PickupPoint, error) {
+	point, err := uc.repo.GetByID(ctx, providerID, pointID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return point, nil
+}
+
+func (uc *UseCasePickupPoints) ListAll(ctx context.Context, params entity.PickupPointListParams) (*entity.PickupPointPage, error) {
+	query, err := newPickupPointQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - ListAll - newPickupPointQuery: %w", err)
+	}
+
+	points, err := uc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - ListAll - uc.repo.GetAll: %w", err)
+	}
+
+	page := &entity.PickupPointPage{}
+
+	var last *entity.PickupPoint
+	if page.PickupPoints, last = trimPage(points, query.Limit); last != nil {
+		page.NextPageToken = encodeIDPageToken(last.PointID)
+	}
+
+	return page, nil
+}
+
+// Update applies masked fields, a non-zero point.Version makes it conditional on the stored version.
+func (uc *UseCasePickupPoints) Update(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, point *entity.PickupPoint, mask entity.PickupPointMask) (*entity.PickupPoint, error) {
+	mask, err := normalizePickupPointMask(mask)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Update - normalizePickupPointMask: %w", err)
+	}
+
+	changes := *point
+	normalizePickupPoint(&changes)
+
+	if err := validatePickupPoint(&changes, mask); err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Update - validatePickupPoint: %w", err)
+	}
+
+	pointUpdated, err := uc.repo.Update(ctx, providerID, pointID, &changes, mask)
+	if err != nil {
+		return nil, fmt.Errorf("UseCasePickupPoints - Update - uc.repo.Update: %w", err)
+	}
+
+	return pointUpdated, nil
+}
+
+func (uc *UseCasePickupPoints) Delete(ctx context.Context, providerID entity.ProviderID, pointID entity.PickupPointID, version int64) error {
+	if err := uc.repo.Delete(ctx, providerID, pointID, version); err != nil {
+		return fmt.Errorf("UseCasePickupPoints - Delete - uc.repo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// updatablePickupPointFields is the set of fields "*" expands to.
+func updatablePickupPointFields() entity.PickupPointMask {
+	return entity.PickupPointMask{
+		entity.PickupPointFieldName,
+		entity.PickupPointFieldType,
+		entity.PickupPointFieldAddress,
+		entity.PickupPointFieldLocation,
+		entity.PickupPointFieldOpeningHours,
+		entity.PickupPointFieldPaymentOptions,
+		entity.PickupPointFieldMaxParcel,
+	}
+}
+
+func normalizePickupPointMask(mask entity.PickupPointMask) (entity.PickupPointMask, error) {
+	if len(mask) == 0 {
+		return nil, fmt.Errorf("update_mask is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	known := updatablePickupPointFields()
+	normalized := make(entity.PickupPointMask, 0, len(mask))
+
+	for _, field := range mask {
+		if field == _maskWildcard {
+			return known, nil
+		}
+
+		if !slices.Contains(known, field) {
+			return nil, fmt.Errorf("update_mask path %q: %w", field, entity.ErrInvalidArgument)
+		}
+
+		if !slices.Contains(normalized, field) {
+			normalized = append(normalized, field)
+		}
+	}
+
+	return normalized, nil
+}
+
+// normalizePickupPoint orders opening hours by weekday and time and payment options alphabetically.
+func normalizePickupPoint(point *entity.PickupPoint) {
+	sortOpeningHours(point.OpeningHours)
+	slices.Sort(point.PaymentOptions)
+	point.PaymentOptions = slices.Compact(point.PaymentOptions)
+}
+
+func sortOpeningHours(hours []entity.OpeningHours) {
+	slices.SortFunc(hours, func(a, b entity.OpeningHours) int {
+		return cmp.Or(cmp.Compare(a.Weekday, b.Weekday), cmp.Compare(a.Opens, b.Opens))
+	})
+}
+
+// validatePickupPoint checks the fields listed in mask.
+func validatePickupPoint(point *entity.PickupPoint, mask entity.PickupPointMask) error {
+	for _, field := range mask {
+		var err error
+
+		switch field {
+		case entity.PickupPointFieldName:
+			err = validateText(point.Name, _maxPickupPointNameLength)
+		case entity.PickupPointFieldType:
+			if !point.Type.Valid() {
+				err = fmt.Errorf("%q is unknown: %w", point.Type, entity.ErrInvalidArgument)
+			}
+		case entity.PickupPointFieldAddress:
+			err = validateText(point.Address, _maxPickupPointAddressLength)
+		case entity.PickupPointFieldLocation:
+			err = validatePosition(point.Position())
+		case entity.PickupPointFieldOpeningHours:
+			err = validateOpeningHours(point.OpeningHours)
+		case entity.PickupPointFieldPaymentOptions:
+			err = validatePaymentOptions(point.PaymentOptions)
+		case entity.PickupPointFieldMaxParcel:
+			err = validateParcelDimensions(point.MaxParcel)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+func validatePickupPointID(id entity.PickupPointID) error {
+	if id == "" {
+		return fmt.Errorf("point_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if len(id) > _maxPickupPointIDLength {
+		return fmt.Errorf("point_id is longer than %d: %w", _maxPickupPointIDLength, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateText(s string, maxLength int) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("is blank: %w", entity.ErrInvalidArgument)
+	}
+
+	if utf8.RuneCountInString(s) > maxLength {
+		return fmt.Errorf("is longer than %d: %w", maxLength, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// validateOpeningHours expects the hours ordered by sortOpeningHours.
+func validateOpeningHours(hours []entity.OpeningHours) error {
+	if len(hours) > _maxOpeningHours {
+		return fmt.Errorf("more than %d intervals: %w", _maxOpeningHours, entity.ErrInvalidArgument)
+	}
+
+	for i, h := range hours {
+		if h.Weekday < time.Sunday || h.Weekday > time.Saturday {
+			return fmt.Errorf("weekday %d is unknown: %w", h.Weekday, entity.ErrInvalidArgument)
+		}
+
+		if h.Opens < 0 || h.Closes > entity.EndOfDay || h.Opens >= h.Closes {
+			return fmt.Errorf("%s %s-%s is empty or out of the day: %w", h.Weekday, h.Opens, h.Closes, entity.ErrInvalidArgument)
+		}
+
+		if i > 0 && hours[i-1].Weekday == h.Weekday && h.Opens < hours[i-1].Closes {
+			return fmt.Errorf("%s %s-%s overlaps %s-%s: %w", h.Weekday, h.Opens, h.Closes, hours[i-1].Opens, hours[i-1].Closes, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func validatePaymentOptions(options []entity.PaymentOption) error {
+	if len(options) == 0 {
+		return fmt.Errorf("is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	for _, o := range options {
+		if !o.Valid() {
+			return fmt.Errorf("%q is unknown: %w", o, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func validateParcelDimensions(d entity.ParcelDimensions) error {
+	if d.LengthCM < 0 || d.WidthCM < 0 || d.HeightCM < 0 || d.WeightG < 0 {
+		return fmt.Errorf("is negative: %w", entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func newPickupPointQuery(params entity.PickupPointListParams) (entity.PickupPointQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.PickupPointQuery{}, err
+	}
+
+	after, err := decodeIDPageToken[entity.PickupPointID](params.PageToken)
+	if err != nil {
+		return entity.PickupPointQuery{}, err
+	}
+
+	return entity.PickupPointQuery{
+		ProviderID: params.ProviderID,
+		Limit:      limit,
+		After:      after,
+	}, nil
+}
@@ -0,0 +1,281 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func pickupPoint() *entity.PickupPoint {
+	return &entity.PickupPoint{
+		ProviderID: "kuper",
+		PointID:    "msk-0001",
+		Name:       "Пункт выдачи на Тверской",
+		Type:       entity.PickupPointTypePVZ,
+		Address:    "Москва, Тверская ул., 1",
+		Lat:        55.7558,
+		Lon:        37.6173,
+		OpeningHours: []entity.OpeningHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 21 * 60},
+		},
+		PaymentOptions: []entity.PaymentOption{entity.PaymentOptionCard},
+	}
+}
+
+func TestUseCasePickupPoints_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockPickupPointRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		modify  func(p *entity.PickupPoint)
+		check   func(t *testing.T, p *entity.PickupPoint)
+		wantErr error
+	}{
+		{
+			name:    "pvz",
+			prepare: stored,
+		},
+		{
+			name:    "hours and payment options are normalized",
+			prepare: stored,
+			modify: func(p *entity.PickupPoint) {
+				p.OpeningHours = []entity.OpeningHours{
+					{Weekday: time.Tuesday, Opens: 14 * 60, Closes: 20 * 60},
+					{Weekday: time.Sunday, Opens: 10 * 60, Closes: 16 * 60},
+					{Weekday: time.Tuesday, Opens: 9 * 60, Closes: 13 * 60},
+				}
+				p.PaymentOptions = []entity.PaymentOption{entity.PaymentOptionPrepaid, entity.PaymentOptionCard, entity.PaymentOptionPrepaid}
+			},
+			check: func(t *testing.T, p *entity.PickupPoint) {
+				t.Helper()
+
+				require.Equal(t, []entity.OpeningHours{
+					{Weekday: time.Sunday, Opens: 10 * 60, Closes: 16 * 60},
+					{Weekday: time.Tuesday, Opens: 9 * 60, Closes: 13 * 60},
+					{Weekday: time.Tuesday, Opens: 14 * 60, Closes: 20 * 60},
+				}, p.OpeningHours)
+				require.Equal(t, []entity.PaymentOption{entity.PaymentOptionCard, entity.PaymentOptionPrepaid}, p.PaymentOptions)
+			},
+		},
+		{
+			name:    "open until midnight",
+			prepare: stored,
+			modify: func(p *entity.PickupPoint) {
+				p.OpeningHours = []entity.OpeningHours{{Weekday: time.Friday, Opens: 0, Closes: entity.EndOfDay}}
+			},
+		},
+		{
+			name:    "error - empty point ID",
+			modify:  func(p *entity.PickupPoint) { p.PointID = "" },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown type",
+			modify:  func(p *entity.PickupPoint) { p.Type = "drone" },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - blank name",
+			modify:  func(p *entity.PickupPoint) { p.Name = " " },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - latitude out of range",
+			modify:  func(p *entity.PickupPoint) { p.Lat = 91 },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - hours overlap",
+			modify: func(p *entity.PickupPoint) {
+				p.OpeningHours = []entity.OpeningHours{
+					{Weekday: time.Monday, Opens: 9 * 60, Closes: 14 * 60},
+					{Weekday: time.Monday, Opens: 13 * 60, Closes: 20 * 60},
+				}
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - closes before it opens",
+			modify: func(p *entity.PickupPoint) {
+				p.OpeningHours = []entity.OpeningHours{{Weekday: time.Monday, Opens: 21 * 60, Closes: 9 * 60}}
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - no payment options",
+			modify:  func(p *entity.PickupPoint) { p.PaymentOptions = nil },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - negative parcel weight",
+			modify:  func(p *entity.PickupPoint) { p.MaxParcel.WeightG = -1 },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - point already exists",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExists)
+			},
+			wantErr: entity.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockPickupPointRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			point := pickupPoint()
+			if tt.modify != nil {
+				tt.modify(point)
+			}
+
+			uc := usecase.NewUseCasePickupPoints(f.repo, f.providers, f.tx)
+
+			res, err := uc.Create(context.Background(), point)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+
+			require.Equal(t, point, res)
+			if tt.check != nil {
+				tt.check(t, res)
+			}
+		})
+	}
+}
+
+func TestUseCasePickupPoints_Update(t *testing.T) {
+	t.Parallel()
+
+	updated := pickupPoint()
+	updated.Version = 3
+
+	tests := []struct {
+		name    string
+		prepare func(r *mock_repo.MockPickupPointRepo)
+		point   *entity.PickupPoint
+		mask    entity.PickupPointMask
+		want    *entity.PickupPoint
+		wantErr error
+	}{
+		{
+			name: "name only, other fields are not validated",
+			prepare: func(r *mock_repo.MockPickupPointRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.PickupPointID("msk-0001"), gomock.Any(), entity.PickupPointMask{entity.PickupPointFieldName}).Return(updated, nil)
+			},
+			point: &entity.PickupPoint{Name: "Пункт выдачи на Тверской"},
+			mask:  entity.PickupPointMask{entity.PickupPointFieldName, entity.PickupPointFieldName},
+			want:  updated,
+		},
+		{
+			name: "empty opening hours close the point",
+			prepare: func(r *mock_repo.MockPickupPointRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.PickupPointID("msk-0001"), gomock.Any(), entity.PickupPointMask{entity.PickupPointFieldOpeningHours}).Return(updated, nil)
+			},
+			point: &entity.PickupPoint{},
+			mask:  entity.PickupPointMask{entity.PickupPointFieldOpeningHours},
+			want:  updated,
+		},
+		{
+			name:    "error - wildcard validates every field",
+			point:   &entity.PickupPoint{Name: "Пункт выдачи на Тверской"},
+			mask:    entity.PickupPointMask{"*"},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - empty mask",
+			point:   &entity.PickupPoint{Name: "Пункт выдачи на Тверской"},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown path",
+			point:   &entity.PickupPoint{Name: "Пункт выдачи на Тверской"},
+			mask:    entity.PickupPointMask{"point_id"},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - longitude out of range",
+			point:   &entity.PickupPoint{Lat: 55.7558, Lon: 181},
+			mask:    entity.PickupPointMask{entity.PickupPointFieldLocation},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - stale version",
+			prepare: func(r *mock_repo.MockPickupPointRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.PickupPointID("msk-0001"), gomock.Any(), gomock.Any()).Return(nil, entity.ErrPreconditionFailed)
+			},
+			point:   &entity.PickupPoint{Name: "Пункт выдачи на Тверской", Version: 2},
+			mask:    entity.PickupPointMask{entity.PickupPointFieldName},
+			wantErr: entity.ErrPreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repo.NewMockPickupPointRepo(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(r)
+			}
+
+			uc := usecase.NewUseCasePickupPoints(r, mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			res, err := uc.Update(context.Background(), "kuper", "msk-0001", tt.point, tt.mask)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	_maxLegalNameLength = 256
+	_maxWebsiteLength   = 512
+	_maxContacts        = 10
+)
+
+var (
+	_taxIDPattern   = regexp.MustCompile(`^[A-Za-z0-9-]{4,32}$`)
+	_phonePattern   = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	_countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// validateProviderProfile checks the profile fields listed in mask.
+func validateProviderProfile(provider *entity.Provider, mask entity.ProviderMask) error {
+	for _, field := range mask {
+		var err error
+
+		switch field {
+		case entity.ProviderFieldLegalEntity:
+			err = validateLegalEntity(provider.LegalEntity)
+		case entity.ProviderFieldContacts:
+			err = validateContacts(provider.Contacts)
+		case entity.ProviderFieldWebsite:
+			err = validateWebsite(provider.Website)
+		case entity.ProviderFieldCountries:
+			err = validateCountries(provider.Countries)
+		case entity.ProviderFieldCapabilities:
+			err = validateCapabilities(provider.Capabilities)
+		case entity.ProviderFieldDisplayNames:
+			err = validateDisplayNames(provider.DisplayNames)
+		case entity.ProviderFieldLabels:
+			err = validateLabels(provider.Labels)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+func validateLegalEntity(legal entity.LegalEntity) error {
+	if len(legal.Name) > _maxLegalNameLength {
+		return fmt.Errorf("name is longer than %d: %w", _maxLegalNameLength, entity.ErrInvalidArgument)
+	}
+
+	if legal.TaxID != "" && !_taxIDPattern.MatchString(legal.TaxID) {
+		return fmt.Errorf("tax_id %q is malformed: %w", legal.TaxID, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateContacts(contacts []entity.Contact) error {
+	if len(contacts) > _maxContacts {
+		return fmt.Errorf("more than %d contacts: %w", _maxContacts, entity.ErrInvalidArgument)
+	}
+
+	for i, contact := range contacts {
+		if contact.Email == "" && contact.Phone == "" {
+			return fmt.Errorf("[%d] has neither email nor phone: %w", i, entity.ErrInvalidArgument)
+		}
+
+		if contact.Email != "" {
+			if addr, err := mail.ParseAddress(contact.Email); err != nil || addr.Address != contact.Email {
+				return fmt.Errorf("[%d] email %q is malformed: %w", i, contact.Email, entity.ErrInvalidArgument)
+			}
+		}
+
+		if contact.Phone != "" && !_phonePattern.MatchString(contact.Phone) {
+			return fmt.Errorf("[%d] phone %q is not in E.164 format: %w", i, contact.Phone, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func validateWebsite(website string) error {
+	if website == "" {
+		return nil
+	}
+
+	if len(website) > _maxWebsiteLength {
+		return fmt.Errorf("longer than %d: %w", _maxWebsiteLength, entity.ErrInvalidArgument)
+	}
+
+	u, err := url.Parse(website)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q is not an absolute http(s) URL: %w", website, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateCountries(countries []string) error {
+	for i, country := range countries {
+		if !_countryPattern.MatchString(country) {
+			return fmt.Errorf("%q is not an ISO 3166-1 alpha-2 code: %w", country, entity.ErrInvalidArgument)
+		}
+
+		if slices.Contains(countries[:i], country) {
+			return fmt.Errorf("%q is listed twice: %w", country, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func validateCapabilities(capabilities entity.Capabilities) error {
+	if capabilities.SameDay && !capabilities.Courier {
+		return fmt.Errorf("same_day requires courier: %w", entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
@@ -9,45 +9,210 @@ import (
 )
 
 type UseCaseProviders struct {
-	repo repo.ProviderRepo
+	repo  repo.ProviderRepo
+	audit repo.AuditRepo
+	tx    repo.Transactor
 }
 
-func NewUseCaseProviders(r repo.ProviderRepo) *UseCaseProviders {
+func NewUseCaseProviders(r repo.ProviderRepo, a repo.AuditRepo, tx repo.Transactor) *UseCaseProviders {
 	return &UseCaseProviders{
-		repo: r,
+		repo:  r,
+		audit: a,
+		tx:    tx,
 	}
 }
 
 func (uc *UseCaseProviders) Create(ctx context.Context, provider *entity.Provider) (entity.ProviderID, error) {
-	err := uc.repo.Store(ctx, provider)
+	displayNames, err := normalizeDisplayNames(provider.DisplayNames)
 	if err != nil {
-		return "", fmt.Errorf("UseCaseProviders - Save - uc.repo.Store: %w", err)
+		return "", fmt.Errorf("UseCaseProviders - Create - normalizeDisplayNames: %w", err)
+	}
+
+	provider.DisplayNames = displayNames
+
+	if err := validateProviderProfile(provider, updatableProviderFields()); err != nil {
+		return "", fmt.Errorf("UseCaseProviders - Create - validateProviderProfile: %w", err)
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Store(ctx, provider); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		created, err := uc.repo.GetByID(ctx, provider.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetByID: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionCreate, nil, created)
+	})
+	if err != nil {
+		return "", fmt.Errorf("UseCaseProviders - Save - uc.tx.InTx: %w", err)
 	}
 	return provider.ProviderID, nil
 }
 
-func (uc *UseCaseProviders) ListAll(ctx context.Context) ([]*entity.Provider, error) {
-	providers, err := uc.repo.GetAll(ctx)
+func (uc *UseCaseProviders) GetByID(ctx context.Context, providerID entity.ProviderID) (*entity.Provider, error) {
+	provider, err := uc.repo.GetByID(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (uc *UseCaseProviders) ListAll(ctx context.Context, params entity.ProviderListParams) (*entity.ProviderPage, error) {
+	query, err := newProviderQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - ListAll - newProviderQuery: %w", err)
+	}
+
+	providers, err := uc.repo.GetAll(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("UseCaseProviders - ListAll - uc.repo.GetAll: %w", err)
 	}
 
-	return providers, nil
+	page := &entity.ProviderPage{}
+
+	var last *entity.Provider
+	if page.Providers, last = trimPage(providers, query.Limit); last != nil {
+		page.NextPageToken = encodePageToken(query.Order, last)
+	}
+
+	return page, nil
 }
 
-func (uc *UseCaseProviders) Update(ctx context.Context, providerID entity.ProviderID, provider *entity.Provider) (*entity.Provider, error) {
-	providerUpdated, err := uc.repo.Update(ctx, providerID, provider)
+func (uc *UseCaseProviders) Update(ctx context.Context, providerID entity.ProviderID, provider *entity.Provider, mask entity.ProviderMask) (*entity.Provider, error) {
+	mask, err := normalizeProviderMask(mask)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Update - normalizeProviderMask: %w", err)
+	}
+
+	changes := *provider
+
+	changes.DisplayNames, err = normalizeDisplayNames(provider.DisplayNames)
 	if err != nil {
-		return nil, fmt.Errorf("UseCaseProviders - Update - uc.repo.Update: %w", err)
+		return nil, fmt.Errorf("UseCaseProviders - Update - normalizeDisplayNames: %w", err)
+	}
+
+	if err := validateProviderUpdate(&changes, mask); err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Update - validateProviderUpdate: %w", err)
+	}
+
+	var providerUpdated *entity.Provider
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		fields := mask
+
+		// Single keys are merged into the locked row, so that concurrent updates of different
+		// keys do not overwrite each other.
+		if hasEntries(fields, entity.ProviderFieldDisplayNames) {
+			changes.DisplayNames = mergeDisplayNames(old.DisplayNames, changes.DisplayNames, fields)
+			if err := validateDisplayNames(changes.DisplayNames); err != nil {
+				return fmt.Errorf("display_names: %w", err)
+			}
+
+			fields = withWholeMap(fields, entity.ProviderFieldDisplayNames)
+		}
+
+		if hasEntries(fields, entity.ProviderFieldLabels) {
+			changes.Labels = mergeLabels(old.Labels, changes.Labels, fields)
+			if err := validateLabels(changes.Labels); err != nil {
+				return fmt.Errorf("labels: %w", err)
+			}
+
+			fields = withWholeMap(fields, entity.ProviderFieldLabels)
+		}
+
+		providerUpdated, err = uc.repo.Update(ctx, providerID, &changes, fields)
+		if err != nil {
+			return fmt.Errorf("uc.repo.Update: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionUpdate, old, providerUpdated)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Update - uc.tx.InTx: %w", err)
 	}
 
 	return providerUpdated, nil
 }
 
-func (uc *UseCaseProviders) Delete(ctx context.Context, providerID entity.ProviderID) error {
-	err := uc.repo.Delete(ctx, providerID)
+func (uc *UseCaseProviders) Delete(ctx context.Context, providerID entity.ProviderID, version int64) error {
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		if err := uc.repo.Archive(ctx, providerID, version); err != nil {
+			return fmt.Errorf("uc.repo.Archive: %w", err)
+		}
+
+		archived, err := uc.repo.GetByID(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetByID: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionDelete, old, archived)
+	})
+	if err != nil {
+		return fmt.Errorf("UseCaseProviders - Delete - uc.tx.InTx: %w", err)
+	}
+
+	return nil
+}
+
+// Restore brings an archived provider back, restoring one that is not archived returns it unchanged.
+func (uc *UseCaseProviders) Restore(ctx context.Context, providerID entity.ProviderID) (*entity.Provider, error) {
+	var provider *entity.Provider
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		if !old.Archived() {
+			provider = old
+
+			return nil
+		}
+
+		provider, err = uc.repo.Restore(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.Restore: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionRestore, old, provider)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Restore - uc.tx.InTx: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (uc *UseCaseProviders) Purge(ctx context.Context, providerID entity.ProviderID) error {
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		if err := uc.repo.Purge(ctx, providerID); err != nil {
+			return fmt.Errorf("uc.repo.Purge: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionPurge, old, nil)
+	})
 	if err != nil {
-		return fmt.Errorf("UseCaseProviders - Delete - uc.repo.Delete: %w", err)
+		return fmt.Errorf("UseCaseProviders - Purge - uc.tx.InTx: %w", err)
 	}
 
 	return nil
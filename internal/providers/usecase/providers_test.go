@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/classydevv/fulfillment/internal/providers/entity"
 	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
@@ -14,9 +15,11 @@ import (
 
 func TestUseCaseProviders_Create(t *testing.T) {
 	t.Parallel()
-	
+
 	type fields struct {
-		repo *mock_repo.MockProviderRepo
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
 	}
 
 	type args struct {
@@ -34,15 +37,25 @@ func TestUseCaseProviders_Create(t *testing.T) {
 		{
 			name: "provider created successfully",
 			prepare: func(f *fields) {
+				expectInTx(f.tx)
 				f.repo.EXPECT().Store(context.Background(), &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}).Return(nil)
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
 			},
 			args:    args{ctx: context.Background(), provider: &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}},
 			want:    entity.ProviderID("id"),
 			wantErr: nil,
 		},
+		{
+			name:    "error - malformed country code",
+			args:    args{ctx: context.Background(), provider: &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name", Countries: []string{"RU", "rus"}}},
+			want:    entity.ProviderID(""),
+			wantErr: entity.ErrInvalidArgument,
+		},
 		{
 			name: "error - missing required field",
 			prepare: func(f *fields) {
+				expectInTx(f.tx)
 				f.repo.EXPECT().Store(context.Background(), &entity.Provider{ProviderID: entity.ProviderID("id")}).Return(entity.ErrInternalServerError)
 			},
 			args:    args{ctx: context.Background(), provider: &entity.Provider{ProviderID: entity.ProviderID("id")}},
@@ -52,6 +65,7 @@ func TestUseCaseProviders_Create(t *testing.T) {
 		{
 			name: "error - entity already exists",
 			prepare: func(f *fields) {
+				expectInTx(f.tx)
 				f.repo.EXPECT().Store(context.Background(), &entity.Provider{ProviderID: entity.ProviderID("id")}).Return(entity.ErrAlreadyExists)
 			},
 			args:    args{ctx: context.Background(), provider: &entity.Provider{ProviderID: entity.ProviderID("id")}},
@@ -61,6 +75,7 @@ func TestUseCaseProviders_Create(t *testing.T) {
 		{
 			name: "error - database not available",
 			prepare: func(f *fields) {
+				expectInTx(f.tx)
 				f.repo.EXPECT().Store(context.Background(), &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}).Return(entity.ErrInternalServerError)
 			},
 			args:    args{ctx: context.Background(), provider: &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}},
@@ -77,13 +92,15 @@ func TestUseCaseProviders_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := fields{
-				repo: mock_repo.NewMockProviderRepo(ctrl),
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
 			}
 			if tt.prepare != nil {
 				tt.prepare(&f)
 			}
 
-			uc := usecase.NewUseCaseProviders(f.repo)
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
 
 			res, err := uc.Create(tt.args.ctx, tt.args.provider)
 
@@ -93,37 +110,164 @@ func TestUseCaseProviders_Create(t *testing.T) {
 	}
 }
 
-func TestUseCaseProviders_ListAll(t *testing.T) {
+func TestUseCaseProviders_GetByID(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		repo *mock_repo.MockProviderRepo
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
 	}
 
 	type args struct {
 		ctx context.Context
+		id  entity.ProviderID
 	}
 
 	tests := []struct {
 		name    string
 		prepare func(f *fields)
 		args    args
-		want    []*entity.Provider
+		want    *entity.Provider
 		wantErr error
+	}{
+		{
+			name: "provider found",
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"},
+			wantErr: nil,
+		},
+		{
+			name: "error - provider not found",
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("id")).Return(nil, entity.ErrNotFound)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - database not available",
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("id")).Return(nil, entity.ErrInternalServerError)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
+
+			res, err := uc.GetByID(tt.args.ctx, tt.args.id)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseProviders_ListAll(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
+	}
+
+	type args struct {
+		ctx    context.Context
+		params entity.ProviderListParams
+	}
+
+	createdAt := time.Date(2025, 5, 8, 6, 7, 14, 0, time.UTC)
+	defaultQuery := entity.ProviderQuery{
+		Order: entity.ProviderOrder{Field: entity.ProviderOrderByProviderID},
+		Limit: 51,
+	}
+	pageQuery := entity.ProviderQuery{
+		Order: entity.ProviderOrder{Field: entity.ProviderOrderByCreatedAt, Desc: true},
+		Limit: 3,
+	}
+	nextPageQuery := pageQuery
+	nextPageQuery.After = &entity.ProviderCursor{ProviderID: entity.ProviderID("b"), CreatedAt: createdAt}
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		args     args
+		want     []*entity.Provider
+		wantNext bool
+		wantErr  error
 	}{
 		{
 			name: "providers listed successfully",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().GetAll(context.Background()).Return([]*entity.Provider{}, nil)
+				f.repo.EXPECT().GetAll(context.Background(), defaultQuery).Return([]*entity.Provider{}, nil)
 			},
 			args:    args{ctx: context.Background()},
 			want:    []*entity.Provider{},
 			wantErr: nil,
 		},
+		{
+			name: "extra row yields next page token",
+			prepare: func(f *fields) {
+				f.repo.EXPECT().GetAll(context.Background(), pageQuery).Return([]*entity.Provider{
+					{ProviderID: entity.ProviderID("a"), CreatedAt: createdAt},
+					{ProviderID: entity.ProviderID("b"), CreatedAt: createdAt},
+					{ProviderID: entity.ProviderID("c"), CreatedAt: createdAt},
+				}, nil)
+			},
+			args: args{ctx: context.Background(), params: entity.ProviderListParams{PageSize: 2, OrderBy: "created_at desc"}},
+			want: []*entity.Provider{
+				{ProviderID: entity.ProviderID("a"), CreatedAt: createdAt},
+				{ProviderID: entity.ProviderID("b"), CreatedAt: createdAt},
+			},
+			wantNext: true,
+			wantErr:  nil,
+		},
+		{
+			name:    "error - unknown order_by field",
+			args:    args{ctx: context.Background(), params: entity.ProviderListParams{OrderBy: "name"}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - malformed page token",
+			args:    args{ctx: context.Background(), params: entity.ProviderListParams{PageToken: "%%%"}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - negative page size",
+			args:    args{ctx: context.Background(), params: entity.ProviderListParams{PageSize: -1}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
 		{
 			name: "error - database not available",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().GetAll(context.Background()).Return(nil, entity.ErrInternalServerError)
+				f.repo.EXPECT().GetAll(context.Background(), defaultQuery).Return(nil, entity.ErrInternalServerError)
 			},
 			args:    args{ctx: context.Background()},
 			want:    nil,
@@ -138,33 +282,90 @@ func TestUseCaseProviders_ListAll(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := fields{
-				repo: mock_repo.NewMockProviderRepo(ctrl),
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
 			}
 			if tt.prepare != nil {
 				tt.prepare(&f)
 			}
 
-			uc := usecase.NewUseCaseProviders(f.repo)
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
 
-			res, err := uc.ListAll(tt.args.ctx)
+			res, err := uc.ListAll(tt.args.ctx, tt.args.params)
 
-			require.Equal(t, tt.want, res)
 			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				require.Nil(t, res)
+
+				return
+			}
+			require.Equal(t, tt.want, res.Providers)
+			require.Equal(t, tt.wantNext, res.NextPageToken != "")
 		})
 	}
+
+	t.Run("next page token resumes after the last provider", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mock_repo.NewMockProviderRepo(ctrl)
+		gomock.InOrder(
+			repo.EXPECT().GetAll(context.Background(), pageQuery).Return([]*entity.Provider{
+				{ProviderID: entity.ProviderID("a"), CreatedAt: createdAt},
+				{ProviderID: entity.ProviderID("b"), CreatedAt: createdAt},
+				{ProviderID: entity.ProviderID("c"), CreatedAt: createdAt},
+			}, nil),
+			repo.EXPECT().GetAll(context.Background(), nextPageQuery).Return([]*entity.Provider{
+				{ProviderID: entity.ProviderID("c"), CreatedAt: createdAt},
+			}, nil),
+		)
+
+		uc := usecase.NewUseCaseProviders(repo, mock_repo.NewMockAuditRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+		params := entity.ProviderListParams{PageSize: 2, OrderBy: "created_at desc"}
+
+		first, err := uc.ListAll(context.Background(), params)
+		require.NoError(t, err)
+
+		params.PageToken = first.NextPageToken
+		second, err := uc.ListAll(context.Background(), params)
+		require.NoError(t, err)
+		require.Len(t, second.Providers, 1)
+		require.Empty(t, second.NextPageToken)
+
+		params.OrderBy = "provider_id"
+		_, err = uc.ListAll(context.Background(), params)
+		require.ErrorIs(t, err, entity.ErrInvalidArgument)
+	})
 }
 
 func TestUseCaseProviders_Update(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		repo *mock_repo.MockProviderRepo
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
 	}
 
 	type args struct {
 		ctx      context.Context
 		id       entity.ProviderID
 		provider *entity.Provider
+		mask     entity.ProviderMask
+	}
+
+	nameMask := entity.ProviderMask{entity.ProviderFieldName}
+	fullMask := entity.ProviderMask{
+		entity.ProviderFieldName,
+		entity.ProviderFieldLegalEntity,
+		entity.ProviderFieldContacts,
+		entity.ProviderFieldWebsite,
+		entity.ProviderFieldCountries,
+		entity.ProviderFieldCapabilities,
+		entity.ProviderFieldDisplayNames,
+		entity.ProviderFieldLabels,
 	}
 
 	tests := []struct {
@@ -177,27 +378,178 @@ func TestUseCaseProviders_Update(t *testing.T) {
 		{
 			name: "provider updated successfully",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}, nameMask).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}, mask: nameMask},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"},
+			wantErr: nil,
+		},
+		{
+			name: "wildcard mask expands to all updatable fields",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}, fullMask).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
 			},
-			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}, mask: entity.ProviderMask{"*"}},
 			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"},
 			wantErr: nil,
 		},
+		{
+			name: "single languages are merged into stored display names",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{
+					ProviderID:   entity.ProviderID("id"),
+					DisplayNames: map[string]string{"ru": "Купер", "kk": "Купер"},
+				}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{
+					DisplayNames: map[string]string{"ru": "Купер", "en-GB": "Kuper"},
+				}, entity.ProviderMask{entity.ProviderFieldDisplayNames}).Return(&entity.Provider{ProviderID: entity.ProviderID("id")}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args: args{
+				ctx:      context.Background(),
+				id:       entity.ProviderID("id"),
+				provider: &entity.Provider{DisplayNames: map[string]string{"en-gb": "Kuper"}},
+				mask:     entity.ProviderMask{entity.ProviderFieldDisplayNames.Entry("en-gb"), entity.ProviderFieldDisplayNames.Entry("kk")},
+			},
+			want: &entity.Provider{ProviderID: entity.ProviderID("id")},
+		},
+		{
+			name: "single labels are set, emptied and removed",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{
+					ProviderID: entity.ProviderID("id"),
+					Labels:     map[string]string{"region": "msk", "legacy": "true", "tier": "silver"},
+				}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{
+					Labels: map[string]string{"region": "", "tier": "gold"},
+				}, entity.ProviderMask{entity.ProviderFieldLabels}).Return(&entity.Provider{ProviderID: entity.ProviderID("id")}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args: args{
+				ctx:      context.Background(),
+				id:       entity.ProviderID("id"),
+				provider: &entity.Provider{Labels: map[string]string{"tier": "gold", "region": ""}},
+				mask: entity.ProviderMask{
+					entity.ProviderFieldLabels.Entry("tier"),
+					entity.ProviderFieldLabels.Entry("region"),
+					entity.ProviderFieldLabels.Entry("legacy"),
+				},
+			},
+			want: &entity.Provider{ProviderID: entity.ProviderID("id")},
+		},
+		{
+			name: "error - malformed label",
+			args: args{
+				ctx:      context.Background(),
+				id:       entity.ProviderID("id"),
+				provider: &entity.Provider{Labels: map[string]string{"tier": "gold!"}},
+				mask:     entity.ProviderMask{entity.ProviderFieldLabels},
+			},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - unknown language in mask",
+			args: args{
+				ctx:      context.Background(),
+				id:       entity.ProviderID("id"),
+				provider: &entity.Provider{},
+				mask:     entity.ProviderMask{entity.ProviderFieldDisplayNames.Entry("not a language")},
+			},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - empty display name",
+			args: args{
+				ctx:      context.Background(),
+				id:       entity.ProviderID("id"),
+				provider: &entity.Provider{DisplayNames: map[string]string{"en": " "}},
+				mask:     entity.ProviderMask{entity.ProviderFieldDisplayNames},
+			},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - empty mask",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown mask path",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}, mask: entity.ProviderMask{"created_at"}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - contact without email and phone",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Contacts: []entity.Contact{{Name: "support"}}}, mask: entity.ProviderMask{entity.ProviderFieldContacts}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - phone is not in E.164 format",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Contacts: []entity.Contact{{Phone: "8 800 555-35-35"}}}, mask: entity.ProviderMask{entity.ProviderFieldContacts}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - same-day delivery without courier",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Capabilities: entity.Capabilities{SameDay: true}}, mask: entity.ProviderMask{entity.ProviderFieldCapabilities}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - website is not an http URL",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Website: "ftp://kuper.ru"}, mask: entity.ProviderMask{entity.ProviderFieldWebsite}},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - masked name is empty",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{}, mask: nameMask},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider changed since it was read",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name", Version: 2}, nameMask).Return(nil, entity.ErrPreconditionFailed)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name", Version: 2}, mask: nameMask},
+			want:    nil,
+			wantErr: entity.ErrPreconditionFailed,
+		},
 		{
 			name: "error - provider not found",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}).Return(nil, entity.ErrNotFound)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}, nameMask).Return(nil, entity.ErrNotFound)
 			},
-			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}, mask: nameMask},
 			want:    nil,
 			wantErr: entity.ErrNotFound,
 		},
 		{
 			name: "error - database not available",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}).Return(nil, entity.ErrInternalServerError)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Update(context.Background(), entity.ProviderID("id"), &entity.Provider{Name: "name"}, nameMask).Return(nil, entity.ErrInternalServerError)
 			},
-			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), provider: &entity.Provider{Name: "name"}, mask: nameMask},
 			want:    nil,
 			wantErr: entity.ErrInternalServerError,
 		},
@@ -211,15 +563,17 @@ func TestUseCaseProviders_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := fields{
-				repo: mock_repo.NewMockProviderRepo(ctrl),
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
 			}
 			if tt.prepare != nil {
 				tt.prepare(&f)
 			}
 
-			uc := usecase.NewUseCaseProviders(f.repo)
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
 
-			res, err := uc.Update(tt.args.ctx, tt.args.id, tt.args.provider)
+			res, err := uc.Update(tt.args.ctx, tt.args.id, tt.args.provider, tt.args.mask)
 
 			require.Equal(t, tt.want, res)
 			require.ErrorIs(t, err, tt.wantErr)
@@ -231,14 +585,19 @@ func TestUseCaseProviders_Delete(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		repo *mock_repo.MockProviderRepo
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
 	}
 
 	type args struct {
-		ctx context.Context
-		id  entity.ProviderID
+		ctx     context.Context
+		id      entity.ProviderID
+		version int64
 	}
 
+	archivedAt := time.Date(2025, 5, 8, 6, 7, 14, 0, time.UTC)
+
 	tests := []struct {
 		name    string
 		prepare func(f *fields)
@@ -248,15 +607,31 @@ func TestUseCaseProviders_Delete(t *testing.T) {
 		{
 			name: "provider deleted successfully",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(context.Background(), entity.ProviderID("id")).Return(nil)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Archive(context.Background(), entity.ProviderID("id"), int64(0)).Return(nil)
+				f.repo.EXPECT().GetByID(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old", DeletedAt: &archivedAt}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
 			},
 			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
 			wantErr: nil,
 		},
+		{
+			name: "error - provider changed since it was read",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Archive(context.Background(), entity.ProviderID("id"), int64(2)).Return(entity.ErrPreconditionFailed)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), version: 2},
+			wantErr: entity.ErrPreconditionFailed,
+		},
 		{
 			name: "error - provider not found",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(context.Background(), entity.ProviderID("id")).Return(entity.ErrNotFound)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Archive(context.Background(), entity.ProviderID("id"), int64(0)).Return(entity.ErrNotFound)
 			},
 			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
 			wantErr: entity.ErrNotFound,
@@ -264,7 +639,9 @@ func TestUseCaseProviders_Delete(t *testing.T) {
 		{
 			name: "error - database not available",
 			prepare: func(f *fields) {
-				f.repo.EXPECT().Delete(context.Background(), entity.ProviderID("id")).Return(entity.ErrInternalServerError)
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old"}, nil)
+				f.repo.EXPECT().Archive(context.Background(), entity.ProviderID("id"), int64(0)).Return(entity.ErrInternalServerError)
 			},
 			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
 			wantErr: entity.ErrInternalServerError,
@@ -279,17 +656,109 @@ func TestUseCaseProviders_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			f := fields{
-				repo: mock_repo.NewMockProviderRepo(ctrl),
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
+
+			err := uc.Delete(tt.args.ctx, tt.args.id, tt.args.version)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseProviders_Restore(t *testing.T) {
+	t.Parallel()
+
+	archivedAt := time.Now()
+
+	type fields struct {
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
+	}
+
+	type args struct {
+		ctx context.Context
+		id  entity.ProviderID
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		args    args
+		want    *entity.Provider
+		wantErr error
+	}{
+		{
+			name: "provider restored successfully",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "old", DeletedAt: &archivedAt}, nil)
+				f.repo.EXPECT().Restore(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"},
+			wantErr: nil,
+		},
+		{
+			name: "provider not archived is returned unchanged",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Name: "name"},
+			wantErr: nil,
+		},
+		{
+			name: "error - provider missing",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(nil, entity.ErrNotFound)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
 			}
 			if tt.prepare != nil {
 				tt.prepare(&f)
 			}
 
-			uc := usecase.NewUseCaseProviders(f.repo)
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
 
-			err := uc.Delete(tt.args.ctx, tt.args.id)
+			res, err := uc.Restore(tt.args.ctx, tt.args.id)
 
+			require.Equal(t, tt.want, res)
 			require.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
+
+// expectInTx makes the mocked transaction run its body directly, as a committed transaction would.
+func expectInTx(tx *mock_repo.MockTransactor) {
+	tx.EXPECT().InTx(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+}
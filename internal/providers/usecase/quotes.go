@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+// UseCaseQuotes prices a shipment by the tariffs of every provider delivering to its destination.
+type UseCaseQuotes struct {
+	coverage Coverage
+	tariffs  repo.TariffRepo
+	// timeout bounds a quote, providers not evaluated by then are reported as timed out.
+	timeout time.Duration
+}
+
+func NewUseCaseQuotes(coverage Coverage, tariffs repo.TariffRepo, timeout time.Duration) *UseCaseQuotes {
+	return &UseCaseQuotes{
+		coverage: coverage,
+		tariffs:  tariffs,
+		timeout:  timeout,
+	}
+}
+
+// providerQuote is the outcome of evaluating the tariffs of a provider.
+type providerQuote struct {
+	providerID entity.ProviderID
+	options    []*entity.QuoteOption
+	// tariffs is the number of tariffs of the provider, providers without any are not eligible.
+	tariffs int
+	err     error
+}
+
+// Quote evaluates the providers concurrently and returns whatever is ready by the deadline, the earlier of the
+// caller's one and the quote timeout. Prices come from the tariff revisions in effect at the time, now when it is
+// zero. Options are grouped by currency, cheapest and then fastest first.
+func (uc *UseCaseQuotes) Quote(ctx context.Context, shipment entity.Shipment, at time.Time) (*entity.Quote, error) {
+	if err := validateShipment(shipment); err != nil {
+		return nil, fmt.Errorf("UseCaseQuotes - Quote - validateShipment: %w", err)
+	}
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	matches, err := uc.coverage.Lookup(ctx, shipment.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseQuotes - Quote - uc.coverage.Lookup: %w", err)
+	}
+
+	quoteCtx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
+
+	// The channel is buffered, so evaluations finishing after the deadline do not block.
+	results := make(chan providerQuote, len(matches))
+
+	for _, match := range matches {
+		go func() {
+			results <- uc.quoteProvider(quoteCtx, match.ProviderID, shipment, at)
+		}()
+	}
+
+	pending := make(map[entity.ProviderID]struct{}, len(matches))
+	for _, match := range matches {
+		pending[match.ProviderID] = struct{}{}
+	}
+
+	quote := &entity.Quote{}
+
+collect:
+	for len(pending) > 0 {
+		select {
+		case result := <-results:
+			delete(pending, result.providerID)
+			collectProviderQuote(quote, result)
+		case <-quoteCtx.Done():
+			break collect
+		}
+	}
+
+	// A caller that went away gets nothing, a deadline yields a partial quote.
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return nil, fmt.Errorf("UseCaseQuotes - Quote: %w", ctx.Err())
+	}
+
+	for providerID := range pending {
+		quote.Failures = append(quote.Failures, entity.QuoteFailure{ProviderID: providerID, Reason: entity.QuoteFailureTimeout})
+	}
+
+	slices.SortFunc(quote.Options, compareQuoteOptions)
+	slices.SortFunc(quote.Failures, func(a, b entity.QuoteFailure) int {
+		return strings.Compare(string(a.ProviderID), string(b.ProviderID))
+	})
+
+	return quote, nil
+}
+
+// quoteProvider evaluates every tariff of the provider a page at a time, the ones without a revision in effect
+// at the time or not pricing the shipment are skipped.
+func (uc *UseCaseQuotes) quoteProvider(ctx context.Context, providerID entity.ProviderID, shipment entity.Shipment, at time.Time) providerQuote {
+	result := providerQuote{providerID: providerID}
+
+	query := entity.TariffQuery{ProviderID: providerID, Limit: _maxPageSize}
+
+	for {
+		tariffs, err := uc.tariffs.GetAll(ctx, query)
+		if err != nil {
+			result.err = fmt.Errorf("uc.tariffs.GetAll: %w", err)
+
+			return result
+		}
+
+		result.tariffs += len(tariffs)
+
+		options, err := uc.quoteTariffs(ctx, providerID, tariffs, shipment, at)
+		if err != nil {
+			result.err = fmt.Errorf("uc.quoteTariffs: %w", err)
+
+			return result
+		}
+
+		result.options = append(result.options, options...)
+
+		if uint64(len(tariffs)) < query.Limit {
+			return result
+		}
+
+		query.After = tariffs[len(tariffs)-1].TariffID
+	}
+}
+
+// quoteTariffs evaluates a page of tariffs of the provider.
+func (uc *UseCaseQuotes) quoteTariffs(ctx context.Context, providerID entity.ProviderID, tariffs []*entity.Tariff, shipment entity.Shipment, at time.Time) ([]*entity.QuoteOption, error) {
+	if len(tariffs) == 0 {
+		return nil, nil
+	}
+
+	tariffIDs := make([]entity.TariffID, len(tariffs))
+	for i, tariff := range tariffs {
+		tariffIDs[i] = tariff.TariffID
+	}
+
+	revisions, err := uc.tariffs.GetRevisionsAt(ctx, providerID, tariffIDs, at)
+	if err != nil {
+		return nil, fmt.Errorf("uc.tariffs.GetRevisionsAt: %w", err)
+	}
+
+	inEffect := make(map[entity.TariffID]*entity.TariffRevision, len(revisions))
+	for _, revision := range revisions {
+		if revision.InEffect(at) {
+			inEffect[revision.TariffID] = revision
+		}
+	}
+
+	var options []*entity.QuoteOption
+
+	for _, tariff := range tariffs {
+		revision, ok := inEffect[tariff.TariffID]
+		if !ok {
+			continue
+		}
+
+		price, err := evaluateTariff(tariff, revision, shipment)
+		if errors.Is(err, entity.ErrTariffNotApplicable) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("evaluateTariff %s: %w", tariff.TariffID, err)
+		}
+
+		options = append(options, &entity.QuoteOption{
+			ProviderID:     providerID,
+			TariffID:       tariff.TariffID,
+			TariffName:     tariff.Name,
+			ServiceLevel:   tariff.ServiceLevel,
+			TransitDaysMin: tariff.TransitDaysMin,
+			TransitDaysMax: tariff.TransitDaysMax,
+			Price:          price,
+		})
+	}
+
+	return options, nil
+}
+
+func collectProviderQuote(quote *entity.Quote, result providerQuote) {
+	switch {
+	case errors.Is(result.err, context.DeadlineExceeded):
+		quote.Failures = append(quote.Failures, entity.QuoteFailure{ProviderID: result.providerID, Reason: entity.QuoteFailureTimeout})
+	case result.err != nil:
+		quote.Failures = append(quote.Failures, entity.QuoteFailure{ProviderID: result.providerID, Reason: entity.QuoteFailureInternal})
+	case result.tariffs == 0:
+		// Providers without tariffs do not quote at all.
+	case len(result.options) == 0:
+		quote.Failures = append(quote.Failures, entity.QuoteFailure{ProviderID: result.providerID, Reason: entity.QuoteFailureNotApplicable})
+	default:
+		quote.Options = append(quote.Options, result.options...)
+	}
+}
+
+func compareQuoteOptions(a, b *entity.QuoteOption) int {
+	return cmp.Or(
+		strings.Compare(string(a.Price.Currency), string(b.Price.Currency)),
+		a.Price.Total.Cmp(b.Price.Total),
+		cmp.Compare(a.TransitDaysMax, b.TransitDaysMax),
+		strings.Compare(string(a.ProviderID), string(b.ProviderID)),
+		strings.Compare(string(a.TariffID), string(b.TariffID)),
+	)
+}
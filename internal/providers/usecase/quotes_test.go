@@ -0,0 +1,225 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	mock_usecase "github.com/classydevv/fulfillment/internal/providers/usecase/mocks"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseQuotes_Quote(t *testing.T) {
+	t.Parallel()
+
+	shipment := entity.Shipment{
+		Origin:      entity.Position{37.6173, 55.7558},
+		Destination: entity.Position{37.6173, 55.7900},
+		Parcels:     []entity.ParcelDimensions{{WeightG: 800}},
+	}
+
+	effectiveFrom := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	revisionOf := func(providerID entity.ProviderID, tariffID entity.TariffID, rules entity.TariffRules) *entity.TariffRevision {
+		return &entity.TariffRevision{
+			ProviderID:    providerID,
+			TariffID:      tariffID,
+			Revision:      1,
+			Rules:         rules,
+			EffectiveFrom: effectiveFrom,
+		}
+	}
+
+	// tariffOf prices every parcel up to 1 kg at the price and delivers in the days.
+	tariffOf := func(providerID entity.ProviderID, tariffID entity.TariffID, price string, days int) *entity.Tariff {
+		return &entity.Tariff{
+			ProviderID:     providerID,
+			TariffID:       tariffID,
+			Currency:       "RUB",
+			ServiceLevel:   entity.ServiceLevelStandard,
+			TransitDaysMax: days,
+			Revision: revisionOf(providerID, tariffID, entity.TariffRules{
+				WeightBreaks: []entity.WeightBreak{{UpToG: 1000, Price: decimal.RequireFromString(price)}},
+			}),
+		}
+	}
+
+	type fields struct {
+		coverage *mock_usecase.MockCoverage
+		tariffs  *mock_repo.MockTariffRepo
+	}
+
+	covered := func(f *fields, providerIDs ...entity.ProviderID) {
+		matches := make([]*entity.CoverageMatch, len(providerIDs))
+		for i, providerID := range providerIDs {
+			matches[i] = &entity.CoverageMatch{ProviderID: providerID}
+		}
+
+		f.coverage.EXPECT().Lookup(gomock.Any(), shipment.Destination).Return(matches, nil)
+	}
+
+	// tariffs returns the tariffs of the provider with their revisions.
+	tariffs := func(f *fields, providerID entity.ProviderID, tariffs ...*entity.Tariff) {
+		f.tariffs.EXPECT().GetAll(gomock.Any(), gomock.Cond(func(q entity.TariffQuery) bool {
+			return q.ProviderID == providerID
+		})).Return(tariffs, nil)
+
+		if len(tariffs) == 0 {
+			return
+		}
+
+		revisions := make([]*entity.TariffRevision, len(tariffs))
+		for i, tariff := range tariffs {
+			revisions[i] = tariff.Revision
+		}
+
+		f.tariffs.EXPECT().GetRevisionsAt(gomock.Any(), providerID, gomock.Any(), gomock.Any()).Return(revisions, nil)
+	}
+
+	tests := []struct {
+		name         string
+		shipment     entity.Shipment
+		at           time.Time
+		prepare      func(f *fields)
+		wantOptions  []string
+		wantFailures []entity.QuoteFailure
+		wantErr      error
+	}{
+		{
+			name:     "cheapest and then fastest first",
+			shipment: shipment,
+			prepare: func(f *fields) {
+				covered(f, "kuper", "lavka", "empty")
+				tariffs(f, "kuper", tariffOf("kuper", "standard", "300", 3), tariffOf("kuper", "express", "500", 1))
+				tariffs(f, "lavka", tariffOf("lavka", "standard", "300", 2))
+				tariffs(f, "empty")
+			},
+			wantOptions: []string{"lavka/standard", "kuper/standard", "kuper/express"},
+		},
+		{
+			name:     "failed providers are reported",
+			shipment: shipment,
+			prepare: func(f *fields) {
+				covered(f, "kuper", "lavka", "heavy")
+				tariffs(f, "kuper", tariffOf("kuper", "standard", "300", 3))
+				f.tariffs.EXPECT().GetAll(gomock.Any(), entity.TariffQuery{ProviderID: "lavka", Limit: 500}).Return(nil, errors.New("connection reset"))
+				tariffs(f, "heavy", &entity.Tariff{
+					ProviderID: "heavy",
+					TariffID:   "pallets",
+					Currency:   "RUB",
+					Revision: revisionOf("heavy", "pallets", entity.TariffRules{
+						WeightBreaks: []entity.WeightBreak{{UpToG: 1}},
+					}),
+				})
+			},
+			wantOptions: []string{"kuper/standard"},
+			wantFailures: []entity.QuoteFailure{
+				{ProviderID: "heavy", Reason: entity.QuoteFailureNotApplicable},
+				{ProviderID: "lavka", Reason: entity.QuoteFailureInternal},
+			},
+		},
+		{
+			name:     "tariffs past the first page are quoted",
+			shipment: shipment,
+			prepare: func(f *fields) {
+				covered(f, "kuper")
+
+				firstPage := make([]*entity.Tariff, 500)
+				for i := range firstPage {
+					firstPage[i] = &entity.Tariff{ProviderID: "kuper", TariffID: entity.TariffID(fmt.Sprintf("archive-%03d", i))}
+				}
+
+				f.tariffs.EXPECT().GetAll(gomock.Any(), entity.TariffQuery{ProviderID: "kuper", Limit: 500}).Return(firstPage, nil)
+				// None of the first page is in effect.
+				f.tariffs.EXPECT().GetRevisionsAt(gomock.Any(), entity.ProviderID("kuper"), gomock.Len(500), gomock.Any()).Return(nil, nil)
+
+				last := tariffOf("kuper", "standard", "300", 3)
+				f.tariffs.EXPECT().GetAll(gomock.Any(), entity.TariffQuery{ProviderID: "kuper", After: "archive-499", Limit: 500}).Return([]*entity.Tariff{last}, nil)
+				f.tariffs.EXPECT().GetRevisionsAt(gomock.Any(), entity.ProviderID("kuper"), []entity.TariffID{"standard"}, gomock.Any()).
+					Return([]*entity.TariffRevision{last.Revision}, nil)
+			},
+			wantOptions: []string{"kuper/standard"},
+		},
+		{
+			name:     "before the revisions start",
+			shipment: shipment,
+			at:       effectiveFrom.Add(-time.Hour),
+			prepare: func(f *fields) {
+				covered(f, "kuper")
+				tariffs(f, "kuper", tariffOf("kuper", "standard", "300", 3))
+			},
+			wantFailures: []entity.QuoteFailure{{ProviderID: "kuper", Reason: entity.QuoteFailureNotApplicable}},
+		},
+		{
+			name:     "slow provider times out",
+			shipment: shipment,
+			prepare: func(f *fields) {
+				covered(f, "kuper", "slow")
+				tariffs(f, "kuper", tariffOf("kuper", "standard", "300", 3))
+				// The provider ignores the deadline, the quote does not wait for it.
+				f.tariffs.EXPECT().GetAll(gomock.Any(), entity.TariffQuery{ProviderID: "slow", Limit: 500}).
+					DoAndReturn(func(context.Context, entity.TariffQuery) ([]*entity.Tariff, error) {
+						time.Sleep(time.Second)
+
+						return nil, nil
+					}).
+					MaxTimes(1)
+			},
+			wantOptions:  []string{"kuper/standard"},
+			wantFailures: []entity.QuoteFailure{{ProviderID: "slow", Reason: entity.QuoteFailureTimeout}},
+		},
+		{
+			name:     "no provider delivers",
+			shipment: shipment,
+			prepare: func(f *fields) {
+				covered(f)
+			},
+		},
+		{
+			name:     "error - no parcels",
+			shipment: entity.Shipment{Origin: shipment.Origin, Destination: shipment.Destination},
+			wantErr:  entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				coverage: mock_usecase.NewMockCoverage(ctrl),
+				tariffs:  mock_repo.NewMockTariffRepo(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseQuotes(f.coverage, f.tariffs, 100*time.Millisecond)
+
+			res, err := uc.Quote(context.Background(), tt.shipment, tt.at)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			var options []string
+			for _, option := range res.Options {
+				options = append(options, string(option.ProviderID)+"/"+string(option.TariffID))
+			}
+
+			require.Equal(t, tt.wantOptions, options)
+			require.Equal(t, tt.wantFailures, res.Failures)
+		})
+	}
+}
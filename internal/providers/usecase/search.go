@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+const (
+	_defaultSearchPageSize = 20
+	_maxSearchPageSize     = 100
+	_maxSearchQueryLength  = 128
+)
+
+func (uc *UseCaseProviders) Search(ctx context.Context, params entity.ProviderSearchParams) ([]*entity.ProviderSearchHit, error) {
+	query, err := newProviderSearchQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Search - newProviderSearchQuery: %w", err)
+	}
+
+	hits, err := uc.repo.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Search - uc.repo.Search: %w", err)
+	}
+
+	return hits, nil
+}
+
+func newProviderSearchQuery(params entity.ProviderSearchParams) (entity.ProviderSearchQuery, error) {
+	text := strings.Join(strings.Fields(params.Query), " ")
+
+	switch {
+	case text == "":
+		return entity.ProviderSearchQuery{}, fmt.Errorf("q is empty: %w", entity.ErrInvalidArgument)
+	case utf8.RuneCountInString(text) > _maxSearchQueryLength:
+		return entity.ProviderSearchQuery{}, fmt.Errorf("q is longer than %d characters: %w", _maxSearchQueryLength, entity.ErrInvalidArgument)
+	case params.PageSize < 0:
+		return entity.ProviderSearchQuery{}, fmt.Errorf("page_size is negative: %w", entity.ErrInvalidArgument)
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = _defaultSearchPageSize
+	}
+
+	return entity.ProviderSearchQuery{
+		Text:  text,
+		Limit: uint64(min(pageSize, _maxSearchPageSize)), //nolint:gosec // bounded by _maxSearchPageSize
+	}, nil
+}
@@ -0,0 +1,96 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_Search(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx    context.Context
+		params entity.ProviderSearchParams
+	}
+
+	hits := []*entity.ProviderSearchHit{
+		{Provider: &entity.Provider{ProviderID: entity.ProviderID("kuper"), Name: "Купер"}, Score: 1},
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(repo *mock_repo.MockProviderRepo)
+		args    args
+		want    []*entity.ProviderSearchHit
+		wantErr error
+	}{
+		{
+			name: "whitespace is collapsed and the default page size is used",
+			prepare: func(repo *mock_repo.MockProviderRepo) {
+				repo.EXPECT().Search(context.Background(), entity.ProviderSearchQuery{Text: "ооо купер", Limit: 20}).Return(hits, nil)
+			},
+			args: args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: "  ооо \t купер "}},
+			want: hits,
+		},
+		{
+			name: "page size is capped",
+			prepare: func(repo *mock_repo.MockProviderRepo) {
+				repo.EXPECT().Search(context.Background(), entity.ProviderSearchQuery{Text: "kuper", Limit: 100}).Return(hits, nil)
+			},
+			args: args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: "kuper", PageSize: 1000}},
+			want: hits,
+		},
+		{
+			name:    "error - empty query",
+			args:    args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: " "}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - query too long",
+			args:    args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: strings.Repeat("я", 129)}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - negative page size",
+			args:    args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: "kuper", PageSize: -1}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - database not available",
+			prepare: func(repo *mock_repo.MockProviderRepo) {
+				repo.EXPECT().Search(context.Background(), gomock.Any()).Return(nil, entity.ErrInternalServerError)
+			},
+			args:    args{ctx: context.Background(), params: entity.ProviderSearchParams{Query: "kuper"}},
+			wantErr: entity.ErrInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repo.NewMockProviderRepo(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(repo)
+			}
+
+			uc := usecase.NewUseCaseProviders(repo, mock_repo.NewMockAuditRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			res, err := uc.Search(tt.args.ctx, tt.args.params)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
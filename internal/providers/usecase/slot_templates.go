@@ -0,0 +1,401 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	_maxTemplateWindows    = 48
+	_maxTemplateExclusions = 366
+)
+
+// UseCaseSlotTemplates keeps weekly slot templates and generates dated slots from the active ones.
+type UseCaseSlotTemplates struct {
+	repo      repo.SlotTemplateRepo
+	slots     repo.SlotRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+	// horizon is how far ahead of now slots are generated.
+	horizon time.Duration
+}
+
+func NewUseCaseSlotTemplates(r repo.SlotTemplateRepo, s repo.SlotRepo, p repo.ProviderRepo, tx repo.Transactor, horizon time.Duration) *UseCaseSlotTemplates {
+	return &UseCaseSlotTemplates{
+		repo:      r,
+		slots:     s,
+		providers: p,
+		tx:        tx,
+		horizon:   horizon,
+	}
+}
+
+// Create adds an inactive template to a zone of a non-archived provider, it generates nothing until activated.
+func (uc *UseCaseSlotTemplates) Create(ctx context.Context, template *entity.SlotTemplate) (*entity.SlotTemplate, error) {
+	normalizeSlotTemplateRule(&template.Rule)
+
+	if err := validateSlotTemplate(template); err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Create - validateSlotTemplate: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		provider, err := uc.providers.GetForUpdate(ctx, template.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		if err := uc.repo.Store(ctx, template); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Create - uc.tx.InTx: %w", err)
+	}
+
+	return template, nil
+}
+
+func (uc *UseCaseSlotTemplates) GetByID(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	template, err := uc.repo.GetByID(ctx, providerID, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return template, nil
+}
+
+func (uc *UseCaseSlotTemplates) ListAll(ctx context.Context, params entity.SlotTemplateListParams) (*entity.SlotTemplatePage, error) {
+	query, err := newSlotTemplateQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - ListAll - newSlotTemplateQuery: %w", err)
+	}
+
+	templates, err := uc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - ListAll - uc.repo.GetAll: %w", err)
+	}
+
+	page := &entity.SlotTemplatePage{}
+
+	var last *entity.SlotTemplate
+	if page.Templates, last = trimPage(templates, query.Limit); last != nil {
+		page.NextPageToken = encodeIDPageToken(last.TemplateID)
+	}
+
+	return page, nil
+}
+
+// Delete removes the template, slots it has generated are kept.
+func (uc *UseCaseSlotTemplates) Delete(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) error {
+	if err := uc.repo.Delete(ctx, providerID, templateID); err != nil {
+		return fmt.Errorf("UseCaseSlotTemplates - Delete - uc.repo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// Activate makes the generator pick the template up and generates its slots within the horizon right away,
+// templates of archived providers are not activated.
+func (uc *UseCaseSlotTemplates) Activate(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	var template *entity.SlotTemplate
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		provider, err := uc.providers.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		template, err = uc.repo.SetActive(ctx, providerID, templateID, true)
+		if err != nil {
+			return fmt.Errorf("uc.repo.SetActive: %w", err)
+		}
+
+		if err := uc.generate(ctx, provider, template, time.Now()); err != nil {
+			return fmt.Errorf("uc.generate: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Activate - uc.tx.InTx: %w", err)
+	}
+
+	return template, nil
+}
+
+// Deactivate stops generating slots, slots already generated are kept.
+func (uc *UseCaseSlotTemplates) Deactivate(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID) (*entity.SlotTemplate, error) {
+	template, err := uc.repo.SetActive(ctx, providerID, templateID, false)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Deactivate - uc.repo.SetActive: %w", err)
+	}
+
+	return template, nil
+}
+
+// Preview returns the slots the template generates starting within [from, to), nothing is stored.
+// The period defaults to the generation horizon from now and is at most _maxAvailabilityPeriod long.
+func (uc *UseCaseSlotTemplates) Preview(ctx context.Context, providerID entity.ProviderID, templateID entity.SlotTemplateID, from, to time.Time) ([]*entity.Slot, error) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+
+	if to.IsZero() {
+		to = from.Add(uc.horizon)
+	}
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Preview - from is not before to: %w", entity.ErrInvalidArgument)
+	}
+
+	if to.Sub(from) > _maxAvailabilityPeriod {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Preview - period is longer than %s: %w", _maxAvailabilityPeriod, entity.ErrInvalidArgument)
+	}
+
+	template, err := uc.repo.GetByID(ctx, providerID, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Preview - uc.repo.GetByID: %w", err)
+	}
+
+	slots, err := templateSlots(template, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlotTemplates - Preview - templateSlots: %w", err)
+	}
+
+	return slots, nil
+}
+
+// Generate stores the slots of every active template within the horizon. Windows that already exist are skipped,
+// so running it again or concurrently never duplicates slots. A failing template does not stop the others.
+func (uc *UseCaseSlotTemplates) Generate(ctx context.Context) error {
+	templates, err := uc.repo.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("UseCaseSlotTemplates - Generate - uc.repo.GetActive: %w", err)
+	}
+
+	now := time.Now()
+
+	var errs []error
+
+	for _, template := range templates {
+		err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+			// The provider may have been archived or suspended since the templates were read.
+			provider, err := uc.providers.GetForUpdate(ctx, template.ProviderID)
+			if err != nil {
+				return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+			}
+
+			if err := uc.generate(ctx, provider, template, now); err != nil {
+				return fmt.Errorf("uc.generate: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("template %s/%s: %w", template.ProviderID, template.TemplateID, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("UseCaseSlotTemplates - Generate - uc.tx.InTx: %w", err)
+	}
+
+	return nil
+}
+
+// generate stores the slots of the template within the horizon, the provider must be locked by the caller.
+// Templates of archived or non-active providers generate nothing.
+func (uc *UseCaseSlotTemplates) generate(ctx context.Context, provider *entity.Provider, template *entity.SlotTemplate, now time.Time) error {
+	if provider.Archived() || provider.Status != entity.ProviderStatusActive {
+		return nil
+	}
+
+	slots, err := templateSlots(template, now, now.Add(uc.horizon))
+	if err != nil {
+		return fmt.Errorf("templateSlots: %w", err)
+	}
+
+	if _, err := uc.slots.StoreGenerated(ctx, slots); err != nil {
+		return fmt.Errorf("uc.slots.StoreGenerated: %w", err)
+	}
+
+	return nil
+}
+
+// templateSlots lays the windows of the template out on the local days overlapping [from, to)
+// and keeps the slots starting within the period, ordered by start.
+func templateSlots(template *entity.SlotTemplate, from, to time.Time) ([]*entity.Slot, error) {
+	loc, err := time.LoadLocation(template.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("time_zone %q: %w", template.TimeZone, entity.ErrInvalidArgument)
+	}
+
+	capacities := make(map[time.Weekday]int, len(template.Rule.Days))
+	for _, day := range template.Rule.Days {
+		capacities[day.Weekday] = day.Capacity
+	}
+
+	var slots []*entity.Slot
+
+	first := from.In(loc)
+	last := to.In(loc)
+
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); !day.After(last); day = day.AddDate(0, 0, 1) {
+		capacity, ok := capacities[day.Weekday()]
+		if !ok || slices.Contains(template.Rule.Exclusions, day.Format(time.DateOnly)) {
+			continue
+		}
+
+		for _, window := range template.Rule.Windows {
+			startsAt := window.Start.On(day.Year(), day.Month(), day.Day(), loc)
+			endsAt := window.End.On(day.Year(), day.Month(), day.Day(), loc)
+
+			// A DST change may swallow a window.
+			if !startsAt.Before(endsAt) || startsAt.Before(from) || !startsAt.Before(to) {
+				continue
+			}
+
+			slots = append(slots, &entity.Slot{
+				ProviderID: template.ProviderID,
+				ZoneID:     template.ZoneID,
+				StartsAt:   startsAt,
+				EndsAt:     endsAt,
+				Capacity:   capacity,
+			})
+		}
+	}
+
+	return slots, nil
+}
+
+// normalizeSlotTemplateRule orders days, windows and exclusions, so generated slots come out ordered by start.
+func normalizeSlotTemplateRule(rule *entity.SlotTemplateRule) {
+	slices.SortFunc(rule.Days, func(a, b entity.SlotTemplateDay) int {
+		return int(a.Weekday - b.Weekday)
+	})
+	slices.SortFunc(rule.Windows, func(a, b entity.SlotTemplateWindow) int {
+		return int(a.Start - b.Start)
+	})
+	slices.Sort(rule.Exclusions)
+	rule.Exclusions = slices.Compact(rule.Exclusions)
+}
+
+func validateSlotTemplate(template *entity.SlotTemplate) error {
+	if template.TemplateID == "" {
+		return fmt.Errorf("template_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if len(template.TemplateID) > _maxZoneIDLength {
+		return fmt.Errorf("template_id is longer than %d: %w", _maxZoneIDLength, entity.ErrInvalidArgument)
+	}
+
+	if err := validateZoneID(template.ZoneID); err != nil {
+		return err
+	}
+
+	if err := validateTimeZone(template.TimeZone); err != nil {
+		return err
+	}
+
+	return validateSlotTemplateRule(template.Rule)
+}
+
+func validateTimeZone(name string) error {
+	// An empty name is UTC and "Local" is whatever the server runs in, neither is what ops mean.
+	if name == "" || name == "Local" {
+		return fmt.Errorf("time_zone is not an IANA name: %w", entity.ErrInvalidArgument)
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("time_zone %q: %w", name, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateSlotTemplateRule(rule entity.SlotTemplateRule) error {
+	if len(rule.Days) == 0 {
+		return fmt.Errorf("days are empty: %w", entity.ErrInvalidArgument)
+	}
+
+	seen := make(map[time.Weekday]bool, len(rule.Days))
+
+	for _, day := range rule.Days {
+		if day.Weekday < time.Sunday || day.Weekday > time.Saturday {
+			return fmt.Errorf("weekday %d is unknown: %w", day.Weekday, entity.ErrInvalidArgument)
+		}
+
+		if seen[day.Weekday] {
+			return fmt.Errorf("%s is repeated: %w", day.Weekday, entity.ErrInvalidArgument)
+		}
+
+		seen[day.Weekday] = true
+
+		if day.Capacity <= 0 || day.Capacity > _maxSlotCapacity {
+			return fmt.Errorf("capacity %d of %s is not within 1..%d: %w", day.Capacity, day.Weekday, _maxSlotCapacity, entity.ErrInvalidArgument)
+		}
+	}
+
+	if len(rule.Windows) == 0 || len(rule.Windows) > _maxTemplateWindows {
+		return fmt.Errorf("number of windows is not within 1..%d: %w", _maxTemplateWindows, entity.ErrInvalidArgument)
+	}
+
+	// Windows are sorted by normalizeSlotTemplateRule.
+	windows := rule.Windows
+
+	for i, window := range windows {
+		if window.Start < 0 || window.End > entity.EndOfDay || window.Start >= window.End {
+			return fmt.Errorf("window %s-%s is empty or out of the day: %w", window.Start, window.End, entity.ErrInvalidArgument)
+		}
+
+		if i > 0 && window.Start < windows[i-1].End {
+			return fmt.Errorf("window %s-%s overlaps %s-%s: %w", window.Start, window.End, windows[i-1].Start, windows[i-1].End, entity.ErrInvalidArgument)
+		}
+	}
+
+	if len(rule.Exclusions) > _maxTemplateExclusions {
+		return fmt.Errorf("more than %d exclusions: %w", _maxTemplateExclusions, entity.ErrInvalidArgument)
+	}
+
+	for _, date := range rule.Exclusions {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return fmt.Errorf("exclusion %q is not a date: %w", date, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+func newSlotTemplateQuery(params entity.SlotTemplateListParams) (entity.SlotTemplateQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.SlotTemplateQuery{}, err
+	}
+
+	after, err := decodeIDPageToken[entity.SlotTemplateID](params.PageToken)
+	if err != nil {
+		return entity.SlotTemplateQuery{}, err
+	}
+
+	return entity.SlotTemplateQuery{
+		ProviderID: params.ProviderID,
+		Limit:      limit,
+		After:      after,
+	}, nil
+}
@@ -0,0 +1,355 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseSlotTemplates_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		providers *mock_repo.MockProviderRepo
+		repo      *mock_repo.MockSlotTemplateRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	archivedAt := time.Now()
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	morning := entity.SlotTemplateWindow{Start: 9 * 60, End: 12 * 60}
+	evening := entity.SlotTemplateWindow{Start: 18 * 60, End: entity.EndOfDay}
+	weekdays := []entity.SlotTemplateDay{{Weekday: time.Monday, Capacity: 20}, {Weekday: time.Friday, Capacity: 10}}
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		template entity.SlotTemplate
+		want     entity.SlotTemplateRule
+		wantErr  error
+	}{
+		{
+			name:    "windows and days are ordered",
+			prepare: stored,
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{
+					Days:       []entity.SlotTemplateDay{weekdays[1], weekdays[0]},
+					Windows:    []entity.SlotTemplateWindow{evening, morning},
+					Exclusions: []string{"2025-05-09", "2025-05-01", "2025-05-09"},
+				},
+			},
+			want: entity.SlotTemplateRule{
+				Days:       weekdays,
+				Windows:    []entity.SlotTemplateWindow{morning, evening},
+				Exclusions: []string{"2025-05-01", "2025-05-09"},
+			},
+		},
+		{
+			name: "error - archived provider",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{morning}},
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - server local time zone",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Local",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{morning}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - unknown time zone",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Mars/Olympus_Mons",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{morning}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - repeated weekday",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: []entity.SlotTemplateDay{weekdays[0], weekdays[0]}, Windows: []entity.SlotTemplateWindow{morning}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - zero capacity",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: []entity.SlotTemplateDay{{Weekday: time.Monday}}, Windows: []entity.SlotTemplateWindow{morning}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - overlapping windows",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{morning, {Start: 11 * 60, End: 14 * 60}}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - empty window",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{{Start: 9 * 60, End: 9 * 60}}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - exclusion is not a date",
+			template: entity.SlotTemplate{
+				TemplateID: "weekdays", ZoneID: "center", TimeZone: "Europe/Moscow",
+				Rule: entity.SlotTemplateRule{Days: weekdays, Windows: []entity.SlotTemplateWindow{morning}, Exclusions: []string{"2025-02-30"}},
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := &fields{
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				repo:      mock_repo.NewMockSlotTemplateRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+
+			uc := usecase.NewUseCaseSlotTemplates(f.repo, mock_repo.NewMockSlotRepo(ctrl), f.providers, f.tx, 14*24*time.Hour)
+
+			template := tt.template
+			template.ProviderID = "kuper"
+
+			res, err := uc.Create(context.Background(), &template)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.want, res.Rule)
+			}
+		})
+	}
+}
+
+func TestUseCaseSlotTemplates_Preview(t *testing.T) {
+	t.Parallel()
+
+	// Berlin moves its clocks forward on 2025-03-30, the windows keep their local time across the change.
+	template := &entity.SlotTemplate{
+		ProviderID: "kuper",
+		TemplateID: "weekends",
+		ZoneID:     "center",
+		TimeZone:   "Europe/Berlin",
+		Rule: entity.SlotTemplateRule{
+			Days: []entity.SlotTemplateDay{
+				{Weekday: time.Sunday, Capacity: 5},
+				{Weekday: time.Monday, Capacity: 20},
+				{Weekday: time.Saturday, Capacity: 5},
+			},
+			Windows: []entity.SlotTemplateWindow{
+				{Start: 9 * 60, End: 12 * 60},
+				{Start: 18 * 60, End: 21 * 60},
+			},
+			Exclusions: []string{"2025-03-30"},
+		},
+	}
+
+	type slot struct {
+		startsAt string
+		endsAt   string
+		capacity int
+	}
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    []slot
+		wantErr error
+	}{
+		{
+			name: "weekdays, exclusions and DST",
+			from: "2025-03-29T00:00:00Z",
+			to:   "2025-04-01T00:00:00Z",
+			want: []slot{
+				{"2025-03-29T08:00:00Z", "2025-03-29T11:00:00Z", 5},
+				{"2025-03-29T17:00:00Z", "2025-03-29T20:00:00Z", 5},
+				{"2025-03-31T07:00:00Z", "2025-03-31T10:00:00Z", 20},
+				{"2025-03-31T16:00:00Z", "2025-03-31T19:00:00Z", 20},
+			},
+		},
+		{
+			name: "slots starting within the period",
+			from: "2025-03-29T09:00:00Z",
+			to:   "2025-03-29T17:00:01Z",
+			want: []slot{
+				{"2025-03-29T17:00:00Z", "2025-03-29T20:00:00Z", 5},
+			},
+		},
+		{
+			name: "no slots",
+			from: "2025-04-01T00:00:00Z",
+			to:   "2025-04-05T00:00:00Z",
+		},
+		{
+			name:    "error - to before from",
+			from:    "2025-04-01T00:00:00Z",
+			to:      "2025-03-29T00:00:00Z",
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - period is too long",
+			from:    "2025-03-01T00:00:00Z",
+			to:      "2025-05-01T00:00:00Z",
+			wantErr: entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repo.NewMockSlotTemplateRepo(ctrl)
+			r.EXPECT().GetByID(gomock.Any(), entity.ProviderID("kuper"), entity.SlotTemplateID("weekends")).Return(template, nil).AnyTimes()
+
+			uc := usecase.NewUseCaseSlotTemplates(r, mock_repo.NewMockSlotRepo(ctrl), mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl), 14*24*time.Hour)
+
+			from, _ := time.Parse(time.RFC3339, tt.from)
+			to, _ := time.Parse(time.RFC3339, tt.to)
+
+			slots, err := uc.Preview(context.Background(), "kuper", "weekends", from, to)
+
+			require.ErrorIs(t, err, tt.wantErr)
+
+			var got []slot
+			for _, s := range slots {
+				require.Equal(t, entity.ZoneID("center"), s.ZoneID)
+				got = append(got, slot{s.StartsAt.UTC().Format(time.RFC3339), s.EndsAt.UTC().Format(time.RFC3339), s.Capacity})
+			}
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUseCaseSlotTemplates_Generate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockSlotTemplateRepo(ctrl)
+	slots := mock_repo.NewMockSlotRepo(ctrl)
+	providers := mock_repo.NewMockProviderRepo(ctrl)
+	tx := mock_repo.NewMockTransactor(ctrl)
+	horizon := 7 * 24 * time.Hour
+	uc := usecase.NewUseCaseSlotTemplates(r, slots, providers, tx, horizon)
+
+	everyDay := make([]entity.SlotTemplateDay, 7)
+	for i := range everyDay {
+		everyDay[i] = entity.SlotTemplateDay{Weekday: time.Weekday(i), Capacity: 1}
+	}
+
+	template := func(providerID entity.ProviderID) *entity.SlotTemplate {
+		return &entity.SlotTemplate{
+			ProviderID: providerID,
+			TemplateID: "daily",
+			ZoneID:     "center",
+			TimeZone:   "Asia/Novosibirsk",
+			Rule: entity.SlotTemplateRule{
+				Days:    everyDay,
+				Windows: []entity.SlotTemplateWindow{{Start: 0, End: entity.EndOfDay}},
+			},
+		}
+	}
+
+	generated := func(providerID entity.ProviderID, err error) any {
+		return slots.EXPECT().StoreGenerated(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s []*entity.Slot) (int64, error) {
+			// A day long window starting every local midnight, the first one is already past.
+			require.Len(t, s, 7)
+			for _, slot := range s {
+				require.Equal(t, providerID, slot.ProviderID)
+				require.Equal(t, 24*time.Hour, slot.EndsAt.Sub(slot.StartsAt))
+				require.WithinDuration(t, time.Now(), slot.StartsAt, horizon)
+			}
+
+			return int64(len(s)), err
+		})
+	}
+
+	locked := func(provider *entity.Provider) any {
+		expectInTx(tx)
+
+		return providers.EXPECT().GetForUpdate(gomock.Any(), provider.ProviderID).Return(provider, nil)
+	}
+
+	archivedAt := time.Now()
+	kuper := &entity.Provider{ProviderID: "kuper", Status: entity.ProviderStatusActive}
+	dostavista := &entity.Provider{ProviderID: "dostavista", Status: entity.ProviderStatusActive}
+	suspended := &entity.Provider{ProviderID: "yandex", Status: entity.ProviderStatusSuspended}
+	archived := &entity.Provider{ProviderID: "cdek", Status: entity.ProviderStatusActive, DeletedAt: &archivedAt}
+
+	r.EXPECT().GetActive(gomock.Any()).Return([]*entity.SlotTemplate{
+		template("kuper"), template("dostavista"), template("yandex"), template("cdek"),
+	}, nil)
+	gomock.InOrder(
+		locked(kuper),
+		generated("kuper", entity.ErrInternalServerError),
+		locked(dostavista),
+		generated("dostavista", nil),
+		// Providers suspended or archived since the templates were read generate nothing.
+		locked(suspended),
+		locked(archived),
+	)
+
+	// A failing template does not stop the others.
+	require.ErrorIs(t, uc.Generate(context.Background()), entity.ErrInternalServerError)
+
+	activatedAt := time.Now()
+	active := template("kuper")
+	active.ActivatedAt = &activatedAt
+
+	locked(kuper)
+	r.EXPECT().SetActive(gomock.Any(), entity.ProviderID("kuper"), entity.SlotTemplateID("daily"), true).Return(active, nil)
+	generated("kuper", nil)
+
+	res, err := uc.Activate(context.Background(), "kuper", "daily")
+	require.NoError(t, err)
+	require.True(t, res.Active())
+
+	locked(archived)
+
+	_, err = uc.Activate(context.Background(), "cdek", "daily")
+	require.ErrorIs(t, err, entity.ErrNotFound)
+}
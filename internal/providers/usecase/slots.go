@@ -0,0 +1,398 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	_maxSlotCapacity           = 10000
+	_maxSlotDuration           = 24 * time.Hour
+	_maxAvailabilityPeriod     = 31 * 24 * time.Hour
+	_defaultAvailabilityPeriod = 7 * 24 * time.Hour
+	// _holdSweepBatch bounds the holds expired in one transaction.
+	_holdSweepBatch = 500
+)
+
+type UseCaseSlots struct {
+	repo      repo.SlotRepo
+	holds     repo.SlotHoldRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+	holdTTL   time.Duration
+}
+
+func NewUseCaseSlots(r repo.SlotRepo, h repo.SlotHoldRepo, p repo.ProviderRepo, tx repo.Transactor, holdTTL time.Duration) *UseCaseSlots {
+	return &UseCaseSlots{
+		repo:      r,
+		holds:     h,
+		providers: p,
+		tx:        tx,
+		holdTTL:   holdTTL,
+	}
+}
+
+// slotPageToken is the opaque cursor of slot listing.
+type slotPageToken struct {
+	StartsAt time.Time     `json:"ts"`
+	SlotID   entity.SlotID `json:"id"`
+}
+
+// Create adds a slot to a zone of a non-archived provider. Slots that have already ended are rejected.
+func (uc *UseCaseSlots) Create(ctx context.Context, slot *entity.Slot) (*entity.Slot, error) {
+	if err := validateSlot(slot); err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Create - validateSlot: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		provider, err := uc.providers.GetForUpdate(ctx, slot.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		if err := uc.repo.Store(ctx, slot); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Create - uc.tx.InTx: %w", err)
+	}
+
+	return slot, nil
+}
+
+func (uc *UseCaseSlots) GetByID(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID) (*entity.Slot, error) {
+	slot, err := uc.repo.GetByID(ctx, providerID, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return slot, nil
+}
+
+func (uc *UseCaseSlots) ListAll(ctx context.Context, params entity.SlotListParams) (*entity.SlotPage, error) {
+	query, err := newSlotQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - ListAll - newSlotQuery: %w", err)
+	}
+
+	slots, err := uc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - ListAll - uc.repo.GetAll: %w", err)
+	}
+
+	page := &entity.SlotPage{}
+
+	var last *entity.Slot
+	if page.Slots, last = trimPage(slots, query.Limit); last != nil {
+		page.NextPageToken = encodeSlotPageToken(last)
+	}
+
+	return page, nil
+}
+
+// Close stops bookings of the slot, closing a closed slot changes nothing.
+func (uc *UseCaseSlots) Close(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID) (*entity.Slot, error) {
+	slot, err := uc.repo.Close(ctx, providerID, slotID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Close - uc.repo.Close: %w", err)
+	}
+
+	return slot, nil
+}
+
+// Availability lists bookable slots of a zone starting within the period, at most _maxAvailabilityPeriod long.
+// The period defaults to a week from now.
+func (uc *UseCaseSlots) Availability(ctx context.Context, params entity.AvailabilityParams) ([]*entity.Slot, error) {
+	if params.ZoneID == "" {
+		return nil, fmt.Errorf("UseCaseSlots - Availability - zone_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if params.From.IsZero() {
+		params.From = time.Now()
+	}
+
+	if params.To.IsZero() {
+		params.To = params.From.Add(_defaultAvailabilityPeriod)
+	}
+
+	if !params.From.Before(params.To) {
+		return nil, fmt.Errorf("UseCaseSlots - Availability - from is not before to: %w", entity.ErrInvalidArgument)
+	}
+
+	if params.To.Sub(params.From) > _maxAvailabilityPeriod {
+		return nil, fmt.Errorf("UseCaseSlots - Availability - period is longer than %s: %w", _maxAvailabilityPeriod, entity.ErrInvalidArgument)
+	}
+
+	slots, err := uc.repo.GetAvailable(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Availability - uc.repo.GetAvailable: %w", err)
+	}
+
+	return slots, nil
+}
+
+// Hold takes capacity of a slot for holdTTL. The slot row stays locked until the hold is stored,
+// so concurrent holds are counted one after another and never book more than the capacity.
+func (uc *UseCaseSlots) Hold(ctx context.Context, providerID entity.ProviderID, slotID entity.SlotID, quantity int) (*entity.SlotHold, error) {
+	if quantity <= 0 || quantity > _maxSlotCapacity {
+		return nil, fmt.Errorf("UseCaseSlots - Hold - quantity %d is not within 1..%d: %w", quantity, _maxSlotCapacity, entity.ErrInvalidArgument)
+	}
+
+	var hold *entity.SlotHold
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		slot, err := uc.repo.GetForUpdate(ctx, providerID, slotID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		provider, err := uc.providers.GetByID(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetByID: %w", err)
+		}
+
+		now := time.Now()
+
+		switch {
+		case provider.Archived() || provider.Status != entity.ProviderStatusActive:
+			return fmt.Errorf("provider %s is not active: %w", providerID, entity.ErrSlotUnavailable)
+		case slot.Closed():
+			return fmt.Errorf("slot %d is closed: %w", slotID, entity.ErrSlotUnavailable)
+		case !slot.StartsAt.After(now):
+			return fmt.Errorf("slot %d has started: %w", slotID, entity.ErrSlotUnavailable)
+		case slot.Available() < quantity:
+			return fmt.Errorf("slot %d has %d left: %w", slotID, slot.Available(), entity.ErrSlotUnavailable)
+		}
+
+		if err := uc.repo.AddBooked(ctx, slotID, quantity); err != nil {
+			return fmt.Errorf("uc.repo.AddBooked: %w", err)
+		}
+
+		hold = &entity.SlotHold{
+			SlotID:     slotID,
+			ProviderID: providerID,
+			Quantity:   quantity,
+			Status:     entity.HoldStatusHeld,
+			ExpiresAt:  now.Add(uc.holdTTL),
+		}
+
+		if err := uc.holds.Store(ctx, hold); err != nil {
+			return fmt.Errorf("uc.holds.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Hold - uc.tx.InTx: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Confirm turns a hold into a booking, confirming a confirmed hold changes nothing.
+// Holds past their TTL can not be confirmed even if the sweeper has not expired them yet.
+func (uc *UseCaseSlots) Confirm(ctx context.Context, providerID entity.ProviderID, holdID entity.HoldID) (*entity.SlotHold, error) {
+	var hold *entity.SlotHold
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		hold, err = uc.holds.GetForUpdate(ctx, providerID, holdID)
+		if err != nil {
+			return fmt.Errorf("uc.holds.GetForUpdate: %w", err)
+		}
+
+		switch {
+		case hold.Status == entity.HoldStatusConfirmed:
+			return nil
+		case hold.Status != entity.HoldStatusHeld || hold.Expired(time.Now()):
+			return fmt.Errorf("hold %s: %w", holdID, entity.ErrHoldNotActive)
+		}
+
+		if hold, err = uc.holds.UpdateStatus(ctx, holdID, entity.HoldStatusConfirmed); err != nil {
+			return fmt.Errorf("uc.holds.UpdateStatus: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Confirm - uc.tx.InTx: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Release gives the capacity of a held or confirmed hold back to its slot.
+// Releasing a hold that no longer counts against the slot changes nothing, so retries never free capacity twice.
+func (uc *UseCaseSlots) Release(ctx context.Context, providerID entity.ProviderID, holdID entity.HoldID) (*entity.SlotHold, error) {
+	var hold *entity.SlotHold
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+
+		hold, err = uc.holds.GetForUpdate(ctx, providerID, holdID)
+		if err != nil {
+			return fmt.Errorf("uc.holds.GetForUpdate: %w", err)
+		}
+
+		if !hold.Active() {
+			return nil
+		}
+
+		if hold, err = uc.holds.UpdateStatus(ctx, holdID, entity.HoldStatusReleased); err != nil {
+			return fmt.Errorf("uc.holds.UpdateStatus: %w", err)
+		}
+
+		if err := uc.repo.AddBooked(ctx, hold.SlotID, -hold.Quantity); err != nil {
+			return fmt.Errorf("uc.repo.AddBooked: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseSlots - Release - uc.tx.InTx: %w", err)
+	}
+
+	return hold, nil
+}
+
+// ExpireHolds gives the capacity of holds past their TTL back to their slots, batch by batch.
+// Holds being confirmed or released at the same time are locked and skipped, the next run picks them up if still held.
+func (uc *UseCaseSlots) ExpireHolds(ctx context.Context) error {
+	for {
+		var expired int
+
+		err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+			holds, err := uc.holds.LockExpired(ctx, time.Now(), _holdSweepBatch)
+			if err != nil {
+				return fmt.Errorf("uc.holds.LockExpired: %w", err)
+			}
+
+			expired = len(holds)
+			if expired == 0 {
+				return nil
+			}
+
+			type freedCapacity struct {
+				slotID   entity.SlotID
+				quantity int
+			}
+
+			ids := make([]entity.HoldID, len(holds))
+			// Holds come ordered by slot, so do the freed quantities and the slot updates below.
+			var freed []freedCapacity
+
+			for i, hold := range holds {
+				ids[i] = hold.HoldID
+
+				if len(freed) == 0 || freed[len(freed)-1].slotID != hold.SlotID {
+					freed = append(freed, freedCapacity{slotID: hold.SlotID})
+				}
+
+				freed[len(freed)-1].quantity += hold.Quantity
+			}
+
+			if err := uc.holds.Expire(ctx, ids); err != nil {
+				return fmt.Errorf("uc.holds.Expire: %w", err)
+			}
+
+			for _, f := range freed {
+				if err := uc.repo.AddBooked(ctx, f.slotID, -f.quantity); err != nil {
+					return fmt.Errorf("uc.repo.AddBooked: %w", err)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("UseCaseSlots - ExpireHolds - uc.tx.InTx: %w", err)
+		}
+
+		if expired < _holdSweepBatch {
+			return nil
+		}
+	}
+}
+
+func validateSlot(slot *entity.Slot) error {
+	if slot.ZoneID == "" {
+		return fmt.Errorf("zone_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if !slot.StartsAt.Before(slot.EndsAt) {
+		return fmt.Errorf("starts_at is not before ends_at: %w", entity.ErrInvalidArgument)
+	}
+
+	if slot.EndsAt.Sub(slot.StartsAt) > _maxSlotDuration {
+		return fmt.Errorf("slot is longer than %s: %w", _maxSlotDuration, entity.ErrInvalidArgument)
+	}
+
+	if !slot.EndsAt.After(time.Now()) {
+		return fmt.Errorf("slot has already ended: %w", entity.ErrInvalidArgument)
+	}
+
+	if slot.Capacity <= 0 || slot.Capacity > _maxSlotCapacity {
+		return fmt.Errorf("capacity %d is not within 1..%d: %w", slot.Capacity, _maxSlotCapacity, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func newSlotQuery(params entity.SlotListParams) (entity.SlotQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.SlotQuery{}, err
+	}
+
+	query := entity.SlotQuery{
+		Filter: params.Filter,
+		Limit:  limit,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := decodeSlotPageToken(params.PageToken)
+		if err != nil {
+			return entity.SlotQuery{}, err
+		}
+
+		query.After = cursor
+	}
+
+	return query, nil
+}
+
+func encodeSlotPageToken(last *entity.Slot) string {
+	raw, err := json.Marshal(slotPageToken{StartsAt: last.StartsAt, SlotID: last.SlotID})
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeSlotPageToken(token string) (*entity.SlotCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	var t slotPageToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.SlotID == 0 {
+		return nil, fmt.Errorf("page_token: %w", entity.ErrInvalidArgument)
+	}
+
+	return &entity.SlotCursor{StartsAt: t.StartsAt, SlotID: t.SlotID}, nil
+}
@@ -0,0 +1,502 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseSlots_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockSlotRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	tomorrow := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	archivedAt := time.Now()
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *entity.Slot) error {
+			s.SlotID = 42
+
+			return nil
+		})
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		slot    entity.Slot
+		want    entity.SlotID
+		wantErr error
+	}{
+		{
+			name:    "three hours",
+			prepare: stored,
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(3 * time.Hour), Capacity: 20},
+			want:    42,
+		},
+		{
+			name:    "already started but not ended",
+			prepare: stored,
+			slot:    entity.Slot{ZoneID: "center", StartsAt: time.Now().Add(-time.Hour), EndsAt: time.Now().Add(time.Hour), Capacity: 1},
+			want:    42,
+		},
+		{
+			name:    "error - no zone",
+			slot:    entity.Slot{StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour), Capacity: 20},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - ends before start",
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(-time.Hour), Capacity: 20},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - longer than a day",
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(25 * time.Hour), Capacity: 20},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - already ended",
+			slot:    entity.Slot{ZoneID: "center", StartsAt: time.Now().Add(-2 * time.Hour), EndsAt: time.Now().Add(-time.Hour), Capacity: 20},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - zero capacity",
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour)},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - capacity too large",
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour), Capacity: 10001},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour), Capacity: 20},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - zone not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(entity.ErrNotFound)
+			},
+			slot:    entity.Slot{ZoneID: "nowhere", StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour), Capacity: 20},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name: "error - same window already exists",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExists)
+			},
+			slot:    entity.Slot{ZoneID: "center", StartsAt: tomorrow, EndsAt: tomorrow.Add(time.Hour), Capacity: 20},
+			wantErr: entity.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockSlotRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseSlots(f.repo, mock_repo.NewMockSlotHoldRepo(ctrl), f.providers, f.tx, 10*time.Minute)
+
+			slot := tt.slot
+			slot.ProviderID = "kuper"
+
+			res, err := uc.Create(context.Background(), &slot)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.want, res.SlotID)
+			}
+		})
+	}
+}
+
+func TestUseCaseSlots_ListAll(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockSlotRepo(ctrl)
+	uc := usecase.NewUseCaseSlots(r, mock_repo.NewMockSlotHoldRepo(ctrl), mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl), 10*time.Minute)
+
+	start := time.Date(2025, 5, 9, 9, 0, 0, 0, time.UTC)
+	filter := entity.SlotFilter{ProviderID: "kuper", ZoneID: "center"}
+	slots := []*entity.Slot{
+		{SlotID: 1, StartsAt: start},
+		{SlotID: 2, StartsAt: start.Add(time.Hour)},
+		{SlotID: 3, StartsAt: start.Add(2 * time.Hour)},
+	}
+
+	r.EXPECT().GetAll(gomock.Any(), entity.SlotQuery{Filter: filter, Limit: 3}).Return(slots, nil)
+
+	page, err := uc.ListAll(context.Background(), entity.SlotListParams{Filter: filter, PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, slots[:2], page.Slots)
+	require.NotEmpty(t, page.NextPageToken)
+
+	// The token resumes after the last slot of the previous page.
+	r.EXPECT().GetAll(gomock.Any(), entity.SlotQuery{
+		Filter: filter,
+		After:  &entity.SlotCursor{StartsAt: start.Add(time.Hour), SlotID: 2},
+		Limit:  3,
+	}).Return(slots[2:], nil)
+
+	page, err = uc.ListAll(context.Background(), entity.SlotListParams{Filter: filter, PageSize: 2, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, slots[2:], page.Slots)
+	require.Empty(t, page.NextPageToken)
+
+	_, err = uc.ListAll(context.Background(), entity.SlotListParams{Filter: filter, PageToken: "not a token"})
+	require.ErrorIs(t, err, entity.ErrInvalidArgument)
+
+	_, err = uc.ListAll(context.Background(), entity.SlotListParams{Filter: filter, PageSize: -1})
+	require.ErrorIs(t, err, entity.ErrInvalidArgument)
+}
+
+func TestUseCaseSlots_Availability(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 5, 9, 0, 0, 0, 0, time.UTC)
+	available := []*entity.Slot{{SlotID: 1, StartsAt: from.Add(9 * time.Hour)}}
+
+	tests := []struct {
+		name    string
+		prepare func(r *mock_repo.MockSlotRepo)
+		params  entity.AvailabilityParams
+		want    []*entity.Slot
+		wantErr error
+	}{
+		{
+			name: "a day",
+			prepare: func(r *mock_repo.MockSlotRepo) {
+				r.EXPECT().GetAvailable(gomock.Any(), entity.AvailabilityParams{
+					ProviderID: "kuper", ZoneID: "center", From: from, To: from.Add(24 * time.Hour),
+				}).Return(available, nil)
+			},
+			params: entity.AvailabilityParams{ProviderID: "kuper", ZoneID: "center", From: from, To: from.Add(24 * time.Hour)},
+			want:   available,
+		},
+		{
+			name: "a week by default",
+			prepare: func(r *mock_repo.MockSlotRepo) {
+				r.EXPECT().GetAvailable(gomock.Any(), entity.AvailabilityParams{
+					ProviderID: "kuper", ZoneID: "center", From: from, To: from.Add(7 * 24 * time.Hour),
+				}).Return(available, nil)
+			},
+			params: entity.AvailabilityParams{ProviderID: "kuper", ZoneID: "center", From: from},
+			want:   available,
+		},
+		{
+			name:    "error - no zone",
+			params:  entity.AvailabilityParams{ProviderID: "kuper", From: from},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - to before from",
+			params:  entity.AvailabilityParams{ProviderID: "kuper", ZoneID: "center", From: from, To: from.Add(-time.Hour)},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - longer than 31 days",
+			params:  entity.AvailabilityParams{ProviderID: "kuper", ZoneID: "center", From: from, To: from.Add(32 * 24 * time.Hour)},
+			wantErr: entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repo.NewMockSlotRepo(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(r)
+			}
+
+			uc := usecase.NewUseCaseSlots(r, mock_repo.NewMockSlotHoldRepo(ctrl), mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl), 10*time.Minute)
+
+			res, err := uc.Availability(context.Background(), tt.params)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseSlots_Hold(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockSlotRepo
+		holds     *mock_repo.MockSlotHoldRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	tomorrow := time.Now().Add(24 * time.Hour)
+	closedAt := time.Now()
+	active := &entity.Provider{ProviderID: "kuper", Status: entity.ProviderStatusActive}
+
+	locked := func(slot *entity.Slot, provider *entity.Provider) func(f *fields) {
+		return func(f *fields) {
+			expectInTx(f.tx)
+			f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.SlotID(42)).Return(slot, nil)
+			f.providers.EXPECT().GetByID(gomock.Any(), entity.ProviderID("kuper")).Return(provider, nil)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		quantity int
+		wantErr  error
+	}{
+		{
+			name: "takes the last capacity",
+			prepare: func(f *fields) {
+				locked(&entity.Slot{SlotID: 42, StartsAt: tomorrow, Capacity: 20, Booked: 18}, active)(f)
+				f.repo.EXPECT().AddBooked(gomock.Any(), entity.SlotID(42), 2).Return(nil)
+				f.holds.EXPECT().Store(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, h *entity.SlotHold) error {
+					require.Equal(t, entity.HoldStatusHeld, h.Status)
+					require.WithinDuration(t, time.Now().Add(10*time.Minute), h.ExpiresAt, time.Minute)
+
+					return nil
+				})
+			},
+			quantity: 2,
+		},
+		{
+			name:     "error - zero quantity",
+			quantity: 0,
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - full",
+			prepare:  locked(&entity.Slot{SlotID: 42, StartsAt: tomorrow, Capacity: 20, Booked: 19}, active),
+			quantity: 2,
+			wantErr:  entity.ErrSlotUnavailable,
+		},
+		{
+			name:     "error - closed",
+			prepare:  locked(&entity.Slot{SlotID: 42, StartsAt: tomorrow, Capacity: 20, ClosedAt: &closedAt}, active),
+			quantity: 1,
+			wantErr:  entity.ErrSlotUnavailable,
+		},
+		{
+			name:     "error - started",
+			prepare:  locked(&entity.Slot{SlotID: 42, StartsAt: time.Now().Add(-time.Minute), Capacity: 20}, active),
+			quantity: 1,
+			wantErr:  entity.ErrSlotUnavailable,
+		},
+		{
+			name: "error - provider suspended",
+			prepare: locked(&entity.Slot{SlotID: 42, StartsAt: tomorrow, Capacity: 20},
+				&entity.Provider{ProviderID: "kuper", Status: entity.ProviderStatusSuspended}),
+			quantity: 1,
+			wantErr:  entity.ErrSlotUnavailable,
+		},
+		{
+			name: "error - slot not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.SlotID(42)).Return(nil, entity.ErrNotFound)
+			},
+			quantity: 1,
+			wantErr:  entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockSlotRepo(ctrl),
+				holds:     mock_repo.NewMockSlotHoldRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseSlots(f.repo, f.holds, f.providers, f.tx, 10*time.Minute)
+
+			res, err := uc.Hold(context.Background(), "kuper", 42, tt.quantity)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.quantity, res.Quantity)
+			}
+		})
+	}
+}
+
+func TestUseCaseSlots_ConfirmRelease(t *testing.T) {
+	t.Parallel()
+
+	later := time.Now().Add(5 * time.Minute)
+	earlier := time.Now().Add(-time.Minute)
+
+	hold := func(status entity.HoldStatus, expiresAt time.Time) *entity.SlotHold {
+		return &entity.SlotHold{HoldID: "h1", SlotID: 42, ProviderID: "kuper", Quantity: 2, Status: status, ExpiresAt: expiresAt}
+	}
+
+	tests := []struct {
+		name    string
+		release bool
+		stored  *entity.SlotHold
+		prepare func(s *mock_repo.MockSlotRepo, h *mock_repo.MockSlotHoldRepo)
+		want    entity.HoldStatus
+		wantErr error
+	}{
+		{
+			name:   "confirm held",
+			stored: hold(entity.HoldStatusHeld, later),
+			prepare: func(_ *mock_repo.MockSlotRepo, h *mock_repo.MockSlotHoldRepo) {
+				h.EXPECT().UpdateStatus(gomock.Any(), entity.HoldID("h1"), entity.HoldStatusConfirmed).Return(hold(entity.HoldStatusConfirmed, later), nil)
+			},
+			want: entity.HoldStatusConfirmed,
+		},
+		{
+			name:   "confirm confirmed again",
+			stored: hold(entity.HoldStatusConfirmed, later),
+			want:   entity.HoldStatusConfirmed,
+		},
+		{
+			name:    "error - confirm past the TTL before the sweeper",
+			stored:  hold(entity.HoldStatusHeld, earlier),
+			wantErr: entity.ErrHoldNotActive,
+		},
+		{
+			name:    "error - confirm released",
+			stored:  hold(entity.HoldStatusReleased, later),
+			wantErr: entity.ErrHoldNotActive,
+		},
+		{
+			name:    "release confirmed",
+			release: true,
+			stored:  hold(entity.HoldStatusConfirmed, later),
+			prepare: func(s *mock_repo.MockSlotRepo, h *mock_repo.MockSlotHoldRepo) {
+				h.EXPECT().UpdateStatus(gomock.Any(), entity.HoldID("h1"), entity.HoldStatusReleased).Return(hold(entity.HoldStatusReleased, later), nil)
+				s.EXPECT().AddBooked(gomock.Any(), entity.SlotID(42), -2).Return(nil)
+			},
+			want: entity.HoldStatusReleased,
+		},
+		{
+			name:    "release expired changes nothing",
+			release: true,
+			stored:  hold(entity.HoldStatusExpired, earlier),
+			want:    entity.HoldStatusExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := mock_repo.NewMockSlotRepo(ctrl)
+			h := mock_repo.NewMockSlotHoldRepo(ctrl)
+			tx := mock_repo.NewMockTransactor(ctrl)
+
+			expectInTx(tx)
+			h.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.HoldID("h1")).Return(tt.stored, nil)
+			if tt.prepare != nil {
+				tt.prepare(s, h)
+			}
+
+			uc := usecase.NewUseCaseSlots(s, h, mock_repo.NewMockProviderRepo(ctrl), tx, 10*time.Minute)
+
+			var (
+				res *entity.SlotHold
+				err error
+			)
+			if tt.release {
+				res, err = uc.Release(context.Background(), "kuper", "h1")
+			} else {
+				res, err = uc.Confirm(context.Background(), "kuper", "h1")
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Equal(t, tt.want, res.Status)
+			}
+		})
+	}
+}
+
+func TestUseCaseSlots_ExpireHolds(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock_repo.NewMockSlotRepo(ctrl)
+	h := mock_repo.NewMockSlotHoldRepo(ctrl)
+	tx := mock_repo.NewMockTransactor(ctrl)
+	uc := usecase.NewUseCaseSlots(s, h, mock_repo.NewMockProviderRepo(ctrl), tx, 10*time.Minute)
+
+	expectInTx(tx)
+	h.EXPECT().LockExpired(gomock.Any(), gomock.Any(), uint64(500)).Return([]*entity.SlotHold{
+		{HoldID: "h1", SlotID: 1, Quantity: 1},
+		{HoldID: "h2", SlotID: 1, Quantity: 2},
+		{HoldID: "h3", SlotID: 7, Quantity: 1},
+	}, nil)
+	gomock.InOrder(
+		h.EXPECT().Expire(gomock.Any(), []entity.HoldID{"h1", "h2", "h3"}).Return(nil),
+		s.EXPECT().AddBooked(gomock.Any(), entity.SlotID(1), -3).Return(nil),
+		s.EXPECT().AddBooked(gomock.Any(), entity.SlotID(7), -1).Return(nil),
+	)
+
+	require.NoError(t, uc.ExpireHolds(context.Background()))
+
+	// Nothing to expire.
+	expectInTx(tx)
+	h.EXPECT().LockExpired(gomock.Any(), gomock.Any(), uint64(500)).Return(nil, nil)
+
+	require.NoError(t, uc.ExpireHolds(context.Background()))
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// revisionSnapshot keeps an immutable value built from the repository, it is rebuilt only when the revision of
+// the data moves. Readers take the current value without locking.
+type revisionSnapshot[T any] struct {
+	// mu serializes reloads, readers only load the current value.
+	mu      sync.Mutex
+	current atomic.Pointer[revisionValue[T]]
+}
+
+type revisionValue[T any] struct {
+	revision int64
+	value    T
+}
+
+// refresh builds a new value with build when the revision has moved since the last one.
+func (s *revisionSnapshot[T]) refresh(ctx context.Context, revision func(context.Context) (int64, error), build func(context.Context) (T, error)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The revision is read first, a change racing with the build leaves it behind and triggers another reload.
+	rev, err := revision(ctx)
+	if err != nil {
+		return fmt.Errorf("revision: %w", err)
+	}
+
+	if current := s.current.Load(); current != nil && current.revision == rev {
+		return nil
+	}
+
+	value, err := build(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.current.Store(&revisionValue[T]{revision: rev, value: value})
+
+	return nil
+}
+
+// load returns the current value, false until the first refresh succeeds.
+func (s *revisionSnapshot[T]) load() (T, bool) {
+	current := s.current.Load()
+	if current == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	return current.value, true
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+// _rtreeNodeCapacity is the fan-out of the R-tree, a lookup visits a handful of nodes per level.
+const _rtreeNodeCapacity = 16
+
+type bbox struct {
+	minLon, minLat, maxLon, maxLat float64
+}
+
+func (b bbox) contains(p entity.Position) bool {
+	return b.minLon <= p.Lon() && p.Lon() <= b.maxLon && b.minLat <= p.Lat() && p.Lat() <= b.maxLat
+}
+
+func (b bbox) intersects(o bbox) bool {
+	return b.minLon <= o.maxLon && o.minLon <= b.maxLon && b.minLat <= o.maxLat && o.minLat <= b.maxLat
+}
+
+func (b bbox) union(o bbox) bbox {
+	return bbox{
+		minLon: min(b.minLon, o.minLon),
+		minLat: min(b.minLat, o.minLat),
+		maxLon: max(b.maxLon, o.maxLon),
+		maxLat: max(b.maxLat, o.maxLat),
+	}
+}
+
+func (b bbox) center() (float64, float64) {
+	return (b.minLon + b.maxLon) / 2, (b.minLat + b.maxLat) / 2
+}
+
+// geometryBBox bounds the exterior rings, holes lie within them.
+func geometryBBox(g entity.Geometry) bbox {
+	b := bbox{minLon: math.Inf(1), minLat: math.Inf(1), maxLon: math.Inf(-1), maxLat: math.Inf(-1)}
+
+	for _, polygon := range g.Polygons {
+		if len(polygon) == 0 {
+			continue
+		}
+
+		for _, p := range polygon[0] {
+			b = b.union(bbox{minLon: p.Lon(), minLat: p.Lat(), maxLon: p.Lon(), maxLat: p.Lat()})
+		}
+	}
+
+	return b
+}
+
+func geometryContains(g entity.Geometry, p entity.Position) bool {
+	for _, polygon := range g.Polygons {
+		if polygonContains(polygon, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rtreeNode is a node of an R-tree over items of type T.
+type rtreeNode[T any] struct {
+	box bbox
+	// children is nil for leaves, they hold an item instead.
+	children []*rtreeNode[T]
+	item     T
+}
+
+// spatialIndex is a static R-tree over the bounding boxes of coverage areas, packed bottom-up with the
+// Sort-Tile-Recursive algorithm. It is immutable once built, so it is safe for concurrent lookups.
+type spatialIndex struct {
+	root *rtreeNode[*entity.CoverageArea]
+}
+
+func newSpatialIndex(areas []*entity.CoverageArea) *spatialIndex {
+	nodes := make([]*rtreeNode[*entity.CoverageArea], 0, len(areas))
+
+	for _, area := range areas {
+		nodes = append(nodes, &rtreeNode[*entity.CoverageArea]{box: geometryBBox(area.Geometry), item: area})
+	}
+
+	return &spatialIndex{root: packRTree(nodes)}
+}
+
+// packRTree builds a tree over the leaves and returns its root, nil when there are no leaves.
+func packRTree[T any](nodes []*rtreeNode[T]) *rtreeNode[T] {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	for len(nodes) > 1 {
+		nodes = packRTreeLevel(nodes)
+	}
+
+	return nodes[0]
+}
+
+// packRTreeLevel groups nodes into parents: they are cut into vertical slices by longitude of their centers,
+// each slice is sorted by latitude and cut into parents of _rtreeNodeCapacity nodes.
+func packRTreeLevel[T any](nodes []*rtreeNode[T]) []*rtreeNode[T] {
+	parents := (len(nodes) + _rtreeNodeCapacity - 1) / _rtreeNodeCapacity
+	sliceSize := int(math.Ceil(math.Sqrt(float64(parents)))) * _rtreeNodeCapacity
+
+	slices.SortFunc(nodes, func(a, b *rtreeNode[T]) int {
+		aLon, _ := a.box.center()
+		bLon, _ := b.box.center()
+
+		return cmp.Compare(aLon, bLon)
+	})
+
+	packed := make([]*rtreeNode[T], 0, parents)
+
+	for slice := range slices.Chunk(nodes, sliceSize) {
+		slices.SortFunc(slice, func(a, b *rtreeNode[T]) int {
+			_, aLat := a.box.center()
+			_, bLat := b.box.center()
+
+			return cmp.Compare(aLat, bLat)
+		})
+
+		for children := range slices.Chunk(slice, _rtreeNodeCapacity) {
+			parent := &rtreeNode[T]{box: children[0].box, children: children}
+			for _, child := range children[1:] {
+				parent.box = parent.box.union(child.box)
+			}
+
+			packed = append(packed, parent)
+		}
+	}
+
+	return packed
+}
+
+// containing returns the areas whose geometry contains the position.
+func (idx *spatialIndex) containing(p entity.Position) []*entity.CoverageArea {
+	if idx.root == nil {
+		return nil
+	}
+
+	var (
+		found []*entity.CoverageArea
+		stack = []*rtreeNode[*entity.CoverageArea]{idx.root}
+	)
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if !node.box.contains(p) {
+			continue
+		}
+
+		if node.children == nil {
+			if geometryContains(node.item.Geometry, p) {
+				found = append(found, node.item)
+			}
+
+			continue
+		}
+
+		stack = append(stack, node.children...)
+	}
+
+	return found
+}
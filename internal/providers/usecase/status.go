@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+)
+
+func (uc *UseCaseProviders) Activate(ctx context.Context, providerID entity.ProviderID) (*entity.Provider, error) {
+	provider, err := uc.transition(ctx, providerID, entity.ProviderStatusActive, "")
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Activate - uc.transition: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (uc *UseCaseProviders) Suspend(ctx context.Context, providerID entity.ProviderID, reason string) (*entity.Provider, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("UseCaseProviders - Suspend - reason is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	provider, err := uc.transition(ctx, providerID, entity.ProviderStatusSuspended, reason)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Suspend - uc.transition: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (uc *UseCaseProviders) Terminate(ctx context.Context, providerID entity.ProviderID, reason string) (*entity.Provider, error) {
+	provider, err := uc.transition(ctx, providerID, entity.ProviderStatusTerminated, reason)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseProviders - Terminate - uc.transition: %w", err)
+	}
+
+	return provider, nil
+}
+
+// transition checks the move against the state machine and applies it, the provider row stays locked in between
+// so a concurrent transition waits and is checked against the status this one leaves.
+func (uc *UseCaseProviders) transition(ctx context.Context, providerID entity.ProviderID, to entity.ProviderStatus, reason string) (*entity.Provider, error) {
+	var provider *entity.Provider
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		old, err := uc.repo.GetForUpdate(ctx, providerID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		if old.Archived() {
+			return fmt.Errorf("provider is archived: %w", entity.ErrNotFound)
+		}
+
+		if err := old.Status.ValidateTransition(to); err != nil {
+			return err
+		}
+
+		provider, err = uc.repo.UpdateStatus(ctx, providerID, to, reason)
+		if err != nil {
+			return fmt.Errorf("uc.repo.UpdateStatus: %w", err)
+		}
+
+		return uc.record(ctx, entity.AuditActionStatusChange, old, provider)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("uc.tx.InTx: %w", err)
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,195 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUseCaseProviders_Activate(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
+	}
+
+	type args struct {
+		ctx context.Context
+		id  entity.ProviderID
+	}
+
+	archivedAt := time.Date(2025, 5, 8, 6, 7, 14, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		args    args
+		want    *entity.Provider
+		wantErr error
+	}{
+		{
+			name: "onboarding provider activated successfully",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusOnboarding}, nil)
+				f.repo.EXPECT().UpdateStatus(context.Background(), entity.ProviderID("id"), entity.ProviderStatusActive, "").Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusActive}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusActive},
+			wantErr: nil,
+		},
+		{
+			name: "error - terminated provider can not be reactivated",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusTerminated}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrIllegalTransition,
+		},
+		{
+			name: "error - active provider is already active",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusActive}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrIllegalTransition,
+		},
+		{
+			name: "error - archived provider",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusSuspended, DeletedAt: &archivedAt}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
+
+			res, err := uc.Activate(tt.args.ctx, tt.args.id)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseProviders_Suspend(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo  *mock_repo.MockProviderRepo
+		audit *mock_repo.MockAuditRepo
+		tx    *mock_repo.MockTransactor
+	}
+
+	type args struct {
+		ctx    context.Context
+		id     entity.ProviderID
+		reason string
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		args    args
+		want    *entity.Provider
+		wantErr error
+	}{
+		{
+			name: "active provider suspended successfully",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusActive}, nil)
+				f.repo.EXPECT().UpdateStatus(context.Background(), entity.ProviderID("id"), entity.ProviderStatusSuspended, "contract review").Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusSuspended, StatusReason: "contract review"}, nil)
+				f.audit.EXPECT().Append(context.Background(), gomock.Any()).Return(nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), reason: "contract review"},
+			want:    &entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusSuspended, StatusReason: "contract review"},
+			wantErr: nil,
+		},
+		{
+			name:    "error - empty reason",
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id")},
+			want:    nil,
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - onboarding provider can not be suspended",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(&entity.Provider{ProviderID: entity.ProviderID("id"), Status: entity.ProviderStatusOnboarding}, nil)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), reason: "contract review"},
+			want:    nil,
+			wantErr: entity.ErrIllegalTransition,
+		},
+		{
+			name: "error - provider not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(context.Background(), entity.ProviderID("id")).Return(nil, entity.ErrNotFound)
+			},
+			args:    args{ctx: context.Background(), id: entity.ProviderID("id"), reason: "contract review"},
+			want:    nil,
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:  mock_repo.NewMockProviderRepo(ctrl),
+				audit: mock_repo.NewMockAuditRepo(ctrl),
+				tx:    mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseProviders(f.repo, f.audit, f.tx)
+
+			res, err := uc.Suspend(tt.args.ctx, tt.args.id, tt.args.reason)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
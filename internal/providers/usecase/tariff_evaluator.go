@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	_maxShipmentParcels = 50
+	// _maxParcelSideCM and _maxParcelWeightG keep volumetric weights well within int64.
+	_maxParcelSideCM  = 1000
+	_maxParcelWeightG = 10000000
+)
+
+var _thousand = decimal.NewFromInt(1000)
+
+// priceBand is a weight break or a distance band in base units, grams or meters.
+type priceBand struct {
+	upTo    int64
+	price   decimal.Decimal
+	perUnit decimal.Decimal
+}
+
+// evaluateTariff prices the shipment by the revision of the tariff. It is pure, so quotes may evaluate tariffs
+// concurrently. Every line is rounded half away from zero to the minor units of the currency, the total is their sum.
+func evaluateTariff(tariff *entity.Tariff, revision *entity.TariffRevision, shipment entity.Shipment) (*entity.PriceBreakdown, error) {
+	scale, err := currencyScale(tariff.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := revision.Rules
+	breakdown := &entity.PriceBreakdown{Currency: tariff.Currency, Revision: revision.Revision}
+
+	weightBands := make([]priceBand, len(rules.WeightBreaks))
+	for i, b := range rules.WeightBreaks {
+		weightBands[i] = priceBand{upTo: int64(b.UpToG), price: b.Price, perUnit: b.PerKg}
+	}
+
+	for i, parcel := range shipment.Parcels {
+		weightG := chargeableWeightG(parcel, rules.VolumetricDivisor)
+
+		amount, ok := bandPrice(weightBands, weightG)
+		if !ok {
+			return nil, fmt.Errorf("parcel %d weighs %d g, more than %d g: %w", i, weightG, rules.WeightBreaks[len(rules.WeightBreaks)-1].UpToG, entity.ErrTariffNotApplicable)
+		}
+
+		breakdown.ChargeableWeightG += int(weightG)
+		breakdown.Lines = append(breakdown.Lines, entity.PriceLine{Kind: entity.PriceLineWeight, Parcel: i, Amount: amount.Round(scale)})
+	}
+
+	breakdown.DistanceM = int(math.Round(haversine(shipment.Origin, shipment.Destination)))
+
+	if len(rules.DistanceBands) > 0 {
+		distanceBands := make([]priceBand, len(rules.DistanceBands))
+		for i, b := range rules.DistanceBands {
+			distanceBands[i] = priceBand{upTo: int64(b.UpToKm) * 1000, price: b.Price, perUnit: b.PerKm}
+		}
+
+		amount, ok := bandPrice(distanceBands, int64(breakdown.DistanceM))
+		if !ok {
+			return nil, fmt.Errorf("route is %d m long, more than %d km: %w", breakdown.DistanceM, rules.DistanceBands[len(rules.DistanceBands)-1].UpToKm, entity.ErrTariffNotApplicable)
+		}
+
+		breakdown.Lines = append(breakdown.Lines, entity.PriceLine{Kind: entity.PriceLineDistance, Amount: amount.Round(scale)})
+	}
+
+	for _, s := range rules.Surcharges {
+		breakdown.Lines = append(breakdown.Lines, entity.PriceLine{Kind: entity.PriceLineSurcharge, Code: s.Code, Amount: s.Amount.Round(scale)})
+	}
+
+	total := decimal.Zero
+	for _, line := range breakdown.Lines {
+		total = total.Add(line.Amount)
+	}
+
+	minPrice, maxPrice := rules.MinPrice.Round(scale), rules.MaxPrice.Round(scale)
+
+	switch {
+	case total.LessThan(minPrice):
+		breakdown.Lines = append(breakdown.Lines, entity.PriceLine{Kind: entity.PriceLineMinPrice, Amount: minPrice.Sub(total)})
+		total = minPrice
+	case !maxPrice.IsZero() && total.GreaterThan(maxPrice):
+		breakdown.Lines = append(breakdown.Lines, entity.PriceLine{Kind: entity.PriceLineMaxPrice, Amount: maxPrice.Sub(total)})
+		total = maxPrice
+	}
+
+	breakdown.Total = total
+
+	return breakdown, nil
+}
+
+// bandPrice prices an amount in base units by the first band it fits in: the price of the band plus
+// the per-unit rate for every thousand base units above the previous band. False means no band fits.
+func bandPrice(bands []priceBand, amount int64) (decimal.Decimal, bool) {
+	var previous int64
+
+	for _, band := range bands {
+		if amount <= band.upTo {
+			above := decimal.NewFromInt(max(amount-previous, 0)).Div(_thousand)
+
+			return band.price.Add(band.perUnit.Mul(above)), true
+		}
+
+		previous = band.upTo
+	}
+
+	return decimal.Decimal{}, false
+}
+
+// chargeableWeightG is the greater of the actual and the volumetric weight, the latter rounded up to a gram.
+func chargeableWeightG(parcel entity.ParcelDimensions, volumetricDivisor int) int64 {
+	weightG := int64(parcel.WeightG)
+
+	if volumetricDivisor > 0 {
+		volumeCM3 := int64(parcel.LengthCM) * int64(parcel.WidthCM) * int64(parcel.HeightCM)
+		divisor := int64(volumetricDivisor)
+
+		weightG = max(weightG, (volumeCM3*1000+divisor-1)/divisor)
+	}
+
+	return weightG
+}
+
+func validateShipment(shipment entity.Shipment) error {
+	if err := validatePosition(shipment.Origin); err != nil {
+		return fmt.Errorf("origin: %w", err)
+	}
+
+	if err := validatePosition(shipment.Destination); err != nil {
+		return fmt.Errorf("destination: %w", err)
+	}
+
+	if len(shipment.Parcels) == 0 || len(shipment.Parcels) > _maxShipmentParcels {
+		return fmt.Errorf("number of parcels is not within 1..%d: %w", _maxShipmentParcels, entity.ErrInvalidArgument)
+	}
+
+	for i, p := range shipment.Parcels {
+		if p.WeightG <= 0 || p.WeightG > _maxParcelWeightG {
+			return fmt.Errorf("parcel %d weight %d g is not within 1..%d: %w", i, p.WeightG, _maxParcelWeightG, entity.ErrInvalidArgument)
+		}
+
+		for _, side := range []int{p.LengthCM, p.WidthCM, p.HeightCM} {
+			if side < 0 || side > _maxParcelSideCM {
+				return fmt.Errorf("parcel %d side %d cm is not within 0..%d: %w", i, side, _maxParcelSideCM, entity.ErrInvalidArgument)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,492 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/currency"
+)
+
+const (
+	_maxTariffIDLength      = 32
+	_maxTariffNameLength    = 128
+	_maxTariffBreaks        = 50
+	_maxTariffSurcharges    = 20
+	_maxSurchargeCodeLength = 32
+	// _maxVolumetricDivisor is far above the 4000-6000 carriers use.
+	_maxVolumetricDivisor = 100000
+	// _maxAmountScale allows rates finer than minor units, 0.125 per kg for example.
+	_maxAmountScale = 6
+	_maxTransitDays = 90
+)
+
+// UseCaseTariffs keeps provider tariffs with their revisions and prices shipments by them.
+type UseCaseTariffs struct {
+	repo      repo.TariffRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+}
+
+func NewUseCaseTariffs(r repo.TariffRepo, p repo.ProviderRepo, tx repo.Transactor) *UseCaseTariffs {
+	return &UseCaseTariffs{
+		repo:      r,
+		providers: p,
+		tx:        tx,
+	}
+}
+
+// Create adds a tariff with its first revision to a provider, archived providers do not get new tariffs.
+func (uc *UseCaseTariffs) Create(ctx context.Context, tariff *entity.Tariff) (*entity.Tariff, error) {
+	if tariff.Revision == nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Create - revision is missing: %w", entity.ErrInvalidArgument)
+	}
+
+	normalizeTariff(tariff)
+
+	if err := validateTariff(tariff); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Create - validateTariff: %w", err)
+	}
+
+	revision := tariff.Revision
+	revision.ProviderID, revision.TariffID, revision.Revision = tariff.ProviderID, tariff.TariffID, 1
+
+	if err := prepareRevision(revision, time.Now()); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Create - prepareRevision: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		provider, err := uc.providers.GetForUpdate(ctx, tariff.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		if err := uc.repo.Store(ctx, tariff); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		if err := uc.repo.StoreRevision(ctx, revision); err != nil {
+			return fmt.Errorf("uc.repo.StoreRevision: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Create - uc.tx.InTx: %w", err)
+	}
+
+	return tariff, nil
+}
+
+func (uc *UseCaseTariffs) GetByID(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) (*entity.Tariff, error) {
+	tariff, err := uc.repo.GetByID(ctx, providerID, tariffID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	if err := uc.attachRevisions(ctx, providerID, []*entity.Tariff{tariff}); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - GetByID - uc.attachRevisions: %w", err)
+	}
+
+	return tariff, nil
+}
+
+func (uc *UseCaseTariffs) ListAll(ctx context.Context, params entity.TariffListParams) (*entity.TariffPage, error) {
+	query, err := newTariffQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - ListAll - newTariffQuery: %w", err)
+	}
+
+	tariffs, err := uc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - ListAll - uc.repo.GetAll: %w", err)
+	}
+
+	page := &entity.TariffPage{}
+
+	var last *entity.Tariff
+	if page.Tariffs, last = trimPage(tariffs, query.Limit); last != nil {
+		page.NextPageToken = encodeIDPageToken(last.TariffID)
+	}
+
+	if err := uc.attachRevisions(ctx, params.ProviderID, page.Tariffs); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - ListAll - uc.attachRevisions: %w", err)
+	}
+
+	return page, nil
+}
+
+// attachRevisions sets the revision in effect now or the next one on every tariff.
+func (uc *UseCaseTariffs) attachRevisions(ctx context.Context, providerID entity.ProviderID, tariffs []*entity.Tariff) error {
+	if len(tariffs) == 0 {
+		return nil
+	}
+
+	tariffIDs := make([]entity.TariffID, len(tariffs))
+	for i, tariff := range tariffs {
+		tariffIDs[i] = tariff.TariffID
+	}
+
+	revisions, err := uc.repo.GetRevisionsAt(ctx, providerID, tariffIDs, time.Now())
+	if err != nil {
+		return fmt.Errorf("uc.repo.GetRevisionsAt: %w", err)
+	}
+
+	byTariff := make(map[entity.TariffID]*entity.TariffRevision, len(revisions))
+	for _, revision := range revisions {
+		byTariff[revision.TariffID] = revision
+	}
+
+	for _, tariff := range tariffs {
+		tariff.Revision = byTariff[tariff.TariffID]
+	}
+
+	return nil
+}
+
+// Delete removes a tariff none of whose revisions has taken effect yet, the prices of past shipments
+// must stay reproducible.
+func (uc *UseCaseTariffs) Delete(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) error {
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.GetForUpdate(ctx, providerID, tariffID); err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		revisions, err := uc.repo.GetRevisions(ctx, providerID, tariffID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetRevisions: %w", err)
+		}
+
+		now := time.Now()
+		for _, r := range revisions {
+			if !r.EffectiveFrom.After(now) {
+				return fmt.Errorf("revision %d: %w", r.Revision, entity.ErrTariffInEffect)
+			}
+		}
+
+		if err := uc.repo.Delete(ctx, providerID, tariffID); err != nil {
+			return fmt.Errorf("uc.repo.Delete: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("UseCaseTariffs - Delete - uc.tx.InTx: %w", err)
+	}
+
+	return nil
+}
+
+// CreateRevision schedules a revision of a tariff. A revision may not start in the past, so prices of past
+// shipments never change. It may not overlap other revisions either, except for the open-ended one in effect
+// before it starts, which is ended when the new revision starts.
+func (uc *UseCaseTariffs) CreateRevision(ctx context.Context, revision *entity.TariffRevision) (*entity.TariffRevision, error) {
+	normalizeTariffRules(&revision.Rules)
+
+	if err := validateTariffRules(revision.Rules); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - CreateRevision - validateTariffRules: %w", err)
+	}
+
+	if err := prepareRevision(revision, time.Now()); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - CreateRevision - prepareRevision: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.repo.GetForUpdate(ctx, revision.ProviderID, revision.TariffID); err != nil {
+			return fmt.Errorf("uc.repo.GetForUpdate: %w", err)
+		}
+
+		revisions, err := uc.repo.GetRevisions(ctx, revision.ProviderID, revision.TariffID)
+		if err != nil {
+			return fmt.Errorf("uc.repo.GetRevisions: %w", err)
+		}
+
+		superseded, err := scheduleRevision(revisions, revision)
+		if err != nil {
+			return fmt.Errorf("scheduleRevision: %w", err)
+		}
+
+		if superseded != nil {
+			err := uc.repo.EndRevision(ctx, superseded.ProviderID, superseded.TariffID, superseded.Revision, revision.EffectiveFrom)
+			if err != nil {
+				return fmt.Errorf("uc.repo.EndRevision: %w", err)
+			}
+		}
+
+		if err := uc.repo.StoreRevision(ctx, revision); err != nil {
+			return fmt.Errorf("uc.repo.StoreRevision: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - CreateRevision - uc.tx.InTx: %w", err)
+	}
+
+	return revision, nil
+}
+
+// ListRevisions returns every revision of the tariff ordered by number.
+func (uc *UseCaseTariffs) ListRevisions(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID) ([]*entity.TariffRevision, error) {
+	revisions, err := uc.repo.GetRevisions(ctx, providerID, tariffID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - ListRevisions - uc.repo.GetRevisions: %w", err)
+	}
+
+	// Every tariff is created with a revision.
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("UseCaseTariffs - ListRevisions - tariff %s: %w", tariffID, entity.ErrNotFound)
+	}
+
+	return revisions, nil
+}
+
+// Evaluate prices the shipment by the revision of the tariff in effect at the time, now when it is zero.
+func (uc *UseCaseTariffs) Evaluate(ctx context.Context, providerID entity.ProviderID, tariffID entity.TariffID, shipment entity.Shipment, at time.Time) (*entity.PriceBreakdown, error) {
+	if err := validateShipment(shipment); err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Evaluate - validateShipment: %w", err)
+	}
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	tariff, err := uc.repo.GetByID(ctx, providerID, tariffID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Evaluate - uc.repo.GetByID: %w", err)
+	}
+
+	revisions, err := uc.repo.GetRevisionsAt(ctx, providerID, []entity.TariffID{tariffID}, at)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Evaluate - uc.repo.GetRevisionsAt: %w", err)
+	}
+
+	if len(revisions) == 0 || !revisions[0].InEffect(at) {
+		return nil, fmt.Errorf("UseCaseTariffs - Evaluate - no revision in effect at %s: %w", at.Format(time.RFC3339), entity.ErrTariffNotApplicable)
+	}
+
+	breakdown, err := evaluateTariff(tariff, revisions[0], shipment)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseTariffs - Evaluate - evaluateTariff: %w", err)
+	}
+
+	return breakdown, nil
+}
+
+// normalizeTariff upper-cases the currency, defaults the service level to standard and orders the rules.
+func normalizeTariff(tariff *entity.Tariff) {
+	tariff.Currency = entity.Currency(strings.ToUpper(string(tariff.Currency)))
+
+	if tariff.ServiceLevel == "" {
+		tariff.ServiceLevel = entity.ServiceLevelStandard
+	}
+
+	if tariff.Revision != nil {
+		normalizeTariffRules(&tariff.Revision.Rules)
+	}
+}
+
+// normalizeTariffRules orders breaks, bands and surcharges.
+func normalizeTariffRules(rules *entity.TariffRules) {
+	slices.SortFunc(rules.WeightBreaks, func(a, b entity.WeightBreak) int {
+		return cmp.Compare(a.UpToG, b.UpToG)
+	})
+	slices.SortFunc(rules.DistanceBands, func(a, b entity.DistanceBand) int {
+		return cmp.Compare(a.UpToKm, b.UpToKm)
+	})
+	slices.SortFunc(rules.Surcharges, func(a, b entity.Surcharge) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+}
+
+// prepareRevision starts a revision without a start now and checks its period.
+func prepareRevision(revision *entity.TariffRevision, now time.Time) error {
+	if revision.EffectiveFrom.IsZero() {
+		revision.EffectiveFrom = now
+	}
+
+	if revision.EffectiveFrom.Before(now) {
+		return fmt.Errorf("effective_from %s is in the past: %w", revision.EffectiveFrom.Format(time.RFC3339), entity.ErrInvalidArgument)
+	}
+
+	if revision.EffectiveTo != nil && !revision.EffectiveTo.After(revision.EffectiveFrom) {
+		return fmt.Errorf("effective_to is not after effective_from: %w", entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// scheduleRevision numbers the new revision and returns the open-ended revision it ends, if any.
+// Any other overlap is an error.
+func scheduleRevision(revisions []*entity.TariffRevision, revision *entity.TariffRevision) (*entity.TariffRevision, error) {
+	var superseded *entity.TariffRevision
+
+	revision.Revision = 1
+
+	for _, r := range revisions {
+		revision.Revision = max(revision.Revision, r.Revision+1)
+
+		if !r.Overlaps(revision) {
+			continue
+		}
+
+		if r.EffectiveTo != nil || !r.EffectiveFrom.Before(revision.EffectiveFrom) {
+			return nil, fmt.Errorf("revision %d: %w", r.Revision, entity.ErrRevisionOverlap)
+		}
+
+		superseded = r
+	}
+
+	return superseded, nil
+}
+
+func validateTariff(tariff *entity.Tariff) error {
+	if tariff.TariffID == "" {
+		return fmt.Errorf("tariff_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if len(tariff.TariffID) > _maxTariffIDLength {
+		return fmt.Errorf("tariff_id is longer than %d: %w", _maxTariffIDLength, entity.ErrInvalidArgument)
+	}
+
+	if err := validateText(tariff.Name, _maxTariffNameLength); err != nil {
+		return fmt.Errorf("name: %w", err)
+	}
+
+	if _, err := currencyScale(tariff.Currency); err != nil {
+		return err
+	}
+
+	switch tariff.ServiceLevel {
+	case entity.ServiceLevelEconomy, entity.ServiceLevelStandard, entity.ServiceLevelExpress, entity.ServiceLevelSameDay:
+	default:
+		return fmt.Errorf("service level %q is unknown: %w", tariff.ServiceLevel, entity.ErrInvalidArgument)
+	}
+
+	if tariff.TransitDaysMin < 0 || tariff.TransitDaysMin > tariff.TransitDaysMax || tariff.TransitDaysMax > _maxTransitDays {
+		return fmt.Errorf("transit days %d..%d are not within 0..%d: %w", tariff.TransitDaysMin, tariff.TransitDaysMax, _maxTransitDays, entity.ErrInvalidArgument)
+	}
+
+	return validateTariffRules(tariff.Revision.Rules)
+}
+
+// validateTariffRules expects the rules ordered by normalizeTariffRules.
+func validateTariffRules(rules entity.TariffRules) error {
+	if len(rules.WeightBreaks) == 0 || len(rules.WeightBreaks) > _maxTariffBreaks {
+		return fmt.Errorf("number of weight breaks is not within 1..%d: %w", _maxTariffBreaks, entity.ErrInvalidArgument)
+	}
+
+	for i, b := range rules.WeightBreaks {
+		if b.UpToG <= 0 || (i > 0 && b.UpToG == rules.WeightBreaks[i-1].UpToG) {
+			return fmt.Errorf("weight break up to %d g is not positive or repeated: %w", b.UpToG, entity.ErrInvalidArgument)
+		}
+
+		if err := validateAmounts(b.Price, b.PerKg); err != nil {
+			return fmt.Errorf("weight break up to %d g: %w", b.UpToG, err)
+		}
+	}
+
+	if rules.VolumetricDivisor < 0 || rules.VolumetricDivisor > _maxVolumetricDivisor {
+		return fmt.Errorf("volumetric divisor %d is not within 0..%d: %w", rules.VolumetricDivisor, _maxVolumetricDivisor, entity.ErrInvalidArgument)
+	}
+
+	if len(rules.DistanceBands) > _maxTariffBreaks {
+		return fmt.Errorf("more than %d distance bands: %w", _maxTariffBreaks, entity.ErrInvalidArgument)
+	}
+
+	for i, b := range rules.DistanceBands {
+		if b.UpToKm <= 0 || (i > 0 && b.UpToKm == rules.DistanceBands[i-1].UpToKm) {
+			return fmt.Errorf("distance band up to %d km is not positive or repeated: %w", b.UpToKm, entity.ErrInvalidArgument)
+		}
+
+		if err := validateAmounts(b.Price, b.PerKm); err != nil {
+			return fmt.Errorf("distance band up to %d km: %w", b.UpToKm, err)
+		}
+	}
+
+	if len(rules.Surcharges) > _maxTariffSurcharges {
+		return fmt.Errorf("more than %d surcharges: %w", _maxTariffSurcharges, entity.ErrInvalidArgument)
+	}
+
+	for i, s := range rules.Surcharges {
+		if s.Code == "" || len(s.Code) > _maxSurchargeCodeLength {
+			return fmt.Errorf("surcharge code %q is empty or longer than %d: %w", s.Code, _maxSurchargeCodeLength, entity.ErrInvalidArgument)
+		}
+
+		if i > 0 && s.Code == rules.Surcharges[i-1].Code {
+			return fmt.Errorf("surcharge %q is repeated: %w", s.Code, entity.ErrInvalidArgument)
+		}
+
+		if err := validateAmounts(s.Amount); err != nil {
+			return fmt.Errorf("surcharge %q: %w", s.Code, err)
+		}
+	}
+
+	if err := validateAmounts(rules.MinPrice, rules.MaxPrice); err != nil {
+		return fmt.Errorf("price bounds: %w", err)
+	}
+
+	if !rules.MaxPrice.IsZero() && rules.MaxPrice.LessThan(rules.MinPrice) {
+		return fmt.Errorf("max price %s is below min price %s: %w", rules.MaxPrice, rules.MinPrice, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateAmounts(amounts ...decimal.Decimal) error {
+	for _, a := range amounts {
+		if a.IsNegative() {
+			return fmt.Errorf("amount %s is negative: %w", a, entity.ErrInvalidArgument)
+		}
+
+		if -a.Exponent() > _maxAmountScale {
+			return fmt.Errorf("amount %s has more than %d decimal places: %w", a, _maxAmountScale, entity.ErrInvalidArgument)
+		}
+	}
+
+	return nil
+}
+
+// currencyScale returns the number of decimal places of the minor units of the currency, 2 for RUB and 0 for JPY.
+func currencyScale(c entity.Currency) (int32, error) {
+	if len(c) != 3 || strings.ToUpper(string(c)) != string(c) {
+		return 0, fmt.Errorf("currency %q is not an upper case ISO 4217 code: %w", c, entity.ErrInvalidArgument)
+	}
+
+	unit, err := currency.ParseISO(string(c))
+	if err != nil {
+		return 0, fmt.Errorf("currency %q: %w", c, entity.ErrInvalidArgument)
+	}
+
+	scale, _ := currency.Standard.Rounding(unit)
+
+	return int32(scale), nil //nolint:gosec // ISO 4217 minor units are 0..4
+}
+
+func newTariffQuery(params entity.TariffListParams) (entity.TariffQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.TariffQuery{}, err
+	}
+
+	after, err := decodeIDPageToken[entity.TariffID](params.PageToken)
+	if err != nil {
+		return entity.TariffQuery{}, err
+	}
+
+	return entity.TariffQuery{
+		ProviderID: params.ProviderID,
+		Limit:      limit,
+		After:      after,
+	}, nil
+}
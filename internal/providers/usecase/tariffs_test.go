@@ -0,0 +1,556 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func tariff() *entity.Tariff {
+	return &entity.Tariff{
+		ProviderID:     "kuper",
+		TariffID:       "standard",
+		Name:           "Стандарт",
+		Currency:       "RUB",
+		ServiceLevel:   entity.ServiceLevelStandard,
+		TransitDaysMin: 1,
+		TransitDaysMax: 3,
+		Revision:       &entity.TariffRevision{Rules: tariffRules()},
+	}
+}
+
+func tariffRules() entity.TariffRules {
+	return entity.TariffRules{
+		WeightBreaks: []entity.WeightBreak{
+			{UpToG: 1000, Price: decimal.RequireFromString("200")},
+			{UpToG: 5000, Price: decimal.RequireFromString("200"), PerKg: decimal.RequireFromString("50")},
+			{UpToG: 20000, Price: decimal.RequireFromString("400"), PerKg: decimal.RequireFromString("30.125")},
+		},
+		VolumetricDivisor: 5000,
+		DistanceBands: []entity.DistanceBand{
+			{UpToKm: 10},
+			{UpToKm: 100, Price: decimal.RequireFromString("150")},
+		},
+		Surcharges: []entity.Surcharge{{Code: "fuel", Amount: decimal.RequireFromString("35.5")}},
+		MinPrice:   decimal.RequireFromString("300"),
+		MaxPrice:   decimal.RequireFromString("5000"),
+	}
+}
+
+func TestUseCaseTariffs_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockTariffRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil)
+		f.repo.EXPECT().StoreRevision(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		modify  func(t *entity.Tariff)
+		wantErr error
+	}{
+		{
+			name:    "tariff",
+			prepare: stored,
+		},
+		{
+			name:    "lower case currency",
+			prepare: stored,
+			modify:  func(t *entity.Tariff) { t.Currency = "rub" },
+		},
+		{
+			name:    "unordered breaks",
+			prepare: stored,
+			modify: func(t *entity.Tariff) {
+				t.Revision.Rules.WeightBreaks[0], t.Revision.Rules.WeightBreaks[2] = t.Revision.Rules.WeightBreaks[2], t.Revision.Rules.WeightBreaks[0]
+			},
+		},
+		{
+			name:    "scheduled",
+			prepare: stored,
+			modify:  func(t *entity.Tariff) { t.Revision.EffectiveFrom = time.Now().Add(24 * time.Hour) },
+		},
+		{
+			name:    "error - no revision",
+			modify:  func(t *entity.Tariff) { t.Revision = nil },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - effective from in the past",
+			modify:  func(t *entity.Tariff) { t.Revision.EffectiveFrom = time.Now().Add(-time.Hour) },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown service level",
+			modify:  func(t *entity.Tariff) { t.ServiceLevel = "overnight" },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - transit days reversed",
+			modify:  func(t *entity.Tariff) { t.TransitDaysMin, t.TransitDaysMax = 3, 2 },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown currency",
+			modify:  func(t *entity.Tariff) { t.Currency = "ZZZ" },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - no weight breaks",
+			modify:  func(t *entity.Tariff) { t.Revision.Rules.WeightBreaks = nil },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - repeated weight break",
+			modify:  func(t *entity.Tariff) { t.Revision.Rules.WeightBreaks[1].UpToG = 1000 },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - negative rate",
+			modify:  func(t *entity.Tariff) { t.Revision.Rules.DistanceBands[1].PerKm = decimal.RequireFromString("-1") },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - too many decimal places",
+			modify: func(t *entity.Tariff) {
+				t.Revision.Rules.WeightBreaks[0].PerKg = decimal.RequireFromString("0.0000001")
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - max price below min price",
+			modify:  func(t *entity.Tariff) { t.Revision.Rules.MaxPrice = decimal.RequireFromString("299.99") },
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - repeated surcharge",
+			modify: func(t *entity.Tariff) {
+				t.Revision.Rules.Surcharges = append(t.Revision.Rules.Surcharges, entity.Surcharge{Code: "fuel", Amount: decimal.RequireFromString("1")})
+			},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockTariffRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			tariff := tariff()
+			if tt.modify != nil {
+				tt.modify(tariff)
+			}
+
+			uc := usecase.NewUseCaseTariffs(f.repo, f.providers, f.tx)
+
+			res, err := uc.Create(context.Background(), tariff)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			rules := res.Revision.Rules
+
+			require.Equal(t, entity.Currency("RUB"), res.Currency)
+			require.Equal(t, 1, res.Revision.Revision)
+			require.Equal(t, entity.TariffID("standard"), res.Revision.TariffID)
+			require.False(t, res.Revision.EffectiveFrom.IsZero())
+			require.Equal(t, []int{1000, 5000, 20000}, []int{rules.WeightBreaks[0].UpToG, rules.WeightBreaks[1].UpToG, rules.WeightBreaks[2].UpToG})
+		})
+	}
+}
+
+func TestUseCaseTariffs_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		moscow = entity.Position{37.6173, 55.7558}
+		// About 3.8 km and 49 km north of it.
+		near = entity.Position{37.6173, 55.7900}
+		far  = entity.Position{37.6173, 56.2000}
+	)
+
+	parcels := func(n, weightG int) []entity.ParcelDimensions {
+		res := make([]entity.ParcelDimensions, n)
+		for i := range res {
+			res[i] = entity.ParcelDimensions{WeightG: weightG}
+		}
+
+		return res
+	}
+
+	effectiveFrom := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		tariff    func(t *entity.Tariff)
+		shipment  entity.Shipment
+		at        time.Time
+		wantLines []string
+		wantTotal string
+		wantErr   error
+	}{
+		{
+			name:      "raised to the min price",
+			shipment:  entity.Shipment{Origin: moscow, Destination: near, Parcels: []entity.ParcelDimensions{{LengthCM: 10, WidthCM: 10, HeightCM: 10, WeightG: 800}}},
+			wantLines: []string{"weight 200.00", "distance 0.00", "surcharge fuel 35.50", "min_price 64.50"},
+			wantTotal: "300.00",
+		},
+		{
+			name:      "volumetric weight over a distance band",
+			shipment:  entity.Shipment{Origin: moscow, Destination: far, Parcels: []entity.ParcelDimensions{{LengthCM: 30, WidthCM: 30, HeightCM: 30, WeightG: 3000}}},
+			wantLines: []string{"weight 412.05", "distance 150.00", "surcharge fuel 35.50"},
+			wantTotal: "597.55",
+		},
+		{
+			name:      "fraction of a kopeck is rounded",
+			shipment:  entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(1, 5001)},
+			wantLines: []string{"weight 400.03", "distance 0.00", "surcharge fuel 35.50"},
+			wantTotal: "435.53",
+		},
+		{
+			name:     "lowered to the max price",
+			shipment: entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(6, 20000)},
+			wantLines: []string{
+				"weight 851.88", "weight 851.88", "weight 851.88", "weight 851.88", "weight 851.88", "weight 851.88",
+				"distance 0.00", "surcharge fuel 35.50", "max_price -146.78",
+			},
+			wantTotal: "5000.00",
+		},
+		{
+			name: "currency without minor units",
+			tariff: func(t *entity.Tariff) {
+				t.Currency = "JPY"
+				t.Revision.Rules.DistanceBands = nil
+				t.Revision.Rules.MinPrice = decimal.Zero
+			},
+			shipment:  entity.Shipment{Origin: moscow, Destination: far, Parcels: parcels(1, 1500)},
+			wantLines: []string{"weight 225.00", "surcharge fuel 36.00"},
+			wantTotal: "261.00",
+		},
+		{
+			name:      "as of the start of the revision",
+			shipment:  entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(1, 5001)},
+			at:        effectiveFrom,
+			wantLines: []string{"weight 400.03", "distance 0.00", "surcharge fuel 35.50"},
+			wantTotal: "435.53",
+		},
+		{
+			name:     "error - before the first revision",
+			shipment: entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(1, 5001)},
+			at:       effectiveFrom.Add(-time.Second),
+			wantErr:  entity.ErrTariffNotApplicable,
+		},
+		{
+			name:     "error - heavier than the last break",
+			shipment: entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(1, 20001)},
+			wantErr:  entity.ErrTariffNotApplicable,
+		},
+		{
+			name:     "error - longer than the last band",
+			shipment: entity.Shipment{Origin: moscow, Destination: entity.Position{131.8855, 43.1155}, Parcels: parcels(1, 500)},
+			wantErr:  entity.ErrTariffNotApplicable,
+		},
+		{
+			name:     "error - no parcels",
+			shipment: entity.Shipment{Origin: moscow, Destination: near},
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - weightless parcel",
+			shipment: entity.Shipment{Origin: moscow, Destination: near, Parcels: parcels(1, 0)},
+			wantErr:  entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tariff := tariff()
+			tariff.Revision.ProviderID, tariff.Revision.TariffID = "kuper", "standard"
+			tariff.Revision.Revision, tariff.Revision.EffectiveFrom = 1, effectiveFrom
+			if tt.tariff != nil {
+				tt.tariff(tariff)
+			}
+
+			r := mock_repo.NewMockTariffRepo(ctrl)
+			r.EXPECT().GetByID(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(tariff, nil).AnyTimes()
+			// The repository returns the revision in effect or the next one.
+			r.EXPECT().GetRevisionsAt(gomock.Any(), entity.ProviderID("kuper"), []entity.TariffID{"standard"}, gomock.Any()).
+				Return([]*entity.TariffRevision{tariff.Revision}, nil).
+				AnyTimes()
+
+			uc := usecase.NewUseCaseTariffs(r, mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			res, err := uc.Evaluate(context.Background(), "kuper", "standard", tt.shipment, tt.at)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			require.Equal(t, 1, res.Revision)
+
+			var lines []string
+			for _, line := range res.Lines {
+				switch {
+				case line.Code != "":
+					lines = append(lines, fmt.Sprintf("%s %s %s", line.Kind, line.Code, line.Amount.StringFixed(2)))
+				default:
+					lines = append(lines, fmt.Sprintf("%s %s", line.Kind, line.Amount.StringFixed(2)))
+				}
+			}
+
+			require.Equal(t, tt.wantLines, lines)
+			require.Equal(t, tt.wantTotal, res.Total.StringFixed(2))
+		})
+	}
+}
+
+func TestUseCaseTariffs_CreateRevision(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	revision := func(n int, from time.Time, to *time.Time) *entity.TariffRevision {
+		return &entity.TariffRevision{
+			ProviderID:    "kuper",
+			TariffID:      "standard",
+			Revision:      n,
+			Rules:         tariffRules(),
+			EffectiveFrom: from,
+			EffectiveTo:   to,
+		}
+	}
+
+	day := func(n int) time.Time {
+		return now.Add(time.Duration(n) * 24 * time.Hour)
+	}
+
+	dayPtr := func(n int) *time.Time {
+		d := day(n)
+
+		return &d
+	}
+
+	type fields struct {
+		repo *mock_repo.MockTariffRepo
+		tx   *mock_repo.MockTransactor
+	}
+
+	existing := func(f *fields, revisions ...*entity.TariffRevision) {
+		expectInTx(f.tx)
+		f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(tariff(), nil)
+		f.repo.EXPECT().GetRevisions(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(revisions, nil)
+	}
+
+	tests := []struct {
+		name         string
+		revision     *entity.TariffRevision
+		prepare      func(f *fields)
+		wantRevision int
+		wantErr      error
+	}{
+		{
+			name:     "ends the open-ended revision",
+			revision: revision(0, day(7), nil),
+			prepare: func(f *fields) {
+				existing(f, revision(1, day(-30), nil))
+				f.repo.EXPECT().EndRevision(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard"), 1, day(7)).Return(nil)
+				f.repo.EXPECT().StoreRevision(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantRevision: 2,
+		},
+		{
+			name:     "after the last ended revision",
+			revision: revision(0, day(7), dayPtr(14)),
+			prepare: func(f *fields) {
+				existing(f, revision(1, day(-30), dayPtr(1)), revision(2, day(1), dayPtr(7)))
+				f.repo.EXPECT().StoreRevision(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantRevision: 3,
+		},
+		{
+			name:     "error - overlaps an ended revision",
+			revision: revision(0, day(3), nil),
+			prepare: func(f *fields) {
+				existing(f, revision(1, day(-30), dayPtr(7)))
+			},
+			wantErr: entity.ErrRevisionOverlap,
+		},
+		{
+			name:     "error - starts with the open-ended revision",
+			revision: revision(0, day(7), dayPtr(14)),
+			prepare: func(f *fields) {
+				existing(f, revision(1, day(-30), dayPtr(1)), revision(2, day(7), nil))
+			},
+			wantErr: entity.ErrRevisionOverlap,
+		},
+		{
+			name:     "error - tariff not found",
+			revision: revision(0, day(7), nil),
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(nil, entity.ErrNotFound)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+		{
+			name:     "error - effective from in the past",
+			revision: revision(0, day(-1), nil),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - effective to before effective from",
+			revision: revision(0, day(7), dayPtr(6)),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo: mock_repo.NewMockTariffRepo(ctrl),
+				tx:   mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseTariffs(f.repo, mock_repo.NewMockProviderRepo(ctrl), f.tx)
+
+			res, err := uc.CreateRevision(context.Background(), tt.revision)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+
+			require.Equal(t, tt.wantRevision, res.Revision)
+		})
+	}
+}
+
+func TestUseCaseTariffs_Delete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	revision := func(n int, from time.Time) *entity.TariffRevision {
+		return &entity.TariffRevision{
+			ProviderID:    "kuper",
+			TariffID:      "standard",
+			Revision:      n,
+			Rules:         tariffRules(),
+			EffectiveFrom: from,
+		}
+	}
+
+	type fields struct {
+		repo *mock_repo.MockTariffRepo
+		tx   *mock_repo.MockTransactor
+	}
+
+	existing := func(f *fields, revisions ...*entity.TariffRevision) {
+		expectInTx(f.tx)
+		f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(tariff(), nil)
+		f.repo.EXPECT().GetRevisions(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(revisions, nil)
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(f *fields)
+		wantErr error
+	}{
+		{
+			name: "no revision has taken effect",
+			prepare: func(f *fields) {
+				existing(f, revision(1, now.Add(24*time.Hour)))
+				f.repo.EXPECT().Delete(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(nil)
+			},
+		},
+		{
+			name: "error - a revision has taken effect",
+			prepare: func(f *fields) {
+				existing(f, revision(1, now.Add(-24*time.Hour)), revision(2, now.Add(24*time.Hour)))
+			},
+			wantErr: entity.ErrTariffInEffect,
+		},
+		{
+			name: "error - tariff not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.repo.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper"), entity.TariffID("standard")).Return(nil, entity.ErrNotFound)
+			},
+			wantErr: entity.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo: mock_repo.NewMockTariffRepo(ctrl),
+				tx:   mock_repo.NewMockTransactor(ctrl),
+			}
+			tt.prepare(&f)
+
+			uc := usecase.NewUseCaseTariffs(f.repo, mock_repo.NewMockProviderRepo(ctrl), f.tx)
+
+			err := uc.Delete(context.Background(), "kuper", "standard")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
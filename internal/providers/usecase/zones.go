@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	"github.com/classydevv/fulfillment/internal/providers/repo"
+)
+
+const (
+	_maxZoneIDLength   = 32
+	_maxZoneNameLength = 128
+)
+
+type UseCaseZones struct {
+	repo      repo.ZoneRepo
+	providers repo.ProviderRepo
+	tx        repo.Transactor
+}
+
+func NewUseCaseZones(r repo.ZoneRepo, p repo.ProviderRepo, tx repo.Transactor) *UseCaseZones {
+	return &UseCaseZones{
+		repo:      r,
+		providers: p,
+		tx:        tx,
+	}
+}
+
+// Create adds a zone to a provider, archived providers do not get new zones.
+func (uc *UseCaseZones) Create(ctx context.Context, zone *entity.Zone) (entity.ZoneID, error) {
+	if err := validateZoneID(zone.ZoneID); err != nil {
+		return "", fmt.Errorf("UseCaseZones - Create - validateZoneID: %w", err)
+	}
+
+	zone.Geometry = normalizeGeometry(zone.Geometry)
+
+	if err := validateZone(zone, updatableZoneFields()); err != nil {
+		return "", fmt.Errorf("UseCaseZones - Create - validateZone: %w", err)
+	}
+
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// The lock keeps the provider from being archived or purged until the zone is stored.
+		provider, err := uc.providers.GetForUpdate(ctx, zone.ProviderID)
+		if err != nil {
+			return fmt.Errorf("uc.providers.GetForUpdate: %w", err)
+		}
+
+		if provider.Archived() {
+			return fmt.Errorf("provider %s is archived: %w", provider.ProviderID, entity.ErrNotFound)
+		}
+
+		if err := uc.repo.Store(ctx, zone); err != nil {
+			return fmt.Errorf("uc.repo.Store: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("UseCaseZones - Create - uc.tx.InTx: %w", err)
+	}
+
+	return zone.ZoneID, nil
+}
+
+func (uc *UseCaseZones) GetByID(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID) (*entity.Zone, error) {
+	zone, err := uc.repo.GetByID(ctx, providerID, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseZones - GetByID - uc.repo.GetByID: %w", err)
+	}
+
+	return zone, nil
+}
+
+func (uc *UseCaseZones) ListAll(ctx context.Context, params entity.ZoneListParams) (*entity.ZonePage, error) {
+	query, err := newZoneQuery(params)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseZones - ListAll - newZoneQuery: %w", err)
+	}
+
+	zones, err := uc.repo.GetAll(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseZones - ListAll - uc.repo.GetAll: %w", err)
+	}
+
+	page := &entity.ZonePage{}
+
+	var last *entity.Zone
+	if page.Zones, last = trimPage(zones, query.Limit); last != nil {
+		page.NextPageToken = encodeIDPageToken(last.ZoneID)
+	}
+
+	return page, nil
+}
+
+// Update applies masked fields, a non-zero zone.Version makes it conditional on the stored version.
+func (uc *UseCaseZones) Update(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, zone *entity.Zone, mask entity.ZoneMask) (*entity.Zone, error) {
+	mask, err := normalizeZoneMask(mask)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseZones - Update - normalizeZoneMask: %w", err)
+	}
+
+	changes := *zone
+	changes.Geometry = normalizeGeometry(zone.Geometry)
+
+	if err := validateZone(&changes, mask); err != nil {
+		return nil, fmt.Errorf("UseCaseZones - Update - validateZone: %w", err)
+	}
+
+	zoneUpdated, err := uc.repo.Update(ctx, providerID, zoneID, &changes, mask)
+	if err != nil {
+		return nil, fmt.Errorf("UseCaseZones - Update - uc.repo.Update: %w", err)
+	}
+
+	return zoneUpdated, nil
+}
+
+func (uc *UseCaseZones) Delete(ctx context.Context, providerID entity.ProviderID, zoneID entity.ZoneID, version int64) error {
+	if err := uc.repo.Delete(ctx, providerID, zoneID, version); err != nil {
+		return fmt.Errorf("UseCaseZones - Delete - uc.repo.Delete: %w", err)
+	}
+
+	return nil
+}
+
+// updatableZoneFields is the set of fields "*" expands to.
+func updatableZoneFields() entity.ZoneMask {
+	return entity.ZoneMask{
+		entity.ZoneFieldName,
+		entity.ZoneFieldGeometry,
+	}
+}
+
+func normalizeZoneMask(mask entity.ZoneMask) (entity.ZoneMask, error) {
+	if len(mask) == 0 {
+		return nil, fmt.Errorf("update_mask is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	known := updatableZoneFields()
+	normalized := make(entity.ZoneMask, 0, len(mask))
+
+	for _, field := range mask {
+		if field == _maskWildcard {
+			return known, nil
+		}
+
+		if !slices.Contains(known, field) {
+			return nil, fmt.Errorf("update_mask path %q: %w", field, entity.ErrInvalidArgument)
+		}
+
+		if !slices.Contains(normalized, field) {
+			normalized = append(normalized, field)
+		}
+	}
+
+	return normalized, nil
+}
+
+// validateZone checks the fields listed in mask.
+func validateZone(zone *entity.Zone, mask entity.ZoneMask) error {
+	for _, field := range mask {
+		var err error
+
+		switch field {
+		case entity.ZoneFieldName:
+			err = validateZoneName(zone.Name)
+		case entity.ZoneFieldGeometry:
+			err = validateGeometry(zone.Geometry)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+func validateZoneID(id entity.ZoneID) error {
+	if id == "" {
+		return fmt.Errorf("zone_id is empty: %w", entity.ErrInvalidArgument)
+	}
+
+	if len(id) > _maxZoneIDLength {
+		return fmt.Errorf("zone_id is longer than %d: %w", _maxZoneIDLength, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func validateZoneName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("is blank: %w", entity.ErrInvalidArgument)
+	}
+
+	if utf8.RuneCountInString(name) > _maxZoneNameLength {
+		return fmt.Errorf("is longer than %d: %w", _maxZoneNameLength, entity.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+func newZoneQuery(params entity.ZoneListParams) (entity.ZoneQuery, error) {
+	limit, err := pageLimit(params.PageSize)
+	if err != nil {
+		return entity.ZoneQuery{}, err
+	}
+
+	after, err := decodeIDPageToken[entity.ZoneID](params.PageToken)
+	if err != nil {
+		return entity.ZoneQuery{}, err
+	}
+
+	return entity.ZoneQuery{
+		ProviderID: params.ProviderID,
+		Limit:      limit,
+		After:      after,
+	}, nil
+}
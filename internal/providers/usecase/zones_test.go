@@ -0,0 +1,303 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/classydevv/fulfillment/internal/providers/entity"
+	mock_repo "github.com/classydevv/fulfillment/internal/providers/repo/mocks"
+	"github.com/classydevv/fulfillment/internal/providers/usecase"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func square(lon, lat, size float64) entity.Ring {
+	return entity.Ring{{lon, lat}, {lon + size, lat}, {lon + size, lat + size}, {lon, lat + size}, {lon, lat}}
+}
+
+func polygon(rings ...entity.Ring) entity.Geometry {
+	return entity.Geometry{Type: entity.GeometryPolygon, Polygons: []entity.Polygon{rings}}
+}
+
+func TestUseCaseZones_Create(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		repo      *mock_repo.MockZoneRepo
+		providers *mock_repo.MockProviderRepo
+		tx        *mock_repo.MockTransactor
+	}
+
+	stored := func(f *fields) {
+		expectInTx(f.tx)
+		f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+		f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	archivedAt := time.Now()
+
+	tests := []struct {
+		name     string
+		prepare  func(f *fields)
+		geometry entity.Geometry
+		want     entity.ZoneID
+		wantErr  error
+	}{
+		{
+			name:     "polygon",
+			prepare:  stored,
+			geometry: polygon(square(37.5, 55.7, 0.1)),
+			want:     "center",
+		},
+		{
+			name:     "polygon with a hole",
+			prepare:  stored,
+			geometry: polygon(square(37.5, 55.7, 0.1), square(37.52, 55.72, 0.01)),
+			want:     "center",
+		},
+		{
+			name:    "multipolygon with an island in a hole",
+			prepare: stored,
+			geometry: entity.Geometry{Type: entity.GeometryMultiPolygon, Polygons: []entity.Polygon{
+				{square(37.5, 55.7, 0.1), square(37.52, 55.72, 0.05)},
+				{square(37.53, 55.73, 0.01)},
+			}},
+			want: "center",
+		},
+		{
+			name:     "repeated positions are dropped",
+			prepare:  stored,
+			geometry: polygon(entity.Ring{{0, 0}, {1, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}),
+			want:     "center",
+		},
+		{
+			name:     "error - ring is not closed",
+			geometry: polygon(entity.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}}),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - too few positions",
+			geometry: polygon(entity.Ring{{0, 0}, {1, 0}, {0, 0}}),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - latitude out of bounds",
+			geometry: polygon(square(37.5, 89.95, 0.1)),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - self-intersecting bow tie",
+			geometry: polygon(entity.Ring{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}}),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - ring folds back onto itself",
+			geometry: polygon(entity.Ring{{0, 0}, {2, 0}, {1, 0}, {1, 1}, {0, 0}}),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - collinear ring has no area",
+			geometry: polygon(entity.Ring{{0, 0}, {1, 0}, {2, 0}, {0, 0}}),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - hole outside of the exterior ring",
+			geometry: polygon(square(0, 0, 1), square(2, 2, 1)),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - hole crosses the exterior ring",
+			geometry: polygon(square(0, 0, 1), square(0.5, 0.5, 1)),
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - polygons of a multipolygon overlap",
+			geometry: entity.Geometry{Type: entity.GeometryMultiPolygon, Polygons: []entity.Polygon{
+				{square(0, 0, 2)},
+				{square(0.5, 0.5, 1)},
+			}},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:     "error - polygon with two polygons",
+			geometry: entity.Geometry{Type: entity.GeometryPolygon, Polygons: []entity.Polygon{{square(0, 0, 1)}, {square(2, 2, 1)}}},
+			wantErr:  entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - provider not found",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(nil, entity.ErrNotFound)
+			},
+			geometry: polygon(square(0, 0, 1)),
+			wantErr:  entity.ErrNotFound,
+		},
+		{
+			name: "error - provider archived",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper", DeletedAt: &archivedAt}, nil)
+			},
+			geometry: polygon(square(0, 0, 1)),
+			wantErr:  entity.ErrNotFound,
+		},
+		{
+			name: "error - zone already exists",
+			prepare: func(f *fields) {
+				expectInTx(f.tx)
+				f.providers.EXPECT().GetForUpdate(gomock.Any(), entity.ProviderID("kuper")).Return(&entity.Provider{ProviderID: "kuper"}, nil)
+				f.repo.EXPECT().Store(gomock.Any(), gomock.Any()).Return(entity.ErrAlreadyExists)
+			},
+			geometry: polygon(square(0, 0, 1)),
+			wantErr:  entity.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			f := fields{
+				repo:      mock_repo.NewMockZoneRepo(ctrl),
+				providers: mock_repo.NewMockProviderRepo(ctrl),
+				tx:        mock_repo.NewMockTransactor(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(&f)
+			}
+
+			uc := usecase.NewUseCaseZones(f.repo, f.providers, f.tx)
+
+			res, err := uc.Create(context.Background(), &entity.Zone{
+				ProviderID: "kuper",
+				ZoneID:     "center",
+				Name:       "Центр",
+				Geometry:   tt.geometry,
+			})
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseZones_Update(t *testing.T) {
+	t.Parallel()
+
+	updated := &entity.Zone{ProviderID: "kuper", ZoneID: "center", Name: "Центр", Version: 3}
+
+	tests := []struct {
+		name    string
+		prepare func(r *mock_repo.MockZoneRepo)
+		zone    *entity.Zone
+		mask    entity.ZoneMask
+		want    *entity.Zone
+		wantErr error
+	}{
+		{
+			name: "name only, geometry is not validated",
+			prepare: func(r *mock_repo.MockZoneRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.ZoneID("center"), gomock.Any(), entity.ZoneMask{entity.ZoneFieldName}).Return(updated, nil)
+			},
+			zone: &entity.Zone{Name: "Центр"},
+			mask: entity.ZoneMask{entity.ZoneFieldName, entity.ZoneFieldName},
+			want: updated,
+		},
+		{
+			name: "wildcard",
+			prepare: func(r *mock_repo.MockZoneRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.ZoneID("center"), gomock.Any(), entity.ZoneMask{entity.ZoneFieldName, entity.ZoneFieldGeometry}).Return(updated, nil)
+			},
+			zone: &entity.Zone{Name: "Центр", Geometry: polygon(square(0, 0, 1))},
+			mask: entity.ZoneMask{"*"},
+			want: updated,
+		},
+		{
+			name:    "error - empty mask",
+			zone:    &entity.Zone{Name: "Центр"},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - unknown path",
+			zone:    &entity.Zone{Name: "Центр"},
+			mask:    entity.ZoneMask{"provider_id"},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - blank name",
+			zone:    &entity.Zone{Name: "  "},
+			mask:    entity.ZoneMask{entity.ZoneFieldName},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name:    "error - invalid geometry",
+			zone:    &entity.Zone{Geometry: polygon(entity.Ring{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}})},
+			mask:    entity.ZoneMask{entity.ZoneFieldGeometry},
+			wantErr: entity.ErrInvalidArgument,
+		},
+		{
+			name: "error - stale version",
+			prepare: func(r *mock_repo.MockZoneRepo) {
+				r.EXPECT().Update(gomock.Any(), entity.ProviderID("kuper"), entity.ZoneID("center"), gomock.Any(), gomock.Any()).Return(nil, entity.ErrPreconditionFailed)
+			},
+			zone:    &entity.Zone{Name: "Центр", Version: 2},
+			mask:    entity.ZoneMask{entity.ZoneFieldName},
+			wantErr: entity.ErrPreconditionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := mock_repo.NewMockZoneRepo(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(r)
+			}
+
+			uc := usecase.NewUseCaseZones(r, mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+			res, err := uc.Update(context.Background(), "kuper", "center", tt.zone, tt.mask)
+
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUseCaseZones_ListAll(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := mock_repo.NewMockZoneRepo(ctrl)
+	uc := usecase.NewUseCaseZones(r, mock_repo.NewMockProviderRepo(ctrl), mock_repo.NewMockTransactor(ctrl))
+
+	r.EXPECT().GetAll(gomock.Any(), entity.ZoneQuery{ProviderID: "kuper", Limit: 3}).
+		Return([]*entity.Zone{{ZoneID: "a"}, {ZoneID: "b"}, {ZoneID: "c"}}, nil)
+
+	page, err := uc.ListAll(context.Background(), entity.ZoneListParams{ProviderID: "kuper", PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Zones, 2)
+	require.NotEmpty(t, page.NextPageToken)
+
+	r.EXPECT().GetAll(gomock.Any(), entity.ZoneQuery{ProviderID: "kuper", After: "b", Limit: 3}).
+		Return([]*entity.Zone{{ZoneID: "c"}}, nil)
+
+	page, err = uc.ListAll(context.Background(), entity.ZoneListParams{ProviderID: "kuper", PageSize: 2, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, page.Zones, 1)
+	require.Empty(t, page.NextPageToken)
+
+	_, err = uc.ListAll(context.Background(), entity.ZoneListParams{ProviderID: "kuper", PageToken: "%%%"})
+	require.ErrorIs(t, err, entity.ErrInvalidArgument)
+}
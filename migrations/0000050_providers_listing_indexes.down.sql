@@ -0,0 +1 @@
+DROP INDEX IF EXISTS providers_created_at_provider_id_idx;
\ No newline at end of file
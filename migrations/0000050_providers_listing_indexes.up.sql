@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS providers_created_at_provider_id_idx ON providers (created_at, provider_id);
\ No newline at end of file
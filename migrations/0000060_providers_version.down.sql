@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS increment_version_providers ON providers;
+DROP FUNCTION IF EXISTS increment_version_column();
+ALTER TABLE providers DROP COLUMN IF EXISTS version;
\ No newline at end of file
@@ -0,0 +1,16 @@
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION increment_version_column()
+RETURNS TRIGGER AS $$
+BEGIN
+   NEW.version = OLD.version + 1;
+   RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER increment_version_providers
+    BEFORE UPDATE
+    ON
+        providers
+    FOR EACH ROW
+EXECUTE PROCEDURE increment_version_column();
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS providers_active_provider_id_idx;
+ALTER TABLE providers DROP COLUMN IF EXISTS deleted_at;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS providers_active_provider_id_idx ON providers (provider_id) WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS providers_status_idx;
+ALTER TABLE providers
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS status;
\ No newline at end of file
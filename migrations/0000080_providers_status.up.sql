@@ -0,0 +1,6 @@
+ALTER TABLE providers
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'onboarding'
+        CHECK (status IN ('onboarding', 'active', 'suspended', 'terminated')),
+    ADD COLUMN IF NOT EXISTS status_reason VARCHAR(256) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS providers_status_idx ON providers (status);
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- No foreign key to providers: history has to outlive purged providers.
+CREATE TABLE IF NOT EXISTS provider_audit_log(
+    audit_id BIGSERIAL PRIMARY KEY,
+    provider_id VARCHAR(32) NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    old_value JSONB,
+    new_value JSONB,
+    -- claimed_actor is named by the caller and is not verified.
+    claimed_actor VARCHAR(128) NOT NULL DEFAULT '',
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS provider_audit_log_provider_idx ON provider_audit_log (provider_id, audit_id DESC);
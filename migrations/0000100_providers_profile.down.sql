@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS providers_countries_idx;
+
+ALTER TABLE providers
+    DROP COLUMN IF EXISTS capabilities,
+    DROP COLUMN IF EXISTS countries,
+    DROP COLUMN IF EXISTS website,
+    DROP COLUMN IF EXISTS contacts,
+    DROP COLUMN IF EXISTS legal_entity;
@@ -0,0 +1,8 @@
+ALTER TABLE providers
+    ADD COLUMN IF NOT EXISTS legal_entity JSONB NOT NULL DEFAULT '{"name": "", "tax_id": ""}',
+    ADD COLUMN IF NOT EXISTS contacts JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS website VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS countries VARCHAR(2)[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS capabilities JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS providers_countries_idx ON providers USING GIN (countries);
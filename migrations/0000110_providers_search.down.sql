@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS providers_search_trgm_idx;
+DROP INDEX IF EXISTS providers_search_tsv_idx;
+DROP FUNCTION IF EXISTS providers_search_text(TEXT, JSONB);
+DROP FUNCTION IF EXISTS providers_translit(TEXT);
@@ -0,0 +1,27 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Romanizes Russian text so that "купер" and "kuper" end up as the same search term.
+-- The query is passed through the same function, keep it IMMUTABLE for the indexes below.
+CREATE OR REPLACE FUNCTION providers_translit(s TEXT)
+RETURNS TEXT AS $$
+    SELECT translate(
+        replace(replace(replace(replace(replace(replace(replace(replace(replace(
+            lower(s),
+            'щ', 'shch'), 'ж', 'zh'), 'ч', 'ch'), 'ш', 'sh'), 'ю', 'yu'), 'я', 'ya'), 'ц', 'ts'), 'х', 'kh'), 'ё', 'e'),
+        'абвгдезийклмнопрстуфыэъь',
+        'abvgdeziiklmnoprstufye'
+    );
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+-- Everything a provider can be found by. Adding a field means changing the signature,
+-- the indexes and PostgresRepo.Search together.
+CREATE OR REPLACE FUNCTION providers_search_text(name TEXT, legal_entity JSONB)
+RETURNS TEXT AS $$
+    SELECT providers_translit(name || ' ' || coalesce(legal_entity->>'name', ''));
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+CREATE INDEX IF NOT EXISTS providers_search_tsv_idx
+    ON providers USING GIN (to_tsvector('simple', providers_search_text(name, legal_entity)));
+
+CREATE INDEX IF NOT EXISTS providers_search_trgm_idx
+    ON providers USING GIN (providers_search_text(name, legal_entity) gin_trgm_ops);
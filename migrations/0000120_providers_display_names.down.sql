@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS providers_search_trgm_idx;
+DROP INDEX IF EXISTS providers_search_tsv_idx;
+DROP FUNCTION IF EXISTS providers_search_text(TEXT, JSONB, JSONB);
+
+CREATE OR REPLACE FUNCTION providers_search_text(name TEXT, legal_entity JSONB)
+RETURNS TEXT AS $$
+    SELECT providers_translit(name || ' ' || coalesce(legal_entity->>'name', ''));
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+CREATE INDEX IF NOT EXISTS providers_search_tsv_idx
+    ON providers USING GIN (to_tsvector('simple', providers_search_text(name, legal_entity)));
+
+CREATE INDEX IF NOT EXISTS providers_search_trgm_idx
+    ON providers USING GIN (providers_search_text(name, legal_entity) gin_trgm_ops);
+
+ALTER TABLE providers
+    DROP COLUMN IF EXISTS display_names;
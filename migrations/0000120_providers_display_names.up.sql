@@ -0,0 +1,23 @@
+ALTER TABLE providers
+    ADD COLUMN IF NOT EXISTS display_names JSONB NOT NULL DEFAULT '{}';
+
+-- Localized names are searchable too, the search function and its indexes are replaced
+-- with ones that take display_names into account.
+DROP INDEX IF EXISTS providers_search_trgm_idx;
+DROP INDEX IF EXISTS providers_search_tsv_idx;
+DROP FUNCTION IF EXISTS providers_search_text(TEXT, JSONB);
+
+CREATE OR REPLACE FUNCTION providers_search_text(name TEXT, legal_entity JSONB, display_names JSONB)
+RETURNS TEXT AS $$
+    SELECT providers_translit(
+        name || ' ' ||
+        coalesce(legal_entity->>'name', '') || ' ' ||
+        translate(jsonb_path_query_array(display_names, '$.*')::text, '[]",', '    ')
+    );
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+CREATE INDEX IF NOT EXISTS providers_search_tsv_idx
+    ON providers USING GIN (to_tsvector('simple', providers_search_text(name, legal_entity, display_names)));
+
+CREATE INDEX IF NOT EXISTS providers_search_trgm_idx
+    ON providers USING GIN (providers_search_text(name, legal_entity, display_names) gin_trgm_ops);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS providers_labels_idx;
+
+ALTER TABLE providers
+    DROP COLUMN IF EXISTS labels;
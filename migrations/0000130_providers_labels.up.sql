@@ -0,0 +1,5 @@
+ALTER TABLE providers
+    ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}';
+
+-- Serves the "?" and "@>" operators label selectors are translated to.
+CREATE INDEX IF NOT EXISTS providers_labels_idx ON providers USING GIN (labels);
@@ -0,0 +1,26 @@
+-- Zones go away together with a purged provider.
+CREATE TABLE IF NOT EXISTS zones(
+    provider_id VARCHAR(32) NOT NULL REFERENCES providers (provider_id) ON DELETE CASCADE,
+    zone_id VARCHAR(32) NOT NULL,
+    name VARCHAR(128) NOT NULL,
+    -- GeoJSON Polygon or MultiPolygon, validated by the service.
+    geometry JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version BIGINT NOT NULL DEFAULT 1,
+    PRIMARY KEY (provider_id, zone_id)
+);
+
+CREATE TRIGGER update_updated_at_zones
+    BEFORE UPDATE
+    ON
+        zones
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+CREATE TRIGGER increment_version_zones
+    BEFORE UPDATE
+    ON
+        zones
+    FOR EACH ROW
+EXECUTE PROCEDURE increment_version_column();
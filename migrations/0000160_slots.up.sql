@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS slots(
+    slot_id BIGSERIAL PRIMARY KEY,
+    provider_id VARCHAR(32) NOT NULL,
+    zone_id VARCHAR(32) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    booked INTEGER NOT NULL DEFAULT 0 CHECK (booked >= 0 AND booked <= capacity),
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (starts_at < ends_at),
+    UNIQUE (provider_id, zone_id, starts_at, ends_at),
+    FOREIGN KEY (provider_id, zone_id) REFERENCES zones (provider_id, zone_id) ON DELETE CASCADE
+);
+
+-- Serves listing and availability, which both go by zone and start.
+CREATE INDEX IF NOT EXISTS slots_provider_starts_idx ON slots (provider_id, starts_at, slot_id);
+
+CREATE TRIGGER update_updated_at_slots
+    BEFORE UPDATE
+    ON
+        slots
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
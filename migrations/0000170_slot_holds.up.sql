@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS slot_holds(
+    hold_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slot_id BIGINT NOT NULL REFERENCES slots (slot_id) ON DELETE CASCADE,
+    provider_id VARCHAR(32) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(16) NOT NULL DEFAULT 'held'
+        CHECK (status IN ('held', 'confirmed', 'released', 'expired')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The sweeper only looks at holds that are still held.
+CREATE INDEX IF NOT EXISTS slot_holds_expires_idx ON slot_holds (expires_at) WHERE status = 'held';
+
+CREATE TRIGGER update_updated_at_slot_holds
+    BEFORE UPDATE
+    ON
+        slot_holds
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
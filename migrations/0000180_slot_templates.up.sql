@@ -0,0 +1,21 @@
+-- Templates go away together with their zone.
+CREATE TABLE IF NOT EXISTS slot_templates(
+    provider_id VARCHAR(32) NOT NULL,
+    template_id VARCHAR(32) NOT NULL,
+    zone_id VARCHAR(32) NOT NULL,
+    time_zone VARCHAR(64) NOT NULL,
+    -- Weekdays, windows and exclusions, validated by the service.
+    rule JSONB NOT NULL,
+    activated_at TIMESTAMPTZ,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider_id, template_id),
+    FOREIGN KEY (provider_id, zone_id) REFERENCES zones (provider_id, zone_id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_updated_at_slot_templates
+    BEFORE UPDATE
+    ON
+        slot_templates
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
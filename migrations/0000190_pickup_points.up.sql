@@ -0,0 +1,32 @@
+-- Pickup points go away together with a purged provider.
+CREATE TABLE IF NOT EXISTS pickup_points(
+    provider_id VARCHAR(32) NOT NULL REFERENCES providers (provider_id) ON DELETE CASCADE,
+    point_id VARCHAR(32) NOT NULL,
+    name VARCHAR(128) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('pvz', 'locker', 'post_office')),
+    address VARCHAR(512) NOT NULL,
+    lat DOUBLE PRECISION NOT NULL CHECK (lat BETWEEN -90 AND 90),
+    lon DOUBLE PRECISION NOT NULL CHECK (lon BETWEEN -180 AND 180),
+    -- Weekday intervals as local "HH:MM", validated by the service.
+    opening_hours JSONB NOT NULL DEFAULT '[]',
+    payment_options VARCHAR(16)[] NOT NULL DEFAULT '{}',
+    max_parcel JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version BIGINT NOT NULL DEFAULT 1,
+    PRIMARY KEY (provider_id, point_id)
+);
+
+CREATE TRIGGER update_updated_at_pickup_points
+    BEFORE UPDATE
+    ON
+        pickup_points
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+CREATE TRIGGER increment_version_pickup_points
+    BEFORE UPDATE
+    ON
+        pickup_points
+    FOR EACH ROW
+EXECUTE PROCEDURE increment_version_column();
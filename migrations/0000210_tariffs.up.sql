@@ -0,0 +1,20 @@
+-- Tariffs go away together with a purged provider.
+CREATE TABLE IF NOT EXISTS tariffs(
+    provider_id VARCHAR(32) NOT NULL REFERENCES providers (provider_id) ON DELETE CASCADE,
+    tariff_id VARCHAR(32) NOT NULL,
+    name VARCHAR(128) NOT NULL,
+    currency CHAR(3) NOT NULL CHECK (currency ~ '^[A-Z]{3}$'),
+    -- Weight breaks, distance bands, surcharges and price bounds with amounts as decimal strings,
+    -- validated by the service.
+    rules JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider_id, tariff_id)
+);
+
+CREATE TRIGGER update_updated_at_tariffs
+    BEFORE UPDATE
+    ON
+        tariffs
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
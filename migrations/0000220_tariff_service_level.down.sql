@@ -0,0 +1,4 @@
+ALTER TABLE tariffs
+    DROP COLUMN IF EXISTS transit_days_max,
+    DROP COLUMN IF EXISTS transit_days_min,
+    DROP COLUMN IF EXISTS service_level;
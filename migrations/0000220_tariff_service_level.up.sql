@@ -0,0 +1,5 @@
+ALTER TABLE tariffs
+    ADD COLUMN IF NOT EXISTS service_level VARCHAR(16) NOT NULL DEFAULT 'standard'
+        CHECK (service_level IN ('economy', 'standard', 'express', 'same_day')),
+    ADD COLUMN IF NOT EXISTS transit_days_min SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS transit_days_max SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,18 @@
+ALTER TABLE tariffs ADD COLUMN IF NOT EXISTS rules JSONB;
+
+-- Tariffs get back the rules of their latest revision.
+UPDATE tariffs t SET rules = r.rules
+FROM (
+    SELECT DISTINCT ON (provider_id, tariff_id) provider_id, tariff_id, rules
+    FROM tariff_revisions
+    ORDER BY provider_id, tariff_id, revision DESC
+) r
+WHERE t.provider_id = r.provider_id AND t.tariff_id = r.tariff_id;
+
+DELETE FROM tariffs WHERE rules IS NULL;
+
+ALTER TABLE tariffs ALTER COLUMN rules SET NOT NULL;
+
+DROP TRIGGER IF EXISTS forbid_tariff_revision_change_tariff_revisions ON tariff_revisions;
+DROP FUNCTION IF EXISTS forbid_tariff_revision_change();
+DROP TABLE IF EXISTS tariff_revisions;
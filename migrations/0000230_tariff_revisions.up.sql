@@ -0,0 +1,42 @@
+-- Price lists of a tariff, each in effect from effective_from until effective_to or forever without it.
+-- The service keeps revisions of a tariff from overlapping.
+CREATE TABLE IF NOT EXISTS tariff_revisions(
+    provider_id VARCHAR(32) NOT NULL,
+    tariff_id VARCHAR(32) NOT NULL,
+    revision INTEGER NOT NULL CHECK (revision > 0),
+    rules JSONB NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (effective_to IS NULL OR effective_from < effective_to),
+    PRIMARY KEY (provider_id, tariff_id, revision),
+    FOREIGN KEY (provider_id, tariff_id) REFERENCES tariffs (provider_id, tariff_id) ON DELETE CASCADE
+);
+
+-- Past prices must stay reproducible: the rules and the start never change and an open end may only be closed,
+-- which happens when a later revision is scheduled.
+CREATE OR REPLACE FUNCTION forbid_tariff_revision_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.rules IS DISTINCT FROM OLD.rules
+      OR NEW.effective_from IS DISTINCT FROM OLD.effective_from
+      OR OLD.effective_to IS NOT NULL THEN
+      RAISE EXCEPTION 'tariff revision %/%/% is immutable', OLD.provider_id, OLD.tariff_id, OLD.revision;
+   END IF;
+   RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER forbid_tariff_revision_change_tariff_revisions
+    BEFORE UPDATE
+    ON
+        tariff_revisions
+    FOR EACH ROW
+EXECUTE PROCEDURE forbid_tariff_revision_change();
+
+-- The rules of existing tariffs become their first revision.
+INSERT INTO tariff_revisions (provider_id, tariff_id, revision, rules, effective_from)
+SELECT provider_id, tariff_id, 1, rules, created_at FROM tariffs
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE tariffs DROP COLUMN IF EXISTS rules;
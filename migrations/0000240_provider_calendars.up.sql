@@ -0,0 +1,19 @@
+-- A calendar goes away together with a purged provider.
+CREATE TABLE IF NOT EXISTS provider_calendars(
+    provider_id VARCHAR(32) PRIMARY KEY REFERENCES providers (provider_id) ON DELETE CASCADE,
+    time_zone VARCHAR(64) NOT NULL,
+    -- Weekly working hours and order cut-offs, validated by the service.
+    hours JSONB NOT NULL,
+    cut_offs JSONB NOT NULL,
+    -- Local dates off work with their names.
+    holidays JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_updated_at_provider_calendars
+    BEFORE UPDATE
+    ON
+        provider_calendars
+    FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
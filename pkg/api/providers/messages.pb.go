@@ -11,6 +11,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,12 +26,476 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProviderStatus int32
+
+const (
+	ProviderStatus_PROVIDER_STATUS_UNSPECIFIED ProviderStatus = 0
+	// Newly created, not yet routed any shipments
+	ProviderStatus_PROVIDER_STATUS_ONBOARDING ProviderStatus = 1
+	ProviderStatus_PROVIDER_STATUS_ACTIVE     ProviderStatus = 2
+	// Temporarily excluded from routing, see status_reason
+	ProviderStatus_PROVIDER_STATUS_SUSPENDED ProviderStatus = 3
+	// Final, the provider can not be activated again
+	ProviderStatus_PROVIDER_STATUS_TERMINATED ProviderStatus = 4
+)
+
+// Enum value maps for ProviderStatus.
+var (
+	ProviderStatus_name = map[int32]string{
+		0: "PROVIDER_STATUS_UNSPECIFIED",
+		1: "PROVIDER_STATUS_ONBOARDING",
+		2: "PROVIDER_STATUS_ACTIVE",
+		3: "PROVIDER_STATUS_SUSPENDED",
+		4: "PROVIDER_STATUS_TERMINATED",
+	}
+	ProviderStatus_value = map[string]int32{
+		"PROVIDER_STATUS_UNSPECIFIED": 0,
+		"PROVIDER_STATUS_ONBOARDING":  1,
+		"PROVIDER_STATUS_ACTIVE":      2,
+		"PROVIDER_STATUS_SUSPENDED":   3,
+		"PROVIDER_STATUS_TERMINATED":  4,
+	}
+)
+
+func (x ProviderStatus) Enum() *ProviderStatus {
+	p := new(ProviderStatus)
+	*p = x
+	return p
+}
+
+func (x ProviderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (ProviderStatus) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[0]
+}
+
+func (x ProviderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderStatus.Descriptor instead.
+func (ProviderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{0}
+}
+
+type ProviderImportAction int32
+
+const (
+	ProviderImportAction_PROVIDER_IMPORT_ACTION_UNSPECIFIED ProviderImportAction = 0
+	ProviderImportAction_PROVIDER_IMPORT_ACTION_CREATED     ProviderImportAction = 1
+	ProviderImportAction_PROVIDER_IMPORT_ACTION_UPDATED     ProviderImportAction = 2
+	ProviderImportAction_PROVIDER_IMPORT_ACTION_UNCHANGED   ProviderImportAction = 3
+	ProviderImportAction_PROVIDER_IMPORT_ACTION_FAILED      ProviderImportAction = 4
+)
+
+// Enum value maps for ProviderImportAction.
+var (
+	ProviderImportAction_name = map[int32]string{
+		0: "PROVIDER_IMPORT_ACTION_UNSPECIFIED",
+		1: "PROVIDER_IMPORT_ACTION_CREATED",
+		2: "PROVIDER_IMPORT_ACTION_UPDATED",
+		3: "PROVIDER_IMPORT_ACTION_UNCHANGED",
+		4: "PROVIDER_IMPORT_ACTION_FAILED",
+	}
+	ProviderImportAction_value = map[string]int32{
+		"PROVIDER_IMPORT_ACTION_UNSPECIFIED": 0,
+		"PROVIDER_IMPORT_ACTION_CREATED":     1,
+		"PROVIDER_IMPORT_ACTION_UPDATED":     2,
+		"PROVIDER_IMPORT_ACTION_UNCHANGED":   3,
+		"PROVIDER_IMPORT_ACTION_FAILED":      4,
+	}
+)
+
+func (x ProviderImportAction) Enum() *ProviderImportAction {
+	p := new(ProviderImportAction)
+	*p = x
+	return p
+}
+
+func (x ProviderImportAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderImportAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (ProviderImportAction) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[1]
+}
+
+func (x ProviderImportAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderImportAction.Descriptor instead.
+func (ProviderImportAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{1}
+}
+
+type SlotHoldStatus int32
+
+const (
+	SlotHoldStatus_SLOT_HOLD_STATUS_UNSPECIFIED SlotHoldStatus = 0
+	// Keeps capacity until expires_at unless confirmed
+	SlotHoldStatus_SLOT_HOLD_STATUS_HELD      SlotHoldStatus = 1
+	SlotHoldStatus_SLOT_HOLD_STATUS_CONFIRMED SlotHoldStatus = 2
+	SlotHoldStatus_SLOT_HOLD_STATUS_RELEASED  SlotHoldStatus = 3
+	SlotHoldStatus_SLOT_HOLD_STATUS_EXPIRED   SlotHoldStatus = 4
+)
+
+// Enum value maps for SlotHoldStatus.
+var (
+	SlotHoldStatus_name = map[int32]string{
+		0: "SLOT_HOLD_STATUS_UNSPECIFIED",
+		1: "SLOT_HOLD_STATUS_HELD",
+		2: "SLOT_HOLD_STATUS_CONFIRMED",
+		3: "SLOT_HOLD_STATUS_RELEASED",
+		4: "SLOT_HOLD_STATUS_EXPIRED",
+	}
+	SlotHoldStatus_value = map[string]int32{
+		"SLOT_HOLD_STATUS_UNSPECIFIED": 0,
+		"SLOT_HOLD_STATUS_HELD":        1,
+		"SLOT_HOLD_STATUS_CONFIRMED":   2,
+		"SLOT_HOLD_STATUS_RELEASED":    3,
+		"SLOT_HOLD_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x SlotHoldStatus) Enum() *SlotHoldStatus {
+	p := new(SlotHoldStatus)
+	*p = x
+	return p
+}
+
+func (x SlotHoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlotHoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (SlotHoldStatus) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[2]
+}
+
+func (x SlotHoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlotHoldStatus.Descriptor instead.
+func (SlotHoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{2}
+}
+
+type PickupPointType int32
+
+const (
+	PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED PickupPointType = 0
+	// Staffed pickup desk (PVZ)
+	PickupPointType_PICKUP_POINT_TYPE_PVZ         PickupPointType = 1
+	PickupPointType_PICKUP_POINT_TYPE_LOCKER      PickupPointType = 2
+	PickupPointType_PICKUP_POINT_TYPE_POST_OFFICE PickupPointType = 3
+)
+
+// Enum value maps for PickupPointType.
+var (
+	PickupPointType_name = map[int32]string{
+		0: "PICKUP_POINT_TYPE_UNSPECIFIED",
+		1: "PICKUP_POINT_TYPE_PVZ",
+		2: "PICKUP_POINT_TYPE_LOCKER",
+		3: "PICKUP_POINT_TYPE_POST_OFFICE",
+	}
+	PickupPointType_value = map[string]int32{
+		"PICKUP_POINT_TYPE_UNSPECIFIED": 0,
+		"PICKUP_POINT_TYPE_PVZ":         1,
+		"PICKUP_POINT_TYPE_LOCKER":      2,
+		"PICKUP_POINT_TYPE_POST_OFFICE": 3,
+	}
+)
+
+func (x PickupPointType) Enum() *PickupPointType {
+	p := new(PickupPointType)
+	*p = x
+	return p
+}
+
+func (x PickupPointType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PickupPointType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (PickupPointType) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[3]
+}
+
+func (x PickupPointType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PickupPointType.Descriptor instead.
+func (PickupPointType) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{3}
+}
+
+type PaymentOption int32
+
+const (
+	PaymentOption_PAYMENT_OPTION_UNSPECIFIED PaymentOption = 0
+	// Parcels paid for at checkout
+	PaymentOption_PAYMENT_OPTION_PREPAID PaymentOption = 1
+	PaymentOption_PAYMENT_OPTION_CASH    PaymentOption = 2
+	PaymentOption_PAYMENT_OPTION_CARD    PaymentOption = 3
+)
+
+// Enum value maps for PaymentOption.
+var (
+	PaymentOption_name = map[int32]string{
+		0: "PAYMENT_OPTION_UNSPECIFIED",
+		1: "PAYMENT_OPTION_PREPAID",
+		2: "PAYMENT_OPTION_CASH",
+		3: "PAYMENT_OPTION_CARD",
+	}
+	PaymentOption_value = map[string]int32{
+		"PAYMENT_OPTION_UNSPECIFIED": 0,
+		"PAYMENT_OPTION_PREPAID":     1,
+		"PAYMENT_OPTION_CASH":        2,
+		"PAYMENT_OPTION_CARD":        3,
+	}
+)
+
+func (x PaymentOption) Enum() *PaymentOption {
+	p := new(PaymentOption)
+	*p = x
+	return p
+}
+
+func (x PaymentOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (PaymentOption) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[4]
+}
+
+func (x PaymentOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentOption.Descriptor instead.
+func (PaymentOption) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{4}
+}
+
+type ServiceLevel int32
+
+const (
+	ServiceLevel_SERVICE_LEVEL_UNSPECIFIED ServiceLevel = 0
+	ServiceLevel_SERVICE_LEVEL_ECONOMY     ServiceLevel = 1
+	ServiceLevel_SERVICE_LEVEL_STANDARD    ServiceLevel = 2
+	ServiceLevel_SERVICE_LEVEL_EXPRESS     ServiceLevel = 3
+	ServiceLevel_SERVICE_LEVEL_SAME_DAY    ServiceLevel = 4
+)
+
+// Enum value maps for ServiceLevel.
+var (
+	ServiceLevel_name = map[int32]string{
+		0: "SERVICE_LEVEL_UNSPECIFIED",
+		1: "SERVICE_LEVEL_ECONOMY",
+		2: "SERVICE_LEVEL_STANDARD",
+		3: "SERVICE_LEVEL_EXPRESS",
+		4: "SERVICE_LEVEL_SAME_DAY",
+	}
+	ServiceLevel_value = map[string]int32{
+		"SERVICE_LEVEL_UNSPECIFIED": 0,
+		"SERVICE_LEVEL_ECONOMY":     1,
+		"SERVICE_LEVEL_STANDARD":    2,
+		"SERVICE_LEVEL_EXPRESS":     3,
+		"SERVICE_LEVEL_SAME_DAY":    4,
+	}
+)
+
+func (x ServiceLevel) Enum() *ServiceLevel {
+	p := new(ServiceLevel)
+	*p = x
+	return p
+}
+
+func (x ServiceLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[5].Descriptor()
+}
+
+func (ServiceLevel) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[5]
+}
+
+func (x ServiceLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceLevel.Descriptor instead.
+func (ServiceLevel) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{5}
+}
+
+type PriceLineKind int32
+
+const (
+	PriceLineKind_PRICE_LINE_KIND_UNSPECIFIED PriceLineKind = 0
+	// Price of a parcel by its chargeable weight
+	PriceLineKind_PRICE_LINE_KIND_WEIGHT    PriceLineKind = 1
+	PriceLineKind_PRICE_LINE_KIND_DISTANCE  PriceLineKind = 2
+	PriceLineKind_PRICE_LINE_KIND_SURCHARGE PriceLineKind = 3
+	// Brings the total up to the min price
+	PriceLineKind_PRICE_LINE_KIND_MIN_PRICE PriceLineKind = 4
+	// Brings the total down to the max price, the amount is negative
+	PriceLineKind_PRICE_LINE_KIND_MAX_PRICE PriceLineKind = 5
+)
+
+// Enum value maps for PriceLineKind.
+var (
+	PriceLineKind_name = map[int32]string{
+		0: "PRICE_LINE_KIND_UNSPECIFIED",
+		1: "PRICE_LINE_KIND_WEIGHT",
+		2: "PRICE_LINE_KIND_DISTANCE",
+		3: "PRICE_LINE_KIND_SURCHARGE",
+		4: "PRICE_LINE_KIND_MIN_PRICE",
+		5: "PRICE_LINE_KIND_MAX_PRICE",
+	}
+	PriceLineKind_value = map[string]int32{
+		"PRICE_LINE_KIND_UNSPECIFIED": 0,
+		"PRICE_LINE_KIND_WEIGHT":      1,
+		"PRICE_LINE_KIND_DISTANCE":    2,
+		"PRICE_LINE_KIND_SURCHARGE":   3,
+		"PRICE_LINE_KIND_MIN_PRICE":   4,
+		"PRICE_LINE_KIND_MAX_PRICE":   5,
+	}
+)
+
+func (x PriceLineKind) Enum() *PriceLineKind {
+	p := new(PriceLineKind)
+	*p = x
+	return p
+}
+
+func (x PriceLineKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceLineKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[6].Descriptor()
+}
+
+func (PriceLineKind) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[6]
+}
+
+func (x PriceLineKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceLineKind.Descriptor instead.
+func (PriceLineKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{6}
+}
+
+type QuoteFailureReason int32
+
+const (
+	QuoteFailureReason_QUOTE_FAILURE_REASON_UNSPECIFIED QuoteFailureReason = 0
+	// The provider was not evaluated before the deadline
+	QuoteFailureReason_QUOTE_FAILURE_REASON_TIMEOUT QuoteFailureReason = 1
+	// No tariff of the provider prices the shipment
+	QuoteFailureReason_QUOTE_FAILURE_REASON_NOT_APPLICABLE QuoteFailureReason = 2
+	QuoteFailureReason_QUOTE_FAILURE_REASON_INTERNAL       QuoteFailureReason = 3
+)
+
+// Enum value maps for QuoteFailureReason.
+var (
+	QuoteFailureReason_name = map[int32]string{
+		0: "QUOTE_FAILURE_REASON_UNSPECIFIED",
+		1: "QUOTE_FAILURE_REASON_TIMEOUT",
+		2: "QUOTE_FAILURE_REASON_NOT_APPLICABLE",
+		3: "QUOTE_FAILURE_REASON_INTERNAL",
+	}
+	QuoteFailureReason_value = map[string]int32{
+		"QUOTE_FAILURE_REASON_UNSPECIFIED":    0,
+		"QUOTE_FAILURE_REASON_TIMEOUT":        1,
+		"QUOTE_FAILURE_REASON_NOT_APPLICABLE": 2,
+		"QUOTE_FAILURE_REASON_INTERNAL":       3,
+	}
+)
+
+func (x QuoteFailureReason) Enum() *QuoteFailureReason {
+	p := new(QuoteFailureReason)
+	*p = x
+	return p
+}
+
+func (x QuoteFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuoteFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_providers_messages_proto_enumTypes[7].Descriptor()
+}
+
+func (QuoteFailureReason) Type() protoreflect.EnumType {
+	return &file_api_providers_messages_proto_enumTypes[7]
+}
+
+func (x QuoteFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuoteFailureReason.Descriptor instead.
+func (QuoteFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{7}
+}
+
 type Provider struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Changes on every update, pass it back to make updates and deletes conditional
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Set while the provider is archived
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,proto3" json:"deleted_at,omitempty"`
+	Status    ProviderStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ProviderStatus" json:"status,omitempty"`
+	// Why the provider was suspended or terminated
+	StatusReason string       `protobuf:"bytes,8,opt,name=status_reason,proto3" json:"status_reason,omitempty"`
+	LegalEntity  *LegalEntity `protobuf:"bytes,9,opt,name=legal_entity,proto3" json:"legal_entity,omitempty"`
+	Contacts     []*Contact   `protobuf:"bytes,10,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Website      string       `protobuf:"bytes,11,opt,name=website,proto3" json:"website,omitempty"`
+	// ISO 3166-1 alpha-2 codes
+	Countries    []string      `protobuf:"bytes,12,rep,name=countries,proto3" json:"countries,omitempty"`
+	Capabilities *Capabilities `protobuf:"bytes,13,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// Names shown to customers keyed by BCP-47 language tag, e.g. "kk" or "en-GB"
+	DisplayNames map[string]string `protobuf:"bytes,14,rep,name=display_names,proto3" json:"display_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Display name in the language of the accept-language metadata, name when there is none
+	DisplayName string `protobuf:"bytes,15,opt,name=display_name,proto3" json:"display_name,omitempty"`
+	// Arbitrary key/value pairs to select providers by, see label_selector of ProviderListAllRequest
+	Labels        map[string]string `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -41,14 +507,8348 @@ func (x *Provider) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Provider) String() string {
+func (x *Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Provider) ProtoMessage() {}
+
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Provider) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Provider) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Provider) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Provider) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Provider) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Provider) GetStatus() ProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProviderStatus_PROVIDER_STATUS_UNSPECIFIED
+}
+
+func (x *Provider) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Provider) GetLegalEntity() *LegalEntity {
+	if x != nil {
+		return x.LegalEntity
+	}
+	return nil
+}
+
+func (x *Provider) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *Provider) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Provider) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *Provider) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *Provider) GetDisplayNames() map[string]string {
+	if x != nil {
+		return x.DisplayNames
+	}
+	return nil
+}
+
+func (x *Provider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Provider) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Company a provider contracts through
+type LegalEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TaxID         string                 `protobuf:"bytes,2,opt,name=tax_id,proto3" json:"tax_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegalEntity) Reset() {
+	*x = LegalEntity{}
+	mi := &file_api_providers_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegalEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegalEntity) ProtoMessage() {}
+
+func (x *LegalEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegalEntity.ProtoReflect.Descriptor instead.
+func (*LegalEntity) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LegalEntity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LegalEntity) GetTaxID() string {
+	if x != nil {
+		return x.TaxID
+	}
+	return ""
+}
+
+// Support channel, at least one of email and phone is required
+type Contact struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// E.164 format, e.g. +78005553535
+	Phone         string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_api_providers_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// Delivery services a provider offers
+type Capabilities struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Courier      bool                   `protobuf:"varint,1,opt,name=courier,proto3" json:"courier,omitempty"`
+	PickupPoints bool                   `protobuf:"varint,2,opt,name=pickup_points,proto3" json:"pickup_points,omitempty"`
+	Lockers      bool                   `protobuf:"varint,3,opt,name=lockers,proto3" json:"lockers,omitempty"`
+	// Requires courier
+	SameDay        bool `protobuf:"varint,4,opt,name=same_day,proto3" json:"same_day,omitempty"`
+	CashOnDelivery bool `protobuf:"varint,5,opt,name=cash_on_delivery,proto3" json:"cash_on_delivery,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_api_providers_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Capabilities) GetCourier() bool {
+	if x != nil {
+		return x.Courier
+	}
+	return false
+}
+
+func (x *Capabilities) GetPickupPoints() bool {
+	if x != nil {
+		return x.PickupPoints
+	}
+	return false
+}
+
+func (x *Capabilities) GetLockers() bool {
+	if x != nil {
+		return x.Lockers
+	}
+	return false
+}
+
+func (x *Capabilities) GetSameDay() bool {
+	if x != nil {
+		return x.SameDay
+	}
+	return false
+}
+
+func (x *Capabilities) GetCashOnDelivery() bool {
+	if x != nil {
+		return x.CashOnDelivery
+	}
+	return false
+}
+
+type ProviderCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LegalEntity   *LegalEntity           `protobuf:"bytes,3,opt,name=legal_entity,proto3" json:"legal_entity,omitempty"`
+	Contacts      []*Contact             `protobuf:"bytes,4,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	Countries     []string               `protobuf:"bytes,6,rep,name=countries,proto3" json:"countries,omitempty"`
+	Capabilities  *Capabilities          `protobuf:"bytes,7,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	DisplayNames  map[string]string      `protobuf:"bytes,8,rep,name=display_names,proto3" json:"display_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCreateRequest) Reset() {
+	*x = ProviderCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateRequest) ProtoMessage() {}
+
+func (x *ProviderCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProviderCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderCreateRequest) GetLegalEntity() *LegalEntity {
+	if x != nil {
+		return x.LegalEntity
+	}
+	return nil
+}
+
+func (x *ProviderCreateRequest) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ProviderCreateRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *ProviderCreateRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ProviderCreateRequest) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *ProviderCreateRequest) GetDisplayNames() map[string]string {
+	if x != nil {
+		return x.DisplayNames
+	}
+	return nil
+}
+
+func (x *ProviderCreateRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ProviderCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderCreateResponse) Reset() {
+	*x = ProviderCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderCreateResponse) ProtoMessage() {}
+
+func (x *ProviderCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderCreateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProviderCreateResponse) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+type ProviderGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderGetRequest) Reset() {
+	*x = ProviderGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetRequest) ProtoMessage() {}
+
+func (x *ProviderGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetRequest.ProtoReflect.Descriptor instead.
+func (*ProviderGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProviderGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+type ProviderGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderGetResponse) Reset() {
+	*x = ProviderGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderGetResponse) ProtoMessage() {}
+
+func (x *ProviderGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderGetResponse.ProtoReflect.Descriptor instead.
+func (*ProviderGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProviderGetResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderListAllRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of providers to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// Case-insensitive prefix of the provider name
+	NamePrefix    string                 `protobuf:"bytes,3,opt,name=name_prefix,proto3" json:"name_prefix,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_after,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_before,proto3" json:"updated_before,omitempty"`
+	// One of "provider_id" (default) or "created_at", optionally followed by "asc" or "desc"
+	OrderBy string `protobuf:"bytes,8,opt,name=order_by,proto3" json:"order_by,omitempty"`
+	// Also return archived providers
+	IncludeArchived bool `protobuf:"varint,9,opt,name=include_archived,proto3" json:"include_archived,omitempty"`
+	// Keep only providers in any of these statuses
+	Statuses []ProviderStatus `protobuf:"varint,10,rep,packed,name=statuses,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ProviderStatus" json:"statuses,omitempty"`
+	// Kubernetes-style selector, e.g. "region=msk,tier in (gold,silver),!legacy". Supported operators are
+	// =, ==, !=, in, notin, a bare key for existence and !key for absence
+	LabelSelector string `protobuf:"bytes,11,opt,name=label_selector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderListAllRequest) Reset() {
+	*x = ProviderListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListAllRequest) ProtoMessage() {}
+
+func (x *ProviderListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListAllRequest.ProtoReflect.Descriptor instead.
+func (*ProviderListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProviderListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ProviderListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ProviderListAllRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ProviderListAllRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ProviderListAllRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ProviderListAllRequest) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ProviderListAllRequest) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ProviderListAllRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ProviderListAllRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ProviderListAllRequest) GetStatuses() []ProviderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ProviderListAllRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ProviderListAllResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Providers []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderListAllResponse) Reset() {
+	*x = ProviderListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderListAllResponse) ProtoMessage() {}
+
+func (x *ProviderListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderListAllResponse.ProtoReflect.Descriptor instead.
+func (*ProviderListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProviderListAllResponse) GetProviders() []*Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *ProviderListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ProviderUpdateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields to update, "*" updates all of them. When omitted only populated fields are updated
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,proto3" json:"update_mask,omitempty"`
+	// When set, the update fails with FAILED_PRECONDITION if the provider was changed since it was read
+	Etag         string        `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	LegalEntity  *LegalEntity  `protobuf:"bytes,5,opt,name=legal_entity,proto3" json:"legal_entity,omitempty"`
+	Contacts     []*Contact    `protobuf:"bytes,6,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Website      string        `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	Countries    []string      `protobuf:"bytes,8,rep,name=countries,proto3" json:"countries,omitempty"`
+	Capabilities *Capabilities `protobuf:"bytes,9,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// Without update_mask the given languages are merged into the stored ones and an empty name removes a language.
+	// A "display_names.<tag>" path updates a single language, "display_names" replaces all of them
+	DisplayNames map[string]string `protobuf:"bytes,10,rep,name=display_names,proto3" json:"display_names,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Without update_mask the given labels are set and the rest are kept. A "labels.<key>" path sets the label
+	// or removes it when it is absent from labels, "labels" replaces all of them
+	Labels        map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderUpdateRequest) Reset() {
+	*x = ProviderUpdateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateRequest) ProtoMessage() {}
+
+func (x *ProviderUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProviderUpdateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ProviderUpdateRequest) GetLegalEntity() *LegalEntity {
+	if x != nil {
+		return x.LegalEntity
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *ProviderUpdateRequest) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetDisplayNames() map[string]string {
+	if x != nil {
+		return x.DisplayNames
+	}
+	return nil
+}
+
+func (x *ProviderUpdateRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ProviderUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderUpdateResponse) Reset() {
+	*x = ProviderUpdateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderUpdateResponse) ProtoMessage() {}
+
+func (x *ProviderUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProviderUpdateResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderDeleteRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// When set, the delete fails with FAILED_PRECONDITION if the provider was changed since it was read
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderDeleteRequest) Reset() {
+	*x = ProviderDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteRequest) ProtoMessage() {}
+
+func (x *ProviderDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProviderDeleteRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderDeleteRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ProviderDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderDeleteResponse) Reset() {
+	*x = ProviderDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderDeleteResponse) ProtoMessage() {}
+
+func (x *ProviderDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ProviderDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{13}
+}
+
+type ProviderRestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderRestoreRequest) Reset() {
+	*x = ProviderRestoreRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRestoreRequest) ProtoMessage() {}
+
+func (x *ProviderRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRestoreRequest.ProtoReflect.Descriptor instead.
+func (*ProviderRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProviderRestoreRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+type ProviderRestoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderRestoreResponse) Reset() {
+	*x = ProviderRestoreResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRestoreResponse) ProtoMessage() {}
+
+func (x *ProviderRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRestoreResponse.ProtoReflect.Descriptor instead.
+func (*ProviderRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProviderRestoreResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderPurgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderPurgeRequest) Reset() {
+	*x = ProviderPurgeRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderPurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderPurgeRequest) ProtoMessage() {}
+
+func (x *ProviderPurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderPurgeRequest.ProtoReflect.Descriptor instead.
+func (*ProviderPurgeRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProviderPurgeRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+type ProviderPurgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderPurgeResponse) Reset() {
+	*x = ProviderPurgeResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderPurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderPurgeResponse) ProtoMessage() {}
+
+func (x *ProviderPurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderPurgeResponse.ProtoReflect.Descriptor instead.
+func (*ProviderPurgeResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{17}
+}
+
+type ProviderActivateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderActivateRequest) Reset() {
+	*x = ProviderActivateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderActivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderActivateRequest) ProtoMessage() {}
+
+func (x *ProviderActivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderActivateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderActivateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProviderActivateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+type ProviderActivateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderActivateResponse) Reset() {
+	*x = ProviderActivateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderActivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderActivateResponse) ProtoMessage() {}
+
+func (x *ProviderActivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderActivateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderActivateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProviderActivateResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderSuspendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSuspendRequest) Reset() {
+	*x = ProviderSuspendRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSuspendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSuspendRequest) ProtoMessage() {}
+
+func (x *ProviderSuspendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSuspendRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSuspendRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProviderSuspendRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderSuspendRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ProviderSuspendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSuspendResponse) Reset() {
+	*x = ProviderSuspendResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSuspendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSuspendResponse) ProtoMessage() {}
+
+func (x *ProviderSuspendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSuspendResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSuspendResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProviderSuspendResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderTerminateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderTerminateRequest) Reset() {
+	*x = ProviderTerminateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderTerminateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderTerminateRequest) ProtoMessage() {}
+
+func (x *ProviderTerminateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderTerminateRequest.ProtoReflect.Descriptor instead.
+func (*ProviderTerminateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProviderTerminateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderTerminateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ProviderTerminateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderTerminateResponse) Reset() {
+	*x = ProviderTerminateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderTerminateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderTerminateResponse) ProtoMessage() {}
+
+func (x *ProviderTerminateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderTerminateResponse.ProtoReflect.Descriptor instead.
+func (*ProviderTerminateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProviderTerminateResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderHistoryRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// 50 by default, 500 at most
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderHistoryRequest) Reset() {
+	*x = ProviderHistoryRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderHistoryRequest) ProtoMessage() {}
+
+func (x *ProviderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ProviderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProviderHistoryRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ProviderHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AuditID    int64                  `protobuf:"varint,1,opt,name=audit_id,proto3" json:"audit_id,omitempty"`
+	ProviderID string                 `protobuf:"bytes,2,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// One of create, update, delete, restore, purge, status_change
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Provider before the change, absent on create
+	OldValue *structpb.Struct `protobuf:"bytes,4,opt,name=old_value,proto3" json:"old_value,omitempty"`
+	// Provider after the change, absent on purge
+	NewValue *structpb.Struct `protobuf:"bytes,5,opt,name=new_value,proto3" json:"new_value,omitempty"`
+	// Named by the caller in x-actor, not verified
+	ClaimedActor  string                 `protobuf:"bytes,6,opt,name=claimed_actor,proto3" json:"claimed_actor,omitempty"`
+	RequestID     string                 `protobuf:"bytes,7,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_api_providers_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuditEntry) GetAuditID() int64 {
+	if x != nil {
+		return x.AuditID
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOldValue() *structpb.Struct {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetNewValue() *structpb.Struct {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetClaimedActor() string {
+	if x != nil {
+		return x.ClaimedActor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ProviderHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderHistoryResponse) Reset() {
+	*x = ProviderHistoryResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderHistoryResponse) ProtoMessage() {}
+
+func (x *ProviderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ProviderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProviderHistoryResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ProviderHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ProviderImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only report what would happen, read from the first message
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	Provider      *ProviderCreateRequest `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderImportRequest) Reset() {
+	*x = ProviderImportRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderImportRequest) ProtoMessage() {}
+
+func (x *ProviderImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderImportRequest.ProtoReflect.Descriptor instead.
+func (*ProviderImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProviderImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ProviderImportRequest) GetProvider() *ProviderCreateRequest {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderImportRowResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based number of the request message
+	Line          int32                `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ProviderID    string               `protobuf:"bytes,2,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Action        ProviderImportAction `protobuf:"varint,3,opt,name=action,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ProviderImportAction" json:"action,omitempty"`
+	Error         string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderImportRowResult) Reset() {
+	*x = ProviderImportRowResult{}
+	mi := &file_api_providers_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderImportRowResult) ProtoMessage() {}
+
+func (x *ProviderImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderImportRowResult.ProtoReflect.Descriptor instead.
+func (*ProviderImportRowResult) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProviderImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ProviderImportRowResult) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ProviderImportRowResult) GetAction() ProviderImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ProviderImportAction_PROVIDER_IMPORT_ACTION_UNSPECIFIED
+}
+
+func (x *ProviderImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProviderImportResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DryRun bool                   `protobuf:"varint,1,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	// Whether the changes were committed
+	Applied       bool                       `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Created       int32                      `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                      `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                      `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Failed        int32                      `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ProviderImportRowResult `protobuf:"bytes,7,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderImportResponse) Reset() {
+	*x = ProviderImportResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderImportResponse) ProtoMessage() {}
+
+func (x *ProviderImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderImportResponse.ProtoReflect.Descriptor instead.
+func (*ProviderImportResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProviderImportResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ProviderImportResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ProviderImportResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ProviderImportResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ProviderImportResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ProviderImportResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ProviderImportResponse) GetRows() []*ProviderImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ProviderExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Case-insensitive prefix of the provider name
+	NamePrefix string `protobuf:"bytes,1,opt,name=name_prefix,proto3" json:"name_prefix,omitempty"`
+	// Also export archived providers
+	IncludeArchived bool `protobuf:"varint,2,opt,name=include_archived,proto3" json:"include_archived,omitempty"`
+	// Keep only providers in any of these statuses
+	Statuses      []ProviderStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ProviderStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderExportRequest) Reset() {
+	*x = ProviderExportRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderExportRequest) ProtoMessage() {}
+
+func (x *ProviderExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderExportRequest.ProtoReflect.Descriptor instead.
+func (*ProviderExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProviderExportRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ProviderExportRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ProviderExportRequest) GetStatuses() []ProviderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ProviderExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderExportResponse) Reset() {
+	*x = ProviderExportResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderExportResponse) ProtoMessage() {}
+
+func (x *ProviderExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderExportResponse.ProtoReflect.Descriptor instead.
+func (*ProviderExportResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProviderExportResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+type ProviderSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words of the name, display names or legal name, in Cyrillic or Latin, typos are tolerated
+	Query string `protobuf:"bytes,1,opt,name=query,json=q,proto3" json:"query,omitempty"`
+	// 20 by default, 100 at most
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSearchRequest) Reset() {
+	*x = ProviderSearchRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSearchRequest) ProtoMessage() {}
+
+func (x *ProviderSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSearchRequest.ProtoReflect.Descriptor instead.
+func (*ProviderSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProviderSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ProviderSearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ProviderSearchResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// From 0 to 1, higher is a better match
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSearchResult) Reset() {
+	*x = ProviderSearchResult{}
+	mi := &file_api_providers_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSearchResult) ProtoMessage() {}
+
+func (x *ProviderSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSearchResult.ProtoReflect.Descriptor instead.
+func (*ProviderSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProviderSearchResult) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *ProviderSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ProviderSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best matches first, archived providers are never returned
+	Results       []*ProviderSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderSearchResponse) Reset() {
+	*x = ProviderSearchResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSearchResponse) ProtoMessage() {}
+
+func (x *ProviderSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSearchResponse.ProtoReflect.Descriptor instead.
+func (*ProviderSearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProviderSearchResponse) GetResults() []*ProviderSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Area a provider delivers to
+type Zone struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// GeoJSON Polygon or MultiPolygon, positions are [longitude, latitude]
+	Geometry  *structpb.Struct       `protobuf:"bytes,4,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Changes on every update, pass it back to make updates and deletes conditional
+	Etag          string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zone) Reset() {
+	*x = Zone{}
+	mi := &file_api_providers_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zone) ProtoMessage() {}
+
+func (x *Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Zone) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *Zone) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Zone) GetGeometry() *structpb.Struct {
+	if x != nil {
+		return x.Geometry
+	}
+	return nil
+}
+
+func (x *Zone) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Zone) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Zone) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ZoneCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Rings must be closed and must not intersect, holes must lie within the exterior ring
+	Geometry      *structpb.Struct `protobuf:"bytes,4,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneCreateRequest) Reset() {
+	*x = ZoneCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneCreateRequest) ProtoMessage() {}
+
+func (x *ZoneCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneCreateRequest.ProtoReflect.Descriptor instead.
+func (*ZoneCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ZoneCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ZoneCreateRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *ZoneCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZoneCreateRequest) GetGeometry() *structpb.Struct {
+	if x != nil {
+		return x.Geometry
+	}
+	return nil
+}
+
+type ZoneCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        string                 `protobuf:"bytes,1,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneCreateResponse) Reset() {
+	*x = ZoneCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneCreateResponse) ProtoMessage() {}
+
+func (x *ZoneCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneCreateResponse.ProtoReflect.Descriptor instead.
+func (*ZoneCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ZoneCreateResponse) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+type ZoneGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID        string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneGetRequest) Reset() {
+	*x = ZoneGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneGetRequest) ProtoMessage() {}
+
+func (x *ZoneGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneGetRequest.ProtoReflect.Descriptor instead.
+func (*ZoneGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ZoneGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ZoneGetRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+type ZoneGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneGetResponse) Reset() {
+	*x = ZoneGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneGetResponse) ProtoMessage() {}
+
+func (x *ZoneGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneGetResponse.ProtoReflect.Descriptor instead.
+func (*ZoneGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ZoneGetResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ZoneListAllRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Maximum number of zones to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneListAllRequest) Reset() {
+	*x = ZoneListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneListAllRequest) ProtoMessage() {}
+
+func (x *ZoneListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneListAllRequest.ProtoReflect.Descriptor instead.
+func (*ZoneListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ZoneListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ZoneListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ZoneListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ZoneListAllResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Zones []*Zone                `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneListAllResponse) Reset() {
+	*x = ZoneListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneListAllResponse) ProtoMessage() {}
+
+func (x *ZoneListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneListAllResponse.ProtoReflect.Descriptor instead.
+func (*ZoneListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ZoneListAllResponse) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *ZoneListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ZoneUpdateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Geometry   *structpb.Struct       `protobuf:"bytes,4,opt,name=geometry,proto3" json:"geometry,omitempty"`
+	// Fields to update, "*" updates all of them. When omitted only populated fields are updated
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,proto3" json:"update_mask,omitempty"`
+	// When set, the update fails with FAILED_PRECONDITION if the zone was changed since it was read
+	Etag          string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneUpdateRequest) Reset() {
+	*x = ZoneUpdateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneUpdateRequest) ProtoMessage() {}
+
+func (x *ZoneUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ZoneUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ZoneUpdateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ZoneUpdateRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *ZoneUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZoneUpdateRequest) GetGeometry() *structpb.Struct {
+	if x != nil {
+		return x.Geometry
+	}
+	return nil
+}
+
+func (x *ZoneUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *ZoneUpdateRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ZoneUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneUpdateResponse) Reset() {
+	*x = ZoneUpdateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneUpdateResponse) ProtoMessage() {}
+
+func (x *ZoneUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ZoneUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ZoneUpdateResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ZoneDeleteRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	// When set, the delete fails with FAILED_PRECONDITION if the zone was changed since it was read
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneDeleteRequest) Reset() {
+	*x = ZoneDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneDeleteRequest) ProtoMessage() {}
+
+func (x *ZoneDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ZoneDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ZoneDeleteRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ZoneDeleteRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *ZoneDeleteRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ZoneDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneDeleteResponse) Reset() {
+	*x = ZoneDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneDeleteResponse) ProtoMessage() {}
+
+func (x *ZoneDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ZoneDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{45}
+}
+
+type CoverageLookupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverageLookupRequest) Reset() {
+	*x = CoverageLookupRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverageLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverageLookupRequest) ProtoMessage() {}
+
+func (x *CoverageLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverageLookupRequest.ProtoReflect.Descriptor instead.
+func (*CoverageLookupRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CoverageLookupRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *CoverageLookupRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+// Provider delivering to the looked up position
+type CoverageMatch struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Zones of the provider containing the position
+	ZoneIds       []string `protobuf:"bytes,2,rep,name=zone_ids,proto3" json:"zone_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverageMatch) Reset() {
+	*x = CoverageMatch{}
+	mi := &file_api_providers_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverageMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverageMatch) ProtoMessage() {}
+
+func (x *CoverageMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverageMatch.ProtoReflect.Descriptor instead.
+func (*CoverageMatch) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CoverageMatch) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *CoverageMatch) GetZoneIds() []string {
+	if x != nil {
+		return x.ZoneIds
+	}
+	return nil
+}
+
+type CoverageLookupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by provider_id, empty when nobody delivers there
+	Matches       []*CoverageMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoverageLookupResponse) Reset() {
+	*x = CoverageLookupResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoverageLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoverageLookupResponse) ProtoMessage() {}
+
+func (x *CoverageLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoverageLookupResponse.ProtoReflect.Descriptor instead.
+func (*CoverageLookupResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CoverageLookupResponse) GetMatches() []*CoverageMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// Delivery window of a provider in one of its zones
+type Slot struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SlotID     int64                  `protobuf:"varint,1,opt,name=slot_id,proto3" json:"slot_id,omitempty"`
+	ProviderID string                 `protobuf:"bytes,2,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,3,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	StartsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	Capacity   int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Booked     int32                  `protobuf:"varint,7,opt,name=booked,proto3" json:"booked,omitempty"`
+	// Bookings the slot can still take, zero once it is closed
+	Available int32 `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
+	// Set once the slot stops taking bookings
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,proto3" json:"closed_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_api_providers_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Slot) GetSlotID() int64 {
+	if x != nil {
+		return x.SlotID
+	}
+	return 0
+}
+
+func (x *Slot) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *Slot) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *Slot) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Slot) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Slot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Slot) GetBooked() int32 {
+	if x != nil {
+		return x.Booked
+	}
+	return 0
+}
+
+func (x *Slot) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Slot) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *Slot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Slot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SlotCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	// The slot lasts at most 24 hours and must end in the future
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	// From 1 to 10000
+	Capacity      int32 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotCreateRequest) Reset() {
+	*x = SlotCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCreateRequest) ProtoMessage() {}
+
+func (x *SlotCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCreateRequest.ProtoReflect.Descriptor instead.
+func (*SlotCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SlotCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotCreateRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *SlotCreateRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SlotCreateRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SlotCreateRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type SlotCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *Slot                  `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotCreateResponse) Reset() {
+	*x = SlotCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCreateResponse) ProtoMessage() {}
+
+func (x *SlotCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCreateResponse.ProtoReflect.Descriptor instead.
+func (*SlotCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SlotCreateResponse) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type SlotGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	SlotID        int64                  `protobuf:"varint,2,opt,name=slot_id,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotGetRequest) Reset() {
+	*x = SlotGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotGetRequest) ProtoMessage() {}
+
+func (x *SlotGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotGetRequest.ProtoReflect.Descriptor instead.
+func (*SlotGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SlotGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotGetRequest) GetSlotID() int64 {
+	if x != nil {
+		return x.SlotID
+	}
+	return 0
+}
+
+type SlotGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *Slot                  `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotGetResponse) Reset() {
+	*x = SlotGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotGetResponse) ProtoMessage() {}
+
+func (x *SlotGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotGetResponse.ProtoReflect.Descriptor instead.
+func (*SlotGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SlotGetResponse) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type SlotListAllRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	// Slots starting at or after this time
+	StartsFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_from,proto3" json:"starts_from,omitempty"`
+	// Slots starting before this time
+	StartsBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_before,proto3" json:"starts_before,omitempty"`
+	// Closed slots are skipped unless set
+	IncludeClosed bool `protobuf:"varint,5,opt,name=include_closed,proto3" json:"include_closed,omitempty"`
+	// Maximum number of slots to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotListAllRequest) Reset() {
+	*x = SlotListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotListAllRequest) ProtoMessage() {}
+
+func (x *SlotListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotListAllRequest.ProtoReflect.Descriptor instead.
+func (*SlotListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SlotListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotListAllRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *SlotListAllRequest) GetStartsFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsFrom
+	}
+	return nil
+}
+
+func (x *SlotListAllRequest) GetStartsBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsBefore
+	}
+	return nil
+}
+
+func (x *SlotListAllRequest) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+func (x *SlotListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SlotListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SlotListAllResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slots []*Slot                `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotListAllResponse) Reset() {
+	*x = SlotListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotListAllResponse) ProtoMessage() {}
+
+func (x *SlotListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotListAllResponse.ProtoReflect.Descriptor instead.
+func (*SlotListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SlotListAllResponse) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SlotListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SlotCloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	SlotID        int64                  `protobuf:"varint,2,opt,name=slot_id,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotCloseRequest) Reset() {
+	*x = SlotCloseRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotCloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCloseRequest) ProtoMessage() {}
+
+func (x *SlotCloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCloseRequest.ProtoReflect.Descriptor instead.
+func (*SlotCloseRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SlotCloseRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotCloseRequest) GetSlotID() int64 {
+	if x != nil {
+		return x.SlotID
+	}
+	return 0
+}
+
+type SlotCloseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          *Slot                  `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotCloseResponse) Reset() {
+	*x = SlotCloseResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotCloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCloseResponse) ProtoMessage() {}
+
+func (x *SlotCloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCloseResponse.ProtoReflect.Descriptor instead.
+func (*SlotCloseResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SlotCloseResponse) GetSlot() *Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type SlotAvailabilityRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,2,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	// Defaults to now
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to a week after from, the period is at most 31 days long
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotAvailabilityRequest) Reset() {
+	*x = SlotAvailabilityRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotAvailabilityRequest) ProtoMessage() {}
+
+func (x *SlotAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SlotAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SlotAvailabilityRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotAvailabilityRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *SlotAvailabilityRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SlotAvailabilityRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type SlotAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Open slots with free capacity that have not started yet, ordered by starts_at
+	Slots         []*Slot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotAvailabilityResponse) Reset() {
+	*x = SlotAvailabilityResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotAvailabilityResponse) ProtoMessage() {}
+
+func (x *SlotAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SlotAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SlotAvailabilityResponse) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// Capacity of a slot held for a customer at checkout
+type SlotHold struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	HoldID     string                 `protobuf:"bytes,1,opt,name=hold_id,proto3" json:"hold_id,omitempty"`
+	SlotID     int64                  `protobuf:"varint,2,opt,name=slot_id,proto3" json:"slot_id,omitempty"`
+	ProviderID string                 `protobuf:"bytes,3,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Quantity   int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status     SlotHoldStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=github.com.classydevv.fulfillment.providers.v1.SlotHoldStatus" json:"status,omitempty"`
+	// Only matters while the hold is held
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotHold) Reset() {
+	*x = SlotHold{}
+	mi := &file_api_providers_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotHold) ProtoMessage() {}
+
+func (x *SlotHold) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotHold.ProtoReflect.Descriptor instead.
+func (*SlotHold) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SlotHold) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *SlotHold) GetSlotID() int64 {
+	if x != nil {
+		return x.SlotID
+	}
+	return 0
+}
+
+func (x *SlotHold) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotHold) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SlotHold) GetStatus() SlotHoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SlotHoldStatus_SLOT_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *SlotHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SlotHold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SlotHold) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SlotHoldRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	SlotID     int64                  `protobuf:"varint,2,opt,name=slot_id,proto3" json:"slot_id,omitempty"`
+	// Defaults to 1
+	Quantity      int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotHoldRequest) Reset() {
+	*x = SlotHoldRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotHoldRequest) ProtoMessage() {}
+
+func (x *SlotHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotHoldRequest.ProtoReflect.Descriptor instead.
+func (*SlotHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SlotHoldRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotHoldRequest) GetSlotID() int64 {
+	if x != nil {
+		return x.SlotID
+	}
+	return 0
+}
+
+func (x *SlotHoldRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SlotHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *SlotHold              `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotHoldResponse) Reset() {
+	*x = SlotHoldResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotHoldResponse) ProtoMessage() {}
+
+func (x *SlotHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotHoldResponse.ProtoReflect.Descriptor instead.
+func (*SlotHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SlotHoldResponse) GetHold() *SlotHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type SlotConfirmRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	HoldID        string                 `protobuf:"bytes,2,opt,name=hold_id,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotConfirmRequest) Reset() {
+	*x = SlotConfirmRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotConfirmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotConfirmRequest) ProtoMessage() {}
+
+func (x *SlotConfirmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotConfirmRequest.ProtoReflect.Descriptor instead.
+func (*SlotConfirmRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SlotConfirmRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotConfirmRequest) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+type SlotConfirmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *SlotHold              `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotConfirmResponse) Reset() {
+	*x = SlotConfirmResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotConfirmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotConfirmResponse) ProtoMessage() {}
+
+func (x *SlotConfirmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotConfirmResponse.ProtoReflect.Descriptor instead.
+func (*SlotConfirmResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SlotConfirmResponse) GetHold() *SlotHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type SlotReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	HoldID        string                 `protobuf:"bytes,2,opt,name=hold_id,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotReleaseRequest) Reset() {
+	*x = SlotReleaseRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotReleaseRequest) ProtoMessage() {}
+
+func (x *SlotReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotReleaseRequest.ProtoReflect.Descriptor instead.
+func (*SlotReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SlotReleaseRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotReleaseRequest) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+type SlotReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *SlotHold              `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotReleaseResponse) Reset() {
+	*x = SlotReleaseResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotReleaseResponse) ProtoMessage() {}
+
+func (x *SlotReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotReleaseResponse.ProtoReflect.Descriptor instead.
+func (*SlotReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SlotReleaseResponse) GetHold() *SlotHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// Capacity of every window on a weekday
+type SlotTemplateDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO weekday, 1 is Monday and 7 is Sunday
+	Weekday       int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Capacity      int32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateDay) Reset() {
+	*x = SlotTemplateDay{}
+	mi := &file_api_providers_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateDay) ProtoMessage() {}
+
+func (x *SlotTemplateDay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateDay.ProtoReflect.Descriptor instead.
+func (*SlotTemplateDay) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SlotTemplateDay) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *SlotTemplateDay) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+// Local wall clock window
+type SlotTemplateWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As "HH:MM"
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// As "HH:MM", "24:00" is the end of the day
+	End           string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateWindow) Reset() {
+	*x = SlotTemplateWindow{}
+	mi := &file_api_providers_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateWindow) ProtoMessage() {}
+
+func (x *SlotTemplateWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateWindow.ProtoReflect.Descriptor instead.
+func (*SlotTemplateWindow) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SlotTemplateWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SlotTemplateWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// Weekly recurring slots of a zone
+type SlotTemplate struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,3,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	// IANA time zone the windows are local to, e.g. Europe/Moscow
+	TimeZone string                `protobuf:"bytes,4,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	Days     []*SlotTemplateDay    `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	Windows  []*SlotTemplateWindow `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Local dates as "YYYY-MM-DD" without slots
+	Exclusions []string `protobuf:"bytes,7,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	// Set while the template generates slots
+	ActivatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=activated_at,proto3" json:"activated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplate) Reset() {
+	*x = SlotTemplate{}
+	mi := &file_api_providers_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplate) ProtoMessage() {}
+
+func (x *SlotTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplate.ProtoReflect.Descriptor instead.
+func (*SlotTemplate) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SlotTemplate) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplate) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *SlotTemplate) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *SlotTemplate) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SlotTemplate) GetDays() []*SlotTemplateDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *SlotTemplate) GetWindows() []*SlotTemplateWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *SlotTemplate) GetExclusions() []string {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *SlotTemplate) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *SlotTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SlotTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SlotTemplateCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	ZoneID     string                 `protobuf:"bytes,3,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	TimeZone   string                 `protobuf:"bytes,4,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	// Every weekday at most once
+	Days []*SlotTemplateDay `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	// At most 48 windows that must not overlap
+	Windows       []*SlotTemplateWindow `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+	Exclusions    []string              `protobuf:"bytes,7,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateCreateRequest) Reset() {
+	*x = SlotTemplateCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateCreateRequest) ProtoMessage() {}
+
+func (x *SlotTemplateCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateCreateRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SlotTemplateCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateCreateRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *SlotTemplateCreateRequest) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *SlotTemplateCreateRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SlotTemplateCreateRequest) GetDays() []*SlotTemplateDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *SlotTemplateCreateRequest) GetWindows() []*SlotTemplateWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *SlotTemplateCreateRequest) GetExclusions() []string {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+type SlotTemplateCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SlotTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateCreateResponse) Reset() {
+	*x = SlotTemplateCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateCreateResponse) ProtoMessage() {}
+
+func (x *SlotTemplateCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateCreateResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SlotTemplateCreateResponse) GetTemplate() *SlotTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SlotTemplateGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateGetRequest) Reset() {
+	*x = SlotTemplateGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateGetRequest) ProtoMessage() {}
+
+func (x *SlotTemplateGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateGetRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SlotTemplateGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateGetRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+type SlotTemplateGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SlotTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateGetResponse) Reset() {
+	*x = SlotTemplateGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateGetResponse) ProtoMessage() {}
+
+func (x *SlotTemplateGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateGetResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SlotTemplateGetResponse) GetTemplate() *SlotTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SlotTemplateListAllRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Maximum number of templates to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateListAllRequest) Reset() {
+	*x = SlotTemplateListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateListAllRequest) ProtoMessage() {}
+
+func (x *SlotTemplateListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateListAllRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SlotTemplateListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SlotTemplateListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SlotTemplateListAllResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Templates []*SlotTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateListAllResponse) Reset() {
+	*x = SlotTemplateListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateListAllResponse) ProtoMessage() {}
+
+func (x *SlotTemplateListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateListAllResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SlotTemplateListAllResponse) GetTemplates() []*SlotTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *SlotTemplateListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SlotTemplateDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateDeleteRequest) Reset() {
+	*x = SlotTemplateDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateDeleteRequest) ProtoMessage() {}
+
+func (x *SlotTemplateDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateDeleteRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SlotTemplateDeleteRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateDeleteRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+type SlotTemplateDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateDeleteResponse) Reset() {
+	*x = SlotTemplateDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateDeleteResponse) ProtoMessage() {}
+
+func (x *SlotTemplateDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateDeleteResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{77}
+}
+
+type SlotTemplateActivateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateActivateRequest) Reset() {
+	*x = SlotTemplateActivateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateActivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateActivateRequest) ProtoMessage() {}
+
+func (x *SlotTemplateActivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateActivateRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateActivateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SlotTemplateActivateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateActivateRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+type SlotTemplateActivateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SlotTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateActivateResponse) Reset() {
+	*x = SlotTemplateActivateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateActivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateActivateResponse) ProtoMessage() {}
+
+func (x *SlotTemplateActivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateActivateResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateActivateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SlotTemplateActivateResponse) GetTemplate() *SlotTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SlotTemplateDeactivateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID    string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateDeactivateRequest) Reset() {
+	*x = SlotTemplateDeactivateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateDeactivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateDeactivateRequest) ProtoMessage() {}
+
+func (x *SlotTemplateDeactivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateDeactivateRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplateDeactivateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SlotTemplateDeactivateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplateDeactivateRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+type SlotTemplateDeactivateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SlotTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplateDeactivateResponse) Reset() {
+	*x = SlotTemplateDeactivateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplateDeactivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplateDeactivateResponse) ProtoMessage() {}
+
+func (x *SlotTemplateDeactivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplateDeactivateResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplateDeactivateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SlotTemplateDeactivateResponse) GetTemplate() *SlotTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type SlotTemplatePreviewRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TemplateID string                 `protobuf:"bytes,2,opt,name=template_id,proto3" json:"template_id,omitempty"`
+	// Defaults to now
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to the generation horizon after from, the period is at most 31 days long
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplatePreviewRequest) Reset() {
+	*x = SlotTemplatePreviewRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplatePreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplatePreviewRequest) ProtoMessage() {}
+
+func (x *SlotTemplatePreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplatePreviewRequest.ProtoReflect.Descriptor instead.
+func (*SlotTemplatePreviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SlotTemplatePreviewRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *SlotTemplatePreviewRequest) GetTemplateID() string {
+	if x != nil {
+		return x.TemplateID
+	}
+	return ""
+}
+
+func (x *SlotTemplatePreviewRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SlotTemplatePreviewRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// Slot a template would generate
+type GeneratedSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneID        string                 `protobuf:"bytes,1,opt,name=zone_id,proto3" json:"zone_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,proto3" json:"ends_at,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratedSlot) Reset() {
+	*x = GeneratedSlot{}
+	mi := &file_api_providers_messages_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedSlot) ProtoMessage() {}
+
+func (x *GeneratedSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedSlot.ProtoReflect.Descriptor instead.
+func (*GeneratedSlot) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GeneratedSlot) GetZoneID() string {
+	if x != nil {
+		return x.ZoneID
+	}
+	return ""
+}
+
+func (x *GeneratedSlot) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *GeneratedSlot) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *GeneratedSlot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type SlotTemplatePreviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by starts_at
+	Slots         []*GeneratedSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotTemplatePreviewResponse) Reset() {
+	*x = SlotTemplatePreviewResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotTemplatePreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotTemplatePreviewResponse) ProtoMessage() {}
+
+func (x *SlotTemplatePreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotTemplatePreviewResponse.ProtoReflect.Descriptor instead.
+func (*SlotTemplatePreviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SlotTemplatePreviewResponse) GetSlots() []*GeneratedSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_api_providers_messages_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Location) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *Location) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+// Local wall clock interval a pickup point is open on a weekday
+type OpeningHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO weekday, 1 is Monday and 7 is Sunday
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// As "HH:MM"
+	Opens string `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	// As "HH:MM", "24:00" is the end of the day
+	Closes        string `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_api_providers_messages_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *OpeningHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+// Limits of accepted parcels, zero means no limit
+type ParcelDimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LengthCm      int32                  `protobuf:"varint,1,opt,name=length_cm,proto3" json:"length_cm,omitempty"`
+	WidthCm       int32                  `protobuf:"varint,2,opt,name=width_cm,proto3" json:"width_cm,omitempty"`
+	HeightCm      int32                  `protobuf:"varint,3,opt,name=height_cm,proto3" json:"height_cm,omitempty"`
+	WeightG       int32                  `protobuf:"varint,4,opt,name=weight_g,proto3" json:"weight_g,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParcelDimensions) Reset() {
+	*x = ParcelDimensions{}
+	mi := &file_api_providers_messages_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParcelDimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParcelDimensions) ProtoMessage() {}
+
+func (x *ParcelDimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParcelDimensions.ProtoReflect.Descriptor instead.
+func (*ParcelDimensions) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ParcelDimensions) GetLengthCm() int32 {
+	if x != nil {
+		return x.LengthCm
+	}
+	return 0
+}
+
+func (x *ParcelDimensions) GetWidthCm() int32 {
+	if x != nil {
+		return x.WidthCm
+	}
+	return 0
+}
+
+func (x *ParcelDimensions) GetHeightCm() int32 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *ParcelDimensions) GetWeightG() int32 {
+	if x != nil {
+		return x.WeightG
+	}
+	return 0
+}
+
+// Place of a provider where customers collect parcels
+type PickupPoint struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	PointID    string                 `protobuf:"bytes,2,opt,name=point_id,proto3" json:"point_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type       PickupPointType        `protobuf:"varint,4,opt,name=type,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PickupPointType" json:"type,omitempty"`
+	Address    string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Location   *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// Weekdays without any are days off
+	OpeningHours   []*OpeningHours        `protobuf:"bytes,7,rep,name=opening_hours,proto3" json:"opening_hours,omitempty"`
+	PaymentOptions []PaymentOption        `protobuf:"varint,8,rep,packed,name=payment_options,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PaymentOption" json:"payment_options,omitempty"`
+	MaxParcel      *ParcelDimensions      `protobuf:"bytes,9,opt,name=max_parcel,proto3" json:"max_parcel,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	// Changes on every update, pass it back to make updates and deletes conditional
+	Etag          string `protobuf:"bytes,12,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPoint) Reset() {
+	*x = PickupPoint{}
+	mi := &file_api_providers_messages_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPoint) ProtoMessage() {}
+
+func (x *PickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPoint.ProtoReflect.Descriptor instead.
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PickupPoint) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetPointID() string {
+	if x != nil {
+		return x.PointID
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetType() PickupPointType {
+	if x != nil {
+		return x.Type
+	}
+	return PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED
+}
+
+func (x *PickupPoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetOpeningHours() []*OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetPaymentOptions() []PaymentOption {
+	if x != nil {
+		return x.PaymentOptions
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetMaxParcel() *ParcelDimensions {
+	if x != nil {
+		return x.MaxParcel
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PickupPoint) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PickupPointCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	PointID    string                 `protobuf:"bytes,2,opt,name=point_id,proto3" json:"point_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type       PickupPointType        `protobuf:"varint,4,opt,name=type,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PickupPointType" json:"type,omitempty"`
+	Address    string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Location   *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// Intervals of a weekday must not overlap
+	OpeningHours   []*OpeningHours   `protobuf:"bytes,7,rep,name=opening_hours,proto3" json:"opening_hours,omitempty"`
+	PaymentOptions []PaymentOption   `protobuf:"varint,8,rep,packed,name=payment_options,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PaymentOption" json:"payment_options,omitempty"`
+	MaxParcel      *ParcelDimensions `protobuf:"bytes,9,opt,name=max_parcel,proto3" json:"max_parcel,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PickupPointCreateRequest) Reset() {
+	*x = PickupPointCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointCreateRequest) ProtoMessage() {}
+
+func (x *PickupPointCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointCreateRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PickupPointCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPointCreateRequest) GetPointID() string {
+	if x != nil {
+		return x.PointID
+	}
+	return ""
+}
+
+func (x *PickupPointCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPointCreateRequest) GetType() PickupPointType {
+	if x != nil {
+		return x.Type
+	}
+	return PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED
+}
+
+func (x *PickupPointCreateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPointCreateRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PickupPointCreateRequest) GetOpeningHours() []*OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *PickupPointCreateRequest) GetPaymentOptions() []PaymentOption {
+	if x != nil {
+		return x.PaymentOptions
+	}
+	return nil
+}
+
+func (x *PickupPointCreateRequest) GetMaxParcel() *ParcelDimensions {
+	if x != nil {
+		return x.MaxParcel
+	}
+	return nil
+}
+
+type PickupPointCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointCreateResponse) Reset() {
+	*x = PickupPointCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointCreateResponse) ProtoMessage() {}
+
+func (x *PickupPointCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointCreateResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PickupPointCreateResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type PickupPointGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	PointID       string                 `protobuf:"bytes,2,opt,name=point_id,proto3" json:"point_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointGetRequest) Reset() {
+	*x = PickupPointGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointGetRequest) ProtoMessage() {}
+
+func (x *PickupPointGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointGetRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PickupPointGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPointGetRequest) GetPointID() string {
+	if x != nil {
+		return x.PointID
+	}
+	return ""
+}
+
+type PickupPointGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointGetResponse) Reset() {
+	*x = PickupPointGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointGetResponse) ProtoMessage() {}
+
+func (x *PickupPointGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointGetResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PickupPointGetResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type PickupPointListAllRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Maximum number of pickup points to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointListAllRequest) Reset() {
+	*x = PickupPointListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointListAllRequest) ProtoMessage() {}
+
+func (x *PickupPointListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointListAllRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PickupPointListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPointListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *PickupPointListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type PickupPointListAllResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoints []*PickupPoint         `protobuf:"bytes,1,rep,name=pickup_points,proto3" json:"pickup_points,omitempty"`
+	// Empty when there are no more pages
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointListAllResponse) Reset() {
+	*x = PickupPointListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointListAllResponse) ProtoMessage() {}
+
+func (x *PickupPointListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointListAllResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PickupPointListAllResponse) GetPickupPoints() []*PickupPoint {
+	if x != nil {
+		return x.PickupPoints
+	}
+	return nil
+}
+
+func (x *PickupPointListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PickupPointUpdateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID     string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	PointID        string                 `protobuf:"bytes,2,opt,name=point_id,proto3" json:"point_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type           PickupPointType        `protobuf:"varint,4,opt,name=type,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PickupPointType" json:"type,omitempty"`
+	Address        string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Location       *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	OpeningHours   []*OpeningHours        `protobuf:"bytes,7,rep,name=opening_hours,proto3" json:"opening_hours,omitempty"`
+	PaymentOptions []PaymentOption        `protobuf:"varint,8,rep,packed,name=payment_options,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PaymentOption" json:"payment_options,omitempty"`
+	MaxParcel      *ParcelDimensions      `protobuf:"bytes,9,opt,name=max_parcel,proto3" json:"max_parcel,omitempty"`
+	// Fields to update, "*" updates all of them. When omitted only populated fields are updated
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=update_mask,proto3" json:"update_mask,omitempty"`
+	// When set, the update fails with FAILED_PRECONDITION if the pickup point was changed since it was read
+	Etag          string `protobuf:"bytes,11,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointUpdateRequest) Reset() {
+	*x = PickupPointUpdateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointUpdateRequest) ProtoMessage() {}
+
+func (x *PickupPointUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PickupPointUpdateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPointUpdateRequest) GetPointID() string {
+	if x != nil {
+		return x.PointID
+	}
+	return ""
+}
+
+func (x *PickupPointUpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPointUpdateRequest) GetType() PickupPointType {
+	if x != nil {
+		return x.Type
+	}
+	return PickupPointType_PICKUP_POINT_TYPE_UNSPECIFIED
+}
+
+func (x *PickupPointUpdateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPointUpdateRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PickupPointUpdateRequest) GetOpeningHours() []*OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *PickupPointUpdateRequest) GetPaymentOptions() []PaymentOption {
+	if x != nil {
+		return x.PaymentOptions
+	}
+	return nil
+}
+
+func (x *PickupPointUpdateRequest) GetMaxParcel() *ParcelDimensions {
+	if x != nil {
+		return x.MaxParcel
+	}
+	return nil
+}
+
+func (x *PickupPointUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *PickupPointUpdateRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PickupPointUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointUpdateResponse) Reset() {
+	*x = PickupPointUpdateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointUpdateResponse) ProtoMessage() {}
+
+func (x *PickupPointUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointUpdateResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *PickupPointUpdateResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type PickupPointDeleteRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	PointID    string                 `protobuf:"bytes,2,opt,name=point_id,proto3" json:"point_id,omitempty"`
+	// When set, the delete fails with FAILED_PRECONDITION if the pickup point was changed since it was read
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointDeleteRequest) Reset() {
+	*x = PickupPointDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointDeleteRequest) ProtoMessage() {}
+
+func (x *PickupPointDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PickupPointDeleteRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *PickupPointDeleteRequest) GetPointID() string {
+	if x != nil {
+		return x.PointID
+	}
+	return ""
+}
+
+func (x *PickupPointDeleteRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PickupPointDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointDeleteResponse) Reset() {
+	*x = PickupPointDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointDeleteResponse) ProtoMessage() {}
+
+func (x *PickupPointDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{98}
+}
+
+type PickupPointsNearbyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Lat   float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon   float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Search radius in meters, 5000 by default and 50000 at most
+	Radius float64 `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	// Number of points to return, 10 by default and 100 at most
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// When not empty, only points of these providers are returned
+	ProviderIds []string `protobuf:"bytes,5,rep,name=provider_ids,proto3" json:"provider_ids,omitempty"`
+	// When not empty, only points of these types are returned
+	Types         []PickupPointType `protobuf:"varint,6,rep,packed,name=types,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PickupPointType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointsNearbyRequest) Reset() {
+	*x = PickupPointsNearbyRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointsNearbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointsNearbyRequest) ProtoMessage() {}
+
+func (x *PickupPointsNearbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointsNearbyRequest.ProtoReflect.Descriptor instead.
+func (*PickupPointsNearbyRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PickupPointsNearbyRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *PickupPointsNearbyRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *PickupPointsNearbyRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PickupPointsNearbyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PickupPointsNearbyRequest) GetProviderIds() []string {
+	if x != nil {
+		return x.ProviderIds
+	}
+	return nil
+}
+
+func (x *PickupPointsNearbyRequest) GetTypes() []PickupPointType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// Pickup point found near the searched position
+type NearbyPickupPoint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,proto3" json:"pickup_point,omitempty"`
+	// Great-circle distance from the searched position in meters
+	Distance      float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPickupPoint) Reset() {
+	*x = NearbyPickupPoint{}
+	mi := &file_api_providers_messages_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPickupPoint) ProtoMessage() {}
+
+func (x *NearbyPickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPickupPoint.ProtoReflect.Descriptor instead.
+func (*NearbyPickupPoint) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *NearbyPickupPoint) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+func (x *NearbyPickupPoint) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type PickupPointsNearbyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nearest first, empty when there are no points within the radius
+	PickupPoints  []*NearbyPickupPoint `protobuf:"bytes,1,rep,name=pickup_points,proto3" json:"pickup_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupPointsNearbyResponse) Reset() {
+	*x = PickupPointsNearbyResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPointsNearbyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPointsNearbyResponse) ProtoMessage() {}
+
+func (x *PickupPointsNearbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPointsNearbyResponse.ProtoReflect.Descriptor instead.
+func (*PickupPointsNearbyResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PickupPointsNearbyResponse) GetPickupPoints() []*NearbyPickupPoint {
+	if x != nil {
+		return x.PickupPoints
+	}
+	return nil
+}
+
+// Prices a parcel heavier than the previous break and at most up_to_g heavy:
+// price plus per_kg for every kilogram above the previous break
+type WeightBreak struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	UpToG int32                  `protobuf:"varint,1,opt,name=up_to_g,proto3" json:"up_to_g,omitempty"`
+	// Decimal amount, e.g. "199.90"
+	Price         string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PerKg         string `protobuf:"bytes,3,opt,name=per_kg,proto3" json:"per_kg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightBreak) Reset() {
+	*x = WeightBreak{}
+	mi := &file_api_providers_messages_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightBreak) ProtoMessage() {}
+
+func (x *WeightBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightBreak.ProtoReflect.Descriptor instead.
+func (*WeightBreak) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *WeightBreak) GetUpToG() int32 {
+	if x != nil {
+		return x.UpToG
+	}
+	return 0
+}
+
+func (x *WeightBreak) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *WeightBreak) GetPerKg() string {
+	if x != nil {
+		return x.PerKg
+	}
+	return ""
+}
+
+// Prices a route longer than the previous band and at most up_to_km long:
+// price plus per_km for every kilometer above the previous band
+type DistanceBand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UpToKm        int32                  `protobuf:"varint,1,opt,name=up_to_km,proto3" json:"up_to_km,omitempty"`
+	Price         string                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PerKm         string                 `protobuf:"bytes,3,opt,name=per_km,proto3" json:"per_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistanceBand) Reset() {
+	*x = DistanceBand{}
+	mi := &file_api_providers_messages_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistanceBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistanceBand) ProtoMessage() {}
+
+func (x *DistanceBand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistanceBand.ProtoReflect.Descriptor instead.
+func (*DistanceBand) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DistanceBand) GetUpToKm() int32 {
+	if x != nil {
+		return x.UpToKm
+	}
+	return 0
+}
+
+func (x *DistanceBand) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DistanceBand) GetPerKm() string {
+	if x != nil {
+		return x.PerKm
+	}
+	return ""
+}
+
+// Fixed amount added to every shipment, e.g. a fuel fee
+type Surcharge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Surcharge) Reset() {
+	*x = Surcharge{}
+	mi := &file_api_providers_messages_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Surcharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Surcharge) ProtoMessage() {}
+
+func (x *Surcharge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Surcharge.ProtoReflect.Descriptor instead.
+func (*Surcharge) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Surcharge) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Surcharge) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Pricing rules of a provider, amounts are decimal strings in the tariff currency.
+// The rules are the ones of the revision in effect or, before the first one starts, the next one
+type Tariff struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID   string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// ISO 4217 code, e.g. RUB
+	Currency     string         `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	WeightBreaks []*WeightBreak `protobuf:"bytes,5,rep,name=weight_breaks,proto3" json:"weight_breaks,omitempty"`
+	// Turns cm³ into volumetric kg, zero prices parcels by actual weight only
+	VolumetricDivisor int32 `protobuf:"varint,6,opt,name=volumetric_divisor,proto3" json:"volumetric_divisor,omitempty"`
+	// Routes are free of charge without any
+	DistanceBands []*DistanceBand `protobuf:"bytes,7,rep,name=distance_bands,proto3" json:"distance_bands,omitempty"`
+	Surcharges    []*Surcharge    `protobuf:"bytes,8,rep,name=surcharges,proto3" json:"surcharges,omitempty"`
+	// Bounds of the total, "0" means no bound
+	MinPrice     string                 `protobuf:"bytes,9,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice     string                 `protobuf:"bytes,10,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	ServiceLevel ServiceLevel           `protobuf:"varint,13,opt,name=service_level,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ServiceLevel" json:"service_level,omitempty"`
+	// Bounds of the delivery time, zero days is delivery on the day of the order
+	TransitDaysMin int32 `protobuf:"varint,14,opt,name=transit_days_min,proto3" json:"transit_days_min,omitempty"`
+	TransitDaysMax int32 `protobuf:"varint,15,opt,name=transit_days_max,proto3" json:"transit_days_max,omitempty"`
+	// Number of the revision the rules come from
+	Revision      int32                  `protobuf:"varint,16,opt,name=revision,proto3" json:"revision,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=effective_from,proto3" json:"effective_from,omitempty"`
+	// Absent while no later revision is scheduled
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=effective_to,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tariff) Reset() {
+	*x = Tariff{}
+	mi := &file_api_providers_messages_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tariff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tariff) ProtoMessage() {}
+
+func (x *Tariff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Tariff) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *Tariff) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *Tariff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tariff) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Tariff) GetWeightBreaks() []*WeightBreak {
+	if x != nil {
+		return x.WeightBreaks
+	}
+	return nil
+}
+
+func (x *Tariff) GetVolumetricDivisor() int32 {
+	if x != nil {
+		return x.VolumetricDivisor
+	}
+	return 0
+}
+
+func (x *Tariff) GetDistanceBands() []*DistanceBand {
+	if x != nil {
+		return x.DistanceBands
+	}
+	return nil
+}
+
+func (x *Tariff) GetSurcharges() []*Surcharge {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *Tariff) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *Tariff) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *Tariff) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tariff) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Tariff) GetServiceLevel() ServiceLevel {
+	if x != nil {
+		return x.ServiceLevel
+	}
+	return ServiceLevel_SERVICE_LEVEL_UNSPECIFIED
+}
+
+func (x *Tariff) GetTransitDaysMin() int32 {
+	if x != nil {
+		return x.TransitDaysMin
+	}
+	return 0
+}
+
+func (x *Tariff) GetTransitDaysMax() int32 {
+	if x != nil {
+		return x.TransitDaysMax
+	}
+	return 0
+}
+
+func (x *Tariff) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Tariff) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *Tariff) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+type TariffCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID   string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Currency   string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// At most 50 breaks with distinct up_to_g
+	WeightBreaks      []*WeightBreak `protobuf:"bytes,5,rep,name=weight_breaks,proto3" json:"weight_breaks,omitempty"`
+	VolumetricDivisor int32          `protobuf:"varint,6,opt,name=volumetric_divisor,proto3" json:"volumetric_divisor,omitempty"`
+	// At most 50 bands with distinct up_to_km
+	DistanceBands []*DistanceBand `protobuf:"bytes,7,rep,name=distance_bands,proto3" json:"distance_bands,omitempty"`
+	// At most 20 surcharges with distinct codes
+	Surcharges []*Surcharge `protobuf:"bytes,8,rep,name=surcharges,proto3" json:"surcharges,omitempty"`
+	MinPrice   string       `protobuf:"bytes,9,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice   string       `protobuf:"bytes,10,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	// Defaults to standard
+	ServiceLevel ServiceLevel `protobuf:"varint,11,opt,name=service_level,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ServiceLevel" json:"service_level,omitempty"`
+	// 0 <= transit_days_min <= transit_days_max <= 90
+	TransitDaysMin int32 `protobuf:"varint,12,opt,name=transit_days_min,proto3" json:"transit_days_min,omitempty"`
+	TransitDaysMax int32 `protobuf:"varint,13,opt,name=transit_days_max,proto3" json:"transit_days_max,omitempty"`
+	// Start of the first revision, now by default and never in the past
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=effective_from,proto3" json:"effective_from,omitempty"`
+	// End of the first revision, it is in effect until a later one starts without it
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=effective_to,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffCreateRequest) Reset() {
+	*x = TariffCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffCreateRequest) ProtoMessage() {}
+
+func (x *TariffCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffCreateRequest.ProtoReflect.Descriptor instead.
+func (*TariffCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *TariffCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetWeightBreaks() []*WeightBreak {
+	if x != nil {
+		return x.WeightBreaks
+	}
+	return nil
+}
+
+func (x *TariffCreateRequest) GetVolumetricDivisor() int32 {
+	if x != nil {
+		return x.VolumetricDivisor
+	}
+	return 0
+}
+
+func (x *TariffCreateRequest) GetDistanceBands() []*DistanceBand {
+	if x != nil {
+		return x.DistanceBands
+	}
+	return nil
+}
+
+func (x *TariffCreateRequest) GetSurcharges() []*Surcharge {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *TariffCreateRequest) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *TariffCreateRequest) GetServiceLevel() ServiceLevel {
+	if x != nil {
+		return x.ServiceLevel
+	}
+	return ServiceLevel_SERVICE_LEVEL_UNSPECIFIED
+}
+
+func (x *TariffCreateRequest) GetTransitDaysMin() int32 {
+	if x != nil {
+		return x.TransitDaysMin
+	}
+	return 0
+}
+
+func (x *TariffCreateRequest) GetTransitDaysMax() int32 {
+	if x != nil {
+		return x.TransitDaysMax
+	}
+	return 0
+}
+
+func (x *TariffCreateRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *TariffCreateRequest) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+type TariffCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tariff        *Tariff                `protobuf:"bytes,1,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffCreateResponse) Reset() {
+	*x = TariffCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffCreateResponse) ProtoMessage() {}
+
+func (x *TariffCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffCreateResponse.ProtoReflect.Descriptor instead.
+func (*TariffCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TariffCreateResponse) GetTariff() *Tariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+type TariffGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID      string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffGetRequest) Reset() {
+	*x = TariffGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffGetRequest) ProtoMessage() {}
+
+func (x *TariffGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffGetRequest.ProtoReflect.Descriptor instead.
+func (*TariffGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TariffGetRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffGetRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+type TariffGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tariff        *Tariff                `protobuf:"bytes,1,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffGetResponse) Reset() {
+	*x = TariffGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffGetResponse) ProtoMessage() {}
+
+func (x *TariffGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffGetResponse.ProtoReflect.Descriptor instead.
+func (*TariffGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *TariffGetResponse) GetTariff() *Tariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+type TariffListAllRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// Maximum number of tariffs to return, defaults to 50 and is capped at 500
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	// Token from a previous response to fetch the next page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffListAllRequest) Reset() {
+	*x = TariffListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffListAllRequest) ProtoMessage() {}
+
+func (x *TariffListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffListAllRequest.ProtoReflect.Descriptor instead.
+func (*TariffListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TariffListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffListAllRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TariffListAllRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type TariffListAllResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Tariffs []*Tariff              `protobuf:"bytes,1,rep,name=tariffs,proto3" json:"tariffs,omitempty"`
+	// Empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffListAllResponse) Reset() {
+	*x = TariffListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffListAllResponse) ProtoMessage() {}
+
+func (x *TariffListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffListAllResponse.ProtoReflect.Descriptor instead.
+func (*TariffListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *TariffListAllResponse) GetTariffs() []*Tariff {
+	if x != nil {
+		return x.Tariffs
+	}
+	return nil
+}
+
+func (x *TariffListAllResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TariffDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID      string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffDeleteRequest) Reset() {
+	*x = TariffDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffDeleteRequest) ProtoMessage() {}
+
+func (x *TariffDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffDeleteRequest.ProtoReflect.Descriptor instead.
+func (*TariffDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *TariffDeleteRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffDeleteRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+type TariffDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffDeleteResponse) Reset() {
+	*x = TariffDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffDeleteResponse) ProtoMessage() {}
+
+func (x *TariffDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffDeleteResponse.ProtoReflect.Descriptor instead.
+func (*TariffDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{113}
+}
+
+type TariffEvaluateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID  string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID    string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	Origin      *Location              `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination *Location              `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	// 1 to 50 parcels, each weighs at least a gram
+	Parcels []*ParcelDimensions `protobuf:"bytes,5,rep,name=parcels,proto3" json:"parcels,omitempty"`
+	// Prices by the revision in effect at the time, now by default
+	At            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffEvaluateRequest) Reset() {
+	*x = TariffEvaluateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffEvaluateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffEvaluateRequest) ProtoMessage() {}
+
+func (x *TariffEvaluateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffEvaluateRequest.ProtoReflect.Descriptor instead.
+func (*TariffEvaluateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TariffEvaluateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffEvaluateRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *TariffEvaluateRequest) GetOrigin() *Location {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *TariffEvaluateRequest) GetDestination() *Location {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TariffEvaluateRequest) GetParcels() []*ParcelDimensions {
+	if x != nil {
+		return x.Parcels
+	}
+	return nil
+}
+
+func (x *TariffEvaluateRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type PriceLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  PriceLineKind          `protobuf:"varint,1,opt,name=kind,proto3,enum=github.com.classydevv.fulfillment.providers.v1.PriceLineKind" json:"kind,omitempty"`
+	// Index of the parcel of a weight line
+	Parcel int32 `protobuf:"varint,2,opt,name=parcel,proto3" json:"parcel,omitempty"`
+	// Code of the surcharge of a surcharge line
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// Decimal amount rounded to minor units of the currency
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_api_providers_messages_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PriceLine) GetKind() PriceLineKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PriceLineKind_PRICE_LINE_KIND_UNSPECIFIED
+}
+
+func (x *PriceLine) GetParcel() int32 {
+	if x != nil {
+		return x.Parcel
+	}
+	return 0
+}
+
+func (x *PriceLine) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PriceLine) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Evaluated price, the amounts of the lines add up to the total
+type PriceBreakdown struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Currency string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Sum of the greater of the actual and the volumetric weight of every parcel
+	ChargeableWeightG int32 `protobuf:"varint,2,opt,name=chargeable_weight_g,proto3" json:"chargeable_weight_g,omitempty"`
+	// Great-circle distance between the origin and the destination
+	DistanceM int32        `protobuf:"varint,3,opt,name=distance_m,proto3" json:"distance_m,omitempty"`
+	Lines     []*PriceLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Total     string       `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of the tariff revision the price comes from
+	Revision      int32 `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_api_providers_messages_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PriceBreakdown) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetChargeableWeightG() int32 {
+	if x != nil {
+		return x.ChargeableWeightG
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDistanceM() int32 {
+	if x != nil {
+		return x.DistanceM
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetLines() []*PriceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type TariffEvaluateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breakdown     *PriceBreakdown        `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffEvaluateResponse) Reset() {
+	*x = TariffEvaluateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffEvaluateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffEvaluateResponse) ProtoMessage() {}
+
+func (x *TariffEvaluateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffEvaluateResponse.ProtoReflect.Descriptor instead.
+func (*TariffEvaluateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *TariffEvaluateResponse) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type QuoteDeliveryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Origin      *Location              `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination *Location              `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// 1 to 50 parcels, each weighs at least a gram
+	Parcels []*ParcelDimensions `protobuf:"bytes,3,rep,name=parcels,proto3" json:"parcels,omitempty"`
+	// Prices by the tariff revisions in effect at the time, now by default
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDeliveryRequest) Reset() {
+	*x = QuoteDeliveryRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDeliveryRequest) ProtoMessage() {}
+
+func (x *QuoteDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*QuoteDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *QuoteDeliveryRequest) GetOrigin() *Location {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *QuoteDeliveryRequest) GetDestination() *Location {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *QuoteDeliveryRequest) GetParcels() []*ParcelDimensions {
+	if x != nil {
+		return x.Parcels
+	}
+	return nil
+}
+
+func (x *QuoteDeliveryRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// Delivery time in days after the order
+type Eta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinDays       int32                  `protobuf:"varint,1,opt,name=min_days,proto3" json:"min_days,omitempty"`
+	MaxDays       int32                  `protobuf:"varint,2,opt,name=max_days,proto3" json:"max_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Eta) Reset() {
+	*x = Eta{}
+	mi := &file_api_providers_messages_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Eta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eta) ProtoMessage() {}
+
+func (x *Eta) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eta.ProtoReflect.Descriptor instead.
+func (*Eta) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Eta) GetMinDays() int32 {
+	if x != nil {
+		return x.MinDays
+	}
+	return 0
+}
+
+func (x *Eta) GetMaxDays() int32 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+// A tariff of a provider pricing the shipment
+type QuoteOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID      string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	TariffName    string                 `protobuf:"bytes,3,opt,name=tariff_name,proto3" json:"tariff_name,omitempty"`
+	ServiceLevel  ServiceLevel           `protobuf:"varint,4,opt,name=service_level,proto3,enum=github.com.classydevv.fulfillment.providers.v1.ServiceLevel" json:"service_level,omitempty"`
+	Eta           *Eta                   `protobuf:"bytes,5,opt,name=eta,proto3" json:"eta,omitempty"`
+	Price         *PriceBreakdown        `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteOption) Reset() {
+	*x = QuoteOption{}
+	mi := &file_api_providers_messages_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOption) ProtoMessage() {}
+
+func (x *QuoteOption) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOption.ProtoReflect.Descriptor instead.
+func (*QuoteOption) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *QuoteOption) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *QuoteOption) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *QuoteOption) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *QuoteOption) GetServiceLevel() ServiceLevel {
+	if x != nil {
+		return x.ServiceLevel
+	}
+	return ServiceLevel_SERVICE_LEVEL_UNSPECIFIED
+}
+
+func (x *QuoteOption) GetEta() *Eta {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *QuoteOption) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// A provider delivering to the destination without options
+type QuoteFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	Reason        QuoteFailureReason     `protobuf:"varint,2,opt,name=reason,proto3,enum=github.com.classydevv.fulfillment.providers.v1.QuoteFailureReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFailure) Reset() {
+	*x = QuoteFailure{}
+	mi := &file_api_providers_messages_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFailure) ProtoMessage() {}
+
+func (x *QuoteFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFailure.ProtoReflect.Descriptor instead.
+func (*QuoteFailure) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *QuoteFailure) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *QuoteFailure) GetReason() QuoteFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return QuoteFailureReason_QUOTE_FAILURE_REASON_UNSPECIFIED
+}
+
+type QuoteDeliveryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Grouped by currency, cheapest and then fastest first
+	Options       []*QuoteOption  `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	Failures      []*QuoteFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDeliveryResponse) Reset() {
+	*x = QuoteDeliveryResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDeliveryResponse) ProtoMessage() {}
+
+func (x *QuoteDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*QuoteDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *QuoteDeliveryResponse) GetOptions() []*QuoteOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QuoteDeliveryResponse) GetFailures() []*QuoteFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Immutable pricing rules of a tariff in effect from effective_from until effective_to
+type TariffRevision struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID   string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	// Numbered from 1 in the order of creation
+	Revision          int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	WeightBreaks      []*WeightBreak         `protobuf:"bytes,4,rep,name=weight_breaks,proto3" json:"weight_breaks,omitempty"`
+	VolumetricDivisor int32                  `protobuf:"varint,5,opt,name=volumetric_divisor,proto3" json:"volumetric_divisor,omitempty"`
+	DistanceBands     []*DistanceBand        `protobuf:"bytes,6,rep,name=distance_bands,proto3" json:"distance_bands,omitempty"`
+	Surcharges        []*Surcharge           `protobuf:"bytes,7,rep,name=surcharges,proto3" json:"surcharges,omitempty"`
+	MinPrice          string                 `protobuf:"bytes,8,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice          string                 `protobuf:"bytes,9,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	EffectiveFrom     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=effective_from,proto3" json:"effective_from,omitempty"`
+	// Absent while no later revision is scheduled
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=effective_to,proto3" json:"effective_to,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffRevision) Reset() {
+	*x = TariffRevision{}
+	mi := &file_api_providers_messages_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffRevision) ProtoMessage() {}
+
+func (x *TariffRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffRevision.ProtoReflect.Descriptor instead.
+func (*TariffRevision) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *TariffRevision) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffRevision) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *TariffRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TariffRevision) GetWeightBreaks() []*WeightBreak {
+	if x != nil {
+		return x.WeightBreaks
+	}
+	return nil
+}
+
+func (x *TariffRevision) GetVolumetricDivisor() int32 {
+	if x != nil {
+		return x.VolumetricDivisor
+	}
+	return 0
+}
+
+func (x *TariffRevision) GetDistanceBands() []*DistanceBand {
+	if x != nil {
+		return x.DistanceBands
+	}
+	return nil
+}
+
+func (x *TariffRevision) GetSurcharges() []*Surcharge {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *TariffRevision) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *TariffRevision) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *TariffRevision) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *TariffRevision) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *TariffRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TariffRevisionCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID   string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	// At most 50 breaks with distinct up_to_g
+	WeightBreaks      []*WeightBreak `protobuf:"bytes,3,rep,name=weight_breaks,proto3" json:"weight_breaks,omitempty"`
+	VolumetricDivisor int32          `protobuf:"varint,4,opt,name=volumetric_divisor,proto3" json:"volumetric_divisor,omitempty"`
+	// At most 50 bands with distinct up_to_km
+	DistanceBands []*DistanceBand `protobuf:"bytes,5,rep,name=distance_bands,proto3" json:"distance_bands,omitempty"`
+	// At most 20 surcharges with distinct codes
+	Surcharges []*Surcharge `protobuf:"bytes,6,rep,name=surcharges,proto3" json:"surcharges,omitempty"`
+	MinPrice   string       `protobuf:"bytes,7,opt,name=min_price,proto3" json:"min_price,omitempty"`
+	MaxPrice   string       `protobuf:"bytes,8,opt,name=max_price,proto3" json:"max_price,omitempty"`
+	// Now by default and never in the past. A revision in effect without an end is ended at this time,
+	// any other overlap is rejected
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=effective_from,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=effective_to,proto3" json:"effective_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffRevisionCreateRequest) Reset() {
+	*x = TariffRevisionCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffRevisionCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffRevisionCreateRequest) ProtoMessage() {}
+
+func (x *TariffRevisionCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffRevisionCreateRequest.ProtoReflect.Descriptor instead.
+func (*TariffRevisionCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *TariffRevisionCreateRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffRevisionCreateRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+func (x *TariffRevisionCreateRequest) GetWeightBreaks() []*WeightBreak {
+	if x != nil {
+		return x.WeightBreaks
+	}
+	return nil
+}
+
+func (x *TariffRevisionCreateRequest) GetVolumetricDivisor() int32 {
+	if x != nil {
+		return x.VolumetricDivisor
+	}
+	return 0
+}
+
+func (x *TariffRevisionCreateRequest) GetDistanceBands() []*DistanceBand {
+	if x != nil {
+		return x.DistanceBands
+	}
+	return nil
+}
+
+func (x *TariffRevisionCreateRequest) GetSurcharges() []*Surcharge {
+	if x != nil {
+		return x.Surcharges
+	}
+	return nil
+}
+
+func (x *TariffRevisionCreateRequest) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *TariffRevisionCreateRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *TariffRevisionCreateRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *TariffRevisionCreateRequest) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+type TariffRevisionCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *TariffRevision        `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffRevisionCreateResponse) Reset() {
+	*x = TariffRevisionCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffRevisionCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffRevisionCreateResponse) ProtoMessage() {}
+
+func (x *TariffRevisionCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffRevisionCreateResponse.ProtoReflect.Descriptor instead.
+func (*TariffRevisionCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TariffRevisionCreateResponse) GetRevision() *TariffRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type TariffRevisionListAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TariffID      string                 `protobuf:"bytes,2,opt,name=tariff_id,proto3" json:"tariff_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffRevisionListAllRequest) Reset() {
+	*x = TariffRevisionListAllRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffRevisionListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffRevisionListAllRequest) ProtoMessage() {}
+
+func (x *TariffRevisionListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffRevisionListAllRequest.ProtoReflect.Descriptor instead.
+func (*TariffRevisionListAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TariffRevisionListAllRequest) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *TariffRevisionListAllRequest) GetTariffID() string {
+	if x != nil {
+		return x.TariffID
+	}
+	return ""
+}
+
+type TariffRevisionListAllResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by revision
+	Revisions     []*TariffRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffRevisionListAllResponse) Reset() {
+	*x = TariffRevisionListAllResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffRevisionListAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffRevisionListAllResponse) ProtoMessage() {}
+
+func (x *TariffRevisionListAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffRevisionListAllResponse.ProtoReflect.Descriptor instead.
+func (*TariffRevisionListAllResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TariffRevisionListAllResponse) GetRevisions() []*TariffRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Order cut-off of a working weekday, later orders wait for the next working day
+type CutOff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO weekday, 1 is Monday and 7 is Sunday
+	Weekday int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// As "HH:MM", within the hours of the weekday
+	At            string `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CutOff) Reset() {
+	*x = CutOff{}
+	mi := &file_api_providers_messages_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CutOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CutOff) ProtoMessage() {}
+
+func (x *CutOff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CutOff.ProtoReflect.Descriptor instead.
+func (*CutOff) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CutOff) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *CutOff) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type Holiday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Local date as "YYYY-MM-DD"
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_api_providers_messages_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Working schedule of a provider, hours, cut-offs and holidays are local to time_zone
+type Calendar struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// IANA time zone, e.g. "Europe/Moscow"
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	// Weekdays without any are days off
+	Hours []*OpeningHours `protobuf:"bytes,3,rep,name=hours,proto3" json:"hours,omitempty"`
+	// Weekdays without one accept orders until closing
+	CutOffs []*CutOff `protobuf:"bytes,4,rep,name=cut_offs,proto3" json:"cut_offs,omitempty"`
+	// Ordered by date
+	Holidays      []*Holiday             `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_api_providers_messages_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Calendar) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *Calendar) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Calendar) GetHours() []*OpeningHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *Calendar) GetCutOffs() []*CutOff {
+	if x != nil {
+		return x.CutOffs
+	}
+	return nil
+}
+
+func (x *Calendar) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *Calendar) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Calendar) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CalendarCreateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TimeZone   string                 `protobuf:"bytes,2,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	// Intervals of a weekday must not overlap
+	Hours         []*OpeningHours `protobuf:"bytes,3,rep,name=hours,proto3" json:"hours,omitempty"`
+	CutOffs       []*CutOff       `protobuf:"bytes,4,rep,name=cut_offs,proto3" json:"cut_offs,omitempty"`
+	Holidays      []*Holiday      `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarCreateRequest) Reset() {
+	*x = CalendarCreateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*CalendarCreateRequest) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[0]
+func (x *CalendarCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,62 +8859,68 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CalendarCreateRequest.ProtoReflect.Descriptor instead.
+func (*CalendarCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *Provider) GetProviderID() string {
+func (x *CalendarCreateRequest) GetProviderID() string {
 	if x != nil {
 		return x.ProviderID
 	}
 	return ""
 }
 
-func (x *Provider) GetName() string {
+func (x *CalendarCreateRequest) GetTimeZone() string {
 	if x != nil {
-		return x.Name
+		return x.TimeZone
 	}
 	return ""
 }
 
-func (x *Provider) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CalendarCreateRequest) GetHours() []*OpeningHours {
 	if x != nil {
-		return x.CreatedAt
+		return x.Hours
 	}
 	return nil
 }
 
-func (x *Provider) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CalendarCreateRequest) GetCutOffs() []*CutOff {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CutOffs
 	}
 	return nil
 }
 
-type ProviderCreateRequest struct {
+func (x *CalendarCreateRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type CalendarCreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderCreateRequest) Reset() {
-	*x = ProviderCreateRequest{}
-	mi := &file_api_providers_messages_proto_msgTypes[1]
+func (x *CalendarCreateResponse) Reset() {
+	*x = CalendarCreateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderCreateRequest) String() string {
+func (x *CalendarCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderCreateRequest) ProtoMessage() {}
+func (*CalendarCreateResponse) ProtoMessage() {}
 
-func (x *ProviderCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[1]
+func (x *CalendarCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,47 +8931,40 @@ func (x *ProviderCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderCreateRequest.ProtoReflect.Descriptor instead.
-func (*ProviderCreateRequest) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *ProviderCreateRequest) GetProviderID() string {
-	if x != nil {
-		return x.ProviderID
-	}
-	return ""
+// Deprecated: Use CalendarCreateResponse.ProtoReflect.Descriptor instead.
+func (*CalendarCreateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ProviderCreateRequest) GetName() string {
+func (x *CalendarCreateResponse) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Name
+		return x.Calendar
 	}
-	return ""
+	return nil
 }
 
-type ProviderCreateResponse struct {
+type CalendarGetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderCreateResponse) Reset() {
-	*x = ProviderCreateResponse{}
-	mi := &file_api_providers_messages_proto_msgTypes[2]
+func (x *CalendarGetRequest) Reset() {
+	*x = CalendarGetRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderCreateResponse) String() string {
+func (x *CalendarGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderCreateResponse) ProtoMessage() {}
+func (*CalendarGetRequest) ProtoMessage() {}
 
-func (x *ProviderCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[2]
+func (x *CalendarGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,39 +8975,40 @@ func (x *ProviderCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderCreateResponse.ProtoReflect.Descriptor instead.
-func (*ProviderCreateResponse) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CalendarGetRequest.ProtoReflect.Descriptor instead.
+func (*CalendarGetRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ProviderCreateResponse) GetProviderID() string {
+func (x *CalendarGetRequest) GetProviderID() string {
 	if x != nil {
 		return x.ProviderID
 	}
 	return ""
 }
 
-type ProviderListAllRequest struct {
+type CalendarGetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderListAllRequest) Reset() {
-	*x = ProviderListAllRequest{}
-	mi := &file_api_providers_messages_proto_msgTypes[3]
+func (x *CalendarGetResponse) Reset() {
+	*x = CalendarGetResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderListAllRequest) String() string {
+func (x *CalendarGetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderListAllRequest) ProtoMessage() {}
+func (*CalendarGetResponse) ProtoMessage() {}
 
-func (x *ProviderListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[3]
+func (x *CalendarGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,33 +9019,45 @@ func (x *ProviderListAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderListAllRequest.ProtoReflect.Descriptor instead.
-func (*ProviderListAllRequest) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CalendarGetResponse.ProtoReflect.Descriptor instead.
+func (*CalendarGetResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{134}
 }
 
-type ProviderListAllResponse struct {
+func (x *CalendarGetResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+// Replaces the calendar as a whole
+type CalendarUpdateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Providers     []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
+	Hours         []*OpeningHours        `protobuf:"bytes,3,rep,name=hours,proto3" json:"hours,omitempty"`
+	CutOffs       []*CutOff              `protobuf:"bytes,4,rep,name=cut_offs,proto3" json:"cut_offs,omitempty"`
+	Holidays      []*Holiday             `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderListAllResponse) Reset() {
-	*x = ProviderListAllResponse{}
-	mi := &file_api_providers_messages_proto_msgTypes[4]
+func (x *CalendarUpdateRequest) Reset() {
+	*x = CalendarUpdateRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderListAllResponse) String() string {
+func (x *CalendarUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderListAllResponse) ProtoMessage() {}
+func (*CalendarUpdateRequest) ProtoMessage() {}
 
-func (x *ProviderListAllResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[4]
+func (x *CalendarUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,41 +9068,68 @@ func (x *ProviderListAllResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderListAllResponse.ProtoReflect.Descriptor instead.
-func (*ProviderListAllResponse) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CalendarUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CalendarUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *ProviderListAllResponse) GetProviders() []*Provider {
+func (x *CalendarUpdateRequest) GetProviderID() string {
 	if x != nil {
-		return x.Providers
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *CalendarUpdateRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CalendarUpdateRequest) GetHours() []*OpeningHours {
+	if x != nil {
+		return x.Hours
 	}
 	return nil
 }
 
-type ProviderUpdateRequest struct {
+func (x *CalendarUpdateRequest) GetCutOffs() []*CutOff {
+	if x != nil {
+		return x.CutOffs
+	}
+	return nil
+}
+
+func (x *CalendarUpdateRequest) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type CalendarUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderUpdateRequest) Reset() {
-	*x = ProviderUpdateRequest{}
-	mi := &file_api_providers_messages_proto_msgTypes[5]
+func (x *CalendarUpdateResponse) Reset() {
+	*x = CalendarUpdateResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderUpdateRequest) String() string {
+func (x *CalendarUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderUpdateRequest) ProtoMessage() {}
+func (*CalendarUpdateResponse) ProtoMessage() {}
 
-func (x *ProviderUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[5]
+func (x *CalendarUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,47 +9140,40 @@ func (x *ProviderUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderUpdateRequest.ProtoReflect.Descriptor instead.
-func (*ProviderUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ProviderUpdateRequest) GetProviderID() string {
-	if x != nil {
-		return x.ProviderID
-	}
-	return ""
+// Deprecated: Use CalendarUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CalendarUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ProviderUpdateRequest) GetName() string {
+func (x *CalendarUpdateResponse) GetCalendar() *Calendar {
 	if x != nil {
-		return x.Name
+		return x.Calendar
 	}
-	return ""
+	return nil
 }
 
-type ProviderUpdateResponse struct {
+type CalendarDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderUpdateResponse) Reset() {
-	*x = ProviderUpdateResponse{}
-	mi := &file_api_providers_messages_proto_msgTypes[6]
+func (x *CalendarDeleteRequest) Reset() {
+	*x = CalendarDeleteRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderUpdateResponse) String() string {
+func (x *CalendarDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderUpdateResponse) ProtoMessage() {}
+func (*CalendarDeleteRequest) ProtoMessage() {}
 
-func (x *ProviderUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[6]
+func (x *CalendarDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,40 +9184,39 @@ func (x *ProviderUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderUpdateResponse.ProtoReflect.Descriptor instead.
-func (*ProviderUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CalendarDeleteRequest.ProtoReflect.Descriptor instead.
+func (*CalendarDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *ProviderUpdateResponse) GetProvider() *Provider {
+func (x *CalendarDeleteRequest) GetProviderID() string {
 	if x != nil {
-		return x.Provider
+		return x.ProviderID
 	}
-	return nil
+	return ""
 }
 
-type ProviderDeleteRequest struct {
+type CalendarDeleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProviderID    string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderDeleteRequest) Reset() {
-	*x = ProviderDeleteRequest{}
-	mi := &file_api_providers_messages_proto_msgTypes[7]
+func (x *CalendarDeleteResponse) Reset() {
+	*x = CalendarDeleteResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderDeleteRequest) String() string {
+func (x *CalendarDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderDeleteRequest) ProtoMessage() {}
+func (*CalendarDeleteResponse) ProtoMessage() {}
 
-func (x *ProviderDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[7]
+func (x *CalendarDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,39 +9227,96 @@ func (x *ProviderDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderDeleteRequest.ProtoReflect.Descriptor instead.
-func (*ProviderDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CalendarDeleteResponse.ProtoReflect.Descriptor instead.
+func (*CalendarDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *ProviderDeleteRequest) GetProviderID() string {
+type CalendarHolidaysImportRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProviderID string                 `protobuf:"bytes,1,opt,name=provider_id,proto3" json:"provider_id,omitempty"`
+	// iCalendar (RFC 5545) file, its events become holidays on their dates in the calendar time zone.
+	// Recurring events are not supported
+	Ics string `protobuf:"bytes,2,opt,name=ics,proto3" json:"ics,omitempty"`
+	// When set, the imported holidays replace the stored ones, otherwise they are merged by date
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarHolidaysImportRequest) Reset() {
+	*x = CalendarHolidaysImportRequest{}
+	mi := &file_api_providers_messages_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarHolidaysImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarHolidaysImportRequest) ProtoMessage() {}
+
+func (x *CalendarHolidaysImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarHolidaysImportRequest.ProtoReflect.Descriptor instead.
+func (*CalendarHolidaysImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CalendarHolidaysImportRequest) GetProviderID() string {
 	if x != nil {
 		return x.ProviderID
 	}
 	return ""
 }
 
-type ProviderDeleteResponse struct {
+func (x *CalendarHolidaysImportRequest) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+func (x *CalendarHolidaysImportRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type CalendarHolidaysImportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *Calendar              `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderDeleteResponse) Reset() {
-	*x = ProviderDeleteResponse{}
-	mi := &file_api_providers_messages_proto_msgTypes[8]
+func (x *CalendarHolidaysImportResponse) Reset() {
+	*x = CalendarHolidaysImportResponse{}
+	mi := &file_api_providers_messages_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderDeleteResponse) String() string {
+func (x *CalendarHolidaysImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderDeleteResponse) ProtoMessage() {}
+func (*CalendarHolidaysImportResponse) ProtoMessage() {}
 
-func (x *ProviderDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_providers_messages_proto_msgTypes[8]
+func (x *CalendarHolidaysImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_providers_messages_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,16 +9327,23 @@ func (x *ProviderDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderDeleteResponse.ProtoReflect.Descriptor instead.
-func (*ProviderDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_api_providers_messages_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CalendarHolidaysImportResponse.ProtoReflect.Descriptor instead.
+func (*CalendarHolidaysImportResponse) Descriptor() ([]byte, []int) {
+	return file_api_providers_messages_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *CalendarHolidaysImportResponse) GetCalendar() *Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
 }
 
 var File_api_providers_messages_proto protoreflect.FileDescriptor
 
 const file_api_providers_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/providers/messages.proto\x12.github.com.classydevv.fulfillment.providers.v1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xb8\x01\n" +
+	"\x1capi/providers/messages.proto\x12.github.com.classydevv.fulfillment.providers.v1\x1a\x1fgoogle/api/field_behavior.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc6\b\n" +
 	"\bProvider\x12 \n" +
 	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
@@ -457,25 +9352,754 @@ const file_api_providers_messages_proto_rawDesc = "" +
 	"created_at\x12:\n" +
 	"\n" +
 	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updated_at\"\xab\x01\n" +
+	"updated_at\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\x12:\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleted_at\x12V\n" +
+	"\x06status\x18\a \x01(\x0e2>.github.com.classydevv.fulfillment.providers.v1.ProviderStatusR\x06status\x12$\n" +
+	"\rstatus_reason\x18\b \x01(\tR\rstatus_reason\x12_\n" +
+	"\flegal_entity\x18\t \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.LegalEntityR\flegal_entity\x12S\n" +
+	"\bcontacts\x18\n" +
+	" \x03(\v27.github.com.classydevv.fulfillment.providers.v1.ContactR\bcontacts\x12\x18\n" +
+	"\awebsite\x18\v \x01(\tR\awebsite\x12\x1c\n" +
+	"\tcountries\x18\f \x03(\tR\tcountries\x12`\n" +
+	"\fcapabilities\x18\r \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.CapabilitiesR\fcapabilities\x12p\n" +
+	"\rdisplay_names\x18\x0e \x03(\v2J.github.com.classydevv.fulfillment.providers.v1.Provider.DisplayNamesEntryR\rdisplay_names\x12\"\n" +
+	"\fdisplay_name\x18\x0f \x01(\tR\fdisplay_name\x12\\\n" +
+	"\x06labels\x18\x10 \x03(\v2D.github.com.classydevv.fulfillment.providers.v1.Provider.LabelsEntryR\x06labels\x1a?\n" +
+	"\x11DisplayNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
+	"\vLegalEntity\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06tax_id\x18\x02 \x01(\tR\x06tax_id\"I\n" +
+	"\aContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\"\xb0\x01\n" +
+	"\fCapabilities\x12\x18\n" +
+	"\acourier\x18\x01 \x01(\bR\acourier\x12$\n" +
+	"\rpickup_points\x18\x02 \x01(\bR\rpickup_points\x12\x18\n" +
+	"\alockers\x18\x03 \x01(\bR\alockers\x12\x1a\n" +
+	"\bsame_day\x18\x04 \x01(\bR\bsame_day\x12*\n" +
+	"\x10cash_on_delivery\x18\x05 \x01(\bR\x10cash_on_delivery\"\xe1\x06\n" +
 	"\x15ProviderCreateRequest\x12%\n" +
 	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name:R\x92AO\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12_\n" +
+	"\flegal_entity\x18\x03 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.LegalEntityR\flegal_entity\x12S\n" +
+	"\bcontacts\x18\x04 \x03(\v27.github.com.classydevv.fulfillment.providers.v1.ContactR\bcontacts\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\x12\x1c\n" +
+	"\tcountries\x18\x06 \x03(\tR\tcountries\x12`\n" +
+	"\fcapabilities\x18\a \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.CapabilitiesR\fcapabilities\x12}\n" +
+	"\rdisplay_names\x18\b \x03(\v2W.github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.DisplayNamesEntryR\rdisplay_names\x12i\n" +
+	"\x06labels\x18\t \x03(\v2Q.github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.LabelsEntryR\x06labels\x1a?\n" +
+	"\x11DisplayNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:R\x92AO\n" +
 	"M*\x15ProviderCreateRequest2\x1fCreates a new delivery provider\xd2\x01\vprovider_id\xd2\x01\x04name\"Y\n" +
 	"\x16ProviderCreateResponse\x12 \n" +
 	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id:\x1d\x92A\x1a\n" +
-	"\x18*\x16ProviderCreateResponse\"\x18\n" +
-	"\x16ProviderListAllRequest\"q\n" +
+	"\x18*\x16ProviderCreateResponse\";\n" +
+	"\x12ProviderGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"k\n" +
+	"\x13ProviderGetResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"\xd0\x04\n" +
+	"\x16ProviderListAllRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12 \n" +
+	"\vname_prefix\x18\x03 \x01(\tR\vname_prefix\x12@\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcreated_after\x12B\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecreated_before\x12@\n" +
+	"\rupdated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rupdated_after\x12B\n" +
+	"\x0eupdated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0eupdated_before\x12\x1a\n" +
+	"\border_by\x18\b \x01(\tR\border_by\x12*\n" +
+	"\x10include_archived\x18\t \x01(\bR\x10include_archived\x12Z\n" +
+	"\bstatuses\x18\n" +
+	" \x03(\x0e2>.github.com.classydevv.fulfillment.providers.v1.ProviderStatusR\bstatuses\x12&\n" +
+	"\x0elabel_selector\x18\v \x01(\tR\x0elabel_selector\"\x9b\x01\n" +
 	"\x17ProviderListAllResponse\x12V\n" +
-	"\tproviders\x18\x01 \x03(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\tproviders\"M\n" +
+	"\tproviders\x18\x01 \x03(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\tproviders\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"\xd5\x06\n" +
 	"\x15ProviderUpdateRequest\x12 \n" +
 	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"n\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12<\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\vupdate_mask\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12_\n" +
+	"\flegal_entity\x18\x05 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.LegalEntityR\flegal_entity\x12S\n" +
+	"\bcontacts\x18\x06 \x03(\v27.github.com.classydevv.fulfillment.providers.v1.ContactR\bcontacts\x12\x18\n" +
+	"\awebsite\x18\a \x01(\tR\awebsite\x12\x1c\n" +
+	"\tcountries\x18\b \x03(\tR\tcountries\x12`\n" +
+	"\fcapabilities\x18\t \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.CapabilitiesR\fcapabilities\x12}\n" +
+	"\rdisplay_names\x18\n" +
+	" \x03(\v2W.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.DisplayNamesEntryR\rdisplay_names\x12i\n" +
+	"\x06labels\x18\v \x03(\v2Q.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.LabelsEntryR\x06labels\x1a?\n" +
+	"\x11DisplayNamesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"n\n" +
 	"\x16ProviderUpdateResponse\x12T\n" +
-	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"9\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"M\n" +
 	"\x15ProviderDeleteRequest\x12 \n" +
-	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\"\x18\n" +
-	"\x16ProviderDeleteResponseBBZ@github.com/classydevv/fulfillment/pkg/api/providers/v1;providersb\x06proto3"
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x18\n" +
+	"\x16ProviderDeleteResponse\"?\n" +
+	"\x16ProviderRestoreRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"o\n" +
+	"\x17ProviderRestoreResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"=\n" +
+	"\x14ProviderPurgeRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"\x17\n" +
+	"\x15ProviderPurgeResponse\"@\n" +
+	"\x17ProviderActivateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"p\n" +
+	"\x18ProviderActivateResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"\\\n" +
+	"\x16ProviderSuspendRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x02R\x06reason\"o\n" +
+	"\x17ProviderSuspendResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"Y\n" +
+	"\x18ProviderTerminateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"q\n" +
+	"\x19ProviderTerminateResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"}\n" +
+	"\x16ProviderHistoryRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\xd2\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x1a\n" +
+	"\baudit_id\x18\x01 \x01(\x03R\baudit_id\x12 \n" +
+	"\vprovider_id\x18\x02 \x01(\tR\vprovider_id\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x125\n" +
+	"\told_value\x18\x04 \x01(\v2\x17.google.protobuf.StructR\told_value\x125\n" +
+	"\tnew_value\x18\x05 \x01(\v2\x17.google.protobuf.StructR\tnew_value\x12$\n" +
+	"\rclaimed_actor\x18\x06 \x01(\tR\rclaimed_actor\x12\x1e\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\n" +
+	"request_id\x12:\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\x99\x01\n" +
+	"\x17ProviderHistoryResponse\x12T\n" +
+	"\aentries\x18\x01 \x03(\v2:.github.com.classydevv.fulfillment.providers.v1.AuditEntryR\aentries\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"\x94\x01\n" +
+	"\x15ProviderImportRequest\x12\x18\n" +
+	"\adry_run\x18\x01 \x01(\bR\adry_run\x12a\n" +
+	"\bprovider\x18\x02 \x01(\v2E.github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequestR\bprovider\"\xc3\x01\n" +
+	"\x17ProviderImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12 \n" +
+	"\vprovider_id\x18\x02 \x01(\tR\vprovider_id\x12\\\n" +
+	"\x06action\x18\x03 \x01(\x0e2D.github.com.classydevv.fulfillment.providers.v1.ProviderImportActionR\x06action\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x93\x02\n" +
+	"\x16ProviderImportResponse\x12\x18\n" +
+	"\adry_run\x18\x01 \x01(\bR\adry_run\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\x05R\tunchanged\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12[\n" +
+	"\x04rows\x18\a \x03(\v2G.github.com.classydevv.fulfillment.providers.v1.ProviderImportRowResultR\x04rows\"\xc1\x01\n" +
+	"\x15ProviderExportRequest\x12 \n" +
+	"\vname_prefix\x18\x01 \x01(\tR\vname_prefix\x12*\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x10include_archived\x12Z\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2>.github.com.classydevv.fulfillment.providers.v1.ProviderStatusR\bstatuses\"n\n" +
+	"\x16ProviderExportResponse\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\"L\n" +
+	"\x15ProviderSearchRequest\x12\x15\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x01q\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\"\x82\x01\n" +
+	"\x14ProviderSearchResult\x12T\n" +
+	"\bprovider\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.ProviderR\bprovider\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"x\n" +
+	"\x16ProviderSearchResponse\x12^\n" +
+	"\aresults\x18\x01 \x03(\v2D.github.com.classydevv.fulfillment.providers.v1.ProviderSearchResultR\aresults\"\x97\x02\n" +
+	"\x04Zone\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\azone_id\x18\x02 \x01(\tR\azone_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x123\n" +
+	"\bgeometry\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bgeometry\x12:\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12\x12\n" +
+	"\x04etag\x18\a \x01(\tR\x04etag\"\x86\x02\n" +
+	"\x11ZoneCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1d\n" +
+	"\azone_id\x18\x02 \x01(\tB\x03\xe0A\x02R\azone_id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x128\n" +
+	"\bgeometry\x18\x04 \x01(\v2\x17.google.protobuf.StructB\x03\xe0A\x02R\bgeometry:X\x92AU\n" +
+	"S*\x11ZoneCreateRequest2\"Adds a delivery zone to a provider\xd2\x01\azone_id\xd2\x01\x04name\xd2\x01\bgeometry\".\n" +
+	"\x12ZoneCreateResponse\x12\x18\n" +
+	"\azone_id\x18\x01 \x01(\tR\azone_id\"V\n" +
+	"\x0eZoneGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1d\n" +
+	"\azone_id\x18\x02 \x01(\tB\x03\xe0A\x02R\azone_id\"[\n" +
+	"\x0fZoneGetResponse\x12H\n" +
+	"\x04zone\x18\x01 \x01(\v24.github.com.classydevv.fulfillment.providers.v1.ZoneR\x04zone\"y\n" +
+	"\x12ZoneListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\x8b\x01\n" +
+	"\x13ZoneListAllResponse\x12J\n" +
+	"\x05zones\x18\x01 \x03(\v24.github.com.classydevv.fulfillment.providers.v1.ZoneR\x05zones\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"\xea\x01\n" +
+	"\x11ZoneUpdateRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\azone_id\x18\x02 \x01(\tR\azone_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x123\n" +
+	"\bgeometry\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bgeometry\x12<\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\vupdate_mask\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"^\n" +
+	"\x12ZoneUpdateResponse\x12H\n" +
+	"\x04zone\x18\x01 \x01(\v24.github.com.classydevv.fulfillment.providers.v1.ZoneR\x04zone\"c\n" +
+	"\x11ZoneDeleteRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\azone_id\x18\x02 \x01(\tR\azone_id\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\x14\n" +
+	"\x12ZoneDeleteResponse\";\n" +
+	"\x15CoverageLookupRequest\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\"M\n" +
+	"\rCoverageMatch\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1a\n" +
+	"\bzone_ids\x18\x02 \x03(\tR\bzone_ids\"q\n" +
+	"\x16CoverageLookupResponse\x12W\n" +
+	"\amatches\x18\x01 \x03(\v2=.github.com.classydevv.fulfillment.providers.v1.CoverageMatchR\amatches\"\xd0\x03\n" +
+	"\x04Slot\x12\x18\n" +
+	"\aslot_id\x18\x01 \x01(\x03R\aslot_id\x12 \n" +
+	"\vprovider_id\x18\x02 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\azone_id\x18\x03 \x01(\tR\azone_id\x128\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstarts_at\x124\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aends_at\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x16\n" +
+	"\x06booked\x18\a \x01(\x05R\x06booked\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\x05R\tavailable\x128\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tclosed_at\x12:\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xe7\x02\n" +
+	"\x11SlotCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1d\n" +
+	"\azone_id\x18\x02 \x01(\tB\x03\xe0A\x02R\azone_id\x12=\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstarts_at\x129\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aends_at\x12\x1f\n" +
+	"\bcapacity\x18\x05 \x01(\x05B\x03\xe0A\x02R\bcapacity:q\x92An\n" +
+	"l*\x11SlotCreateRequest2,Adds a delivery slot to a zone of a provider\xd2\x01\azone_id\xd2\x01\tstarts_at\xd2\x01\aends_at\xd2\x01\bcapacity\"^\n" +
+	"\x12SlotCreateResponse\x12H\n" +
+	"\x04slot\x18\x01 \x01(\v24.github.com.classydevv.fulfillment.providers.v1.SlotR\x04slot\"V\n" +
+	"\x0eSlotGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1d\n" +
+	"\aslot_id\x18\x02 \x01(\x03B\x03\xe0A\x02R\aslot_id\"[\n" +
+	"\x0fSlotGetResponse\x12H\n" +
+	"\x04slot\x18\x01 \x01(\v24.github.com.classydevv.fulfillment.providers.v1.SlotR\x04slot\"\xbb\x02\n" +
+	"\x12SlotListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x18\n" +
+	"\azone_id\x18\x02 \x01(\tR\azone_id\x12<\n" +
+	"\vstarts_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vstarts_from\x12@\n" +
+	"\rstarts_before\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rstarts_before\x12&\n" +
+	"\x0einclude_closed\x18\x05 \x01(\bR\x0einclude_closed\x12\x1c\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\n" +
+	"page_token\"\x8b\x01\n" +
+	"\x13SlotListAllResponse\x12J\n" +
+	"\x05slots\x18\x01 \x03(\v24.github.com.classydevv.fulfillment.providers.v1.SlotR\x05slots\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"N\n" +
+	"\x10SlotCloseRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\aslot_id\x18\x02 \x01(\x03R\aslot_id\"]\n" +
+	"\x11SlotCloseResponse\x12H\n" +
+	"\x04slot\x18\x01 \x01(\v24.github.com.classydevv.fulfillment.providers.v1.SlotR\x04slot\"\xbb\x01\n" +
+	"\x17SlotAvailabilityRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1d\n" +
+	"\azone_id\x18\x02 \x01(\tB\x03\xe0A\x02R\azone_id\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"f\n" +
+	"\x18SlotAvailabilityResponse\x12J\n" +
+	"\x05slots\x18\x01 \x03(\v24.github.com.classydevv.fulfillment.providers.v1.SlotR\x05slots\"\x88\x03\n" +
+	"\bSlotHold\x12\x18\n" +
+	"\ahold_id\x18\x01 \x01(\tR\ahold_id\x12\x18\n" +
+	"\aslot_id\x18\x02 \x01(\x03R\aslot_id\x12 \n" +
+	"\vprovider_id\x18\x03 \x01(\tR\vprovider_id\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12V\n" +
+	"\x06status\x18\x05 \x01(\x0e2>.github.com.classydevv.fulfillment.providers.v1.SlotHoldStatusR\x06status\x12:\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expires_at\x12:\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"i\n" +
+	"\x0fSlotHoldRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\aslot_id\x18\x02 \x01(\x03R\aslot_id\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"`\n" +
+	"\x10SlotHoldResponse\x12L\n" +
+	"\x04hold\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.SlotHoldR\x04hold\"P\n" +
+	"\x12SlotConfirmRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\ahold_id\x18\x02 \x01(\tR\ahold_id\"c\n" +
+	"\x13SlotConfirmResponse\x12L\n" +
+	"\x04hold\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.SlotHoldR\x04hold\"P\n" +
+	"\x12SlotReleaseRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x18\n" +
+	"\ahold_id\x18\x02 \x01(\tR\ahold_id\"c\n" +
+	"\x13SlotReleaseResponse\x12L\n" +
+	"\x04hold\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.SlotHoldR\x04hold\"G\n" +
+	"\x0fSlotTemplateDay\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\"<\n" +
+	"\x12SlotTemplateWindow\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x95\x04\n" +
+	"\fSlotTemplate\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12 \n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\vtemplate_id\x12\x18\n" +
+	"\azone_id\x18\x03 \x01(\tR\azone_id\x12\x1c\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\ttime_zone\x12S\n" +
+	"\x04days\x18\x05 \x03(\v2?.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDayR\x04days\x12\\\n" +
+	"\awindows\x18\x06 \x03(\v2B.github.com.classydevv.fulfillment.providers.v1.SlotTemplateWindowR\awindows\x12\x1e\n" +
+	"\n" +
+	"exclusions\x18\a \x03(\tR\n" +
+	"exclusions\x12>\n" +
+	"\factivated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\factivated_at\x12:\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xa1\x04\n" +
+	"\x19SlotTemplateCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12%\n" +
+	"\vtemplate_id\x18\x02 \x01(\tB\x03\xe0A\x02R\vtemplate_id\x12\x1d\n" +
+	"\azone_id\x18\x03 \x01(\tB\x03\xe0A\x02R\azone_id\x12!\n" +
+	"\ttime_zone\x18\x04 \x01(\tB\x03\xe0A\x02R\ttime_zone\x12X\n" +
+	"\x04days\x18\x05 \x03(\v2?.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDayB\x03\xe0A\x02R\x04days\x12a\n" +
+	"\awindows\x18\x06 \x03(\v2B.github.com.classydevv.fulfillment.providers.v1.SlotTemplateWindowB\x03\xe0A\x02R\awindows\x12\x1e\n" +
+	"\n" +
+	"exclusions\x18\a \x03(\tR\n" +
+	"exclusions:\x96\x01\x92A\x92\x01\n" +
+	"\x8f\x01*\x19SlotTemplateCreateRequest2=Adds an inactive weekly slot template to a zone of a provider\xd2\x01\vtemplate_id\xd2\x01\azone_id\xd2\x01\ttime_zone\xd2\x01\x04days\xd2\x01\awindows\"v\n" +
+	"\x1aSlotTemplateCreateResponse\x12X\n" +
+	"\btemplate\x18\x01 \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.SlotTemplateR\btemplate\"f\n" +
+	"\x16SlotTemplateGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12%\n" +
+	"\vtemplate_id\x18\x02 \x01(\tB\x03\xe0A\x02R\vtemplate_id\"s\n" +
+	"\x17SlotTemplateGetResponse\x12X\n" +
+	"\btemplate\x18\x01 \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.SlotTemplateR\btemplate\"\x81\x01\n" +
+	"\x1aSlotTemplateListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\xa3\x01\n" +
+	"\x1bSlotTemplateListAllResponse\x12Z\n" +
+	"\ttemplates\x18\x01 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.SlotTemplateR\ttemplates\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"_\n" +
+	"\x19SlotTemplateDeleteRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12 \n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\vtemplate_id\"\x1c\n" +
+	"\x1aSlotTemplateDeleteResponse\"a\n" +
+	"\x1bSlotTemplateActivateRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12 \n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\vtemplate_id\"x\n" +
+	"\x1cSlotTemplateActivateResponse\x12X\n" +
+	"\btemplate\x18\x01 \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.SlotTemplateR\btemplate\"c\n" +
+	"\x1dSlotTemplateDeactivateRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12 \n" +
+	"\vtemplate_id\x18\x02 \x01(\tR\vtemplate_id\"z\n" +
+	"\x1eSlotTemplateDeactivateResponse\x12X\n" +
+	"\btemplate\x18\x01 \x01(\v2<.github.com.classydevv.fulfillment.providers.v1.SlotTemplateR\btemplate\"\xc6\x01\n" +
+	"\x1aSlotTemplatePreviewRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12%\n" +
+	"\vtemplate_id\x18\x02 \x01(\tB\x03\xe0A\x02R\vtemplate_id\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xb5\x01\n" +
+	"\rGeneratedSlot\x12\x18\n" +
+	"\azone_id\x18\x01 \x01(\tR\azone_id\x128\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstarts_at\x124\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aends_at\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\"r\n" +
+	"\x1bSlotTemplatePreviewResponse\x12S\n" +
+	"\x05slots\x18\x01 \x03(\v2=.github.com.classydevv.fulfillment.providers.v1.GeneratedSlotR\x05slots\".\n" +
+	"\bLocation\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\"V\n" +
+	"\fOpeningHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05opens\x18\x02 \x01(\tR\x05opens\x12\x16\n" +
+	"\x06closes\x18\x03 \x01(\tR\x06closes\"\x86\x01\n" +
+	"\x10ParcelDimensions\x12\x1c\n" +
+	"\tlength_cm\x18\x01 \x01(\x05R\tlength_cm\x12\x1a\n" +
+	"\bwidth_cm\x18\x02 \x01(\x05R\bwidth_cm\x12\x1c\n" +
+	"\theight_cm\x18\x03 \x01(\x05R\theight_cm\x12\x1a\n" +
+	"\bweight_g\x18\x04 \x01(\x05R\bweight_g\"\xdf\x05\n" +
+	"\vPickupPoint\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1a\n" +
+	"\bpoint_id\x18\x02 \x01(\tR\bpoint_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12S\n" +
+	"\x04type\x18\x04 \x01(\x0e2?.github.com.classydevv.fulfillment.providers.v1.PickupPointTypeR\x04type\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12T\n" +
+	"\blocation\x18\x06 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationR\blocation\x12b\n" +
+	"\ropening_hours\x18\a \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursR\ropening_hours\x12g\n" +
+	"\x0fpayment_options\x18\b \x03(\x0e2=.github.com.classydevv.fulfillment.providers.v1.PaymentOptionR\x0fpayment_options\x12`\n" +
+	"\n" +
+	"max_parcel\x18\t \x01(\v2@.github.com.classydevv.fulfillment.providers.v1.ParcelDimensionsR\n" +
+	"max_parcel\x12:\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12\x12\n" +
+	"\x04etag\x18\f \x01(\tR\x04etag\"\x88\x06\n" +
+	"\x18PickupPointCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1f\n" +
+	"\bpoint_id\x18\x02 \x01(\tB\x03\xe0A\x02R\bpoint_id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12X\n" +
+	"\x04type\x18\x04 \x01(\x0e2?.github.com.classydevv.fulfillment.providers.v1.PickupPointTypeB\x03\xe0A\x02R\x04type\x12\x1d\n" +
+	"\aaddress\x18\x05 \x01(\tB\x03\xe0A\x02R\aaddress\x12Y\n" +
+	"\blocation\x18\x06 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationB\x03\xe0A\x02R\blocation\x12b\n" +
+	"\ropening_hours\x18\a \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursR\ropening_hours\x12l\n" +
+	"\x0fpayment_options\x18\b \x03(\x0e2=.github.com.classydevv.fulfillment.providers.v1.PaymentOptionB\x03\xe0A\x02R\x0fpayment_options\x12`\n" +
+	"\n" +
+	"max_parcel\x18\t \x01(\v2@.github.com.classydevv.fulfillment.providers.v1.ParcelDimensionsR\n" +
+	"max_parcel:\x82\x01\x92A\x7f\n" +
+	"}*\x18PickupPointCreateRequest2!Adds a pickup point to a provider\xd2\x01\bpoint_id\xd2\x01\x04name\xd2\x01\x04type\xd2\x01\aaddress\xd2\x01\blocation\xd2\x01\x0fpayment_options\"|\n" +
+	"\x19PickupPointCreateResponse\x12_\n" +
+	"\fpickup_point\x18\x01 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.PickupPointR\fpickup_point\"_\n" +
+	"\x15PickupPointGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1f\n" +
+	"\bpoint_id\x18\x02 \x01(\tB\x03\xe0A\x02R\bpoint_id\"y\n" +
+	"\x16PickupPointGetResponse\x12_\n" +
+	"\fpickup_point\x18\x01 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.PickupPointR\fpickup_point\"\x80\x01\n" +
+	"\x19PickupPointListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\xa9\x01\n" +
+	"\x1aPickupPointListAllResponse\x12a\n" +
+	"\rpickup_points\x18\x01 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.PickupPointR\rpickup_points\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"\xb2\x05\n" +
+	"\x18PickupPointUpdateRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1a\n" +
+	"\bpoint_id\x18\x02 \x01(\tR\bpoint_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12S\n" +
+	"\x04type\x18\x04 \x01(\x0e2?.github.com.classydevv.fulfillment.providers.v1.PickupPointTypeR\x04type\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12T\n" +
+	"\blocation\x18\x06 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationR\blocation\x12b\n" +
+	"\ropening_hours\x18\a \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursR\ropening_hours\x12g\n" +
+	"\x0fpayment_options\x18\b \x03(\x0e2=.github.com.classydevv.fulfillment.providers.v1.PaymentOptionR\x0fpayment_options\x12`\n" +
+	"\n" +
+	"max_parcel\x18\t \x01(\v2@.github.com.classydevv.fulfillment.providers.v1.ParcelDimensionsR\n" +
+	"max_parcel\x12<\n" +
+	"\vupdate_mask\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.FieldMaskR\vupdate_mask\x12\x12\n" +
+	"\x04etag\x18\v \x01(\tR\x04etag\"|\n" +
+	"\x19PickupPointUpdateResponse\x12_\n" +
+	"\fpickup_point\x18\x01 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.PickupPointR\fpickup_point\"l\n" +
+	"\x18PickupPointDeleteRequest\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1a\n" +
+	"\bpoint_id\x18\x02 \x01(\tR\bpoint_id\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\x1b\n" +
+	"\x19PickupPointDeleteResponse\"\xe8\x01\n" +
+	"\x19PickupPointsNearbyRequest\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\"\n" +
+	"\fprovider_ids\x18\x05 \x03(\tR\fprovider_ids\x12U\n" +
+	"\x05types\x18\x06 \x03(\x0e2?.github.com.classydevv.fulfillment.providers.v1.PickupPointTypeR\x05types\"\x90\x01\n" +
+	"\x11NearbyPickupPoint\x12_\n" +
+	"\fpickup_point\x18\x01 \x01(\v2;.github.com.classydevv.fulfillment.providers.v1.PickupPointR\fpickup_point\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\"\x85\x01\n" +
+	"\x1aPickupPointsNearbyResponse\x12g\n" +
+	"\rpickup_points\x18\x01 \x03(\v2A.github.com.classydevv.fulfillment.providers.v1.NearbyPickupPointR\rpickup_points\"U\n" +
+	"\vWeightBreak\x12\x18\n" +
+	"\aup_to_g\x18\x01 \x01(\x05R\aup_to_g\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\x12\x16\n" +
+	"\x06per_kg\x18\x03 \x01(\tR\x06per_kg\"X\n" +
+	"\fDistanceBand\x12\x1a\n" +
+	"\bup_to_km\x18\x01 \x01(\x05R\bup_to_km\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\x12\x16\n" +
+	"\x06per_km\x18\x03 \x01(\tR\x06per_km\"7\n" +
+	"\tSurcharge\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\xdc\a\n" +
+	"\x06Tariff\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1c\n" +
+	"\ttariff_id\x18\x02 \x01(\tR\ttariff_id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12a\n" +
+	"\rweight_breaks\x18\x05 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.WeightBreakR\rweight_breaks\x12.\n" +
+	"\x12volumetric_divisor\x18\x06 \x01(\x05R\x12volumetric_divisor\x12d\n" +
+	"\x0edistance_bands\x18\a \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.DistanceBandR\x0edistance_bands\x12Y\n" +
+	"\n" +
+	"surcharges\x18\b \x03(\v29.github.com.classydevv.fulfillment.providers.v1.SurchargeR\n" +
+	"surcharges\x12\x1c\n" +
+	"\tmin_price\x18\t \x01(\tR\tmin_price\x12\x1c\n" +
+	"\tmax_price\x18\n" +
+	" \x01(\tR\tmax_price\x12:\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\x12b\n" +
+	"\rservice_level\x18\r \x01(\x0e2<.github.com.classydevv.fulfillment.providers.v1.ServiceLevelR\rservice_level\x12*\n" +
+	"\x10transit_days_min\x18\x0e \x01(\x05R\x10transit_days_min\x12*\n" +
+	"\x10transit_days_max\x18\x0f \x01(\x05R\x10transit_days_max\x12\x1a\n" +
+	"\brevision\x18\x10 \x01(\x05R\brevision\x12B\n" +
+	"\x0eeffective_from\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0eeffective_from\x12>\n" +
+	"\feffective_to\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\feffective_to\"\xd5\a\n" +
+	"\x13TariffCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tB\x03\xe0A\x02R\x04name\x12\x1f\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x03\xe0A\x02R\bcurrency\x12f\n" +
+	"\rweight_breaks\x18\x05 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.WeightBreakB\x03\xe0A\x02R\rweight_breaks\x12.\n" +
+	"\x12volumetric_divisor\x18\x06 \x01(\x05R\x12volumetric_divisor\x12d\n" +
+	"\x0edistance_bands\x18\a \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.DistanceBandR\x0edistance_bands\x12Y\n" +
+	"\n" +
+	"surcharges\x18\b \x03(\v29.github.com.classydevv.fulfillment.providers.v1.SurchargeR\n" +
+	"surcharges\x12\x1c\n" +
+	"\tmin_price\x18\t \x01(\tR\tmin_price\x12\x1c\n" +
+	"\tmax_price\x18\n" +
+	" \x01(\tR\tmax_price\x12b\n" +
+	"\rservice_level\x18\v \x01(\x0e2<.github.com.classydevv.fulfillment.providers.v1.ServiceLevelR\rservice_level\x12*\n" +
+	"\x10transit_days_min\x18\f \x01(\x05R\x10transit_days_min\x12*\n" +
+	"\x10transit_days_max\x18\r \x01(\x05R\x10transit_days_max\x12B\n" +
+	"\x0eeffective_from\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0eeffective_from\x12>\n" +
+	"\feffective_to\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\feffective_to:e\x92Ab\n" +
+	"`*\x13TariffCreateRequest2\x1bAdds a tariff to a provider\xd2\x01\ttariff_id\xd2\x01\x04name\xd2\x01\bcurrency\xd2\x01\rweight_breaks\"f\n" +
+	"\x14TariffCreateResponse\x12N\n" +
+	"\x06tariff\x18\x01 \x01(\v26.github.com.classydevv.fulfillment.providers.v1.TariffR\x06tariff\"\\\n" +
+	"\x10TariffGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\"c\n" +
+	"\x11TariffGetResponse\x12N\n" +
+	"\x06tariff\x18\x01 \x01(\v26.github.com.classydevv.fulfillment.providers.v1.TariffR\x06tariff\"{\n" +
+	"\x14TariffListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\x93\x01\n" +
+	"\x15TariffListAllResponse\x12P\n" +
+	"\atariffs\x18\x01 \x03(\v26.github.com.classydevv.fulfillment.providers.v1.TariffR\atariffs\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"_\n" +
+	"\x13TariffDeleteRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\"\x16\n" +
+	"\x14TariffDeleteResponse\"\x9f\x04\n" +
+	"\x15TariffEvaluateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\x12U\n" +
+	"\x06origin\x18\x03 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationB\x03\xe0A\x02R\x06origin\x12_\n" +
+	"\vdestination\x18\x04 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationB\x03\xe0A\x02R\vdestination\x12_\n" +
+	"\aparcels\x18\x05 \x03(\v2@.github.com.classydevv.fulfillment.providers.v1.ParcelDimensionsB\x03\xe0A\x02R\aparcels\x12*\n" +
+	"\x02at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02at:w\x92At\n" +
+	"r*\x15TariffEvaluateRequest28Prices parcels carried between two positions by a tariff\xd2\x01\x06origin\xd2\x01\vdestination\xd2\x01\aparcels\"\xa2\x01\n" +
+	"\tPriceLine\x12Q\n" +
+	"\x04kind\x18\x01 \x01(\x0e2=.github.com.classydevv.fulfillment.providers.v1.PriceLineKindR\x04kind\x12\x16\n" +
+	"\x06parcel\x18\x02 \x01(\x05R\x06parcel\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"\x81\x02\n" +
+	"\x0ePriceBreakdown\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x120\n" +
+	"\x13chargeable_weight_g\x18\x02 \x01(\x05R\x13chargeable_weight_g\x12\x1e\n" +
+	"\n" +
+	"distance_m\x18\x03 \x01(\x05R\n" +
+	"distance_m\x12O\n" +
+	"\x05lines\x18\x04 \x03(\v29.github.com.classydevv.fulfillment.providers.v1.PriceLineR\x05lines\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\tR\x05total\x12\x1a\n" +
+	"\brevision\x18\x06 \x01(\x05R\brevision\"v\n" +
+	"\x16TariffEvaluateResponse\x12\\\n" +
+	"\tbreakdown\x18\x01 \x01(\v2>.github.com.classydevv.fulfillment.providers.v1.PriceBreakdownR\tbreakdown\"\x81\x04\n" +
+	"\x14QuoteDeliveryRequest\x12U\n" +
+	"\x06origin\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationB\x03\xe0A\x02R\x06origin\x12_\n" +
+	"\vdestination\x18\x02 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.LocationB\x03\xe0A\x02R\vdestination\x12_\n" +
+	"\aparcels\x18\x03 \x03(\v2@.github.com.classydevv.fulfillment.providers.v1.ParcelDimensionsB\x03\xe0A\x02R\aparcels\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at:\xa3\x01\x92A\x9f\x01\n" +
+	"\x9c\x01*\x14QuoteDeliveryRequest2cPrices parcels carried between two positions by every active provider delivering to the destination\xd2\x01\x06origin\xd2\x01\vdestination\xd2\x01\aparcels\"=\n" +
+	"\x03Eta\x12\x1a\n" +
+	"\bmin_days\x18\x01 \x01(\x05R\bmin_days\x12\x1a\n" +
+	"\bmax_days\x18\x02 \x01(\x05R\bmax_days\"\xf0\x02\n" +
+	"\vQuoteOption\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1c\n" +
+	"\ttariff_id\x18\x02 \x01(\tR\ttariff_id\x12 \n" +
+	"\vtariff_name\x18\x03 \x01(\tR\vtariff_name\x12b\n" +
+	"\rservice_level\x18\x04 \x01(\x0e2<.github.com.classydevv.fulfillment.providers.v1.ServiceLevelR\rservice_level\x12E\n" +
+	"\x03eta\x18\x05 \x01(\v23.github.com.classydevv.fulfillment.providers.v1.EtaR\x03eta\x12T\n" +
+	"\x05price\x18\x06 \x01(\v2>.github.com.classydevv.fulfillment.providers.v1.PriceBreakdownR\x05price\"\x8c\x01\n" +
+	"\fQuoteFailure\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12Z\n" +
+	"\x06reason\x18\x02 \x01(\x0e2B.github.com.classydevv.fulfillment.providers.v1.QuoteFailureReasonR\x06reason\"\xc8\x01\n" +
+	"\x15QuoteDeliveryResponse\x12U\n" +
+	"\aoptions\x18\x01 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.QuoteOptionR\aoptions\x12X\n" +
+	"\bfailures\x18\x02 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.QuoteFailureR\bfailures\"\xbc\x05\n" +
+	"\x0eTariffRevision\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1c\n" +
+	"\ttariff_id\x18\x02 \x01(\tR\ttariff_id\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12a\n" +
+	"\rweight_breaks\x18\x04 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.WeightBreakR\rweight_breaks\x12.\n" +
+	"\x12volumetric_divisor\x18\x05 \x01(\x05R\x12volumetric_divisor\x12d\n" +
+	"\x0edistance_bands\x18\x06 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.DistanceBandR\x0edistance_bands\x12Y\n" +
+	"\n" +
+	"surcharges\x18\a \x03(\v29.github.com.classydevv.fulfillment.providers.v1.SurchargeR\n" +
+	"surcharges\x12\x1c\n" +
+	"\tmin_price\x18\b \x01(\tR\tmin_price\x12\x1c\n" +
+	"\tmax_price\x18\t \x01(\tR\tmax_price\x12B\n" +
+	"\x0eeffective_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0eeffective_from\x12>\n" +
+	"\feffective_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\feffective_to\x12:\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\"\xd6\x05\n" +
+	"\x1bTariffRevisionCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\x12f\n" +
+	"\rweight_breaks\x18\x03 \x03(\v2;.github.com.classydevv.fulfillment.providers.v1.WeightBreakB\x03\xe0A\x02R\rweight_breaks\x12.\n" +
+	"\x12volumetric_divisor\x18\x04 \x01(\x05R\x12volumetric_divisor\x12d\n" +
+	"\x0edistance_bands\x18\x05 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.DistanceBandR\x0edistance_bands\x12Y\n" +
+	"\n" +
+	"surcharges\x18\x06 \x03(\v29.github.com.classydevv.fulfillment.providers.v1.SurchargeR\n" +
+	"surcharges\x12\x1c\n" +
+	"\tmin_price\x18\a \x01(\tR\tmin_price\x12\x1c\n" +
+	"\tmax_price\x18\b \x01(\tR\tmax_price\x12B\n" +
+	"\x0eeffective_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0eeffective_from\x12>\n" +
+	"\feffective_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\feffective_to:T\x92AQ\n" +
+	"O*\x1bTariffRevisionCreateRequest2 Schedules a revision of a tariff\xd2\x01\rweight_breaks\"z\n" +
+	"\x1cTariffRevisionCreateResponse\x12Z\n" +
+	"\brevision\x18\x01 \x01(\v2>.github.com.classydevv.fulfillment.providers.v1.TariffRevisionR\brevision\"h\n" +
+	"\x1cTariffRevisionListAllRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttariff_id\x18\x02 \x01(\tB\x03\xe0A\x02R\ttariff_id\"}\n" +
+	"\x1dTariffRevisionListAllResponse\x12\\\n" +
+	"\trevisions\x18\x01 \x03(\v2>.github.com.classydevv.fulfillment.providers.v1.TariffRevisionR\trevisions\"2\n" +
+	"\x06CutOff\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x0e\n" +
+	"\x02at\x18\x02 \x01(\tR\x02at\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xbf\x03\n" +
+	"\bCalendar\x12 \n" +
+	"\vprovider_id\x18\x01 \x01(\tR\vprovider_id\x12\x1c\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\ttime_zone\x12R\n" +
+	"\x05hours\x18\x03 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursR\x05hours\x12R\n" +
+	"\bcut_offs\x18\x04 \x03(\v26.github.com.classydevv.fulfillment.providers.v1.CutOffR\bcut_offs\x12S\n" +
+	"\bholidays\x18\x05 \x03(\v27.github.com.classydevv.fulfillment.providers.v1.HolidayR\bholidays\x12:\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"created_at\x12:\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updated_at\"\xc1\x03\n" +
+	"\x15CalendarCreateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttime_zone\x18\x02 \x01(\tB\x03\xe0A\x02R\ttime_zone\x12W\n" +
+	"\x05hours\x18\x03 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursB\x03\xe0A\x02R\x05hours\x12R\n" +
+	"\bcut_offs\x18\x04 \x03(\v26.github.com.classydevv.fulfillment.providers.v1.CutOffR\bcut_offs\x12S\n" +
+	"\bholidays\x18\x05 \x03(\v27.github.com.classydevv.fulfillment.providers.v1.HolidayR\bholidays:\\\x92AY\n" +
+	"W*\x15CalendarCreateRequest2*Sets up the working calendar of a provider\xd2\x01\ttime_zone\xd2\x01\x05hours\"n\n" +
+	"\x16CalendarCreateResponse\x12T\n" +
+	"\bcalendar\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.CalendarR\bcalendar\";\n" +
+	"\x12CalendarGetRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"k\n" +
+	"\x13CalendarGetResponse\x12T\n" +
+	"\bcalendar\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.CalendarR\bcalendar\"\xe3\x02\n" +
+	"\x15CalendarUpdateRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12!\n" +
+	"\ttime_zone\x18\x02 \x01(\tB\x03\xe0A\x02R\ttime_zone\x12W\n" +
+	"\x05hours\x18\x03 \x03(\v2<.github.com.classydevv.fulfillment.providers.v1.OpeningHoursB\x03\xe0A\x02R\x05hours\x12R\n" +
+	"\bcut_offs\x18\x04 \x03(\v26.github.com.classydevv.fulfillment.providers.v1.CutOffR\bcut_offs\x12S\n" +
+	"\bholidays\x18\x05 \x03(\v27.github.com.classydevv.fulfillment.providers.v1.HolidayR\bholidays\"n\n" +
+	"\x16CalendarUpdateResponse\x12T\n" +
+	"\bcalendar\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.CalendarR\bcalendar\">\n" +
+	"\x15CalendarDeleteRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\"\x18\n" +
+	"\x16CalendarDeleteResponse\"w\n" +
+	"\x1dCalendarHolidaysImportRequest\x12%\n" +
+	"\vprovider_id\x18\x01 \x01(\tB\x03\xe0A\x02R\vprovider_id\x12\x15\n" +
+	"\x03ics\x18\x02 \x01(\tB\x03\xe0A\x02R\x03ics\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"v\n" +
+	"\x1eCalendarHolidaysImportResponse\x12T\n" +
+	"\bcalendar\x18\x01 \x01(\v28.github.com.classydevv.fulfillment.providers.v1.CalendarR\bcalendar*\xac\x01\n" +
+	"\x0eProviderStatus\x12\x1f\n" +
+	"\x1bPROVIDER_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aPROVIDER_STATUS_ONBOARDING\x10\x01\x12\x1a\n" +
+	"\x16PROVIDER_STATUS_ACTIVE\x10\x02\x12\x1d\n" +
+	"\x19PROVIDER_STATUS_SUSPENDED\x10\x03\x12\x1e\n" +
+	"\x1aPROVIDER_STATUS_TERMINATED\x10\x04*\xcf\x01\n" +
+	"\x14ProviderImportAction\x12&\n" +
+	"\"PROVIDER_IMPORT_ACTION_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePROVIDER_IMPORT_ACTION_CREATED\x10\x01\x12\"\n" +
+	"\x1ePROVIDER_IMPORT_ACTION_UPDATED\x10\x02\x12$\n" +
+	" PROVIDER_IMPORT_ACTION_UNCHANGED\x10\x03\x12!\n" +
+	"\x1dPROVIDER_IMPORT_ACTION_FAILED\x10\x04*\xaa\x01\n" +
+	"\x0eSlotHoldStatus\x12 \n" +
+	"\x1cSLOT_HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SLOT_HOLD_STATUS_HELD\x10\x01\x12\x1e\n" +
+	"\x1aSLOT_HOLD_STATUS_CONFIRMED\x10\x02\x12\x1d\n" +
+	"\x19SLOT_HOLD_STATUS_RELEASED\x10\x03\x12\x1c\n" +
+	"\x18SLOT_HOLD_STATUS_EXPIRED\x10\x04*\x90\x01\n" +
+	"\x0fPickupPointType\x12!\n" +
+	"\x1dPICKUP_POINT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PICKUP_POINT_TYPE_PVZ\x10\x01\x12\x1c\n" +
+	"\x18PICKUP_POINT_TYPE_LOCKER\x10\x02\x12!\n" +
+	"\x1dPICKUP_POINT_TYPE_POST_OFFICE\x10\x03*}\n" +
+	"\rPaymentOption\x12\x1e\n" +
+	"\x1aPAYMENT_OPTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PAYMENT_OPTION_PREPAID\x10\x01\x12\x17\n" +
+	"\x13PAYMENT_OPTION_CASH\x10\x02\x12\x17\n" +
+	"\x13PAYMENT_OPTION_CARD\x10\x03*\x9b\x01\n" +
+	"\fServiceLevel\x12\x1d\n" +
+	"\x19SERVICE_LEVEL_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SERVICE_LEVEL_ECONOMY\x10\x01\x12\x1a\n" +
+	"\x16SERVICE_LEVEL_STANDARD\x10\x02\x12\x19\n" +
+	"\x15SERVICE_LEVEL_EXPRESS\x10\x03\x12\x1a\n" +
+	"\x16SERVICE_LEVEL_SAME_DAY\x10\x04*\xc7\x01\n" +
+	"\rPriceLineKind\x12\x1f\n" +
+	"\x1bPRICE_LINE_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PRICE_LINE_KIND_WEIGHT\x10\x01\x12\x1c\n" +
+	"\x18PRICE_LINE_KIND_DISTANCE\x10\x02\x12\x1d\n" +
+	"\x19PRICE_LINE_KIND_SURCHARGE\x10\x03\x12\x1d\n" +
+	"\x19PRICE_LINE_KIND_MIN_PRICE\x10\x04\x12\x1d\n" +
+	"\x19PRICE_LINE_KIND_MAX_PRICE\x10\x05*\xa8\x01\n" +
+	"\x12QuoteFailureReason\x12$\n" +
+	" QUOTE_FAILURE_REASON_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cQUOTE_FAILURE_REASON_TIMEOUT\x10\x01\x12'\n" +
+	"#QUOTE_FAILURE_REASON_NOT_APPLICABLE\x10\x02\x12!\n" +
+	"\x1dQUOTE_FAILURE_REASON_INTERNAL\x10\x03BBZ@github.com/classydevv/fulfillment/pkg/api/providers/v1;providersb\x06proto3"
 
 var (
 	file_api_providers_messages_proto_rawDescOnce sync.Once
@@ -489,29 +10113,354 @@ func file_api_providers_messages_proto_rawDescGZIP() []byte {
 	return file_api_providers_messages_proto_rawDescData
 }
 
-var file_api_providers_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_providers_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_api_providers_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
 var file_api_providers_messages_proto_goTypes = []any{
-	(*Provider)(nil),                // 0: github.com.classydevv.fulfillment.providers.v1.Provider
-	(*ProviderCreateRequest)(nil),   // 1: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
-	(*ProviderCreateResponse)(nil),  // 2: github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
-	(*ProviderListAllRequest)(nil),  // 3: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
-	(*ProviderListAllResponse)(nil), // 4: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
-	(*ProviderUpdateRequest)(nil),   // 5: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
-	(*ProviderUpdateResponse)(nil),  // 6: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
-	(*ProviderDeleteRequest)(nil),   // 7: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
-	(*ProviderDeleteResponse)(nil),  // 8: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
-	(*timestamppb.Timestamp)(nil),   // 9: google.protobuf.Timestamp
+	(ProviderStatus)(0),                    // 0: github.com.classydevv.fulfillment.providers.v1.ProviderStatus
+	(ProviderImportAction)(0),              // 1: github.com.classydevv.fulfillment.providers.v1.ProviderImportAction
+	(SlotHoldStatus)(0),                    // 2: github.com.classydevv.fulfillment.providers.v1.SlotHoldStatus
+	(PickupPointType)(0),                   // 3: github.com.classydevv.fulfillment.providers.v1.PickupPointType
+	(PaymentOption)(0),                     // 4: github.com.classydevv.fulfillment.providers.v1.PaymentOption
+	(ServiceLevel)(0),                      // 5: github.com.classydevv.fulfillment.providers.v1.ServiceLevel
+	(PriceLineKind)(0),                     // 6: github.com.classydevv.fulfillment.providers.v1.PriceLineKind
+	(QuoteFailureReason)(0),                // 7: github.com.classydevv.fulfillment.providers.v1.QuoteFailureReason
+	(*Provider)(nil),                       // 8: github.com.classydevv.fulfillment.providers.v1.Provider
+	(*LegalEntity)(nil),                    // 9: github.com.classydevv.fulfillment.providers.v1.LegalEntity
+	(*Contact)(nil),                        // 10: github.com.classydevv.fulfillment.providers.v1.Contact
+	(*Capabilities)(nil),                   // 11: github.com.classydevv.fulfillment.providers.v1.Capabilities
+	(*ProviderCreateRequest)(nil),          // 12: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
+	(*ProviderCreateResponse)(nil),         // 13: github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
+	(*ProviderGetRequest)(nil),             // 14: github.com.classydevv.fulfillment.providers.v1.ProviderGetRequest
+	(*ProviderGetResponse)(nil),            // 15: github.com.classydevv.fulfillment.providers.v1.ProviderGetResponse
+	(*ProviderListAllRequest)(nil),         // 16: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
+	(*ProviderListAllResponse)(nil),        // 17: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
+	(*ProviderUpdateRequest)(nil),          // 18: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
+	(*ProviderUpdateResponse)(nil),         // 19: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
+	(*ProviderDeleteRequest)(nil),          // 20: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
+	(*ProviderDeleteResponse)(nil),         // 21: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
+	(*ProviderRestoreRequest)(nil),         // 22: github.com.classydevv.fulfillment.providers.v1.ProviderRestoreRequest
+	(*ProviderRestoreResponse)(nil),        // 23: github.com.classydevv.fulfillment.providers.v1.ProviderRestoreResponse
+	(*ProviderPurgeRequest)(nil),           // 24: github.com.classydevv.fulfillment.providers.v1.ProviderPurgeRequest
+	(*ProviderPurgeResponse)(nil),          // 25: github.com.classydevv.fulfillment.providers.v1.ProviderPurgeResponse
+	(*ProviderActivateRequest)(nil),        // 26: github.com.classydevv.fulfillment.providers.v1.ProviderActivateRequest
+	(*ProviderActivateResponse)(nil),       // 27: github.com.classydevv.fulfillment.providers.v1.ProviderActivateResponse
+	(*ProviderSuspendRequest)(nil),         // 28: github.com.classydevv.fulfillment.providers.v1.ProviderSuspendRequest
+	(*ProviderSuspendResponse)(nil),        // 29: github.com.classydevv.fulfillment.providers.v1.ProviderSuspendResponse
+	(*ProviderTerminateRequest)(nil),       // 30: github.com.classydevv.fulfillment.providers.v1.ProviderTerminateRequest
+	(*ProviderTerminateResponse)(nil),      // 31: github.com.classydevv.fulfillment.providers.v1.ProviderTerminateResponse
+	(*ProviderHistoryRequest)(nil),         // 32: github.com.classydevv.fulfillment.providers.v1.ProviderHistoryRequest
+	(*AuditEntry)(nil),                     // 33: github.com.classydevv.fulfillment.providers.v1.AuditEntry
+	(*ProviderHistoryResponse)(nil),        // 34: github.com.classydevv.fulfillment.providers.v1.ProviderHistoryResponse
+	(*ProviderImportRequest)(nil),          // 35: github.com.classydevv.fulfillment.providers.v1.ProviderImportRequest
+	(*ProviderImportRowResult)(nil),        // 36: github.com.classydevv.fulfillment.providers.v1.ProviderImportRowResult
+	(*ProviderImportResponse)(nil),         // 37: github.com.classydevv.fulfillment.providers.v1.ProviderImportResponse
+	(*ProviderExportRequest)(nil),          // 38: github.com.classydevv.fulfillment.providers.v1.ProviderExportRequest
+	(*ProviderExportResponse)(nil),         // 39: github.com.classydevv.fulfillment.providers.v1.ProviderExportResponse
+	(*ProviderSearchRequest)(nil),          // 40: github.com.classydevv.fulfillment.providers.v1.ProviderSearchRequest
+	(*ProviderSearchResult)(nil),           // 41: github.com.classydevv.fulfillment.providers.v1.ProviderSearchResult
+	(*ProviderSearchResponse)(nil),         // 42: github.com.classydevv.fulfillment.providers.v1.ProviderSearchResponse
+	(*Zone)(nil),                           // 43: github.com.classydevv.fulfillment.providers.v1.Zone
+	(*ZoneCreateRequest)(nil),              // 44: github.com.classydevv.fulfillment.providers.v1.ZoneCreateRequest
+	(*ZoneCreateResponse)(nil),             // 45: github.com.classydevv.fulfillment.providers.v1.ZoneCreateResponse
+	(*ZoneGetRequest)(nil),                 // 46: github.com.classydevv.fulfillment.providers.v1.ZoneGetRequest
+	(*ZoneGetResponse)(nil),                // 47: github.com.classydevv.fulfillment.providers.v1.ZoneGetResponse
+	(*ZoneListAllRequest)(nil),             // 48: github.com.classydevv.fulfillment.providers.v1.ZoneListAllRequest
+	(*ZoneListAllResponse)(nil),            // 49: github.com.classydevv.fulfillment.providers.v1.ZoneListAllResponse
+	(*ZoneUpdateRequest)(nil),              // 50: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest
+	(*ZoneUpdateResponse)(nil),             // 51: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateResponse
+	(*ZoneDeleteRequest)(nil),              // 52: github.com.classydevv.fulfillment.providers.v1.ZoneDeleteRequest
+	(*ZoneDeleteResponse)(nil),             // 53: github.com.classydevv.fulfillment.providers.v1.ZoneDeleteResponse
+	(*CoverageLookupRequest)(nil),          // 54: github.com.classydevv.fulfillment.providers.v1.CoverageLookupRequest
+	(*CoverageMatch)(nil),                  // 55: github.com.classydevv.fulfillment.providers.v1.CoverageMatch
+	(*CoverageLookupResponse)(nil),         // 56: github.com.classydevv.fulfillment.providers.v1.CoverageLookupResponse
+	(*Slot)(nil),                           // 57: github.com.classydevv.fulfillment.providers.v1.Slot
+	(*SlotCreateRequest)(nil),              // 58: github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest
+	(*SlotCreateResponse)(nil),             // 59: github.com.classydevv.fulfillment.providers.v1.SlotCreateResponse
+	(*SlotGetRequest)(nil),                 // 60: github.com.classydevv.fulfillment.providers.v1.SlotGetRequest
+	(*SlotGetResponse)(nil),                // 61: github.com.classydevv.fulfillment.providers.v1.SlotGetResponse
+	(*SlotListAllRequest)(nil),             // 62: github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest
+	(*SlotListAllResponse)(nil),            // 63: github.com.classydevv.fulfillment.providers.v1.SlotListAllResponse
+	(*SlotCloseRequest)(nil),               // 64: github.com.classydevv.fulfillment.providers.v1.SlotCloseRequest
+	(*SlotCloseResponse)(nil),              // 65: github.com.classydevv.fulfillment.providers.v1.SlotCloseResponse
+	(*SlotAvailabilityRequest)(nil),        // 66: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest
+	(*SlotAvailabilityResponse)(nil),       // 67: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityResponse
+	(*SlotHold)(nil),                       // 68: github.com.classydevv.fulfillment.providers.v1.SlotHold
+	(*SlotHoldRequest)(nil),                // 69: github.com.classydevv.fulfillment.providers.v1.SlotHoldRequest
+	(*SlotHoldResponse)(nil),               // 70: github.com.classydevv.fulfillment.providers.v1.SlotHoldResponse
+	(*SlotConfirmRequest)(nil),             // 71: github.com.classydevv.fulfillment.providers.v1.SlotConfirmRequest
+	(*SlotConfirmResponse)(nil),            // 72: github.com.classydevv.fulfillment.providers.v1.SlotConfirmResponse
+	(*SlotReleaseRequest)(nil),             // 73: github.com.classydevv.fulfillment.providers.v1.SlotReleaseRequest
+	(*SlotReleaseResponse)(nil),            // 74: github.com.classydevv.fulfillment.providers.v1.SlotReleaseResponse
+	(*SlotTemplateDay)(nil),                // 75: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDay
+	(*SlotTemplateWindow)(nil),             // 76: github.com.classydevv.fulfillment.providers.v1.SlotTemplateWindow
+	(*SlotTemplate)(nil),                   // 77: github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	(*SlotTemplateCreateRequest)(nil),      // 78: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest
+	(*SlotTemplateCreateResponse)(nil),     // 79: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateResponse
+	(*SlotTemplateGetRequest)(nil),         // 80: github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetRequest
+	(*SlotTemplateGetResponse)(nil),        // 81: github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetResponse
+	(*SlotTemplateListAllRequest)(nil),     // 82: github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllRequest
+	(*SlotTemplateListAllResponse)(nil),    // 83: github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllResponse
+	(*SlotTemplateDeleteRequest)(nil),      // 84: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteRequest
+	(*SlotTemplateDeleteResponse)(nil),     // 85: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteResponse
+	(*SlotTemplateActivateRequest)(nil),    // 86: github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateRequest
+	(*SlotTemplateActivateResponse)(nil),   // 87: github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateResponse
+	(*SlotTemplateDeactivateRequest)(nil),  // 88: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateRequest
+	(*SlotTemplateDeactivateResponse)(nil), // 89: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateResponse
+	(*SlotTemplatePreviewRequest)(nil),     // 90: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest
+	(*GeneratedSlot)(nil),                  // 91: github.com.classydevv.fulfillment.providers.v1.GeneratedSlot
+	(*SlotTemplatePreviewResponse)(nil),    // 92: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewResponse
+	(*Location)(nil),                       // 93: github.com.classydevv.fulfillment.providers.v1.Location
+	(*OpeningHours)(nil),                   // 94: github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	(*ParcelDimensions)(nil),               // 95: github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	(*PickupPoint)(nil),                    // 96: github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	(*PickupPointCreateRequest)(nil),       // 97: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest
+	(*PickupPointCreateResponse)(nil),      // 98: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateResponse
+	(*PickupPointGetRequest)(nil),          // 99: github.com.classydevv.fulfillment.providers.v1.PickupPointGetRequest
+	(*PickupPointGetResponse)(nil),         // 100: github.com.classydevv.fulfillment.providers.v1.PickupPointGetResponse
+	(*PickupPointListAllRequest)(nil),      // 101: github.com.classydevv.fulfillment.providers.v1.PickupPointListAllRequest
+	(*PickupPointListAllResponse)(nil),     // 102: github.com.classydevv.fulfillment.providers.v1.PickupPointListAllResponse
+	(*PickupPointUpdateRequest)(nil),       // 103: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest
+	(*PickupPointUpdateResponse)(nil),      // 104: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateResponse
+	(*PickupPointDeleteRequest)(nil),       // 105: github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteRequest
+	(*PickupPointDeleteResponse)(nil),      // 106: github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteResponse
+	(*PickupPointsNearbyRequest)(nil),      // 107: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyRequest
+	(*NearbyPickupPoint)(nil),              // 108: github.com.classydevv.fulfillment.providers.v1.NearbyPickupPoint
+	(*PickupPointsNearbyResponse)(nil),     // 109: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyResponse
+	(*WeightBreak)(nil),                    // 110: github.com.classydevv.fulfillment.providers.v1.WeightBreak
+	(*DistanceBand)(nil),                   // 111: github.com.classydevv.fulfillment.providers.v1.DistanceBand
+	(*Surcharge)(nil),                      // 112: github.com.classydevv.fulfillment.providers.v1.Surcharge
+	(*Tariff)(nil),                         // 113: github.com.classydevv.fulfillment.providers.v1.Tariff
+	(*TariffCreateRequest)(nil),            // 114: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest
+	(*TariffCreateResponse)(nil),           // 115: github.com.classydevv.fulfillment.providers.v1.TariffCreateResponse
+	(*TariffGetRequest)(nil),               // 116: github.com.classydevv.fulfillment.providers.v1.TariffGetRequest
+	(*TariffGetResponse)(nil),              // 117: github.com.classydevv.fulfillment.providers.v1.TariffGetResponse
+	(*TariffListAllRequest)(nil),           // 118: github.com.classydevv.fulfillment.providers.v1.TariffListAllRequest
+	(*TariffListAllResponse)(nil),          // 119: github.com.classydevv.fulfillment.providers.v1.TariffListAllResponse
+	(*TariffDeleteRequest)(nil),            // 120: github.com.classydevv.fulfillment.providers.v1.TariffDeleteRequest
+	(*TariffDeleteResponse)(nil),           // 121: github.com.classydevv.fulfillment.providers.v1.TariffDeleteResponse
+	(*TariffEvaluateRequest)(nil),          // 122: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest
+	(*PriceLine)(nil),                      // 123: github.com.classydevv.fulfillment.providers.v1.PriceLine
+	(*PriceBreakdown)(nil),                 // 124: github.com.classydevv.fulfillment.providers.v1.PriceBreakdown
+	(*TariffEvaluateResponse)(nil),         // 125: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateResponse
+	(*QuoteDeliveryRequest)(nil),           // 126: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest
+	(*Eta)(nil),                            // 127: github.com.classydevv.fulfillment.providers.v1.Eta
+	(*QuoteOption)(nil),                    // 128: github.com.classydevv.fulfillment.providers.v1.QuoteOption
+	(*QuoteFailure)(nil),                   // 129: github.com.classydevv.fulfillment.providers.v1.QuoteFailure
+	(*QuoteDeliveryResponse)(nil),          // 130: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse
+	(*TariffRevision)(nil),                 // 131: github.com.classydevv.fulfillment.providers.v1.TariffRevision
+	(*TariffRevisionCreateRequest)(nil),    // 132: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest
+	(*TariffRevisionCreateResponse)(nil),   // 133: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateResponse
+	(*TariffRevisionListAllRequest)(nil),   // 134: github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllRequest
+	(*TariffRevisionListAllResponse)(nil),  // 135: github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllResponse
+	(*CutOff)(nil),                         // 136: github.com.classydevv.fulfillment.providers.v1.CutOff
+	(*Holiday)(nil),                        // 137: github.com.classydevv.fulfillment.providers.v1.Holiday
+	(*Calendar)(nil),                       // 138: github.com.classydevv.fulfillment.providers.v1.Calendar
+	(*CalendarCreateRequest)(nil),          // 139: github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest
+	(*CalendarCreateResponse)(nil),         // 140: github.com.classydevv.fulfillment.providers.v1.CalendarCreateResponse
+	(*CalendarGetRequest)(nil),             // 141: github.com.classydevv.fulfillment.providers.v1.CalendarGetRequest
+	(*CalendarGetResponse)(nil),            // 142: github.com.classydevv.fulfillment.providers.v1.CalendarGetResponse
+	(*CalendarUpdateRequest)(nil),          // 143: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest
+	(*CalendarUpdateResponse)(nil),         // 144: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateResponse
+	(*CalendarDeleteRequest)(nil),          // 145: github.com.classydevv.fulfillment.providers.v1.CalendarDeleteRequest
+	(*CalendarDeleteResponse)(nil),         // 146: github.com.classydevv.fulfillment.providers.v1.CalendarDeleteResponse
+	(*CalendarHolidaysImportRequest)(nil),  // 147: github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportRequest
+	(*CalendarHolidaysImportResponse)(nil), // 148: github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportResponse
+	nil,                                    // 149: github.com.classydevv.fulfillment.providers.v1.Provider.DisplayNamesEntry
+	nil,                                    // 150: github.com.classydevv.fulfillment.providers.v1.Provider.LabelsEntry
+	nil,                                    // 151: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.DisplayNamesEntry
+	nil,                                    // 152: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.LabelsEntry
+	nil,                                    // 153: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.DisplayNamesEntry
+	nil,                                    // 154: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),          // 155: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 156: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),                // 157: google.protobuf.Struct
 }
 var file_api_providers_messages_proto_depIdxs = []int32{
-	9, // 0: github.com.classydevv.fulfillment.providers.v1.Provider.created_at:type_name -> google.protobuf.Timestamp
-	9, // 1: github.com.classydevv.fulfillment.providers.v1.Provider.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse.providers:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
-	0, // 3: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	155, // 0: github.com.classydevv.fulfillment.providers.v1.Provider.created_at:type_name -> google.protobuf.Timestamp
+	155, // 1: github.com.classydevv.fulfillment.providers.v1.Provider.updated_at:type_name -> google.protobuf.Timestamp
+	155, // 2: github.com.classydevv.fulfillment.providers.v1.Provider.deleted_at:type_name -> google.protobuf.Timestamp
+	0,   // 3: github.com.classydevv.fulfillment.providers.v1.Provider.status:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderStatus
+	9,   // 4: github.com.classydevv.fulfillment.providers.v1.Provider.legal_entity:type_name -> github.com.classydevv.fulfillment.providers.v1.LegalEntity
+	10,  // 5: github.com.classydevv.fulfillment.providers.v1.Provider.contacts:type_name -> github.com.classydevv.fulfillment.providers.v1.Contact
+	11,  // 6: github.com.classydevv.fulfillment.providers.v1.Provider.capabilities:type_name -> github.com.classydevv.fulfillment.providers.v1.Capabilities
+	149, // 7: github.com.classydevv.fulfillment.providers.v1.Provider.display_names:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider.DisplayNamesEntry
+	150, // 8: github.com.classydevv.fulfillment.providers.v1.Provider.labels:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider.LabelsEntry
+	9,   // 9: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.legal_entity:type_name -> github.com.classydevv.fulfillment.providers.v1.LegalEntity
+	10,  // 10: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.contacts:type_name -> github.com.classydevv.fulfillment.providers.v1.Contact
+	11,  // 11: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.capabilities:type_name -> github.com.classydevv.fulfillment.providers.v1.Capabilities
+	151, // 12: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.display_names:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.DisplayNamesEntry
+	152, // 13: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.labels:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest.LabelsEntry
+	8,   // 14: github.com.classydevv.fulfillment.providers.v1.ProviderGetResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	155, // 15: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest.created_after:type_name -> google.protobuf.Timestamp
+	155, // 16: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest.created_before:type_name -> google.protobuf.Timestamp
+	155, // 17: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest.updated_after:type_name -> google.protobuf.Timestamp
+	155, // 18: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest.updated_before:type_name -> google.protobuf.Timestamp
+	0,   // 19: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest.statuses:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderStatus
+	8,   // 20: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse.providers:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	156, // 21: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,   // 22: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.legal_entity:type_name -> github.com.classydevv.fulfillment.providers.v1.LegalEntity
+	10,  // 23: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.contacts:type_name -> github.com.classydevv.fulfillment.providers.v1.Contact
+	11,  // 24: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.capabilities:type_name -> github.com.classydevv.fulfillment.providers.v1.Capabilities
+	153, // 25: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.display_names:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.DisplayNamesEntry
+	154, // 26: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.labels:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest.LabelsEntry
+	8,   // 27: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	8,   // 28: github.com.classydevv.fulfillment.providers.v1.ProviderRestoreResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	8,   // 29: github.com.classydevv.fulfillment.providers.v1.ProviderActivateResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	8,   // 30: github.com.classydevv.fulfillment.providers.v1.ProviderSuspendResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	8,   // 31: github.com.classydevv.fulfillment.providers.v1.ProviderTerminateResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	157, // 32: github.com.classydevv.fulfillment.providers.v1.AuditEntry.old_value:type_name -> google.protobuf.Struct
+	157, // 33: github.com.classydevv.fulfillment.providers.v1.AuditEntry.new_value:type_name -> google.protobuf.Struct
+	155, // 34: github.com.classydevv.fulfillment.providers.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	33,  // 35: github.com.classydevv.fulfillment.providers.v1.ProviderHistoryResponse.entries:type_name -> github.com.classydevv.fulfillment.providers.v1.AuditEntry
+	12,  // 36: github.com.classydevv.fulfillment.providers.v1.ProviderImportRequest.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
+	1,   // 37: github.com.classydevv.fulfillment.providers.v1.ProviderImportRowResult.action:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderImportAction
+	36,  // 38: github.com.classydevv.fulfillment.providers.v1.ProviderImportResponse.rows:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderImportRowResult
+	0,   // 39: github.com.classydevv.fulfillment.providers.v1.ProviderExportRequest.statuses:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderStatus
+	8,   // 40: github.com.classydevv.fulfillment.providers.v1.ProviderExportResponse.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	8,   // 41: github.com.classydevv.fulfillment.providers.v1.ProviderSearchResult.provider:type_name -> github.com.classydevv.fulfillment.providers.v1.Provider
+	41,  // 42: github.com.classydevv.fulfillment.providers.v1.ProviderSearchResponse.results:type_name -> github.com.classydevv.fulfillment.providers.v1.ProviderSearchResult
+	157, // 43: github.com.classydevv.fulfillment.providers.v1.Zone.geometry:type_name -> google.protobuf.Struct
+	155, // 44: github.com.classydevv.fulfillment.providers.v1.Zone.created_at:type_name -> google.protobuf.Timestamp
+	155, // 45: github.com.classydevv.fulfillment.providers.v1.Zone.updated_at:type_name -> google.protobuf.Timestamp
+	157, // 46: github.com.classydevv.fulfillment.providers.v1.ZoneCreateRequest.geometry:type_name -> google.protobuf.Struct
+	43,  // 47: github.com.classydevv.fulfillment.providers.v1.ZoneGetResponse.zone:type_name -> github.com.classydevv.fulfillment.providers.v1.Zone
+	43,  // 48: github.com.classydevv.fulfillment.providers.v1.ZoneListAllResponse.zones:type_name -> github.com.classydevv.fulfillment.providers.v1.Zone
+	157, // 49: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest.geometry:type_name -> google.protobuf.Struct
+	156, // 50: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	43,  // 51: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateResponse.zone:type_name -> github.com.classydevv.fulfillment.providers.v1.Zone
+	55,  // 52: github.com.classydevv.fulfillment.providers.v1.CoverageLookupResponse.matches:type_name -> github.com.classydevv.fulfillment.providers.v1.CoverageMatch
+	155, // 53: github.com.classydevv.fulfillment.providers.v1.Slot.starts_at:type_name -> google.protobuf.Timestamp
+	155, // 54: github.com.classydevv.fulfillment.providers.v1.Slot.ends_at:type_name -> google.protobuf.Timestamp
+	155, // 55: github.com.classydevv.fulfillment.providers.v1.Slot.closed_at:type_name -> google.protobuf.Timestamp
+	155, // 56: github.com.classydevv.fulfillment.providers.v1.Slot.created_at:type_name -> google.protobuf.Timestamp
+	155, // 57: github.com.classydevv.fulfillment.providers.v1.Slot.updated_at:type_name -> google.protobuf.Timestamp
+	155, // 58: github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest.starts_at:type_name -> google.protobuf.Timestamp
+	155, // 59: github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest.ends_at:type_name -> google.protobuf.Timestamp
+	57,  // 60: github.com.classydevv.fulfillment.providers.v1.SlotCreateResponse.slot:type_name -> github.com.classydevv.fulfillment.providers.v1.Slot
+	57,  // 61: github.com.classydevv.fulfillment.providers.v1.SlotGetResponse.slot:type_name -> github.com.classydevv.fulfillment.providers.v1.Slot
+	155, // 62: github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest.starts_from:type_name -> google.protobuf.Timestamp
+	155, // 63: github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest.starts_before:type_name -> google.protobuf.Timestamp
+	57,  // 64: github.com.classydevv.fulfillment.providers.v1.SlotListAllResponse.slots:type_name -> github.com.classydevv.fulfillment.providers.v1.Slot
+	57,  // 65: github.com.classydevv.fulfillment.providers.v1.SlotCloseResponse.slot:type_name -> github.com.classydevv.fulfillment.providers.v1.Slot
+	155, // 66: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest.from:type_name -> google.protobuf.Timestamp
+	155, // 67: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest.to:type_name -> google.protobuf.Timestamp
+	57,  // 68: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityResponse.slots:type_name -> github.com.classydevv.fulfillment.providers.v1.Slot
+	2,   // 69: github.com.classydevv.fulfillment.providers.v1.SlotHold.status:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotHoldStatus
+	155, // 70: github.com.classydevv.fulfillment.providers.v1.SlotHold.expires_at:type_name -> google.protobuf.Timestamp
+	155, // 71: github.com.classydevv.fulfillment.providers.v1.SlotHold.created_at:type_name -> google.protobuf.Timestamp
+	155, // 72: github.com.classydevv.fulfillment.providers.v1.SlotHold.updated_at:type_name -> google.protobuf.Timestamp
+	68,  // 73: github.com.classydevv.fulfillment.providers.v1.SlotHoldResponse.hold:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotHold
+	68,  // 74: github.com.classydevv.fulfillment.providers.v1.SlotConfirmResponse.hold:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotHold
+	68,  // 75: github.com.classydevv.fulfillment.providers.v1.SlotReleaseResponse.hold:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotHold
+	75,  // 76: github.com.classydevv.fulfillment.providers.v1.SlotTemplate.days:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDay
+	76,  // 77: github.com.classydevv.fulfillment.providers.v1.SlotTemplate.windows:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateWindow
+	155, // 78: github.com.classydevv.fulfillment.providers.v1.SlotTemplate.activated_at:type_name -> google.protobuf.Timestamp
+	155, // 79: github.com.classydevv.fulfillment.providers.v1.SlotTemplate.created_at:type_name -> google.protobuf.Timestamp
+	155, // 80: github.com.classydevv.fulfillment.providers.v1.SlotTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 81: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest.days:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDay
+	76,  // 82: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest.windows:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateWindow
+	77,  // 83: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateResponse.template:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	77,  // 84: github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetResponse.template:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	77,  // 85: github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllResponse.templates:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	77,  // 86: github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateResponse.template:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	77,  // 87: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateResponse.template:type_name -> github.com.classydevv.fulfillment.providers.v1.SlotTemplate
+	155, // 88: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest.from:type_name -> google.protobuf.Timestamp
+	155, // 89: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest.to:type_name -> google.protobuf.Timestamp
+	155, // 90: github.com.classydevv.fulfillment.providers.v1.GeneratedSlot.starts_at:type_name -> google.protobuf.Timestamp
+	155, // 91: github.com.classydevv.fulfillment.providers.v1.GeneratedSlot.ends_at:type_name -> google.protobuf.Timestamp
+	91,  // 92: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewResponse.slots:type_name -> github.com.classydevv.fulfillment.providers.v1.GeneratedSlot
+	3,   // 93: github.com.classydevv.fulfillment.providers.v1.PickupPoint.type:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPointType
+	93,  // 94: github.com.classydevv.fulfillment.providers.v1.PickupPoint.location:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	94,  // 95: github.com.classydevv.fulfillment.providers.v1.PickupPoint.opening_hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	4,   // 96: github.com.classydevv.fulfillment.providers.v1.PickupPoint.payment_options:type_name -> github.com.classydevv.fulfillment.providers.v1.PaymentOption
+	95,  // 97: github.com.classydevv.fulfillment.providers.v1.PickupPoint.max_parcel:type_name -> github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	155, // 98: github.com.classydevv.fulfillment.providers.v1.PickupPoint.created_at:type_name -> google.protobuf.Timestamp
+	155, // 99: github.com.classydevv.fulfillment.providers.v1.PickupPoint.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 100: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest.type:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPointType
+	93,  // 101: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest.location:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	94,  // 102: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest.opening_hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	4,   // 103: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest.payment_options:type_name -> github.com.classydevv.fulfillment.providers.v1.PaymentOption
+	95,  // 104: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest.max_parcel:type_name -> github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	96,  // 105: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateResponse.pickup_point:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	96,  // 106: github.com.classydevv.fulfillment.providers.v1.PickupPointGetResponse.pickup_point:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	96,  // 107: github.com.classydevv.fulfillment.providers.v1.PickupPointListAllResponse.pickup_points:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	3,   // 108: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.type:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPointType
+	93,  // 109: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.location:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	94,  // 110: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.opening_hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	4,   // 111: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.payment_options:type_name -> github.com.classydevv.fulfillment.providers.v1.PaymentOption
+	95,  // 112: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.max_parcel:type_name -> github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	156, // 113: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	96,  // 114: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateResponse.pickup_point:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	3,   // 115: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyRequest.types:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPointType
+	96,  // 116: github.com.classydevv.fulfillment.providers.v1.NearbyPickupPoint.pickup_point:type_name -> github.com.classydevv.fulfillment.providers.v1.PickupPoint
+	108, // 117: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyResponse.pickup_points:type_name -> github.com.classydevv.fulfillment.providers.v1.NearbyPickupPoint
+	110, // 118: github.com.classydevv.fulfillment.providers.v1.Tariff.weight_breaks:type_name -> github.com.classydevv.fulfillment.providers.v1.WeightBreak
+	111, // 119: github.com.classydevv.fulfillment.providers.v1.Tariff.distance_bands:type_name -> github.com.classydevv.fulfillment.providers.v1.DistanceBand
+	112, // 120: github.com.classydevv.fulfillment.providers.v1.Tariff.surcharges:type_name -> github.com.classydevv.fulfillment.providers.v1.Surcharge
+	155, // 121: github.com.classydevv.fulfillment.providers.v1.Tariff.created_at:type_name -> google.protobuf.Timestamp
+	155, // 122: github.com.classydevv.fulfillment.providers.v1.Tariff.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 123: github.com.classydevv.fulfillment.providers.v1.Tariff.service_level:type_name -> github.com.classydevv.fulfillment.providers.v1.ServiceLevel
+	155, // 124: github.com.classydevv.fulfillment.providers.v1.Tariff.effective_from:type_name -> google.protobuf.Timestamp
+	155, // 125: github.com.classydevv.fulfillment.providers.v1.Tariff.effective_to:type_name -> google.protobuf.Timestamp
+	110, // 126: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.weight_breaks:type_name -> github.com.classydevv.fulfillment.providers.v1.WeightBreak
+	111, // 127: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.distance_bands:type_name -> github.com.classydevv.fulfillment.providers.v1.DistanceBand
+	112, // 128: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.surcharges:type_name -> github.com.classydevv.fulfillment.providers.v1.Surcharge
+	5,   // 129: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.service_level:type_name -> github.com.classydevv.fulfillment.providers.v1.ServiceLevel
+	155, // 130: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.effective_from:type_name -> google.protobuf.Timestamp
+	155, // 131: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest.effective_to:type_name -> google.protobuf.Timestamp
+	113, // 132: github.com.classydevv.fulfillment.providers.v1.TariffCreateResponse.tariff:type_name -> github.com.classydevv.fulfillment.providers.v1.Tariff
+	113, // 133: github.com.classydevv.fulfillment.providers.v1.TariffGetResponse.tariff:type_name -> github.com.classydevv.fulfillment.providers.v1.Tariff
+	113, // 134: github.com.classydevv.fulfillment.providers.v1.TariffListAllResponse.tariffs:type_name -> github.com.classydevv.fulfillment.providers.v1.Tariff
+	93,  // 135: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest.origin:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	93,  // 136: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest.destination:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	95,  // 137: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest.parcels:type_name -> github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	155, // 138: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest.at:type_name -> google.protobuf.Timestamp
+	6,   // 139: github.com.classydevv.fulfillment.providers.v1.PriceLine.kind:type_name -> github.com.classydevv.fulfillment.providers.v1.PriceLineKind
+	123, // 140: github.com.classydevv.fulfillment.providers.v1.PriceBreakdown.lines:type_name -> github.com.classydevv.fulfillment.providers.v1.PriceLine
+	124, // 141: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateResponse.breakdown:type_name -> github.com.classydevv.fulfillment.providers.v1.PriceBreakdown
+	93,  // 142: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest.origin:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	93,  // 143: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest.destination:type_name -> github.com.classydevv.fulfillment.providers.v1.Location
+	95,  // 144: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest.parcels:type_name -> github.com.classydevv.fulfillment.providers.v1.ParcelDimensions
+	155, // 145: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest.at:type_name -> google.protobuf.Timestamp
+	5,   // 146: github.com.classydevv.fulfillment.providers.v1.QuoteOption.service_level:type_name -> github.com.classydevv.fulfillment.providers.v1.ServiceLevel
+	127, // 147: github.com.classydevv.fulfillment.providers.v1.QuoteOption.eta:type_name -> github.com.classydevv.fulfillment.providers.v1.Eta
+	124, // 148: github.com.classydevv.fulfillment.providers.v1.QuoteOption.price:type_name -> github.com.classydevv.fulfillment.providers.v1.PriceBreakdown
+	7,   // 149: github.com.classydevv.fulfillment.providers.v1.QuoteFailure.reason:type_name -> github.com.classydevv.fulfillment.providers.v1.QuoteFailureReason
+	128, // 150: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse.options:type_name -> github.com.classydevv.fulfillment.providers.v1.QuoteOption
+	129, // 151: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse.failures:type_name -> github.com.classydevv.fulfillment.providers.v1.QuoteFailure
+	110, // 152: github.com.classydevv.fulfillment.providers.v1.TariffRevision.weight_breaks:type_name -> github.com.classydevv.fulfillment.providers.v1.WeightBreak
+	111, // 153: github.com.classydevv.fulfillment.providers.v1.TariffRevision.distance_bands:type_name -> github.com.classydevv.fulfillment.providers.v1.DistanceBand
+	112, // 154: github.com.classydevv.fulfillment.providers.v1.TariffRevision.surcharges:type_name -> github.com.classydevv.fulfillment.providers.v1.Surcharge
+	155, // 155: github.com.classydevv.fulfillment.providers.v1.TariffRevision.effective_from:type_name -> google.protobuf.Timestamp
+	155, // 156: github.com.classydevv.fulfillment.providers.v1.TariffRevision.effective_to:type_name -> google.protobuf.Timestamp
+	155, // 157: github.com.classydevv.fulfillment.providers.v1.TariffRevision.created_at:type_name -> google.protobuf.Timestamp
+	110, // 158: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest.weight_breaks:type_name -> github.com.classydevv.fulfillment.providers.v1.WeightBreak
+	111, // 159: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest.distance_bands:type_name -> github.com.classydevv.fulfillment.providers.v1.DistanceBand
+	112, // 160: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest.surcharges:type_name -> github.com.classydevv.fulfillment.providers.v1.Surcharge
+	155, // 161: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest.effective_from:type_name -> google.protobuf.Timestamp
+	155, // 162: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest.effective_to:type_name -> google.protobuf.Timestamp
+	131, // 163: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateResponse.revision:type_name -> github.com.classydevv.fulfillment.providers.v1.TariffRevision
+	131, // 164: github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllResponse.revisions:type_name -> github.com.classydevv.fulfillment.providers.v1.TariffRevision
+	94,  // 165: github.com.classydevv.fulfillment.providers.v1.Calendar.hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	136, // 166: github.com.classydevv.fulfillment.providers.v1.Calendar.cut_offs:type_name -> github.com.classydevv.fulfillment.providers.v1.CutOff
+	137, // 167: github.com.classydevv.fulfillment.providers.v1.Calendar.holidays:type_name -> github.com.classydevv.fulfillment.providers.v1.Holiday
+	155, // 168: github.com.classydevv.fulfillment.providers.v1.Calendar.created_at:type_name -> google.protobuf.Timestamp
+	155, // 169: github.com.classydevv.fulfillment.providers.v1.Calendar.updated_at:type_name -> google.protobuf.Timestamp
+	94,  // 170: github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest.hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	136, // 171: github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest.cut_offs:type_name -> github.com.classydevv.fulfillment.providers.v1.CutOff
+	137, // 172: github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest.holidays:type_name -> github.com.classydevv.fulfillment.providers.v1.Holiday
+	138, // 173: github.com.classydevv.fulfillment.providers.v1.CalendarCreateResponse.calendar:type_name -> github.com.classydevv.fulfillment.providers.v1.Calendar
+	138, // 174: github.com.classydevv.fulfillment.providers.v1.CalendarGetResponse.calendar:type_name -> github.com.classydevv.fulfillment.providers.v1.Calendar
+	94,  // 175: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest.hours:type_name -> github.com.classydevv.fulfillment.providers.v1.OpeningHours
+	136, // 176: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest.cut_offs:type_name -> github.com.classydevv.fulfillment.providers.v1.CutOff
+	137, // 177: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest.holidays:type_name -> github.com.classydevv.fulfillment.providers.v1.Holiday
+	138, // 178: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateResponse.calendar:type_name -> github.com.classydevv.fulfillment.providers.v1.Calendar
+	138, // 179: github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportResponse.calendar:type_name -> github.com.classydevv.fulfillment.providers.v1.Calendar
+	180, // [180:180] is the sub-list for method output_type
+	180, // [180:180] is the sub-list for method input_type
+	180, // [180:180] is the sub-list for extension type_name
+	180, // [180:180] is the sub-list for extension extendee
+	0,   // [0:180] is the sub-list for field type_name
 }
 
 func init() { file_api_providers_messages_proto_init() }
@@ -524,13 +10473,14 @@ func file_api_providers_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_providers_messages_proto_rawDesc), len(file_api_providers_messages_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      8,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_providers_messages_proto_goTypes,
 		DependencyIndexes: file_api_providers_messages_proto_depIdxs,
+		EnumInfos:         file_api_providers_messages_proto_enumTypes,
 		MessageInfos:      file_api_providers_messages_proto_msgTypes,
 	}.Build()
 	File_api_providers_messages_proto = out.File
@@ -26,38 +26,293 @@ var File_api_providers_service_proto protoreflect.FileDescriptor
 
 const file_api_providers_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/providers/service.proto\x12.github.com.classydevv.fulfillment.providers.v1\x1a\x1capi/providers/messages.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x9b\x06\n" +
+	"\x1bapi/providers/service.proto\x12.github.com.classydevv.fulfillment.providers.v1\x1a\x1capi/providers/messages.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xffZ\n" +
 	"\x10ProvidersService\x12\xb9\x01\n" +
-	"\x0eProviderCreate\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/providers\x12\xb9\x01\n" +
-	"\x0fProviderListAll\x12F.github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/providers\x12\xc7\x01\n" +
-	"\x0eProviderUpdate\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/providers/{provider_id}\x12\xc4\x01\n" +
-	"\x0eProviderDelete\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/providers/{provider_id}B\xc4\x01\x92A\x7f\x12y\n" +
+	"\x0eProviderCreate\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/providers\x12\xbd\x01\n" +
+	"\x0eProviderSearch\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderSearchRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderSearchResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/providers:search\x12\xbb\x01\n" +
+	"\vProviderGet\x12B.github.com.classydevv.fulfillment.providers.v1.ProviderGetRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.ProviderGetResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/providers/{provider_id}\x12\xb9\x01\n" +
+	"\x0fProviderListAll\x12F.github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/providers\x12\xe9\x01\n" +
+	"\x0eProviderUpdate\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse\"H\x82\xd3\xe4\x93\x02B:\x01*Z :\x01*2\x1b/v1/providers/{provider_id}\x1a\x1b/v1/providers/{provider_id}\x12\xc4\x01\n" +
+	"\x0eProviderDelete\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/providers/{provider_id}\x12\xd2\x01\n" +
+	"\x0fProviderRestore\x12F.github.com.classydevv.fulfillment.providers.v1.ProviderRestoreRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.ProviderRestoreResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/providers/{provider_id}:restore\x12\xca\x01\n" +
+	"\rProviderPurge\x12D.github.com.classydevv.fulfillment.providers.v1.ProviderPurgeRequest\x1aE.github.com.classydevv.fulfillment.providers.v1.ProviderPurgeResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/providers/{provider_id}:purge\x12\xd6\x01\n" +
+	"\x10ProviderActivate\x12G.github.com.classydevv.fulfillment.providers.v1.ProviderActivateRequest\x1aH.github.com.classydevv.fulfillment.providers.v1.ProviderActivateResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/providers/{provider_id}:activate\x12\xd2\x01\n" +
+	"\x0fProviderSuspend\x12F.github.com.classydevv.fulfillment.providers.v1.ProviderSuspendRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.ProviderSuspendResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/providers/{provider_id}:suspend\x12\xda\x01\n" +
+	"\x11ProviderTerminate\x12H.github.com.classydevv.fulfillment.providers.v1.ProviderTerminateRequest\x1aI.github.com.classydevv.fulfillment.providers.v1.ProviderTerminateResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/providers/{provider_id}:terminate\x12\xcf\x01\n" +
+	"\x0fProviderHistory\x12F.github.com.classydevv.fulfillment.providers.v1.ProviderHistoryRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.ProviderHistoryResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/providers/{provider_id}/history\x12\xa1\x01\n" +
+	"\x0eProviderImport\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderImportRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderImportResponse(\x01\x12\xa1\x01\n" +
+	"\x0eProviderExport\x12E.github.com.classydevv.fulfillment.providers.v1.ProviderExportRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.ProviderExportResponse0\x01\x12\xc1\x01\n" +
+	"\n" +
+	"ZoneCreate\x12A.github.com.classydevv.fulfillment.providers.v1.ZoneCreateRequest\x1aB.github.com.classydevv.fulfillment.providers.v1.ZoneCreateResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/providers/{provider_id}/zones\x12\xbf\x01\n" +
+	"\aZoneGet\x12>.github.com.classydevv.fulfillment.providers.v1.ZoneGetRequest\x1a?.github.com.classydevv.fulfillment.providers.v1.ZoneGetResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/providers/{provider_id}/zones/{zone_id}\x12\xc1\x01\n" +
+	"\vZoneListAll\x12B.github.com.classydevv.fulfillment.providers.v1.ZoneListAllRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.ZoneListAllResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/providers/{provider_id}/zones\x12\xfd\x01\n" +
+	"\n" +
+	"ZoneUpdate\x12A.github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest\x1aB.github.com.classydevv.fulfillment.providers.v1.ZoneUpdateResponse\"h\x82\xd3\xe4\x93\x02b:\x01*Z0:\x01*2+/v1/providers/{provider_id}/zones/{zone_id}\x1a+/v1/providers/{provider_id}/zones/{zone_id}\x12\xc8\x01\n" +
+	"\n" +
+	"ZoneDelete\x12A.github.com.classydevv.fulfillment.providers.v1.ZoneDeleteRequest\x1aB.github.com.classydevv.fulfillment.providers.v1.ZoneDeleteResponse\"3\x82\xd3\xe4\x93\x02-*+/v1/providers/{provider_id}/zones/{zone_id}\x12\xb5\x01\n" +
+	"\x0eCoverageLookup\x12E.github.com.classydevv.fulfillment.providers.v1.CoverageLookupRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.CoverageLookupResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/coverage\x12\xc1\x01\n" +
+	"\n" +
+	"SlotCreate\x12A.github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest\x1aB.github.com.classydevv.fulfillment.providers.v1.SlotCreateResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/providers/{provider_id}/slots\x12\xbf\x01\n" +
+	"\aSlotGet\x12>.github.com.classydevv.fulfillment.providers.v1.SlotGetRequest\x1a?.github.com.classydevv.fulfillment.providers.v1.SlotGetResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/providers/{provider_id}/slots/{slot_id}\x12\xc1\x01\n" +
+	"\vSlotListAll\x12B.github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.SlotListAllResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/providers/{provider_id}/slots\x12\xce\x01\n" +
+	"\tSlotClose\x12@.github.com.classydevv.fulfillment.providers.v1.SlotCloseRequest\x1aA.github.com.classydevv.fulfillment.providers.v1.SlotCloseResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/providers/{provider_id}/slots/{slot_id}:close\x12\xe7\x01\n" +
+	"\x10SlotAvailability\x12G.github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest\x1aH.github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityResponse\"@\x82\xd3\xe4\x93\x02:\x128/v1/providers/{provider_id}/zones/{zone_id}/availability\x12\xca\x01\n" +
+	"\bSlotHold\x12?.github.com.classydevv.fulfillment.providers.v1.SlotHoldRequest\x1a@.github.com.classydevv.fulfillment.providers.v1.SlotHoldResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/providers/{provider_id}/slots/{slot_id}:hold\x12\xd6\x01\n" +
+	"\vSlotConfirm\x12B.github.com.classydevv.fulfillment.providers.v1.SlotConfirmRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.SlotConfirmResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/providers/{provider_id}/holds/{hold_id}:confirm\x12\xd6\x01\n" +
+	"\vSlotRelease\x12B.github.com.classydevv.fulfillment.providers.v1.SlotReleaseRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.SlotReleaseResponse\">\x82\xd3\xe4\x93\x028:\x01*\"3/v1/providers/{provider_id}/holds/{hold_id}:release\x12\xe2\x01\n" +
+	"\x12SlotTemplateCreate\x12I.github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest\x1aJ.github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/providers/{provider_id}/slot-templates\x12\xe4\x01\n" +
+	"\x0fSlotTemplateGet\x12F.github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetRequest\x1aG.github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetResponse\"@\x82\xd3\xe4\x93\x02:\x128/v1/providers/{provider_id}/slot-templates/{template_id}\x12\xe2\x01\n" +
+	"\x13SlotTemplateListAll\x12J.github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllRequest\x1aK.github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/providers/{provider_id}/slot-templates\x12\xed\x01\n" +
+	"\x12SlotTemplateDelete\x12I.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteRequest\x1aJ.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteResponse\"@\x82\xd3\xe4\x93\x02:*8/v1/providers/{provider_id}/slot-templates/{template_id}\x12\xff\x01\n" +
+	"\x14SlotTemplateActivate\x12K.github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateRequest\x1aL.github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateResponse\"L\x82\xd3\xe4\x93\x02F:\x01*\"A/v1/providers/{provider_id}/slot-templates/{template_id}:activate\x12\x87\x02\n" +
+	"\x16SlotTemplateDeactivate\x12M.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateRequest\x1aN.github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateResponse\"N\x82\xd3\xe4\x93\x02H:\x01*\"C/v1/providers/{provider_id}/slot-templates/{template_id}:deactivate\x12\xf8\x01\n" +
+	"\x13SlotTemplatePreview\x12J.github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest\x1aK.github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewResponse\"H\x82\xd3\xe4\x93\x02B\x12@/v1/providers/{provider_id}/slot-templates/{template_id}:preview\x12\xde\x01\n" +
+	"\x11PickupPointCreate\x12H.github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest\x1aI.github.com.classydevv.fulfillment.providers.v1.PickupPointCreateResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/providers/{provider_id}/pickup-points\x12\xdd\x01\n" +
+	"\x0ePickupPointGet\x12E.github.com.classydevv.fulfillment.providers.v1.PickupPointGetRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.PickupPointGetResponse\"<\x82\xd3\xe4\x93\x026\x124/v1/providers/{provider_id}/pickup-points/{point_id}\x12\xde\x01\n" +
+	"\x12PickupPointListAll\x12I.github.com.classydevv.fulfillment.providers.v1.PickupPointListAllRequest\x1aJ.github.com.classydevv.fulfillment.providers.v1.PickupPointListAllResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/providers/{provider_id}/pickup-points\x12\xa4\x02\n" +
+	"\x11PickupPointUpdate\x12H.github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest\x1aI.github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateResponse\"z\x82\xd3\xe4\x93\x02t:\x01*Z9:\x01*24/v1/providers/{provider_id}/pickup-points/{point_id}\x1a4/v1/providers/{provider_id}/pickup-points/{point_id}\x12\xe6\x01\n" +
+	"\x11PickupPointDelete\x12H.github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteRequest\x1aI.github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteResponse\"<\x82\xd3\xe4\x93\x026*4/v1/providers/{provider_id}/pickup-points/{point_id}\x12\xcd\x01\n" +
+	"\x12PickupPointsNearby\x12I.github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyRequest\x1aJ.github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/pickup-points:nearby\x12\xc9\x01\n" +
+	"\fTariffCreate\x12C.github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest\x1aD.github.com.classydevv.fulfillment.providers.v1.TariffCreateResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/providers/{provider_id}/tariffs\x12\xc9\x01\n" +
+	"\tTariffGet\x12@.github.com.classydevv.fulfillment.providers.v1.TariffGetRequest\x1aA.github.com.classydevv.fulfillment.providers.v1.TariffGetResponse\"7\x82\xd3\xe4\x93\x021\x12//v1/providers/{provider_id}/tariffs/{tariff_id}\x12\xc9\x01\n" +
+	"\rTariffListAll\x12D.github.com.classydevv.fulfillment.providers.v1.TariffListAllRequest\x1aE.github.com.classydevv.fulfillment.providers.v1.TariffListAllResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/providers/{provider_id}/tariffs\x12\xd2\x01\n" +
+	"\fTariffDelete\x12C.github.com.classydevv.fulfillment.providers.v1.TariffDeleteRequest\x1aD.github.com.classydevv.fulfillment.providers.v1.TariffDeleteResponse\"7\x82\xd3\xe4\x93\x021*//v1/providers/{provider_id}/tariffs/{tariff_id}\x12\xe4\x01\n" +
+	"\x0eTariffEvaluate\x12E.github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.TariffEvaluateResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/providers/{provider_id}/tariffs/{tariff_id}:evaluate\x12\xb3\x01\n" +
+	"\rQuoteDelivery\x12D.github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest\x1aE.github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/quotes\x12\xf7\x01\n" +
+	"\x14TariffRevisionCreate\x12K.github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest\x1aL.github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/providers/{provider_id}/tariffs/{tariff_id}/revisions\x12\xf7\x01\n" +
+	"\x15TariffRevisionListAll\x12L.github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllRequest\x1aM.github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllResponse\"A\x82\xd3\xe4\x93\x02;\x129/v1/providers/{provider_id}/tariffs/{tariff_id}/revisions\x12\xd0\x01\n" +
+	"\x0eCalendarCreate\x12E.github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.CalendarCreateResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/providers/{provider_id}/calendar\x12\xc4\x01\n" +
+	"\vCalendarGet\x12B.github.com.classydevv.fulfillment.providers.v1.CalendarGetRequest\x1aC.github.com.classydevv.fulfillment.providers.v1.CalendarGetResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/providers/{provider_id}/calendar\x12\xd0\x01\n" +
+	"\x0eCalendarUpdate\x12E.github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.CalendarUpdateResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/providers/{provider_id}/calendar\x12\xcd\x01\n" +
+	"\x0eCalendarDelete\x12E.github.com.classydevv.fulfillment.providers.v1.CalendarDeleteRequest\x1aF.github.com.classydevv.fulfillment.providers.v1.CalendarDeleteResponse\",\x82\xd3\xe4\x93\x02&*$/v1/providers/{provider_id}/calendar\x12\xf8\x01\n" +
+	"\x16CalendarHolidaysImport\x12M.github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportRequest\x1aN.github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/providers/{provider_id}/calendar/holidays:importB\xc4\x01\x92A\x7f\x12y\n" +
 	"\fProvider API\x12dService to manager all provider related data: delivery zones and slots, pickup points, tariffs, etc.2\x031.0*\x02\x01\x02Z@github.com/classydevv/fulfillment/pkg/api/providers/v1;providersb\x06proto3"
 
 var file_api_providers_service_proto_goTypes = []any{
-	(*ProviderCreateRequest)(nil),   // 0: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
-	(*ProviderListAllRequest)(nil),  // 1: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
-	(*ProviderUpdateRequest)(nil),   // 2: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
-	(*ProviderDeleteRequest)(nil),   // 3: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
-	(*ProviderCreateResponse)(nil),  // 4: github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
-	(*ProviderListAllResponse)(nil), // 5: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
-	(*ProviderUpdateResponse)(nil),  // 6: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
-	(*ProviderDeleteResponse)(nil),  // 7: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
+	(*ProviderCreateRequest)(nil),          // 0: github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
+	(*ProviderSearchRequest)(nil),          // 1: github.com.classydevv.fulfillment.providers.v1.ProviderSearchRequest
+	(*ProviderGetRequest)(nil),             // 2: github.com.classydevv.fulfillment.providers.v1.ProviderGetRequest
+	(*ProviderListAllRequest)(nil),         // 3: github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
+	(*ProviderUpdateRequest)(nil),          // 4: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
+	(*ProviderDeleteRequest)(nil),          // 5: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
+	(*ProviderRestoreRequest)(nil),         // 6: github.com.classydevv.fulfillment.providers.v1.ProviderRestoreRequest
+	(*ProviderPurgeRequest)(nil),           // 7: github.com.classydevv.fulfillment.providers.v1.ProviderPurgeRequest
+	(*ProviderActivateRequest)(nil),        // 8: github.com.classydevv.fulfillment.providers.v1.ProviderActivateRequest
+	(*ProviderSuspendRequest)(nil),         // 9: github.com.classydevv.fulfillment.providers.v1.ProviderSuspendRequest
+	(*ProviderTerminateRequest)(nil),       // 10: github.com.classydevv.fulfillment.providers.v1.ProviderTerminateRequest
+	(*ProviderHistoryRequest)(nil),         // 11: github.com.classydevv.fulfillment.providers.v1.ProviderHistoryRequest
+	(*ProviderImportRequest)(nil),          // 12: github.com.classydevv.fulfillment.providers.v1.ProviderImportRequest
+	(*ProviderExportRequest)(nil),          // 13: github.com.classydevv.fulfillment.providers.v1.ProviderExportRequest
+	(*ZoneCreateRequest)(nil),              // 14: github.com.classydevv.fulfillment.providers.v1.ZoneCreateRequest
+	(*ZoneGetRequest)(nil),                 // 15: github.com.classydevv.fulfillment.providers.v1.ZoneGetRequest
+	(*ZoneListAllRequest)(nil),             // 16: github.com.classydevv.fulfillment.providers.v1.ZoneListAllRequest
+	(*ZoneUpdateRequest)(nil),              // 17: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest
+	(*ZoneDeleteRequest)(nil),              // 18: github.com.classydevv.fulfillment.providers.v1.ZoneDeleteRequest
+	(*CoverageLookupRequest)(nil),          // 19: github.com.classydevv.fulfillment.providers.v1.CoverageLookupRequest
+	(*SlotCreateRequest)(nil),              // 20: github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest
+	(*SlotGetRequest)(nil),                 // 21: github.com.classydevv.fulfillment.providers.v1.SlotGetRequest
+	(*SlotListAllRequest)(nil),             // 22: github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest
+	(*SlotCloseRequest)(nil),               // 23: github.com.classydevv.fulfillment.providers.v1.SlotCloseRequest
+	(*SlotAvailabilityRequest)(nil),        // 24: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest
+	(*SlotHoldRequest)(nil),                // 25: github.com.classydevv.fulfillment.providers.v1.SlotHoldRequest
+	(*SlotConfirmRequest)(nil),             // 26: github.com.classydevv.fulfillment.providers.v1.SlotConfirmRequest
+	(*SlotReleaseRequest)(nil),             // 27: github.com.classydevv.fulfillment.providers.v1.SlotReleaseRequest
+	(*SlotTemplateCreateRequest)(nil),      // 28: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest
+	(*SlotTemplateGetRequest)(nil),         // 29: github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetRequest
+	(*SlotTemplateListAllRequest)(nil),     // 30: github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllRequest
+	(*SlotTemplateDeleteRequest)(nil),      // 31: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteRequest
+	(*SlotTemplateActivateRequest)(nil),    // 32: github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateRequest
+	(*SlotTemplateDeactivateRequest)(nil),  // 33: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateRequest
+	(*SlotTemplatePreviewRequest)(nil),     // 34: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest
+	(*PickupPointCreateRequest)(nil),       // 35: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest
+	(*PickupPointGetRequest)(nil),          // 36: github.com.classydevv.fulfillment.providers.v1.PickupPointGetRequest
+	(*PickupPointListAllRequest)(nil),      // 37: github.com.classydevv.fulfillment.providers.v1.PickupPointListAllRequest
+	(*PickupPointUpdateRequest)(nil),       // 38: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest
+	(*PickupPointDeleteRequest)(nil),       // 39: github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteRequest
+	(*PickupPointsNearbyRequest)(nil),      // 40: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyRequest
+	(*TariffCreateRequest)(nil),            // 41: github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest
+	(*TariffGetRequest)(nil),               // 42: github.com.classydevv.fulfillment.providers.v1.TariffGetRequest
+	(*TariffListAllRequest)(nil),           // 43: github.com.classydevv.fulfillment.providers.v1.TariffListAllRequest
+	(*TariffDeleteRequest)(nil),            // 44: github.com.classydevv.fulfillment.providers.v1.TariffDeleteRequest
+	(*TariffEvaluateRequest)(nil),          // 45: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest
+	(*QuoteDeliveryRequest)(nil),           // 46: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest
+	(*TariffRevisionCreateRequest)(nil),    // 47: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest
+	(*TariffRevisionListAllRequest)(nil),   // 48: github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllRequest
+	(*CalendarCreateRequest)(nil),          // 49: github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest
+	(*CalendarGetRequest)(nil),             // 50: github.com.classydevv.fulfillment.providers.v1.CalendarGetRequest
+	(*CalendarUpdateRequest)(nil),          // 51: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest
+	(*CalendarDeleteRequest)(nil),          // 52: github.com.classydevv.fulfillment.providers.v1.CalendarDeleteRequest
+	(*CalendarHolidaysImportRequest)(nil),  // 53: github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportRequest
+	(*ProviderCreateResponse)(nil),         // 54: github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
+	(*ProviderSearchResponse)(nil),         // 55: github.com.classydevv.fulfillment.providers.v1.ProviderSearchResponse
+	(*ProviderGetResponse)(nil),            // 56: github.com.classydevv.fulfillment.providers.v1.ProviderGetResponse
+	(*ProviderListAllResponse)(nil),        // 57: github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
+	(*ProviderUpdateResponse)(nil),         // 58: github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
+	(*ProviderDeleteResponse)(nil),         // 59: github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
+	(*ProviderRestoreResponse)(nil),        // 60: github.com.classydevv.fulfillment.providers.v1.ProviderRestoreResponse
+	(*ProviderPurgeResponse)(nil),          // 61: github.com.classydevv.fulfillment.providers.v1.ProviderPurgeResponse
+	(*ProviderActivateResponse)(nil),       // 62: github.com.classydevv.fulfillment.providers.v1.ProviderActivateResponse
+	(*ProviderSuspendResponse)(nil),        // 63: github.com.classydevv.fulfillment.providers.v1.ProviderSuspendResponse
+	(*ProviderTerminateResponse)(nil),      // 64: github.com.classydevv.fulfillment.providers.v1.ProviderTerminateResponse
+	(*ProviderHistoryResponse)(nil),        // 65: github.com.classydevv.fulfillment.providers.v1.ProviderHistoryResponse
+	(*ProviderImportResponse)(nil),         // 66: github.com.classydevv.fulfillment.providers.v1.ProviderImportResponse
+	(*ProviderExportResponse)(nil),         // 67: github.com.classydevv.fulfillment.providers.v1.ProviderExportResponse
+	(*ZoneCreateResponse)(nil),             // 68: github.com.classydevv.fulfillment.providers.v1.ZoneCreateResponse
+	(*ZoneGetResponse)(nil),                // 69: github.com.classydevv.fulfillment.providers.v1.ZoneGetResponse
+	(*ZoneListAllResponse)(nil),            // 70: github.com.classydevv.fulfillment.providers.v1.ZoneListAllResponse
+	(*ZoneUpdateResponse)(nil),             // 71: github.com.classydevv.fulfillment.providers.v1.ZoneUpdateResponse
+	(*ZoneDeleteResponse)(nil),             // 72: github.com.classydevv.fulfillment.providers.v1.ZoneDeleteResponse
+	(*CoverageLookupResponse)(nil),         // 73: github.com.classydevv.fulfillment.providers.v1.CoverageLookupResponse
+	(*SlotCreateResponse)(nil),             // 74: github.com.classydevv.fulfillment.providers.v1.SlotCreateResponse
+	(*SlotGetResponse)(nil),                // 75: github.com.classydevv.fulfillment.providers.v1.SlotGetResponse
+	(*SlotListAllResponse)(nil),            // 76: github.com.classydevv.fulfillment.providers.v1.SlotListAllResponse
+	(*SlotCloseResponse)(nil),              // 77: github.com.classydevv.fulfillment.providers.v1.SlotCloseResponse
+	(*SlotAvailabilityResponse)(nil),       // 78: github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityResponse
+	(*SlotHoldResponse)(nil),               // 79: github.com.classydevv.fulfillment.providers.v1.SlotHoldResponse
+	(*SlotConfirmResponse)(nil),            // 80: github.com.classydevv.fulfillment.providers.v1.SlotConfirmResponse
+	(*SlotReleaseResponse)(nil),            // 81: github.com.classydevv.fulfillment.providers.v1.SlotReleaseResponse
+	(*SlotTemplateCreateResponse)(nil),     // 82: github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateResponse
+	(*SlotTemplateGetResponse)(nil),        // 83: github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetResponse
+	(*SlotTemplateListAllResponse)(nil),    // 84: github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllResponse
+	(*SlotTemplateDeleteResponse)(nil),     // 85: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteResponse
+	(*SlotTemplateActivateResponse)(nil),   // 86: github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateResponse
+	(*SlotTemplateDeactivateResponse)(nil), // 87: github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateResponse
+	(*SlotTemplatePreviewResponse)(nil),    // 88: github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewResponse
+	(*PickupPointCreateResponse)(nil),      // 89: github.com.classydevv.fulfillment.providers.v1.PickupPointCreateResponse
+	(*PickupPointGetResponse)(nil),         // 90: github.com.classydevv.fulfillment.providers.v1.PickupPointGetResponse
+	(*PickupPointListAllResponse)(nil),     // 91: github.com.classydevv.fulfillment.providers.v1.PickupPointListAllResponse
+	(*PickupPointUpdateResponse)(nil),      // 92: github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateResponse
+	(*PickupPointDeleteResponse)(nil),      // 93: github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteResponse
+	(*PickupPointsNearbyResponse)(nil),     // 94: github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyResponse
+	(*TariffCreateResponse)(nil),           // 95: github.com.classydevv.fulfillment.providers.v1.TariffCreateResponse
+	(*TariffGetResponse)(nil),              // 96: github.com.classydevv.fulfillment.providers.v1.TariffGetResponse
+	(*TariffListAllResponse)(nil),          // 97: github.com.classydevv.fulfillment.providers.v1.TariffListAllResponse
+	(*TariffDeleteResponse)(nil),           // 98: github.com.classydevv.fulfillment.providers.v1.TariffDeleteResponse
+	(*TariffEvaluateResponse)(nil),         // 99: github.com.classydevv.fulfillment.providers.v1.TariffEvaluateResponse
+	(*QuoteDeliveryResponse)(nil),          // 100: github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse
+	(*TariffRevisionCreateResponse)(nil),   // 101: github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateResponse
+	(*TariffRevisionListAllResponse)(nil),  // 102: github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllResponse
+	(*CalendarCreateResponse)(nil),         // 103: github.com.classydevv.fulfillment.providers.v1.CalendarCreateResponse
+	(*CalendarGetResponse)(nil),            // 104: github.com.classydevv.fulfillment.providers.v1.CalendarGetResponse
+	(*CalendarUpdateResponse)(nil),         // 105: github.com.classydevv.fulfillment.providers.v1.CalendarUpdateResponse
+	(*CalendarDeleteResponse)(nil),         // 106: github.com.classydevv.fulfillment.providers.v1.CalendarDeleteResponse
+	(*CalendarHolidaysImportResponse)(nil), // 107: github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportResponse
 }
 var file_api_providers_service_proto_depIdxs = []int32{
-	0, // 0: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
-	1, // 1: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
-	2, // 2: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderUpdate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
-	3, // 3: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
-	4, // 4: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
-	5, // 5: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
-	6, // 6: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderUpdate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
-	7, // 7: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateRequest
+	1,   // 1: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderSearch:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderSearchRequest
+	2,   // 2: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderGet:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderGetRequest
+	3,   // 3: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderListAllRequest
+	4,   // 4: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderUpdate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateRequest
+	5,   // 5: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderDeleteRequest
+	6,   // 6: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderRestore:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderRestoreRequest
+	7,   // 7: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderPurge:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderPurgeRequest
+	8,   // 8: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderActivate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderActivateRequest
+	9,   // 9: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderSuspend:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderSuspendRequest
+	10,  // 10: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderTerminate:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderTerminateRequest
+	11,  // 11: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderHistory:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderHistoryRequest
+	12,  // 12: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderImport:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderImportRequest
+	13,  // 13: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderExport:input_type -> github.com.classydevv.fulfillment.providers.v1.ProviderExportRequest
+	14,  // 14: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.ZoneCreateRequest
+	15,  // 15: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneGet:input_type -> github.com.classydevv.fulfillment.providers.v1.ZoneGetRequest
+	16,  // 16: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.ZoneListAllRequest
+	17,  // 17: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneUpdate:input_type -> github.com.classydevv.fulfillment.providers.v1.ZoneUpdateRequest
+	18,  // 18: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.ZoneDeleteRequest
+	19,  // 19: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CoverageLookup:input_type -> github.com.classydevv.fulfillment.providers.v1.CoverageLookupRequest
+	20,  // 20: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotCreateRequest
+	21,  // 21: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotGet:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotGetRequest
+	22,  // 22: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotListAllRequest
+	23,  // 23: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotClose:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotCloseRequest
+	24,  // 24: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotAvailability:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityRequest
+	25,  // 25: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotHold:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotHoldRequest
+	26,  // 26: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotConfirm:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotConfirmRequest
+	27,  // 27: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotRelease:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotReleaseRequest
+	28,  // 28: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateRequest
+	29,  // 29: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateGet:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetRequest
+	30,  // 30: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllRequest
+	31,  // 31: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteRequest
+	32,  // 32: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateActivate:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateRequest
+	33,  // 33: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateDeactivate:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateRequest
+	34,  // 34: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplatePreview:input_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewRequest
+	35,  // 35: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointCreateRequest
+	36,  // 36: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointGet:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointGetRequest
+	37,  // 37: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointListAllRequest
+	38,  // 38: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointUpdate:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateRequest
+	39,  // 39: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteRequest
+	40,  // 40: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointsNearby:input_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyRequest
+	41,  // 41: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffCreateRequest
+	42,  // 42: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffGet:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffGetRequest
+	43,  // 43: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffListAllRequest
+	44,  // 44: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffDeleteRequest
+	45,  // 45: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffEvaluate:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffEvaluateRequest
+	46,  // 46: github.com.classydevv.fulfillment.providers.v1.ProvidersService.QuoteDelivery:input_type -> github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryRequest
+	47,  // 47: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffRevisionCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateRequest
+	48,  // 48: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffRevisionListAll:input_type -> github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllRequest
+	49,  // 49: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarCreate:input_type -> github.com.classydevv.fulfillment.providers.v1.CalendarCreateRequest
+	50,  // 50: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarGet:input_type -> github.com.classydevv.fulfillment.providers.v1.CalendarGetRequest
+	51,  // 51: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarUpdate:input_type -> github.com.classydevv.fulfillment.providers.v1.CalendarUpdateRequest
+	52,  // 52: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarDelete:input_type -> github.com.classydevv.fulfillment.providers.v1.CalendarDeleteRequest
+	53,  // 53: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarHolidaysImport:input_type -> github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportRequest
+	54,  // 54: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderCreateResponse
+	55,  // 55: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderSearch:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderSearchResponse
+	56,  // 56: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderGet:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderGetResponse
+	57,  // 57: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderListAllResponse
+	58,  // 58: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderUpdate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderUpdateResponse
+	59,  // 59: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderDeleteResponse
+	60,  // 60: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderRestore:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderRestoreResponse
+	61,  // 61: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderPurge:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderPurgeResponse
+	62,  // 62: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderActivate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderActivateResponse
+	63,  // 63: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderSuspend:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderSuspendResponse
+	64,  // 64: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderTerminate:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderTerminateResponse
+	65,  // 65: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderHistory:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderHistoryResponse
+	66,  // 66: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderImport:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderImportResponse
+	67,  // 67: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ProviderExport:output_type -> github.com.classydevv.fulfillment.providers.v1.ProviderExportResponse
+	68,  // 68: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.ZoneCreateResponse
+	69,  // 69: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneGet:output_type -> github.com.classydevv.fulfillment.providers.v1.ZoneGetResponse
+	70,  // 70: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.ZoneListAllResponse
+	71,  // 71: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneUpdate:output_type -> github.com.classydevv.fulfillment.providers.v1.ZoneUpdateResponse
+	72,  // 72: github.com.classydevv.fulfillment.providers.v1.ProvidersService.ZoneDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.ZoneDeleteResponse
+	73,  // 73: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CoverageLookup:output_type -> github.com.classydevv.fulfillment.providers.v1.CoverageLookupResponse
+	74,  // 74: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotCreateResponse
+	75,  // 75: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotGet:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotGetResponse
+	76,  // 76: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotListAllResponse
+	77,  // 77: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotClose:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotCloseResponse
+	78,  // 78: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotAvailability:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotAvailabilityResponse
+	79,  // 79: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotHold:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotHoldResponse
+	80,  // 80: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotConfirm:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotConfirmResponse
+	81,  // 81: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotRelease:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotReleaseResponse
+	82,  // 82: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateCreateResponse
+	83,  // 83: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateGet:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateGetResponse
+	84,  // 84: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateListAllResponse
+	85,  // 85: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeleteResponse
+	86,  // 86: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateActivate:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateActivateResponse
+	87,  // 87: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplateDeactivate:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplateDeactivateResponse
+	88,  // 88: github.com.classydevv.fulfillment.providers.v1.ProvidersService.SlotTemplatePreview:output_type -> github.com.classydevv.fulfillment.providers.v1.SlotTemplatePreviewResponse
+	89,  // 89: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointCreateResponse
+	90,  // 90: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointGet:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointGetResponse
+	91,  // 91: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointListAllResponse
+	92,  // 92: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointUpdate:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointUpdateResponse
+	93,  // 93: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointDeleteResponse
+	94,  // 94: github.com.classydevv.fulfillment.providers.v1.ProvidersService.PickupPointsNearby:output_type -> github.com.classydevv.fulfillment.providers.v1.PickupPointsNearbyResponse
+	95,  // 95: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffCreateResponse
+	96,  // 96: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffGet:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffGetResponse
+	97,  // 97: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffListAllResponse
+	98,  // 98: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffDeleteResponse
+	99,  // 99: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffEvaluate:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffEvaluateResponse
+	100, // 100: github.com.classydevv.fulfillment.providers.v1.ProvidersService.QuoteDelivery:output_type -> github.com.classydevv.fulfillment.providers.v1.QuoteDeliveryResponse
+	101, // 101: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffRevisionCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffRevisionCreateResponse
+	102, // 102: github.com.classydevv.fulfillment.providers.v1.ProvidersService.TariffRevisionListAll:output_type -> github.com.classydevv.fulfillment.providers.v1.TariffRevisionListAllResponse
+	103, // 103: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarCreate:output_type -> github.com.classydevv.fulfillment.providers.v1.CalendarCreateResponse
+	104, // 104: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarGet:output_type -> github.com.classydevv.fulfillment.providers.v1.CalendarGetResponse
+	105, // 105: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarUpdate:output_type -> github.com.classydevv.fulfillment.providers.v1.CalendarUpdateResponse
+	106, // 106: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarDelete:output_type -> github.com.classydevv.fulfillment.providers.v1.CalendarDeleteResponse
+	107, // 107: github.com.classydevv.fulfillment.providers.v1.ProvidersService.CalendarHolidaysImport:output_type -> github.com.classydevv.fulfillment.providers.v1.CalendarHolidaysImportResponse
+	54,  // [54:108] is the sub-list for method output_type
+	0,   // [0:54] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_api_providers_service_proto_init() }
@@ -19,10 +19,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProvidersService_ProviderCreate_FullMethodName  = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderCreate"
-	ProvidersService_ProviderListAll_FullMethodName = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderListAll"
-	ProvidersService_ProviderUpdate_FullMethodName  = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderUpdate"
-	ProvidersService_ProviderDelete_FullMethodName  = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderDelete"
+	ProvidersService_ProviderCreate_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderCreate"
+	ProvidersService_ProviderSearch_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderSearch"
+	ProvidersService_ProviderGet_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderGet"
+	ProvidersService_ProviderListAll_FullMethodName        = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderListAll"
+	ProvidersService_ProviderUpdate_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderUpdate"
+	ProvidersService_ProviderDelete_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderDelete"
+	ProvidersService_ProviderRestore_FullMethodName        = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderRestore"
+	ProvidersService_ProviderPurge_FullMethodName          = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderPurge"
+	ProvidersService_ProviderActivate_FullMethodName       = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderActivate"
+	ProvidersService_ProviderSuspend_FullMethodName        = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderSuspend"
+	ProvidersService_ProviderTerminate_FullMethodName      = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderTerminate"
+	ProvidersService_ProviderHistory_FullMethodName        = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderHistory"
+	ProvidersService_ProviderImport_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderImport"
+	ProvidersService_ProviderExport_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ProviderExport"
+	ProvidersService_ZoneCreate_FullMethodName             = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ZoneCreate"
+	ProvidersService_ZoneGet_FullMethodName                = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ZoneGet"
+	ProvidersService_ZoneListAll_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ZoneListAll"
+	ProvidersService_ZoneUpdate_FullMethodName             = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ZoneUpdate"
+	ProvidersService_ZoneDelete_FullMethodName             = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/ZoneDelete"
+	ProvidersService_CoverageLookup_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CoverageLookup"
+	ProvidersService_SlotCreate_FullMethodName             = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotCreate"
+	ProvidersService_SlotGet_FullMethodName                = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotGet"
+	ProvidersService_SlotListAll_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotListAll"
+	ProvidersService_SlotClose_FullMethodName              = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotClose"
+	ProvidersService_SlotAvailability_FullMethodName       = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotAvailability"
+	ProvidersService_SlotHold_FullMethodName               = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotHold"
+	ProvidersService_SlotConfirm_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotConfirm"
+	ProvidersService_SlotRelease_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotRelease"
+	ProvidersService_SlotTemplateCreate_FullMethodName     = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateCreate"
+	ProvidersService_SlotTemplateGet_FullMethodName        = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateGet"
+	ProvidersService_SlotTemplateListAll_FullMethodName    = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateListAll"
+	ProvidersService_SlotTemplateDelete_FullMethodName     = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateDelete"
+	ProvidersService_SlotTemplateActivate_FullMethodName   = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateActivate"
+	ProvidersService_SlotTemplateDeactivate_FullMethodName = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplateDeactivate"
+	ProvidersService_SlotTemplatePreview_FullMethodName    = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/SlotTemplatePreview"
+	ProvidersService_PickupPointCreate_FullMethodName      = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointCreate"
+	ProvidersService_PickupPointGet_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointGet"
+	ProvidersService_PickupPointListAll_FullMethodName     = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointListAll"
+	ProvidersService_PickupPointUpdate_FullMethodName      = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointUpdate"
+	ProvidersService_PickupPointDelete_FullMethodName      = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointDelete"
+	ProvidersService_PickupPointsNearby_FullMethodName     = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/PickupPointsNearby"
+	ProvidersService_TariffCreate_FullMethodName           = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffCreate"
+	ProvidersService_TariffGet_FullMethodName              = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffGet"
+	ProvidersService_TariffListAll_FullMethodName          = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffListAll"
+	ProvidersService_TariffDelete_FullMethodName           = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffDelete"
+	ProvidersService_TariffEvaluate_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffEvaluate"
+	ProvidersService_QuoteDelivery_FullMethodName          = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/QuoteDelivery"
+	ProvidersService_TariffRevisionCreate_FullMethodName   = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffRevisionCreate"
+	ProvidersService_TariffRevisionListAll_FullMethodName  = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/TariffRevisionListAll"
+	ProvidersService_CalendarCreate_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CalendarCreate"
+	ProvidersService_CalendarGet_FullMethodName            = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CalendarGet"
+	ProvidersService_CalendarUpdate_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CalendarUpdate"
+	ProvidersService_CalendarDelete_FullMethodName         = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CalendarDelete"
+	ProvidersService_CalendarHolidaysImport_FullMethodName = "/github.com.classydevv.fulfillment.providers.v1.ProvidersService/CalendarHolidaysImport"
 )
 
 // ProvidersServiceClient is the client API for ProvidersService service.
@@ -33,12 +83,114 @@ const (
 type ProvidersServiceClient interface {
 	// Create a provider
 	ProviderCreate(ctx context.Context, in *ProviderCreateRequest, opts ...grpc.CallOption) (*ProviderCreateResponse, error)
+	// Find providers by name, best matches first
+	ProviderSearch(ctx context.Context, in *ProviderSearchRequest, opts ...grpc.CallOption) (*ProviderSearchResponse, error)
+	// Get a provider by its ID
+	ProviderGet(ctx context.Context, in *ProviderGetRequest, opts ...grpc.CallOption) (*ProviderGetResponse, error)
 	// List all providers
 	ProviderListAll(ctx context.Context, in *ProviderListAllRequest, opts ...grpc.CallOption) (*ProviderListAllResponse, error)
-	// Update a provider
+	// Update a provider, fully or partially according to update_mask
 	ProviderUpdate(ctx context.Context, in *ProviderUpdateRequest, opts ...grpc.CallOption) (*ProviderUpdateResponse, error)
-	// Delete a provider
+	// Archive a provider, it can be restored later
 	ProviderDelete(ctx context.Context, in *ProviderDeleteRequest, opts ...grpc.CallOption) (*ProviderDeleteResponse, error)
+	// Restore an archived provider, one that is not archived is returned unchanged
+	ProviderRestore(ctx context.Context, in *ProviderRestoreRequest, opts ...grpc.CallOption) (*ProviderRestoreResponse, error)
+	// Delete a provider permanently, requires the admin token
+	ProviderPurge(ctx context.Context, in *ProviderPurgeRequest, opts ...grpc.CallOption) (*ProviderPurgeResponse, error)
+	// Activate an onboarding or suspended provider
+	ProviderActivate(ctx context.Context, in *ProviderActivateRequest, opts ...grpc.CallOption) (*ProviderActivateResponse, error)
+	// Suspend an active provider, routing ignores suspended providers
+	ProviderSuspend(ctx context.Context, in *ProviderSuspendRequest, opts ...grpc.CallOption) (*ProviderSuspendResponse, error)
+	// Terminate a provider, this is final
+	ProviderTerminate(ctx context.Context, in *ProviderTerminateRequest, opts ...grpc.CallOption) (*ProviderTerminateResponse, error)
+	// List recorded changes of a provider, newest first
+	ProviderHistory(ctx context.Context, in *ProviderHistoryRequest, opts ...grpc.CallOption) (*ProviderHistoryResponse, error)
+	// Create or update providers in bulk, fields left empty keep their stored values. The first message may set
+	// dry_run, every message carries one provider. The import is applied in a single transaction only if all rows succeed
+	ProviderImport(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ProviderImportRequest, ProviderImportResponse], error)
+	// Stream all providers matching the filter ordered by provider_id, one per message
+	ProviderExport(ctx context.Context, in *ProviderExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProviderExportResponse], error)
+	// Add a delivery zone to a provider
+	ZoneCreate(ctx context.Context, in *ZoneCreateRequest, opts ...grpc.CallOption) (*ZoneCreateResponse, error)
+	// Get a delivery zone by its ID
+	ZoneGet(ctx context.Context, in *ZoneGetRequest, opts ...grpc.CallOption) (*ZoneGetResponse, error)
+	// List delivery zones of a provider ordered by zone_id
+	ZoneListAll(ctx context.Context, in *ZoneListAllRequest, opts ...grpc.CallOption) (*ZoneListAllResponse, error)
+	// Update a delivery zone, fully or partially according to update_mask
+	ZoneUpdate(ctx context.Context, in *ZoneUpdateRequest, opts ...grpc.CallOption) (*ZoneUpdateResponse, error)
+	// Delete a delivery zone
+	ZoneDelete(ctx context.Context, in *ZoneDeleteRequest, opts ...grpc.CallOption) (*ZoneDeleteResponse, error)
+	// Find active providers delivering to a position, served from memory and refreshed when zones change
+	CoverageLookup(ctx context.Context, in *CoverageLookupRequest, opts ...grpc.CallOption) (*CoverageLookupResponse, error)
+	// Add a delivery slot to a zone of a provider
+	SlotCreate(ctx context.Context, in *SlotCreateRequest, opts ...grpc.CallOption) (*SlotCreateResponse, error)
+	// Get a delivery slot by its ID
+	SlotGet(ctx context.Context, in *SlotGetRequest, opts ...grpc.CallOption) (*SlotGetResponse, error)
+	// List delivery slots of a provider ordered by starts_at
+	SlotListAll(ctx context.Context, in *SlotListAllRequest, opts ...grpc.CallOption) (*SlotListAllResponse, error)
+	// Stop bookings of a delivery slot, existing bookings are kept
+	SlotClose(ctx context.Context, in *SlotCloseRequest, opts ...grpc.CallOption) (*SlotCloseResponse, error)
+	// List bookable slots of a zone within a period
+	SlotAvailability(ctx context.Context, in *SlotAvailabilityRequest, opts ...grpc.CallOption) (*SlotAvailabilityResponse, error)
+	// Hold capacity of a slot at checkout, the hold expires unless confirmed in time
+	SlotHold(ctx context.Context, in *SlotHoldRequest, opts ...grpc.CallOption) (*SlotHoldResponse, error)
+	// Turn an unexpired hold into a booking
+	SlotConfirm(ctx context.Context, in *SlotConfirmRequest, opts ...grpc.CallOption) (*SlotConfirmResponse, error)
+	// Give the capacity of a hold or a booking back to its slot
+	SlotRelease(ctx context.Context, in *SlotReleaseRequest, opts ...grpc.CallOption) (*SlotReleaseResponse, error)
+	// Add an inactive weekly slot template to a zone of a provider
+	SlotTemplateCreate(ctx context.Context, in *SlotTemplateCreateRequest, opts ...grpc.CallOption) (*SlotTemplateCreateResponse, error)
+	// Get a slot template by its ID
+	SlotTemplateGet(ctx context.Context, in *SlotTemplateGetRequest, opts ...grpc.CallOption) (*SlotTemplateGetResponse, error)
+	// List slot templates of a provider ordered by template_id
+	SlotTemplateListAll(ctx context.Context, in *SlotTemplateListAllRequest, opts ...grpc.CallOption) (*SlotTemplateListAllResponse, error)
+	// Delete a slot template, slots it has generated are kept
+	SlotTemplateDelete(ctx context.Context, in *SlotTemplateDeleteRequest, opts ...grpc.CallOption) (*SlotTemplateDeleteResponse, error)
+	// Start generating slots from a template, slots within the horizon are generated right away
+	SlotTemplateActivate(ctx context.Context, in *SlotTemplateActivateRequest, opts ...grpc.CallOption) (*SlotTemplateActivateResponse, error)
+	// Stop generating slots from a template, slots already generated are kept
+	SlotTemplateDeactivate(ctx context.Context, in *SlotTemplateDeactivateRequest, opts ...grpc.CallOption) (*SlotTemplateDeactivateResponse, error)
+	// List the slots a template would generate within a period without storing them
+	SlotTemplatePreview(ctx context.Context, in *SlotTemplatePreviewRequest, opts ...grpc.CallOption) (*SlotTemplatePreviewResponse, error)
+	// Add a pickup point to a provider
+	PickupPointCreate(ctx context.Context, in *PickupPointCreateRequest, opts ...grpc.CallOption) (*PickupPointCreateResponse, error)
+	// Get a pickup point by its ID
+	PickupPointGet(ctx context.Context, in *PickupPointGetRequest, opts ...grpc.CallOption) (*PickupPointGetResponse, error)
+	// List pickup points of a provider ordered by point_id
+	PickupPointListAll(ctx context.Context, in *PickupPointListAllRequest, opts ...grpc.CallOption) (*PickupPointListAllResponse, error)
+	// Update a pickup point, fully or partially according to update_mask
+	PickupPointUpdate(ctx context.Context, in *PickupPointUpdateRequest, opts ...grpc.CallOption) (*PickupPointUpdateResponse, error)
+	// Delete a pickup point
+	PickupPointDelete(ctx context.Context, in *PickupPointDeleteRequest, opts ...grpc.CallOption) (*PickupPointDeleteResponse, error)
+	// Find pickup points of active providers nearest to a position, served from memory and refreshed when points change
+	PickupPointsNearby(ctx context.Context, in *PickupPointsNearbyRequest, opts ...grpc.CallOption) (*PickupPointsNearbyResponse, error)
+	// Add a tariff to a provider
+	TariffCreate(ctx context.Context, in *TariffCreateRequest, opts ...grpc.CallOption) (*TariffCreateResponse, error)
+	// Get a tariff by its ID
+	TariffGet(ctx context.Context, in *TariffGetRequest, opts ...grpc.CallOption) (*TariffGetResponse, error)
+	// List tariffs of a provider ordered by tariff_id
+	TariffListAll(ctx context.Context, in *TariffListAllRequest, opts ...grpc.CallOption) (*TariffListAllResponse, error)
+	// Delete a tariff none of whose revisions has taken effect
+	TariffDelete(ctx context.Context, in *TariffDeleteRequest, opts ...grpc.CallOption) (*TariffDeleteResponse, error)
+	// Price parcels carried between two positions by a tariff, nothing is stored
+	TariffEvaluate(ctx context.Context, in *TariffEvaluateRequest, opts ...grpc.CallOption) (*TariffEvaluateResponse, error)
+	// Price a shipment by every active provider delivering to its destination, slow providers are reported
+	// as failures instead of delaying the response
+	QuoteDelivery(ctx context.Context, in *QuoteDeliveryRequest, opts ...grpc.CallOption) (*QuoteDeliveryResponse, error)
+	// Schedule a revision of a tariff, revisions never change once created
+	TariffRevisionCreate(ctx context.Context, in *TariffRevisionCreateRequest, opts ...grpc.CallOption) (*TariffRevisionCreateResponse, error)
+	// List revisions of a tariff ordered by revision
+	TariffRevisionListAll(ctx context.Context, in *TariffRevisionListAllRequest, opts ...grpc.CallOption) (*TariffRevisionListAllResponse, error)
+	// Set up the working calendar of a provider
+	CalendarCreate(ctx context.Context, in *CalendarCreateRequest, opts ...grpc.CallOption) (*CalendarCreateResponse, error)
+	// Get the working calendar of a provider
+	CalendarGet(ctx context.Context, in *CalendarGetRequest, opts ...grpc.CallOption) (*CalendarGetResponse, error)
+	// Replace the working calendar of a provider
+	CalendarUpdate(ctx context.Context, in *CalendarUpdateRequest, opts ...grpc.CallOption) (*CalendarUpdateResponse, error)
+	// Delete the working calendar of a provider
+	CalendarDelete(ctx context.Context, in *CalendarDeleteRequest, opts ...grpc.CallOption) (*CalendarDeleteResponse, error)
+	// Import holidays of a provider calendar from an iCalendar file
+	CalendarHolidaysImport(ctx context.Context, in *CalendarHolidaysImportRequest, opts ...grpc.CallOption) (*CalendarHolidaysImportResponse, error)
 }
 
 type providersServiceClient struct {
@@ -59,6 +211,26 @@ func (c *providersServiceClient) ProviderCreate(ctx context.Context, in *Provide
 	return out, nil
 }
 
+func (c *providersServiceClient) ProviderSearch(ctx context.Context, in *ProviderSearchRequest, opts ...grpc.CallOption) (*ProviderSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSearchResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderGet(ctx context.Context, in *ProviderGetRequest, opts ...grpc.CallOption) (*ProviderGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providersServiceClient) ProviderListAll(ctx context.Context, in *ProviderListAllRequest, opts ...grpc.CallOption) (*ProviderListAllResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProviderListAllResponse)
@@ -66,154 +238,1780 @@ func (c *providersServiceClient) ProviderListAll(ctx context.Context, in *Provid
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderUpdate(ctx context.Context, in *ProviderUpdateRequest, opts ...grpc.CallOption) (*ProviderUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderUpdateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderDelete(ctx context.Context, in *ProviderDeleteRequest, opts ...grpc.CallOption) (*ProviderDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderRestore(ctx context.Context, in *ProviderRestoreRequest, opts ...grpc.CallOption) (*ProviderRestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderRestoreResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderRestore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderPurge(ctx context.Context, in *ProviderPurgeRequest, opts ...grpc.CallOption) (*ProviderPurgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderPurgeResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderPurge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderActivate(ctx context.Context, in *ProviderActivateRequest, opts ...grpc.CallOption) (*ProviderActivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderActivateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderActivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderSuspend(ctx context.Context, in *ProviderSuspendRequest, opts ...grpc.CallOption) (*ProviderSuspendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderSuspendResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderSuspend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderTerminate(ctx context.Context, in *ProviderTerminateRequest, opts ...grpc.CallOption) (*ProviderTerminateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderTerminateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderTerminate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderHistory(ctx context.Context, in *ProviderHistoryRequest, opts ...grpc.CallOption) (*ProviderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderHistoryResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ProviderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ProviderImport(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ProviderImportRequest, ProviderImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProvidersService_ServiceDesc.Streams[0], ProvidersService_ProviderImport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProviderImportRequest, ProviderImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProvidersService_ProviderImportClient = grpc.ClientStreamingClient[ProviderImportRequest, ProviderImportResponse]
+
+func (c *providersServiceClient) ProviderExport(ctx context.Context, in *ProviderExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProviderExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProvidersService_ServiceDesc.Streams[1], ProvidersService_ProviderExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ProviderExportRequest, ProviderExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProvidersService_ProviderExportClient = grpc.ServerStreamingClient[ProviderExportResponse]
+
+func (c *providersServiceClient) ZoneCreate(ctx context.Context, in *ZoneCreateRequest, opts ...grpc.CallOption) (*ZoneCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ZoneCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ZoneGet(ctx context.Context, in *ZoneGetRequest, opts ...grpc.CallOption) (*ZoneGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ZoneGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ZoneListAll(ctx context.Context, in *ZoneListAllRequest, opts ...grpc.CallOption) (*ZoneListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ZoneListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ZoneUpdate(ctx context.Context, in *ZoneUpdateRequest, opts ...grpc.CallOption) (*ZoneUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneUpdateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ZoneUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ZoneDelete(ctx context.Context, in *ZoneDeleteRequest, opts ...grpc.CallOption) (*ZoneDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ZoneDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CoverageLookup(ctx context.Context, in *CoverageLookupRequest, opts ...grpc.CallOption) (*CoverageLookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CoverageLookupResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CoverageLookup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotCreate(ctx context.Context, in *SlotCreateRequest, opts ...grpc.CallOption) (*SlotCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotGet(ctx context.Context, in *SlotGetRequest, opts ...grpc.CallOption) (*SlotGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotListAll(ctx context.Context, in *SlotListAllRequest, opts ...grpc.CallOption) (*SlotListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotClose(ctx context.Context, in *SlotCloseRequest, opts ...grpc.CallOption) (*SlotCloseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotCloseResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotClose_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotAvailability(ctx context.Context, in *SlotAvailabilityRequest, opts ...grpc.CallOption) (*SlotAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotAvailabilityResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotHold(ctx context.Context, in *SlotHoldRequest, opts ...grpc.CallOption) (*SlotHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotHoldResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotConfirm(ctx context.Context, in *SlotConfirmRequest, opts ...grpc.CallOption) (*SlotConfirmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotConfirmResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotConfirm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotRelease(ctx context.Context, in *SlotReleaseRequest, opts ...grpc.CallOption) (*SlotReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotReleaseResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateCreate(ctx context.Context, in *SlotTemplateCreateRequest, opts ...grpc.CallOption) (*SlotTemplateCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateGet(ctx context.Context, in *SlotTemplateGetRequest, opts ...grpc.CallOption) (*SlotTemplateGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateListAll(ctx context.Context, in *SlotTemplateListAllRequest, opts ...grpc.CallOption) (*SlotTemplateListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateDelete(ctx context.Context, in *SlotTemplateDeleteRequest, opts ...grpc.CallOption) (*SlotTemplateDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateActivate(ctx context.Context, in *SlotTemplateActivateRequest, opts ...grpc.CallOption) (*SlotTemplateActivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateActivateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateActivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplateDeactivate(ctx context.Context, in *SlotTemplateDeactivateRequest, opts ...grpc.CallOption) (*SlotTemplateDeactivateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplateDeactivateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplateDeactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) SlotTemplatePreview(ctx context.Context, in *SlotTemplatePreviewRequest, opts ...grpc.CallOption) (*SlotTemplatePreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SlotTemplatePreviewResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_SlotTemplatePreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointCreate(ctx context.Context, in *PickupPointCreateRequest, opts ...grpc.CallOption) (*PickupPointCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointGet(ctx context.Context, in *PickupPointGetRequest, opts ...grpc.CallOption) (*PickupPointGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointListAll(ctx context.Context, in *PickupPointListAllRequest, opts ...grpc.CallOption) (*PickupPointListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointUpdate(ctx context.Context, in *PickupPointUpdateRequest, opts ...grpc.CallOption) (*PickupPointUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointUpdateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointDelete(ctx context.Context, in *PickupPointDeleteRequest, opts ...grpc.CallOption) (*PickupPointDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PickupPointsNearby(ctx context.Context, in *PickupPointsNearbyRequest, opts ...grpc.CallOption) (*PickupPointsNearbyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupPointsNearbyResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_PickupPointsNearby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffCreate(ctx context.Context, in *TariffCreateRequest, opts ...grpc.CallOption) (*TariffCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffGet(ctx context.Context, in *TariffGetRequest, opts ...grpc.CallOption) (*TariffGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffListAll(ctx context.Context, in *TariffListAllRequest, opts ...grpc.CallOption) (*TariffListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffDelete(ctx context.Context, in *TariffDeleteRequest, opts ...grpc.CallOption) (*TariffDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffEvaluate(ctx context.Context, in *TariffEvaluateRequest, opts ...grpc.CallOption) (*TariffEvaluateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffEvaluateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffEvaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) QuoteDelivery(ctx context.Context, in *QuoteDeliveryRequest, opts ...grpc.CallOption) (*QuoteDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteDeliveryResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_QuoteDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffRevisionCreate(ctx context.Context, in *TariffRevisionCreateRequest, opts ...grpc.CallOption) (*TariffRevisionCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffRevisionCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffRevisionCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) TariffRevisionListAll(ctx context.Context, in *TariffRevisionListAllRequest, opts ...grpc.CallOption) (*TariffRevisionListAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffRevisionListAllResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_TariffRevisionListAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CalendarCreate(ctx context.Context, in *CalendarCreateRequest, opts ...grpc.CallOption) (*CalendarCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarCreateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CalendarCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CalendarGet(ctx context.Context, in *CalendarGetRequest, opts ...grpc.CallOption) (*CalendarGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarGetResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CalendarGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CalendarUpdate(ctx context.Context, in *CalendarUpdateRequest, opts ...grpc.CallOption) (*CalendarUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarUpdateResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CalendarUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CalendarDelete(ctx context.Context, in *CalendarDeleteRequest, opts ...grpc.CallOption) (*CalendarDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarDeleteResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CalendarDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) CalendarHolidaysImport(ctx context.Context, in *CalendarHolidaysImportRequest, opts ...grpc.CallOption) (*CalendarHolidaysImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarHolidaysImportResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CalendarHolidaysImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProvidersServiceServer is the server API for ProvidersService service.
+// All implementations must embed UnimplementedProvidersServiceServer
+// for forward compatibility.
+//
+// Service is resposible for CRUD of providers
+type ProvidersServiceServer interface {
+	// Create a provider
+	ProviderCreate(context.Context, *ProviderCreateRequest) (*ProviderCreateResponse, error)
+	// Find providers by name, best matches first
+	ProviderSearch(context.Context, *ProviderSearchRequest) (*ProviderSearchResponse, error)
+	// Get a provider by its ID
+	ProviderGet(context.Context, *ProviderGetRequest) (*ProviderGetResponse, error)
+	// List all providers
+	ProviderListAll(context.Context, *ProviderListAllRequest) (*ProviderListAllResponse, error)
+	// Update a provider, fully or partially according to update_mask
+	ProviderUpdate(context.Context, *ProviderUpdateRequest) (*ProviderUpdateResponse, error)
+	// Archive a provider, it can be restored later
+	ProviderDelete(context.Context, *ProviderDeleteRequest) (*ProviderDeleteResponse, error)
+	// Restore an archived provider, one that is not archived is returned unchanged
+	ProviderRestore(context.Context, *ProviderRestoreRequest) (*ProviderRestoreResponse, error)
+	// Delete a provider permanently, requires the admin token
+	ProviderPurge(context.Context, *ProviderPurgeRequest) (*ProviderPurgeResponse, error)
+	// Activate an onboarding or suspended provider
+	ProviderActivate(context.Context, *ProviderActivateRequest) (*ProviderActivateResponse, error)
+	// Suspend an active provider, routing ignores suspended providers
+	ProviderSuspend(context.Context, *ProviderSuspendRequest) (*ProviderSuspendResponse, error)
+	// Terminate a provider, this is final
+	ProviderTerminate(context.Context, *ProviderTerminateRequest) (*ProviderTerminateResponse, error)
+	// List recorded changes of a provider, newest first
+	ProviderHistory(context.Context, *ProviderHistoryRequest) (*ProviderHistoryResponse, error)
+	// Create or update providers in bulk, fields left empty keep their stored values. The first message may set
+	// dry_run, every message carries one provider. The import is applied in a single transaction only if all rows succeed
+	ProviderImport(grpc.ClientStreamingServer[ProviderImportRequest, ProviderImportResponse]) error
+	// Stream all providers matching the filter ordered by provider_id, one per message
+	ProviderExport(*ProviderExportRequest, grpc.ServerStreamingServer[ProviderExportResponse]) error
+	// Add a delivery zone to a provider
+	ZoneCreate(context.Context, *ZoneCreateRequest) (*ZoneCreateResponse, error)
+	// Get a delivery zone by its ID
+	ZoneGet(context.Context, *ZoneGetRequest) (*ZoneGetResponse, error)
+	// List delivery zones of a provider ordered by zone_id
+	ZoneListAll(context.Context, *ZoneListAllRequest) (*ZoneListAllResponse, error)
+	// Update a delivery zone, fully or partially according to update_mask
+	ZoneUpdate(context.Context, *ZoneUpdateRequest) (*ZoneUpdateResponse, error)
+	// Delete a delivery zone
+	ZoneDelete(context.Context, *ZoneDeleteRequest) (*ZoneDeleteResponse, error)
+	// Find active providers delivering to a position, served from memory and refreshed when zones change
+	CoverageLookup(context.Context, *CoverageLookupRequest) (*CoverageLookupResponse, error)
+	// Add a delivery slot to a zone of a provider
+	SlotCreate(context.Context, *SlotCreateRequest) (*SlotCreateResponse, error)
+	// Get a delivery slot by its ID
+	SlotGet(context.Context, *SlotGetRequest) (*SlotGetResponse, error)
+	// List delivery slots of a provider ordered by starts_at
+	SlotListAll(context.Context, *SlotListAllRequest) (*SlotListAllResponse, error)
+	// Stop bookings of a delivery slot, existing bookings are kept
+	SlotClose(context.Context, *SlotCloseRequest) (*SlotCloseResponse, error)
+	// List bookable slots of a zone within a period
+	SlotAvailability(context.Context, *SlotAvailabilityRequest) (*SlotAvailabilityResponse, error)
+	// Hold capacity of a slot at checkout, the hold expires unless confirmed in time
+	SlotHold(context.Context, *SlotHoldRequest) (*SlotHoldResponse, error)
+	// Turn an unexpired hold into a booking
+	SlotConfirm(context.Context, *SlotConfirmRequest) (*SlotConfirmResponse, error)
+	// Give the capacity of a hold or a booking back to its slot
+	SlotRelease(context.Context, *SlotReleaseRequest) (*SlotReleaseResponse, error)
+	// Add an inactive weekly slot template to a zone of a provider
+	SlotTemplateCreate(context.Context, *SlotTemplateCreateRequest) (*SlotTemplateCreateResponse, error)
+	// Get a slot template by its ID
+	SlotTemplateGet(context.Context, *SlotTemplateGetRequest) (*SlotTemplateGetResponse, error)
+	// List slot templates of a provider ordered by template_id
+	SlotTemplateListAll(context.Context, *SlotTemplateListAllRequest) (*SlotTemplateListAllResponse, error)
+	// Delete a slot template, slots it has generated are kept
+	SlotTemplateDelete(context.Context, *SlotTemplateDeleteRequest) (*SlotTemplateDeleteResponse, error)
+	// Start generating slots from a template, slots within the horizon are generated right away
+	SlotTemplateActivate(context.Context, *SlotTemplateActivateRequest) (*SlotTemplateActivateResponse, error)
+	// Stop generating slots from a template, slots already generated are kept
+	SlotTemplateDeactivate(context.Context, *SlotTemplateDeactivateRequest) (*SlotTemplateDeactivateResponse, error)
+	// List the slots a template would generate within a period without storing them
+	SlotTemplatePreview(context.Context, *SlotTemplatePreviewRequest) (*SlotTemplatePreviewResponse, error)
+	// Add a pickup point to a provider
+	PickupPointCreate(context.Context, *PickupPointCreateRequest) (*PickupPointCreateResponse, error)
+	// Get a pickup point by its ID
+	PickupPointGet(context.Context, *PickupPointGetRequest) (*PickupPointGetResponse, error)
+	// List pickup points of a provider ordered by point_id
+	PickupPointListAll(context.Context, *PickupPointListAllRequest) (*PickupPointListAllResponse, error)
+	// Update a pickup point, fully or partially according to update_mask
+	PickupPointUpdate(context.Context, *PickupPointUpdateRequest) (*PickupPointUpdateResponse, error)
+	// Delete a pickup point
+	PickupPointDelete(context.Context, *PickupPointDeleteRequest) (*PickupPointDeleteResponse, error)
+	// Find pickup points of active providers nearest to a position, served from memory and refreshed when points change
+	PickupPointsNearby(context.Context, *PickupPointsNearbyRequest) (*PickupPointsNearbyResponse, error)
+	// Add a tariff to a provider
+	TariffCreate(context.Context, *TariffCreateRequest) (*TariffCreateResponse, error)
+	// Get a tariff by its ID
+	TariffGet(context.Context, *TariffGetRequest) (*TariffGetResponse, error)
+	// List tariffs of a provider ordered by tariff_id
+	TariffListAll(context.Context, *TariffListAllRequest) (*TariffListAllResponse, error)
+	// Delete a tariff none of whose revisions has taken effect
+	TariffDelete(context.Context, *TariffDeleteRequest) (*TariffDeleteResponse, error)
+	// Price parcels carried between two positions by a tariff, nothing is stored
+	TariffEvaluate(context.Context, *TariffEvaluateRequest) (*TariffEvaluateResponse, error)
+	// Price a shipment by every active provider delivering to its destination, slow providers are reported
+	// as failures instead of delaying the response
+	QuoteDelivery(context.Context, *QuoteDeliveryRequest) (*QuoteDeliveryResponse, error)
+	// Schedule a revision of a tariff, revisions never change once created
+	TariffRevisionCreate(context.Context, *TariffRevisionCreateRequest) (*TariffRevisionCreateResponse, error)
+	// List revisions of a tariff ordered by revision
+	TariffRevisionListAll(context.Context, *TariffRevisionListAllRequest) (*TariffRevisionListAllResponse, error)
+	// Set up the working calendar of a provider
+	CalendarCreate(context.Context, *CalendarCreateRequest) (*CalendarCreateResponse, error)
+	// Get the working calendar of a provider
+	CalendarGet(context.Context, *CalendarGetRequest) (*CalendarGetResponse, error)
+	// Replace the working calendar of a provider
+	CalendarUpdate(context.Context, *CalendarUpdateRequest) (*CalendarUpdateResponse, error)
+	// Delete the working calendar of a provider
+	CalendarDelete(context.Context, *CalendarDeleteRequest) (*CalendarDeleteResponse, error)
+	// Import holidays of a provider calendar from an iCalendar file
+	CalendarHolidaysImport(context.Context, *CalendarHolidaysImportRequest) (*CalendarHolidaysImportResponse, error)
+	mustEmbedUnimplementedProvidersServiceServer()
+}
+
+// UnimplementedProvidersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProvidersServiceServer struct{}
+
+func (UnimplementedProvidersServiceServer) ProviderCreate(context.Context, *ProviderCreateRequest) (*ProviderCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderSearch(context.Context, *ProviderSearchRequest) (*ProviderSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderSearch not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderGet(context.Context, *ProviderGetRequest) (*ProviderGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderListAll(context.Context, *ProviderListAllRequest) (*ProviderListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderUpdate(context.Context, *ProviderUpdateRequest) (*ProviderUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderUpdate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderDelete(context.Context, *ProviderDeleteRequest) (*ProviderDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderRestore(context.Context, *ProviderRestoreRequest) (*ProviderRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderRestore not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderPurge(context.Context, *ProviderPurgeRequest) (*ProviderPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderPurge not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderActivate(context.Context, *ProviderActivateRequest) (*ProviderActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderActivate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderSuspend(context.Context, *ProviderSuspendRequest) (*ProviderSuspendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderSuspend not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderTerminate(context.Context, *ProviderTerminateRequest) (*ProviderTerminateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderTerminate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderHistory(context.Context, *ProviderHistoryRequest) (*ProviderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderHistory not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderImport(grpc.ClientStreamingServer[ProviderImportRequest, ProviderImportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ProviderImport not implemented")
+}
+func (UnimplementedProvidersServiceServer) ProviderExport(*ProviderExportRequest, grpc.ServerStreamingServer[ProviderExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ProviderExport not implemented")
+}
+func (UnimplementedProvidersServiceServer) ZoneCreate(context.Context, *ZoneCreateRequest) (*ZoneCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ZoneGet(context.Context, *ZoneGetRequest) (*ZoneGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) ZoneListAll(context.Context, *ZoneListAllRequest) (*ZoneListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) ZoneUpdate(context.Context, *ZoneUpdateRequest) (*ZoneUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneUpdate not implemented")
+}
+func (UnimplementedProvidersServiceServer) ZoneDelete(context.Context, *ZoneDeleteRequest) (*ZoneDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) CoverageLookup(context.Context, *CoverageLookupRequest) (*CoverageLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CoverageLookup not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotCreate(context.Context, *SlotCreateRequest) (*SlotCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotGet(context.Context, *SlotGetRequest) (*SlotGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotListAll(context.Context, *SlotListAllRequest) (*SlotListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotClose(context.Context, *SlotCloseRequest) (*SlotCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotClose not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotAvailability(context.Context, *SlotAvailabilityRequest) (*SlotAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotAvailability not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotHold(context.Context, *SlotHoldRequest) (*SlotHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotHold not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotConfirm(context.Context, *SlotConfirmRequest) (*SlotConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotConfirm not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotRelease(context.Context, *SlotReleaseRequest) (*SlotReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotRelease not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateCreate(context.Context, *SlotTemplateCreateRequest) (*SlotTemplateCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateGet(context.Context, *SlotTemplateGetRequest) (*SlotTemplateGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateListAll(context.Context, *SlotTemplateListAllRequest) (*SlotTemplateListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateDelete(context.Context, *SlotTemplateDeleteRequest) (*SlotTemplateDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateActivate(context.Context, *SlotTemplateActivateRequest) (*SlotTemplateActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateActivate not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplateDeactivate(context.Context, *SlotTemplateDeactivateRequest) (*SlotTemplateDeactivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplateDeactivate not implemented")
+}
+func (UnimplementedProvidersServiceServer) SlotTemplatePreview(context.Context, *SlotTemplatePreviewRequest) (*SlotTemplatePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlotTemplatePreview not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointCreate(context.Context, *PickupPointCreateRequest) (*PickupPointCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointGet(context.Context, *PickupPointGetRequest) (*PickupPointGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointListAll(context.Context, *PickupPointListAllRequest) (*PickupPointListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointUpdate(context.Context, *PickupPointUpdateRequest) (*PickupPointUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointUpdate not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointDelete(context.Context, *PickupPointDeleteRequest) (*PickupPointDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) PickupPointsNearby(context.Context, *PickupPointsNearbyRequest) (*PickupPointsNearbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickupPointsNearby not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffCreate(context.Context, *TariffCreateRequest) (*TariffCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffGet(context.Context, *TariffGetRequest) (*TariffGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffListAll(context.Context, *TariffListAllRequest) (*TariffListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffDelete(context.Context, *TariffDeleteRequest) (*TariffDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffEvaluate(context.Context, *TariffEvaluateRequest) (*TariffEvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffEvaluate not implemented")
+}
+func (UnimplementedProvidersServiceServer) QuoteDelivery(context.Context, *QuoteDeliveryRequest) (*QuoteDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteDelivery not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffRevisionCreate(context.Context, *TariffRevisionCreateRequest) (*TariffRevisionCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffRevisionCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) TariffRevisionListAll(context.Context, *TariffRevisionListAllRequest) (*TariffRevisionListAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TariffRevisionListAll not implemented")
+}
+func (UnimplementedProvidersServiceServer) CalendarCreate(context.Context, *CalendarCreateRequest) (*CalendarCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarCreate not implemented")
+}
+func (UnimplementedProvidersServiceServer) CalendarGet(context.Context, *CalendarGetRequest) (*CalendarGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarGet not implemented")
+}
+func (UnimplementedProvidersServiceServer) CalendarUpdate(context.Context, *CalendarUpdateRequest) (*CalendarUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarUpdate not implemented")
+}
+func (UnimplementedProvidersServiceServer) CalendarDelete(context.Context, *CalendarDeleteRequest) (*CalendarDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarDelete not implemented")
+}
+func (UnimplementedProvidersServiceServer) CalendarHolidaysImport(context.Context, *CalendarHolidaysImportRequest) (*CalendarHolidaysImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarHolidaysImport not implemented")
+}
+func (UnimplementedProvidersServiceServer) mustEmbedUnimplementedProvidersServiceServer() {}
+func (UnimplementedProvidersServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeProvidersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProvidersServiceServer will
+// result in compilation errors.
+type UnsafeProvidersServiceServer interface {
+	mustEmbedUnimplementedProvidersServiceServer()
+}
+
+func RegisterProvidersServiceServer(s grpc.ServiceRegistrar, srv ProvidersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProvidersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProvidersService_ServiceDesc, srv)
+}
+
+func _ProvidersService_ProviderCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderCreate(ctx, req.(*ProviderCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderSearch(ctx, req.(*ProviderSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderGet(ctx, req.(*ProviderGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderListAll(ctx, req.(*ProviderListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderUpdate(ctx, req.(*ProviderUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderDelete(ctx, req.(*ProviderDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderRestore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderRestore(ctx, req.(*ProviderRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderPurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderPurge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderPurge(ctx, req.(*ProviderPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderActivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderActivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderActivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderActivate(ctx, req.(*ProviderActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderSuspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderSuspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderSuspend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderSuspend(ctx, req.(*ProviderSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderTerminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderTerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderTerminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderTerminate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderTerminate(ctx, req.(*ProviderTerminateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ProviderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ProviderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ProviderHistory(ctx, req.(*ProviderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ProviderImport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProvidersServiceServer).ProviderImport(&grpc.GenericServerStream[ProviderImportRequest, ProviderImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProvidersService_ProviderImportServer = grpc.ClientStreamingServer[ProviderImportRequest, ProviderImportResponse]
+
+func _ProvidersService_ProviderExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProviderExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProvidersServiceServer).ProviderExport(m, &grpc.GenericServerStream[ProviderExportRequest, ProviderExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProvidersService_ProviderExportServer = grpc.ServerStreamingServer[ProviderExportResponse]
+
+func _ProvidersService_ZoneCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ZoneCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ZoneCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ZoneCreate(ctx, req.(*ZoneCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ZoneGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ZoneGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ZoneGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ZoneGet(ctx, req.(*ZoneGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ZoneListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ZoneListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ZoneListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ZoneListAll(ctx, req.(*ZoneListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ZoneUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ZoneUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ZoneUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ZoneUpdate(ctx, req.(*ZoneUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ZoneDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ZoneDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ZoneDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ZoneDelete(ctx, req.(*ZoneDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_CoverageLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoverageLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).CoverageLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_CoverageLookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).CoverageLookup(ctx, req.(*CoverageLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotCreate(ctx, req.(*SlotCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotGet(ctx, req.(*SlotGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotListAll(ctx, req.(*SlotListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotClose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotClose(ctx, req.(*SlotCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotAvailability(ctx, req.(*SlotAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotHold(ctx, req.(*SlotHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotConfirmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotConfirm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotConfirm(ctx, req.(*SlotConfirmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotRelease(ctx, req.(*SlotReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateCreate(ctx, req.(*SlotTemplateCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateGet(ctx, req.(*SlotTemplateGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateListAll(ctx, req.(*SlotTemplateListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateDelete(ctx, req.(*SlotTemplateDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateActivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateActivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateActivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateActivate(ctx, req.(*SlotTemplateActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplateDeactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplateDeactivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplateDeactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplateDeactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplateDeactivate(ctx, req.(*SlotTemplateDeactivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_SlotTemplatePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotTemplatePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).SlotTemplatePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_SlotTemplatePreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).SlotTemplatePreview(ctx, req.(*SlotTemplatePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointCreate(ctx, req.(*PickupPointCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointGet(ctx, req.(*PickupPointGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointListAll(ctx, req.(*PickupPointListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointUpdate(ctx, req.(*PickupPointUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointDelete(ctx, req.(*PickupPointDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PickupPointsNearby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupPointsNearbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PickupPointsNearby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_PickupPointsNearby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PickupPointsNearby(ctx, req.(*PickupPointsNearbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_TariffCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffCreate(ctx, req.(*TariffCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_TariffGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffGet(ctx, req.(*TariffGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *providersServiceClient) ProviderUpdate(ctx context.Context, in *ProviderUpdateRequest, opts ...grpc.CallOption) (*ProviderUpdateResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ProviderUpdateResponse)
-	err := c.cc.Invoke(ctx, ProvidersService_ProviderUpdate_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ProvidersService_TariffListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffListAllRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffListAll(ctx, req.(*TariffListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *providersServiceClient) ProviderDelete(ctx context.Context, in *ProviderDeleteRequest, opts ...grpc.CallOption) (*ProviderDeleteResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ProviderDeleteResponse)
-	err := c.cc.Invoke(ctx, ProvidersService_ProviderDelete_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ProvidersService_TariffDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffDelete(ctx, req.(*TariffDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ProvidersServiceServer is the server API for ProvidersService service.
-// All implementations must embed UnimplementedProvidersServiceServer
-// for forward compatibility.
-//
-// Service is resposible for CRUD of providers
-type ProvidersServiceServer interface {
-	// Create a provider
-	ProviderCreate(context.Context, *ProviderCreateRequest) (*ProviderCreateResponse, error)
-	// List all providers
-	ProviderListAll(context.Context, *ProviderListAllRequest) (*ProviderListAllResponse, error)
-	// Update a provider
-	ProviderUpdate(context.Context, *ProviderUpdateRequest) (*ProviderUpdateResponse, error)
-	// Delete a provider
-	ProviderDelete(context.Context, *ProviderDeleteRequest) (*ProviderDeleteResponse, error)
-	mustEmbedUnimplementedProvidersServiceServer()
+func _ProvidersService_TariffEvaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffEvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffEvaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffEvaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffEvaluate(ctx, req.(*TariffEvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedProvidersServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProvidersServiceServer struct{}
-
-func (UnimplementedProvidersServiceServer) ProviderCreate(context.Context, *ProviderCreateRequest) (*ProviderCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderCreate not implemented")
-}
-func (UnimplementedProvidersServiceServer) ProviderListAll(context.Context, *ProviderListAllRequest) (*ProviderListAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderListAll not implemented")
-}
-func (UnimplementedProvidersServiceServer) ProviderUpdate(context.Context, *ProviderUpdateRequest) (*ProviderUpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderUpdate not implemented")
+func _ProvidersService_QuoteDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).QuoteDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_QuoteDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).QuoteDelivery(ctx, req.(*QuoteDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProvidersServiceServer) ProviderDelete(context.Context, *ProviderDeleteRequest) (*ProviderDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProviderDelete not implemented")
+
+func _ProvidersService_TariffRevisionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffRevisionCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffRevisionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffRevisionCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffRevisionCreate(ctx, req.(*TariffRevisionCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProvidersServiceServer) mustEmbedUnimplementedProvidersServiceServer() {}
-func (UnimplementedProvidersServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeProvidersServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProvidersServiceServer will
-// result in compilation errors.
-type UnsafeProvidersServiceServer interface {
-	mustEmbedUnimplementedProvidersServiceServer()
+func _ProvidersService_TariffRevisionListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffRevisionListAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).TariffRevisionListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_TariffRevisionListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).TariffRevisionListAll(ctx, req.(*TariffRevisionListAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProvidersServiceServer(s grpc.ServiceRegistrar, srv ProvidersServiceServer) {
-	// If the following call pancis, it indicates UnimplementedProvidersServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProvidersService_CalendarCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProvidersService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).CalendarCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_CalendarCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).CalendarCreate(ctx, req.(*CalendarCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvidersService_ProviderCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProviderCreateRequest)
+func _ProvidersService_CalendarGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvidersServiceServer).ProviderCreate(ctx, in)
+		return srv.(ProvidersServiceServer).CalendarGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProvidersService_ProviderCreate_FullMethodName,
+		FullMethod: ProvidersService_CalendarGet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvidersServiceServer).ProviderCreate(ctx, req.(*ProviderCreateRequest))
+		return srv.(ProvidersServiceServer).CalendarGet(ctx, req.(*CalendarGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvidersService_ProviderListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProviderListAllRequest)
+func _ProvidersService_CalendarUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvidersServiceServer).ProviderListAll(ctx, in)
+		return srv.(ProvidersServiceServer).CalendarUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProvidersService_ProviderListAll_FullMethodName,
+		FullMethod: ProvidersService_CalendarUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvidersServiceServer).ProviderListAll(ctx, req.(*ProviderListAllRequest))
+		return srv.(ProvidersServiceServer).CalendarUpdate(ctx, req.(*CalendarUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvidersService_ProviderUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProviderUpdateRequest)
+func _ProvidersService_CalendarDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvidersServiceServer).ProviderUpdate(ctx, in)
+		return srv.(ProvidersServiceServer).CalendarDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProvidersService_ProviderUpdate_FullMethodName,
+		FullMethod: ProvidersService_CalendarDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvidersServiceServer).ProviderUpdate(ctx, req.(*ProviderUpdateRequest))
+		return srv.(ProvidersServiceServer).CalendarDelete(ctx, req.(*CalendarDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProvidersService_ProviderDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProviderDeleteRequest)
+func _ProvidersService_CalendarHolidaysImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarHolidaysImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProvidersServiceServer).ProviderDelete(ctx, in)
+		return srv.(ProvidersServiceServer).CalendarHolidaysImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProvidersService_ProviderDelete_FullMethodName,
+		FullMethod: ProvidersService_CalendarHolidaysImport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProvidersServiceServer).ProviderDelete(ctx, req.(*ProviderDeleteRequest))
+		return srv.(ProvidersServiceServer).CalendarHolidaysImport(ctx, req.(*CalendarHolidaysImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -229,6 +2027,14 @@ var ProvidersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProviderCreate",
 			Handler:    _ProvidersService_ProviderCreate_Handler,
 		},
+		{
+			MethodName: "ProviderSearch",
+			Handler:    _ProvidersService_ProviderSearch_Handler,
+		},
+		{
+			MethodName: "ProviderGet",
+			Handler:    _ProvidersService_ProviderGet_Handler,
+		},
 		{
 			MethodName: "ProviderListAll",
 			Handler:    _ProvidersService_ProviderListAll_Handler,
@@ -241,7 +2047,202 @@ var ProvidersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProviderDelete",
 			Handler:    _ProvidersService_ProviderDelete_Handler,
 		},
+		{
+			MethodName: "ProviderRestore",
+			Handler:    _ProvidersService_ProviderRestore_Handler,
+		},
+		{
+			MethodName: "ProviderPurge",
+			Handler:    _ProvidersService_ProviderPurge_Handler,
+		},
+		{
+			MethodName: "ProviderActivate",
+			Handler:    _ProvidersService_ProviderActivate_Handler,
+		},
+		{
+			MethodName: "ProviderSuspend",
+			Handler:    _ProvidersService_ProviderSuspend_Handler,
+		},
+		{
+			MethodName: "ProviderTerminate",
+			Handler:    _ProvidersService_ProviderTerminate_Handler,
+		},
+		{
+			MethodName: "ProviderHistory",
+			Handler:    _ProvidersService_ProviderHistory_Handler,
+		},
+		{
+			MethodName: "ZoneCreate",
+			Handler:    _ProvidersService_ZoneCreate_Handler,
+		},
+		{
+			MethodName: "ZoneGet",
+			Handler:    _ProvidersService_ZoneGet_Handler,
+		},
+		{
+			MethodName: "ZoneListAll",
+			Handler:    _ProvidersService_ZoneListAll_Handler,
+		},
+		{
+			MethodName: "ZoneUpdate",
+			Handler:    _ProvidersService_ZoneUpdate_Handler,
+		},
+		{
+			MethodName: "ZoneDelete",
+			Handler:    _ProvidersService_ZoneDelete_Handler,
+		},
+		{
+			MethodName: "CoverageLookup",
+			Handler:    _ProvidersService_CoverageLookup_Handler,
+		},
+		{
+			MethodName: "SlotCreate",
+			Handler:    _ProvidersService_SlotCreate_Handler,
+		},
+		{
+			MethodName: "SlotGet",
+			Handler:    _ProvidersService_SlotGet_Handler,
+		},
+		{
+			MethodName: "SlotListAll",
+			Handler:    _ProvidersService_SlotListAll_Handler,
+		},
+		{
+			MethodName: "SlotClose",
+			Handler:    _ProvidersService_SlotClose_Handler,
+		},
+		{
+			MethodName: "SlotAvailability",
+			Handler:    _ProvidersService_SlotAvailability_Handler,
+		},
+		{
+			MethodName: "SlotHold",
+			Handler:    _ProvidersService_SlotHold_Handler,
+		},
+		{
+			MethodName: "SlotConfirm",
+			Handler:    _ProvidersService_SlotConfirm_Handler,
+		},
+		{
+			MethodName: "SlotRelease",
+			Handler:    _ProvidersService_SlotRelease_Handler,
+		},
+		{
+			MethodName: "SlotTemplateCreate",
+			Handler:    _ProvidersService_SlotTemplateCreate_Handler,
+		},
+		{
+			MethodName: "SlotTemplateGet",
+			Handler:    _ProvidersService_SlotTemplateGet_Handler,
+		},
+		{
+			MethodName: "SlotTemplateListAll",
+			Handler:    _ProvidersService_SlotTemplateListAll_Handler,
+		},
+		{
+			MethodName: "SlotTemplateDelete",
+			Handler:    _ProvidersService_SlotTemplateDelete_Handler,
+		},
+		{
+			MethodName: "SlotTemplateActivate",
+			Handler:    _ProvidersService_SlotTemplateActivate_Handler,
+		},
+		{
+			MethodName: "SlotTemplateDeactivate",
+			Handler:    _ProvidersService_SlotTemplateDeactivate_Handler,
+		},
+		{
+			MethodName: "SlotTemplatePreview",
+			Handler:    _ProvidersService_SlotTemplatePreview_Handler,
+		},
+		{
+			MethodName: "PickupPointCreate",
+			Handler:    _ProvidersService_PickupPointCreate_Handler,
+		},
+		{
+			MethodName: "PickupPointGet",
+			Handler:    _ProvidersService_PickupPointGet_Handler,
+		},
+		{
+			MethodName: "PickupPointListAll",
+			Handler:    _ProvidersService_PickupPointListAll_Handler,
+		},
+		{
+			MethodName: "PickupPointUpdate",
+			Handler:    _ProvidersService_PickupPointUpdate_Handler,
+		},
+		{
+			MethodName: "PickupPointDelete",
+			Handler:    _ProvidersService_PickupPointDelete_Handler,
+		},
+		{
+			MethodName: "PickupPointsNearby",
+			Handler:    _ProvidersService_PickupPointsNearby_Handler,
+		},
+		{
+			MethodName: "TariffCreate",
+			Handler:    _ProvidersService_TariffCreate_Handler,
+		},
+		{
+			MethodName: "TariffGet",
+			Handler:    _ProvidersService_TariffGet_Handler,
+		},
+		{
+			MethodName: "TariffListAll",
+			Handler:    _ProvidersService_TariffListAll_Handler,
+		},
+		{
+			MethodName: "TariffDelete",
+			Handler:    _ProvidersService_TariffDelete_Handler,
+		},
+		{
+			MethodName: "TariffEvaluate",
+			Handler:    _ProvidersService_TariffEvaluate_Handler,
+		},
+		{
+			MethodName: "QuoteDelivery",
+			Handler:    _ProvidersService_QuoteDelivery_Handler,
+		},
+		{
+			MethodName: "TariffRevisionCreate",
+			Handler:    _ProvidersService_TariffRevisionCreate_Handler,
+		},
+		{
+			MethodName: "TariffRevisionListAll",
+			Handler:    _ProvidersService_TariffRevisionListAll_Handler,
+		},
+		{
+			MethodName: "CalendarCreate",
+			Handler:    _ProvidersService_CalendarCreate_Handler,
+		},
+		{
+			MethodName: "CalendarGet",
+			Handler:    _ProvidersService_CalendarGet_Handler,
+		},
+		{
+			MethodName: "CalendarUpdate",
+			Handler:    _ProvidersService_CalendarUpdate_Handler,
+		},
+		{
+			MethodName: "CalendarDelete",
+			Handler:    _ProvidersService_CalendarDelete_Handler,
+		},
+		{
+			MethodName: "CalendarHolidaysImport",
+			Handler:    _ProvidersService_CalendarHolidaysImport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ProviderImport",
+			Handler:       _ProvidersService_ProviderImport_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ProviderExport",
+			Handler:       _ProvidersService_ProviderExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/providers/service.proto",
 }
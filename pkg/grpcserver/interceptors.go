@@ -0,0 +1,43 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// ErrorTranslator converts an application error into a gRPC status error.
+type ErrorTranslator func(error) error
+
+// UnaryErrorInterceptor translates errors returned by unary handlers before they reach the client.
+func UnaryErrorInterceptor(translate ErrorTranslator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, translate(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamErrorInterceptor translates errors returned by streaming handlers before they reach the client.
+func StreamErrorInterceptor(translate ErrorTranslator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return translate(err)
+		}
+
+		return nil
+	}
+}
+
+// gatewayErrorHandler applies the same translation to the REST surface, which calls handlers in-process
+// and therefore bypasses gRPC interceptors.
+func gatewayErrorHandler(translate ErrorTranslator) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, r, translate(err))
+	}
+}
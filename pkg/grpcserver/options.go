@@ -17,3 +17,16 @@ func AddressGateway(host, port string) Option {
 		s.Gateway.Address = net.JoinHostPort(host, port)
 	}
 }
+
+func ErrorTranslation(translate ErrorTranslator) Option {
+	return func(s *Server) {
+		s.errorTranslator = translate
+	}
+}
+
+// GatewayIncomingHeaders forwards the given HTTP headers to handlers as gRPC metadata, in addition to the default ones.
+func GatewayIncomingHeaders(headers ...string) Option {
+	return func(s *Server) {
+		s.gatewayHeaders = append(s.gatewayHeaders, headers...)
+	}
+}
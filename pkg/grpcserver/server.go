@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -21,6 +22,9 @@ type Server struct {
 		Mux     *runtime.ServeMux
 	}
 	notify chan error
+
+	errorTranslator ErrorTranslator
+	gatewayHeaders  []string
 }
 
 func New(opts ...Option) *Server {
@@ -28,15 +32,32 @@ func New(opts ...Option) *Server {
 		notify: make(chan error, 10),
 	}
 
-	s.GRPC.Server = grpc.NewServer()
-	mux := runtime.NewServeMux()
-	s.Gateway.Mux = mux
-	s.Gateway.Server = &http.Server{Handler: mux}
-
 	for _, opt := range opts {
 		opt(s)
 	}
 
+	var (
+		serverOpts []grpc.ServerOption
+		muxOpts    []runtime.ServeMuxOption
+	)
+
+	if s.errorTranslator != nil {
+		serverOpts = append(serverOpts,
+			grpc.ChainUnaryInterceptor(UnaryErrorInterceptor(s.errorTranslator)),
+			grpc.ChainStreamInterceptor(StreamErrorInterceptor(s.errorTranslator)),
+		)
+		muxOpts = append(muxOpts, runtime.WithErrorHandler(gatewayErrorHandler(s.errorTranslator)))
+	}
+
+	if len(s.gatewayHeaders) > 0 {
+		muxOpts = append(muxOpts, runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher(s.gatewayHeaders)))
+	}
+
+	s.GRPC.Server = grpc.NewServer(serverOpts...)
+	mux := runtime.NewServeMux(muxOpts...)
+	s.Gateway.Mux = mux
+	s.Gateway.Server = &http.Server{Handler: mux}
+
 	return s
 }
 
@@ -75,3 +96,15 @@ func (s *Server) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+func incomingHeaderMatcher(headers []string) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		for _, h := range headers {
+			if strings.EqualFold(key, h) {
+				return strings.ToLower(h), true
+			}
+		}
+
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}